@@ -152,6 +152,8 @@ pub(crate) unsafe fn create_connection_request(
         pubsub_subscriptions: None,
         inflight_requests_limit: None,
         lazy_connect: false,
+        tcp_nodelay: false,
+        tcp_keepalive_interval: None,
     }
 }
 
@@ -650,7 +650,8 @@ impl From<redis::PushKind> for PushKind {
 /// - Dereferences raw pointers
 /// - Calls an FFI function (`pubsub_callback`) that may have undefined behavior
 /// - Creates and destroys vectors via `Vec::from_raw_parts`
-/// - Assumes push_msg.data contains valid BulkString values
+/// - Assumes the BulkString values of push_msg.data are the pattern, channel and message of a message, or the channel
+///   of an unsubscription
 ///
 /// The caller must ensure:
 /// - `pubsub_callback` is a valid function pointer to a properly implemented callback
@@ -661,25 +662,24 @@ unsafe fn process_push_notification(
     pubsub_callback: PubSubCallback,
     client_adapter_ptr: usize,
 ) {
+    // Unsubscriptions also hold the number of remaining subscriptions, and disconnections hold nothing.
     let strings: Vec<(*mut u8, i64)> = push_msg
         .data
         .iter()
-        .map(|v| {
-            let Value::BulkString(str) = v else {
-                unreachable!()
-            };
-            let (ptr, len) = convert_vec_to_pointer(str.clone());
-            (ptr, len)
+        .filter_map(|v| match v {
+            Value::BulkString(str) => Some(convert_vec_to_pointer(str.clone())),
+            _ => None,
         })
         .collect();
 
-    let ((pattern_ptr, pattern_len), (channel, channel_len), (message_ptr, message_len)) = {
-        if strings.len() == 3 {
-            (strings[0], strings[1], strings[2])
-        } else {
-            ((std::ptr::null_mut::<u8>(), 0), strings[0], strings[1])
-        }
-    };
+    let null = (std::ptr::null_mut::<u8>(), 0);
+    let ((pattern_ptr, pattern_len), (channel, channel_len), (message_ptr, message_len)) =
+        match strings.len() {
+            3 => (strings[0], strings[1], strings[2]),
+            2 => (null, strings[0], strings[1]),
+            1 => (null, strings[0], null),
+            _ => (null, null, null),
+        };
 
     // Call the pubsub callback with the push notification data
     unsafe {
@@ -694,8 +694,12 @@ unsafe fn process_push_notification(
             pattern_len,
         );
         // Free memory
-        let _ = Vec::from_raw_parts(message_ptr, message_len as usize, message_len as usize);
-        let _ = Vec::from_raw_parts(channel, channel_len as usize, channel_len as usize);
+        if !message_ptr.is_null() {
+            let _ = Vec::from_raw_parts(message_ptr, message_len as usize, message_len as usize);
+        }
+        if !channel.is_null() {
+            let _ = Vec::from_raw_parts(channel, channel_len as usize, channel_len as usize);
+        }
         if !pattern_ptr.is_null() {
             let _ = Vec::from_raw_parts(pattern_ptr, pattern_len as usize, pattern_len as usize);
         }
@@ -744,10 +748,18 @@ fn create_client_internal(
     if is_subscriber {
         client_adapter.runtime.spawn(async move {
             while let Some(push_msg) = push_rx.recv().await {
-                if push_msg.kind == redis::PushKind::Message
-                    || push_msg.kind == redis::PushKind::PMessage
-                    || push_msg.kind == redis::PushKind::SMessage
-                {
+                // Disconnections and unsubscriptions are forwarded too, so that the subscriptions which are not
+                // part of the connection request can be restored.
+                if matches!(
+                    push_msg.kind,
+                    redis::PushKind::Message
+                        | redis::PushKind::PMessage
+                        | redis::PushKind::SMessage
+                        | redis::PushKind::Disconnection
+                        | redis::PushKind::Unsubscribe
+                        | redis::PushKind::PUnsubscribe
+                        | redis::PushKind::SUnsubscribe
+                ) {
                     unsafe {
                         process_push_notification(push_msg, pubsub_callback, client_adapter_ptr);
                     }
@@ -2000,6 +2012,7 @@ pub struct OpenTelemetryConfig {
 ///   - For file exporter: `file:///absolute/path/to/folder/file.json`
 /// - `has_sample_percentage`: Whether sample percentage is specified
 /// - `sample_percentage`: The percentage of requests to sample and create a span for, used to measure command duration. Only valid if has_sample_percentage is true.
+/// - `min_duration_us`: The minimum duration in microseconds of the exported spans. Sampled spans which are shorter are dropped. Zero exports all the sampled spans.
 #[repr(C)]
 #[derive(Clone, Debug)]
 pub struct OpenTelemetryTracesConfig {
@@ -2009,6 +2022,8 @@ pub struct OpenTelemetryTracesConfig {
     pub has_sample_percentage: bool,
     /// The percentage of requests to sample and create a span for, used to measure command duration. Only valid if has_sample_percentage is true.
     pub sample_percentage: u32,
+    /// The minimum duration in microseconds of the exported spans, zero to export all the sampled spans.
+    pub min_duration_us: u64,
 }
 
 /// Configuration for exporting OpenTelemetry metrics.
@@ -2058,7 +2073,12 @@ pub unsafe extern "C" fn init_open_telemetry(
                     } else {
                         None
                     };
-                config = config.with_trace_exporter(exporter, sample_percentage);
+                let min_duration = std::time::Duration::from_micros(unsafe {
+                    (*(*open_telemetry_config).traces).min_duration_us
+                });
+                config = config
+                    .with_trace_exporter(exporter, sample_percentage)
+                    .with_trace_min_duration(min_duration);
             }
             Err(e) => {
                 let error_msg = format!("Invalid traces exporter configuration: {e}");
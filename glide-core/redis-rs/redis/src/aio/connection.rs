@@ -2,6 +2,7 @@
 
 use super::ConnectionLike;
 use super::{setup_connection, AsyncStream, RedisRuntime};
+use crate::client::TcpSettings;
 use crate::cmd::{cmd, Cmd};
 use crate::connection::{
     resp2_is_pub_sub_state_cleared, resp3_is_pub_sub_state_cleared, ConnectionAddr, ConnectionInfo,
@@ -419,12 +420,13 @@ where
 pub(crate) async fn connect_simple<T: RedisRuntime>(
     connection_info: &ConnectionInfo,
     _socket_addr: Option<SocketAddr>,
+    tcp_settings: TcpSettings,
 ) -> RedisResult<(T, Option<IpAddr>)> {
     Ok(match connection_info.addr {
         ConnectionAddr::Tcp(ref host, port) => {
             if let Some(socket_addr) = _socket_addr {
                 return Ok::<_, RedisError>((
-                    <T>::connect_tcp(socket_addr).await?,
+                    <T>::connect_tcp(socket_addr, tcp_settings).await?,
                     Some(socket_addr.ip()),
                 ));
             }
@@ -433,7 +435,7 @@ pub(crate) async fn connect_simple<T: RedisRuntime>(
                 log_conn_creation("TCP", format!("{host}:{port}"), Some(socket_addr.ip()));
                 Box::pin(async move {
                     Ok::<_, RedisError>((
-                        <T>::connect_tcp(socket_addr).await?,
+                        <T>::connect_tcp(socket_addr, tcp_settings).await?,
                         Some(socket_addr.ip()),
                     ))
                 })
@@ -450,7 +452,8 @@ pub(crate) async fn connect_simple<T: RedisRuntime>(
         } => {
             if let Some(socket_addr) = _socket_addr {
                 return Ok::<_, RedisError>((
-                    <T>::connect_tcp_tls(host, socket_addr, insecure, tls_params).await?,
+                    <T>::connect_tcp_tls(host, socket_addr, insecure, tls_params, tcp_settings)
+                        .await?,
                     Some(socket_addr.ip()),
                 ));
             }
@@ -463,7 +466,8 @@ pub(crate) async fn connect_simple<T: RedisRuntime>(
                 );
                 Box::pin(async move {
                     Ok::<_, RedisError>((
-                        <T>::connect_tcp_tls(host, socket_addr, insecure, tls_params).await?,
+                        <T>::connect_tcp_tls(host, socket_addr, insecure, tls_params, tcp_settings)
+                        .await?,
                         Some(socket_addr.ip()),
                     ))
                 })
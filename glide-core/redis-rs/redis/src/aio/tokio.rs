@@ -19,24 +19,44 @@ use crate::connection::create_rustls_config;
 use std::sync::Arc;
 use tokio_rustls::{client::TlsStream, TlsConnector};
 
+use crate::client::TcpSettings;
 use crate::tls::TlsConnParams;
 
 #[cfg(unix)]
 use super::Path;
 
 #[inline(always)]
-async fn connect_tcp(addr: &SocketAddr) -> io::Result<TcpStreamTokio> {
+async fn connect_tcp(addr: &SocketAddr, tcp_settings: TcpSettings) -> io::Result<TcpStreamTokio> {
     let socket = TcpStreamTokio::connect(addr).await?;
     #[cfg(feature = "tcp_nodelay")]
     socket.set_nodelay(true)?;
+    if tcp_settings.nodelay {
+        socket.set_nodelay(true)?;
+    }
     #[cfg(feature = "keep-alive")]
     {
-        //For now rely on system defaults
-        const KEEP_ALIVE: socket2::TcpKeepalive = socket2::TcpKeepalive::new();
+        // Rely on system defaults unless a keepalive interval is configured
+        let mut keep_alive = socket2::TcpKeepalive::new();
+        if let Some(interval) = tcp_settings.keepalive_interval {
+            keep_alive = keep_alive.with_time(interval);
+            #[cfg(any(
+                target_os = "android",
+                target_os = "freebsd",
+                target_os = "fuchsia",
+                target_os = "ios",
+                target_os = "linux",
+                target_os = "macos",
+                target_os = "netbsd",
+                target_os = "windows",
+            ))]
+            {
+                keep_alive = keep_alive.with_interval(interval);
+            }
+        }
         //these are useless error that not going to happen
         let std_socket = socket.into_std()?;
         let socket2: socket2::Socket = std_socket.into();
-        socket2.set_tcp_keepalive(&KEEP_ALIVE)?;
+        socket2.set_tcp_keepalive(&keep_alive)?;
         // TCP_USER_TIMEOUT configuration isn't supported across all operation systems
         #[cfg(any(target_os = "android", target_os = "fuchsia", target_os = "linux"))]
         {
@@ -113,8 +133,10 @@ impl AsyncRead for Tokio {
 
 #[async_trait]
 impl RedisRuntime for Tokio {
-    async fn connect_tcp(socket_addr: SocketAddr) -> RedisResult<Self> {
-        Ok(connect_tcp(&socket_addr).await.map(Tokio::Tcp)?)
+    async fn connect_tcp(socket_addr: SocketAddr, tcp_settings: TcpSettings) -> RedisResult<Self> {
+        Ok(connect_tcp(&socket_addr, tcp_settings)
+            .await
+            .map(Tokio::Tcp)?)
     }
 
     async fn connect_tcp_tls(
@@ -122,6 +144,7 @@ impl RedisRuntime for Tokio {
         socket_addr: SocketAddr,
         insecure: bool,
         tls_params: &Option<TlsConnParams>,
+        tcp_settings: TcpSettings,
     ) -> RedisResult<Self> {
         let config = create_rustls_config(insecure, tls_params.clone())?;
         let tls_connector = TlsConnector::from(Arc::new(config));
@@ -129,7 +152,7 @@ impl RedisRuntime for Tokio {
         Ok(tls_connector
             .connect(
                 rustls_pki_types::ServerName::try_from(hostname)?.to_owned(),
-                connect_tcp(&socket_addr).await?,
+                connect_tcp(&socket_addr, tcp_settings).await?,
             )
             .await
             .map(|con| Tokio::TcpTls(Box::new(con)))?)
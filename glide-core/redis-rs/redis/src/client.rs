@@ -78,6 +78,16 @@ impl Client {
     }
 }
 
+/// TCP socket options of a connection
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq)]
+pub struct TcpSettings {
+    /// Disables Nagle's algorithm on the socket, so that small writes are sent immediately.
+    pub nodelay: bool,
+    /// Idle time before TCP keepalive probes are sent, which is also used as the interval between probes.
+    /// If `None`, the system defaults are used.
+    pub keepalive_interval: Option<Duration>,
+}
+
 /// Glide-specific connection options
 #[derive(Clone, Default)]
 pub struct GlideConnectionOptions {
@@ -96,6 +106,8 @@ pub struct GlideConnectionOptions {
     pub connection_timeout: Option<Duration>,
     /// Retry strategy configuration for reconnect attempts.
     pub connection_retry_strategy: Option<RetryStrategy>,
+    /// TCP socket options applied to the connection.
+    pub tcp_settings: TcpSettings,
 }
 
 /// To enable async support you need to enable the feature: `tokio-comp`
@@ -115,8 +127,11 @@ impl Client {
         let (con, _ip) = match Runtime::locate() {
             #[cfg(feature = "tokio-comp")]
             Runtime::Tokio => {
-                self.get_simple_async_connection::<crate::aio::tokio::Tokio>(None)
-                    .await?
+                self.get_simple_async_connection::<crate::aio::tokio::Tokio>(
+                    None,
+                    TcpSettings::default(),
+                )
+                .await?
             }
         };
 
@@ -427,7 +442,9 @@ impl Client {
     where
         T: crate::aio::RedisRuntime,
     {
-        let (con, ip) = self.get_simple_async_connection::<T>(socket_addr).await?;
+        let (con, ip) = self
+            .get_simple_async_connection::<T>(socket_addr, glide_connection_options.tcp_settings)
+            .await?;
         crate::aio::MultiplexedConnection::new_with_response_timeout(
             &self.connection_info,
             con,
@@ -441,6 +458,7 @@ impl Client {
     async fn get_simple_async_connection<T>(
         &self,
         socket_addr: Option<SocketAddr>,
+        tcp_settings: TcpSettings,
     ) -> RedisResult<(
         Pin<Box<dyn crate::aio::AsyncStream + Send + Sync>>,
         Option<IpAddr>,
@@ -449,7 +467,8 @@ impl Client {
         T: crate::aio::RedisRuntime,
     {
         let (conn, ip) =
-            crate::aio::connect_simple::<T>(&self.connection_info, socket_addr).await?;
+            crate::aio::connect_simple::<T>(&self.connection_info, socket_addr, tcp_settings)
+                .await?;
         Ok((conn.boxed(), ip))
     }
 
@@ -1116,6 +1116,7 @@ where
             discover_az,
             connection_timeout: Some(cluster_params.connection_timeout),
             connection_retry_strategy: Some(connection_retry_strategy),
+            tcp_settings: cluster_params.tcp_settings,
         };
 
         let connections = Self::create_initial_connections(
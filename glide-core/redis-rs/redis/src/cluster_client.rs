@@ -6,7 +6,7 @@ use crate::cluster_topology::{
 use crate::connection::{ConnectionAddr, ConnectionInfo, IntoConnectionInfo};
 use crate::types::{ErrorKind, ProtocolVersion, RedisError, RedisResult};
 use crate::{cluster, cluster::TlsMode};
-use crate::{PubSubSubscriptionInfo, PushInfo, RetryStrategy};
+use crate::{PubSubSubscriptionInfo, PushInfo, RetryStrategy, TcpSettings};
 use rand::Rng;
 #[cfg(feature = "cluster-async")]
 use std::ops::Add;
@@ -33,6 +33,7 @@ struct BuilderParams {
     certs: Option<TlsCertificates>,
     retries_configuration: RetryParams,
     connection_timeout: Option<Duration>,
+    tcp_settings: TcpSettings,
     #[cfg(feature = "cluster-async")]
     topology_checks_interval: Option<Duration>,
     #[cfg(feature = "cluster-async")]
@@ -140,6 +141,7 @@ pub struct ClusterParams {
     pub(crate) tls_params: Option<TlsConnParams>,
     pub(crate) client_name: Option<String>,
     pub(crate) connection_timeout: Duration,
+    pub(crate) tcp_settings: TcpSettings,
     pub(crate) response_timeout: Duration,
     pub(crate) protocol: ProtocolVersion,
     pub(crate) pubsub_subscriptions: Option<PubSubSubscriptionInfo>,
@@ -161,6 +163,7 @@ impl ClusterParams {
             tls: value.tls,
             retry_params: value.retries_configuration,
             connection_timeout: value.connection_timeout.unwrap_or(Duration::MAX),
+            tcp_settings: value.tcp_settings,
             #[cfg(feature = "cluster-async")]
             topology_checks_interval: value.topology_checks_interval,
             #[cfg(feature = "cluster-async")]
@@ -475,6 +478,12 @@ impl ClusterClientBuilder {
         self
     }
 
+    /// Sets the TCP socket options of the connections to the nodes.
+    pub fn tcp_settings(mut self, tcp_settings: TcpSettings) -> ClusterClientBuilder {
+        self.builder_params.tcp_settings = tcp_settings;
+        self
+    }
+
     /// Enables timing out on slow responses.
     ///
     /// If enabled, the cluster will only wait the given time to each response from each node.
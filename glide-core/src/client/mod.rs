@@ -867,6 +867,15 @@ fn to_duration(time_in_millis: Option<u32>, default: Duration) -> Duration {
         .unwrap_or(default)
 }
 
+pub(crate) fn tcp_settings(request: &ConnectionRequest) -> redis::TcpSettings {
+    redis::TcpSettings {
+        nodelay: request.tcp_nodelay,
+        keepalive_interval: request
+            .tcp_keepalive_interval
+            .map(|val| Duration::from_millis(val as u64)),
+    }
+}
+
 async fn create_cluster_client(
     request: ConnectionRequest,
     push_sender: Option<mpsc::UnboundedSender<PushInfo>>,
@@ -874,6 +883,7 @@ async fn create_cluster_client(
     // TODO - implement timeout for each connection attempt
     let tls_mode = request.tls_mode.unwrap_or_default();
     let redis_connection_info = get_redis_connection_info(&request);
+    let socket_settings = tcp_settings(&request);
     let initial_nodes: Vec<_> = request
         .addresses
         .into_iter()
@@ -888,6 +898,7 @@ async fn create_cluster_client(
     let connection_timeout = to_duration(request.connection_timeout, DEFAULT_CONNECTION_TIMEOUT);
     let mut builder = redis::cluster::ClusterClientBuilder::new(initial_nodes)
         .connection_timeout(connection_timeout)
+        .tcp_settings(socket_settings)
         .retries(DEFAULT_RETRIES);
     let read_from_strategy = request.read_from.unwrap_or_default();
     builder = builder.read_from(match read_from_strategy {
@@ -1052,6 +1063,13 @@ fn sanitized_request_string(request: &ConnectionRequest) -> String {
     let request_timeout = format_optional_value("Request timeout", request.request_timeout);
     let connection_timeout =
         format_optional_value("Connection timeout", request.connection_timeout);
+    let tcp_nodelay = if request.tcp_nodelay {
+        "\nTCP nodelay"
+    } else {
+        ""
+    };
+    let tcp_keepalive_interval =
+        format_optional_value("TCP keepalive interval", request.tcp_keepalive_interval);
     let database_id = format!("\ndatabase ID: {}", request.database_id);
     let rfr_strategy = request
         .read_from
@@ -1109,7 +1127,7 @@ fn sanitized_request_string(request: &ConnectionRequest) -> String {
     );
 
     format!(
-        "\nAddresses: {addresses}{tls_mode}{cluster_mode}{request_timeout}{connection_timeout}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}",
+        "\nAddresses: {addresses}{tls_mode}{cluster_mode}{request_timeout}{connection_timeout}{tcp_nodelay}{tcp_keepalive_interval}{rfr_strategy}{connection_retry_strategy}{database_id}{protocol}{client_name}{periodic_checks}{pubsub_subscriptions}{inflight_requests_limit}",
     )
 }
 
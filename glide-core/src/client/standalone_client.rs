@@ -3,7 +3,7 @@
 use super::get_redis_connection_info;
 use super::reconnecting_connection::{ReconnectReason, ReconnectingConnection};
 use super::{ConnectionRequest, NodeAddress, TlsMode};
-use super::{DEFAULT_CONNECTION_TIMEOUT, to_duration};
+use super::{DEFAULT_CONNECTION_TIMEOUT, tcp_settings, to_duration};
 use crate::client::types::ReadFrom as ClientReadFrom;
 use futures::{StreamExt, future, stream};
 use logger_core::log_debug;
@@ -148,6 +148,7 @@ impl StandaloneClient {
             connection_request.connection_timeout,
             DEFAULT_CONNECTION_TIMEOUT,
         );
+        let socket_settings = tcp_settings(&connection_request);
 
         let mut stream = stream::iter(connection_request.addresses.into_iter())
             .map(move |address| {
@@ -163,7 +164,14 @@ impl StandaloneClient {
                 let timeout = connection_timeout;
                 async move {
                     get_connection_and_replication_info(
-                        &address, &retry, &info, tls, &sender, discover, timeout,
+                        &address,
+                        &retry,
+                        &info,
+                        tls,
+                        &sender,
+                        discover,
+                        timeout,
+                        socket_settings,
                     )
                     .await
                     .map_err(|err| (format!("{}:{}", address.host, address.port), err))
@@ -633,6 +641,7 @@ impl StandaloneClient {
     }
 }
 
+#[allow(clippy::too_many_arguments)]
 async fn get_connection_and_replication_info(
     address: &NodeAddress,
     retry_strategy: &RetryStrategy,
@@ -641,6 +650,7 @@ async fn get_connection_and_replication_info(
     push_sender: &Option<mpsc::UnboundedSender<PushInfo>>,
     discover_az: bool,
     connection_timeout: Duration,
+    tcp_settings: redis::TcpSettings,
 ) -> Result<(ReconnectingConnection, Value), (ReconnectingConnection, RedisError)> {
     let result = ReconnectingConnection::new(
         address,
@@ -650,6 +660,7 @@ async fn get_connection_and_replication_info(
         push_sender.clone(),
         discover_az,
         connection_timeout,
+        tcp_settings,
     )
     .await;
     let reconnecting_connection = match result {
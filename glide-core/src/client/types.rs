@@ -26,6 +26,8 @@ pub struct ConnectionRequest {
     pub pubsub_subscriptions: Option<redis::PubSubSubscriptionInfo>,
     pub inflight_requests_limit: Option<u32>,
     pub lazy_connect: bool,
+    pub tcp_nodelay: bool,
+    pub tcp_keepalive_interval: Option<u32>,
 }
 
 #[derive(PartialEq, Eq, Clone, Default, Debug)]
@@ -224,6 +226,8 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
 
         let inflight_requests_limit = none_if_zero(value.inflight_requests_limit);
         let lazy_connect = value.lazy_connect;
+        let tcp_nodelay = value.tcp_nodelay;
+        let tcp_keepalive_interval = none_if_zero(value.tcp_keepalive_interval);
 
         ConnectionRequest {
             read_from,
@@ -241,6 +245,8 @@ impl From<protobuf::ConnectionRequest> for ConnectionRequest {
             pubsub_subscriptions,
             inflight_requests_limit,
             lazy_connect,
+            tcp_nodelay,
+            tcp_keepalive_interval,
         }
     }
 }
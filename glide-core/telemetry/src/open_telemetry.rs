@@ -3,7 +3,8 @@ use opentelemetry::global::ObjectSafeSpan;
 use opentelemetry::trace::{SpanKind, TraceContextExt, TraceError};
 use opentelemetry::{global, trace::Tracer};
 use opentelemetry_otlp::{MetricExporter, Protocol, WithExportConfig};
-use opentelemetry_sdk::export::trace::SpanExporter;
+use futures_util::future::BoxFuture;
+use opentelemetry_sdk::export::trace::{ExportResult, SpanData, SpanExporter};
 use opentelemetry_sdk::metrics::{MetricError, SdkMeterProvider};
 use opentelemetry_sdk::propagation::TraceContextPropagator;
 use opentelemetry_sdk::runtime::Tokio;
@@ -376,6 +377,8 @@ pub struct GlideOpenTelemetryTracesConfig {
     trace_exporter: GlideOpenTelemetrySignalsExporter,
     /// The percentage of requests to sample and create a span for, used to measure command duration.
     trace_sample_percentage: u32,
+    /// Spans shorter than this duration are not exported. Zero exports all the sampled spans.
+    trace_min_duration: Duration,
 }
 
 #[derive(Clone, Debug)]
@@ -434,10 +437,22 @@ impl GlideOpenTelemetryConfigBuilder {
         self.traces_config = Some(GlideOpenTelemetryTracesConfig {
             trace_exporter: exporter,
             trace_sample_percentage: sample_percentage.unwrap_or(DEFAULT_TRACE_SAMPLE_PERCENTAGE),
+            trace_min_duration: Duration::ZERO,
         });
         self
     }
 
+    /// Configure the minimum duration of the exported spans, so that only slow commands are traced
+    ///
+    /// - `duration`: Spans shorter than this duration are dropped instead of being exported. Has no effect unless the
+    ///   trace exporter is configured first.
+    pub fn with_trace_min_duration(mut self, duration: Duration) -> Self {
+        if let Some(traces_config) = self.traces_config.as_mut() {
+            traces_config.trace_min_duration = duration;
+        }
+        self
+    }
+
     /// Configure the metrics exporter
     ///
     /// - `exporter`: The exporter endpoint to use for metrics data.
@@ -459,13 +474,68 @@ impl GlideOpenTelemetryConfigBuilder {
 
 fn build_span_exporter(
     batch_config: BatchConfig,
+    min_duration: Duration,
     exporter: impl SpanExporter + 'static,
 ) -> BatchSpanProcessor<Tokio> {
-    BatchSpanProcessor::builder(exporter, Tokio)
+    if min_duration.is_zero() {
+        return BatchSpanProcessor::builder(exporter, Tokio)
+            .with_batch_config(batch_config)
+            .build();
+    }
+    BatchSpanProcessor::builder(MinDurationSpanExporter::new(exporter, min_duration), Tokio)
         .with_batch_config(batch_config)
         .build()
 }
 
+/// A span exporter dropping the spans shorter than a minimum duration before passing the others to another exporter.
+#[derive(Debug)]
+struct MinDurationSpanExporter<E> {
+    exporter: E,
+    min_duration: Duration,
+}
+
+impl<E: SpanExporter> MinDurationSpanExporter<E> {
+    fn new(exporter: E, min_duration: Duration) -> Self {
+        MinDurationSpanExporter {
+            exporter,
+            min_duration,
+        }
+    }
+
+    fn is_exported(&self, span: &SpanData) -> bool {
+        match span.end_time.duration_since(span.start_time) {
+            Ok(duration) => duration >= self.min_duration,
+            // A span whose end precedes its start, e.g. after a clock adjustment, is exported rather than lost.
+            Err(_) => true,
+        }
+    }
+}
+
+impl<E: SpanExporter> SpanExporter for MinDurationSpanExporter<E> {
+    fn export(&mut self, batch: Vec<SpanData>) -> BoxFuture<'static, ExportResult> {
+        let batch: Vec<SpanData> = batch
+            .into_iter()
+            .filter(|span| self.is_exported(span))
+            .collect();
+        if batch.is_empty() {
+            return Box::pin(std::future::ready(Ok(())));
+        }
+        self.exporter.export(batch)
+    }
+
+    fn shutdown(&mut self) {
+        self.exporter.shutdown()
+    }
+
+    fn force_flush(&mut self) -> BoxFuture<'static, ExportResult> {
+        self.exporter.force_flush()
+    }
+
+    fn set_resource(&mut self, resource: &opentelemetry_sdk::Resource) {
+        self.exporter.set_resource(resource)
+    }
+}
+
 #[derive(Clone)]
 pub struct GlideOpenTelemetry {}
 
@@ -489,6 +559,7 @@ impl GlideOpenTelemetry {
             if let Some(traces_config) = config.traces.as_ref() {
                 Self::initialise_trace_exporter(
                     config.flush_interval_ms,
+                    traces_config.trace_min_duration,
                     &traces_config.trace_exporter,
                 )?;
             }
@@ -536,6 +607,7 @@ impl GlideOpenTelemetry {
     /// Initialize the trace exporter based on the configuration
     fn initialise_trace_exporter(
         flush_interval_ms: Duration,
+        min_duration: Duration,
         trace_exporter: &GlideOpenTelemetrySignalsExporter,
     ) -> Result<(), GlideOTELError> {
         let batch_config = opentelemetry_sdk::trace::BatchConfigBuilder::default()
@@ -547,7 +619,7 @@ impl GlideOpenTelemetry {
                 let exporter = crate::SpanExporterFile::new(p.clone()).map_err(|e| {
                     GlideOTELError::Other(format!("Failed to create traces exporter: {}", e))
                 })?;
-                build_span_exporter(batch_config, exporter)
+                build_span_exporter(batch_config, min_duration, exporter)
             }
             GlideOpenTelemetrySignalsExporter::Http(url) => {
                 let exporter = opentelemetry_otlp::SpanExporter::builder()
@@ -555,7 +627,7 @@ impl GlideOpenTelemetry {
                     .with_endpoint(url)
                     .with_protocol(Protocol::HttpBinary)
                     .build()?;
-                build_span_exporter(batch_config, exporter)
+                build_span_exporter(batch_config, min_duration, exporter)
             }
             GlideOpenTelemetrySignalsExporter::Grpc(url) => {
                 let exporter = opentelemetry_otlp::SpanExporter::builder()
@@ -563,7 +635,7 @@ impl GlideOpenTelemetry {
                     .with_endpoint(url)
                     .with_protocol(Protocol::Grpc)
                     .build()?;
-                build_span_exporter(batch_config, exporter)
+                build_span_exporter(batch_config, min_duration, exporter)
             }
         };
 
@@ -1035,4 +1107,27 @@ mod tests {
             assert!(status.contains("simple error"));
         });
     }
+
+    #[test]
+    fn test_trace_min_duration() {
+        let exporter = GlideOpenTelemetrySignalsExporter::File(PathBuf::from(SPANS_JSON));
+        let config = GlideOpenTelemetryConfigBuilder::default()
+            .with_trace_exporter(exporter.clone(), Some(10))
+            .with_trace_min_duration(Duration::from_millis(5))
+            .build();
+        let traces_config = config.traces.expect("traces are configured");
+        assert_eq!(traces_config.trace_min_duration, Duration::from_millis(5));
+        assert_eq!(traces_config.trace_sample_percentage, 10);
+
+        let config = GlideOpenTelemetryConfigBuilder::default()
+            .with_trace_exporter(exporter, None)
+            .build();
+        assert!(config.traces.unwrap().trace_min_duration.is_zero());
+
+        // Without traces, the minimum duration is ignored.
+        let config = GlideOpenTelemetryConfigBuilder::default()
+            .with_trace_min_duration(Duration::from_millis(5))
+            .build();
+        assert!(config.traces.is_none());
+    }
 }
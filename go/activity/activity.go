@@ -0,0 +1,180 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package activity provides daily-active-user analytics built on bitmaps with a Valkey GLIDE client. Every user ID
+// maps to a bit of a daily bitmap. Since a bitmap holds at most 2^32 bits, the bitmaps are partitioned, so that any
+// non-negative int64 user ID can be tracked.
+package activity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// DefaultPartitionSize is the number of user IDs per bitmap if none is set with [Tracker.WithPartitionSize]. A full
+// partition takes 1 MiB.
+const DefaultPartitionSize = 1 << 23
+
+const dayLayout = "2006-01-02"
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the tracker.
+type Client interface {
+	SetBit(ctx context.Context, key string, offset int64, value int64) (int64, error)
+	GetBit(ctx context.Context, key string, offset int64) (int64, error)
+	BitCount(ctx context.Context, key string) (int64, error)
+	BitOp(ctx context.Context, bitwiseOperation options.BitOpType, destination string, keys []string) (int64, error)
+	Del(ctx context.Context, keys []string) (int64, error)
+	SAdd(ctx context.Context, key string, members []string) (int64, error)
+	SMembers(ctx context.Context, key string) (map[string]struct{}, error)
+}
+
+// Tracker records which users were active on which days.
+type Tracker struct {
+	client        Client
+	name          string
+	partitionSize int64
+}
+
+// New returns a [Tracker] with the given name. The bitmaps are stored at "{name:<partition>}:<day>", where day is
+// formatted as "2006-01-02" in UTC, so that the bitmaps of a partition share a hash slot in cluster mode. The set of
+// used partitions is stored at "name:partitions".
+//
+// Parameters:
+//
+//	client - The client used to store the bitmaps, e.g. a glide.Client or glide.ClusterClient.
+//	name - The name of the tracker.
+func New(client Client, name string) *Tracker {
+	return &Tracker{client: client, name: name, partitionSize: DefaultPartitionSize}
+}
+
+// WithPartitionSize sets the number of user IDs per bitmap, at most 2^32. It must not change once users are marked
+// active. If not explicitly set, [DefaultPartitionSize] is used.
+func (tracker *Tracker) WithPartitionSize(partitionSize int64) *Tracker {
+	tracker.partitionSize = partitionSize
+	return tracker
+}
+
+func (tracker *Tracker) partitionsKey() string {
+	return tracker.name + ":partitions"
+}
+
+func (tracker *Tracker) key(partition string, day time.Time) string {
+	return fmt.Sprintf("{%s:%s}:%s", tracker.name, partition, day.UTC().Format(dayLayout))
+}
+
+// locate returns the partition and the offset within it of a user ID.
+func (tracker *Tracker) locate(userID int64) (string, int64, error) {
+	if userID < 0 {
+		return "", 0, errors.New("user IDs must not be negative")
+	}
+	if tracker.partitionSize <= 0 || tracker.partitionSize > 1<<32 {
+		return "", 0, errors.New("the partition size must be between 1 and 2^32")
+	}
+	return strconv.FormatInt(userID/tracker.partitionSize, 10), userID % tracker.partitionSize, nil
+}
+
+// MarkActive records that a user was active on a day.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	userID - The non-negative ID of the user.
+//	day - Any time within the day, which is taken in UTC.
+func (tracker *Tracker) MarkActive(ctx context.Context, userID int64, day time.Time) error {
+	partition, offset, err := tracker.locate(userID)
+	if err != nil {
+		return err
+	}
+	if _, err := tracker.client.SetBit(ctx, tracker.key(partition, day), offset, 1); err != nil {
+		return err
+	}
+	_, err = tracker.client.SAdd(ctx, tracker.partitionsKey(), []string{partition})
+	return err
+}
+
+// IsActive returns whether a user was active on a day.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	userID - The non-negative ID of the user.
+//	day - Any time within the day, which is taken in UTC.
+func (tracker *Tracker) IsActive(ctx context.Context, userID int64, day time.Time) (bool, error) {
+	partition, offset, err := tracker.locate(userID)
+	if err != nil {
+		return false, err
+	}
+	bit, err := tracker.client.GetBit(ctx, tracker.key(partition, day), offset)
+	return bit == 1, err
+}
+
+// CountActive returns the number of users active on a day.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	day - Any time within the day, which is taken in UTC.
+func (tracker *Tracker) CountActive(ctx context.Context, day time.Time) (int64, error) {
+	partitions, err := tracker.client.SMembers(ctx, tracker.partitionsKey())
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for partition := range partitions {
+		count, err := tracker.client.BitCount(ctx, tracker.key(partition, day))
+		if err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// ActiveInAll returns the number of users active on every one of the given days, e.g. to measure retention.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	days - Times within the days, which are taken in UTC.
+func (tracker *Tracker) ActiveInAll(ctx context.Context, days ...time.Time) (int64, error) {
+	if len(days) == 0 {
+		return 0, nil
+	}
+	partitions, err := tracker.client.SMembers(ctx, tracker.partitionsKey())
+	if err != nil {
+		return 0, err
+	}
+	var total int64
+	for partition := range partitions {
+		count, err := tracker.countAll(ctx, partition, days)
+		if err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// countAll counts the users of a partition active on all days, intersecting the bitmaps into a temporary key.
+func (tracker *Tracker) countAll(ctx context.Context, partition string, days []time.Time) (int64, error) {
+	keys := make([]string, len(days))
+	for i, day := range days {
+		keys[i] = tracker.key(partition, day)
+	}
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return 0, err
+	}
+	destination := fmt.Sprintf("{%s:%s}:tmp:%s", tracker.name, partition, hex.EncodeToString(suffix))
+	if _, err := tracker.client.BitOp(ctx, options.AND, destination, keys); err != nil {
+		return 0, err
+	}
+	defer func() { _, _ = tracker.client.Del(context.WithoutCancel(ctx), []string{destination}) }()
+	return tracker.client.BitCount(ctx, destination)
+}
@@ -0,0 +1,27 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package activity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+)
+
+func TestTrackerLocate(t *testing.T) {
+	tracker := New(nil, "dau")
+	partition, offset, err := tracker.locate(1<<62 + 5)
+	assert.NoError(t, err)
+	assert.Equal(t, "549755813888", partition)
+	assert.Equal(t, int64(5), offset)
+
+	_, _, err = tracker.locate(-1)
+	assert.Error(t, err)
+	_, _, err = tracker.WithPartitionSize(1 << 33).locate(1)
+	assert.Error(t, err)
+
+	day := time.Date(2024, 3, 1, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+	assert.Equal(t, "{dau:7}:2024-03-02", tracker.key("7", day))
+}
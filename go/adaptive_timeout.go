@@ -0,0 +1,127 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// latencyWindowSize is the number of recent latencies kept per command family.
+	latencyWindowSize = 256
+	// minLatencySamples is the number of latencies needed before the percentile is used instead of the ceiling.
+	minLatencySamples = 32
+	// latencyRecomputeInterval is the number of new latencies after which the deadline of a family is recomputed.
+	latencyRecomputeInterval = 16
+)
+
+// blockingRequestTypes are excluded from the adaptive timeout, since their latency depends on their arguments rather
+// than on the server.
+var blockingRequestTypes = map[C.RequestType]struct{}{
+	C.BLMove:     {},
+	C.BLMPop:     {},
+	C.BLPop:      {},
+	C.BRPop:      {},
+	C.BZMPop:     {},
+	C.BZPopMax:   {},
+	C.BZPopMin:   {},
+	C.XRead:      {},
+	C.XReadGroup: {},
+	C.Wait:       {},
+}
+
+// latencyWindow holds the recent latencies of a command family and the deadline derived from them.
+type latencyWindow struct {
+	latencies [latencyWindowSize]time.Duration
+	next      int
+	count     int
+	sinceLast int
+	deadline  time.Duration
+}
+
+// adaptiveTimeout derives per command family deadlines from observed latencies. It is shared by pointer between the
+// copies of a client.
+type adaptiveTimeout struct {
+	percentile float64
+	floor      time.Duration
+	ceiling    time.Duration
+
+	mu      sync.Mutex
+	windows map[string]*latencyWindow
+}
+
+func newAdaptiveTimeout(percentile float64, floor time.Duration, ceiling time.Duration) *adaptiveTimeout {
+	return &adaptiveTimeout{
+		percentile: percentile,
+		floor:      floor,
+		ceiling:    ceiling,
+		windows:    make(map[string]*latencyWindow),
+	}
+}
+
+func adaptiveTimeoutApplies(requestType C.RequestType) bool {
+	if requestType == C.CustomCommand {
+		return false
+	}
+	_, blocking := blockingRequestTypes[requestType]
+	return !blocking && commandFamily(uint32(requestType)) != "Scripting"
+}
+
+// deadline returns the timeout for the next command of the given family.
+func (timeout *adaptiveTimeout) deadline(family string) time.Duration {
+	timeout.mu.Lock()
+	defer timeout.mu.Unlock()
+	window, ok := timeout.windows[family]
+	if !ok || window.count < minLatencySamples {
+		return timeout.ceiling
+	}
+	return window.deadline
+}
+
+// observe records the latency of a successful command of the given family.
+func (timeout *adaptiveTimeout) observe(family string, latency time.Duration) {
+	timeout.mu.Lock()
+	defer timeout.mu.Unlock()
+	window, ok := timeout.windows[family]
+	if !ok {
+		window = &latencyWindow{}
+		timeout.windows[family] = window
+	}
+	window.latencies[window.next] = latency
+	window.next = (window.next + 1) % latencyWindowSize
+	window.count = min(window.count+1, latencyWindowSize)
+	window.sinceLast++
+	if window.count >= minLatencySamples && (window.deadline == 0 || window.sinceLast >= latencyRecomputeInterval) {
+		window.deadline = timeout.compute(window)
+		window.sinceLast = 0
+	}
+}
+
+func (timeout *adaptiveTimeout) compute(window *latencyWindow) time.Duration {
+	sorted := slices.Clone(window.latencies[:window.count])
+	slices.Sort(sorted)
+	idx := int(math.Ceil(timeout.percentile/100*float64(len(sorted)))) - 1
+	idx = max(0, min(idx, len(sorted)-1))
+	return max(timeout.floor, min(sorted[idx], timeout.ceiling))
+}
+
+// withDeadline returns a context bounded by the adaptive deadline of the family, unless the given context already
+// has an earlier deadline. The returned cancel function must always be called.
+func (timeout *adaptiveTimeout) withDeadline(
+	ctx context.Context,
+	family string,
+) (context.Context, context.CancelFunc, time.Duration) {
+	limit := timeout.deadline(family)
+	if existing, ok := ctx.Deadline(); ok && time.Until(existing) <= limit {
+		return ctx, func() {}, 0
+	}
+	adaptiveCtx, cancel := context.WithTimeout(ctx, limit)
+	return adaptiveCtx, cancel, limit
+}
@@ -0,0 +1,59 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveTimeoutUsesCeilingUntilEnoughSamples(t *testing.T) {
+	timeout := newAdaptiveTimeout(99, time.Millisecond, time.Second)
+	assert.Equal(t, time.Second, timeout.deadline("String"))
+
+	for i := 0; i < minLatencySamples-1; i++ {
+		timeout.observe("String", 10*time.Millisecond)
+	}
+	assert.Equal(t, time.Second, timeout.deadline("String"))
+
+	timeout.observe("String", 10*time.Millisecond)
+	assert.Equal(t, 10*time.Millisecond, timeout.deadline("String"))
+	assert.Equal(t, time.Second, timeout.deadline("Hash"))
+}
+
+func TestAdaptiveTimeoutPercentileIsBounded(t *testing.T) {
+	timeout := newAdaptiveTimeout(90, 5*time.Millisecond, 50*time.Millisecond)
+	// the deadline is recomputed every latencyRecomputeInterval latencies, so observe a multiple of it
+	for i := 1; i <= 80; i++ {
+		timeout.observe("String", time.Duration(i)*time.Millisecond/2)
+	}
+	// the 90th percentile of 0.5ms..40ms is 36ms
+	assert.Equal(t, 36*time.Millisecond, timeout.deadline("String"))
+
+	for i := 0; i < latencyWindowSize; i++ {
+		timeout.observe("Hash", time.Microsecond)
+		timeout.observe("List", time.Minute)
+	}
+	assert.Equal(t, 5*time.Millisecond, timeout.deadline("Hash"))
+	assert.Equal(t, 50*time.Millisecond, timeout.deadline("List"))
+}
+
+func TestAdaptiveTimeoutKeepsEarlierDeadline(t *testing.T) {
+	timeout := newAdaptiveTimeout(99, time.Millisecond, time.Second)
+
+	parent, cancelParent := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelParent()
+	ctx, cancel, limit := timeout.withDeadline(parent, "String")
+	defer cancel()
+	assert.Equal(t, parent, ctx)
+	assert.Zero(t, limit)
+
+	ctx, cancel, limit = timeout.withDeadline(context.Background(), "String")
+	defer cancel()
+	_, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, limit)
+}
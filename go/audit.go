@@ -0,0 +1,52 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// audit reports a command and its redacted arguments to the audit hook, if any.
+func (client *baseClient) audit(requestType C.RequestType, args []string, batch bool, err error) {
+	if client.auditHook == nil {
+		return
+	}
+	name, args := commandName(requestType, args)
+	client.auditHook(config.AuditRecord{
+		Command: name,
+		Args:    client.auditRedaction.Redact(name, args),
+		Batch:   batch,
+		Err:     err,
+	})
+}
+
+// auditBatch reports every command of a batch to the audit hook, if any.
+func (client *baseClient) auditBatch(batch internal.Batch, err error) {
+	if client.auditHook == nil {
+		return
+	}
+	for _, cmd := range batch.Commands {
+		client.audit(C.RequestType(cmd.RequestType), cmd.Args, true, err)
+	}
+}
+
+// auditScript reports a script invocation to the audit hook, if any, as the EVALSHA command it is sent as.
+func (client *baseClient) auditScript(hash string, keys []string, args []string, err error) {
+	if client.auditHook == nil {
+		return
+	}
+	evalArgs := make([]string, 0, 2+len(keys)+len(args))
+	evalArgs = append(evalArgs, hash, utils.IntToString(int64(len(keys))))
+	evalArgs = append(evalArgs, keys...)
+	evalArgs = append(evalArgs, args...)
+	client.auditHook(config.AuditRecord{
+		Command: "EVALSHA",
+		Args:    client.auditRedaction.Redact("EVALSHA", evalArgs),
+		Err:     err,
+	})
+}
@@ -24,8 +24,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
+	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 	"unsafe"
@@ -37,6 +40,7 @@ import (
 	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -49,6 +53,25 @@ type payload struct {
 
 type clientConfiguration interface {
 	ToProtobuf() (*protobuf.ConnectionRequest, error)
+	GetMaxPendingCommands() int
+	GetResolver() config.Resolver
+	GetDNSRefreshInterval() time.Duration
+	GetIPPreference() config.IPPreference
+	GetHeartbeat() (time.Duration, int)
+	GetMetricsHook() config.MetricsHook
+	GetAuditHook() (config.AuditHook, *config.Redaction)
+	GetAdaptiveTimeout() (float64, time.Duration, time.Duration)
+	GetCircuitBreaker() *config.CircuitBreaker
+	GetStrictValidation() bool
+	GetTransformers() []config.Transformer
+	GetReadCoalescing() bool
+	GetMaxRequestSize() int
+	GetAllowedCommands() []string
+	GetDeniedCommands() []string
+	GetQuotaHook() config.QuotaHook
+	GetMapKeyPolicy() config.MapKeyPolicy
+	GetProfilerLabels() bool
+	GetSlowCommandLog() (time.Duration, int)
 }
 
 type baseClient struct {
@@ -56,6 +79,50 @@ type baseClient struct {
 	coreClient     unsafe.Pointer
 	mu             *sync.Mutex
 	messageHandler *MessageHandler
+	stats          *clientStats
+	maxPending     int
+	subscribers    *subscriberSet
+	seedResolver   *seedResolver
+	heartbeat      *heartbeat
+	metricsHook    config.MetricsHook
+	quotaHook      config.QuotaHook
+	auditHook      config.AuditHook
+	auditRedaction *config.Redaction
+	prepared       *preparedCommands
+	runtime        *runtimeConfig
+	// transformers are applied to written values in order, and to read values in reverse order.
+	transformers []config.Transformer
+	// coalescer de-duplicates concurrent reads, or is nil if read coalescing is disabled.
+	coalescer       *readCoalescer
+	adaptiveTimeout *adaptiveTimeout
+	circuitBreaker  *circuitBreaker
+	// hedgeDelay is the delay after which reads are hedged, or 0 if hedged reads are disabled.
+	hedgeDelay time.Duration
+	// replicaLag routes reads to the replicas within the lag threshold, or is nil if lag-aware reads are disabled.
+	replicaLag *replicaLagMonitor
+	// readYourWrites routes the reads of recently written keys to their primary, or is nil if it is disabled.
+	readYourWrites *readYourWrites
+	// routingOverrides pins slots and keys to nodes, or is nil for standalone clients.
+	routingOverrides *routingOverrides
+	// nodeStats attributes the commands to the nodes of the cluster, or is nil if node statistics are disabled.
+	nodeStats *nodeStatsMonitor
+	// strictValidation is set if the arguments of commands are checked before they are sent.
+	strictValidation bool
+	// maxRequestSize is the maximum size in bytes of the arguments of a request, or 0 if it is not limited.
+	maxRequestSize int
+	mapKeyPolicy   config.MapKeyPolicy
+	// profilerLabels is set if goroutines waiting for a command are labeled with its name for pprof.
+	profilerLabels bool
+	// slowLog records the commands exceeding the slow command threshold, or is nil if the slow command log is disabled.
+	slowLog *slowCommandLog
+	// commandFilter rejects the commands which are not allowed, or which are denied, or is nil if all commands are
+	// allowed.
+	commandFilter *commandFilter
+	// derived is set on clients created by WithSubscriptions or TenantClient, which share the core connection of another
+	// client.
+	derived bool
+	// tenant scopes the keys of the commands to a tenant, or is nil if the client is not a tenant client.
+	tenant *tenantScope
 }
 
 // setMessageHandler assigns a message handler to the client for processing pub/sub messages
@@ -137,15 +204,25 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	msg, err := proto.Marshal(request)
-	if err != nil {
-		return nil, err
+	var resolver *seedResolver
+	resolveHere := resolvesSeeds(config)
+	if resolveHere || config.GetDNSRefreshInterval() > 0 {
+		resolver = newSeedResolver(config.GetResolver(), config.GetIPPreference(), request)
+		resolver.passThrough = !resolveHere
+		resolver.interval = config.GetDNSRefreshInterval()
+		ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout(request))
+		addresses, err := resolver.resolve(ctx)
+		cancel()
+		// Without a custom resolver or an IP preference the hostnames are passed to the core as is, and resolving them
+		// here only records the current seeds.
+		if resolveHere {
+			if err != nil {
+				return nil, NewConnectionError(err.Error())
+			}
+			request.Addresses = addresses
+		}
 	}
 
-	byteCount := len(msg)
-	requestBytes := C.CBytes(msg)
-	defer C.free(requestBytes)
-
 	clientType, err := buildAsyncClientType(
 		(C.SuccessCallback)(unsafe.Pointer(C.successCallback)),
 		(C.FailureCallback)(unsafe.Pointer(C.failureCallback)),
@@ -153,13 +230,109 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 	if err != nil {
 		return nil, NewClosingError(err.Error())
 	}
-	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}}
+	client := &baseClient{
+		pending:          make(map[unsafe.Pointer]struct{}),
+		mu:               &sync.Mutex{},
+		stats:            &clientStats{},
+		maxPending:       config.GetMaxPendingCommands(),
+		subscribers:      newSubscriberSet(request.PubsubSubscriptions),
+		seedResolver:     resolver,
+		metricsHook:      config.GetMetricsHook(),
+		quotaHook:        config.GetQuotaHook(),
+		strictValidation: config.GetStrictValidation(),
+		maxRequestSize:   config.GetMaxRequestSize(),
+		mapKeyPolicy:     config.GetMapKeyPolicy(),
+		profilerLabels:   config.GetProfilerLabels(),
+		commandFilter:    newCommandFilter(config.GetAllowedCommands(), config.GetDeniedCommands()),
+		prepared:         &preparedCommands{commands: make(map[string]*PreparedCommand)},
+		transformers:     config.GetTransformers(),
+	}
+	client.auditHook, client.auditRedaction = config.GetAuditHook()
+	client.runtime = &runtimeConfig{
+		clusterMode:  request.ClusterModeEnabled,
+		replicaReads: request.ReadFrom != protobuf.ReadFrom_Primary,
+	}
+	if config.GetReadCoalescing() {
+		client.coalescer = newReadCoalescer()
+	}
+	if threshold, capacity := config.GetSlowCommandLog(); capacity > 0 {
+		client.slowLog = newSlowCommandLog(threshold, capacity)
+	}
+	if request.AuthenticationInfo != nil {
+		client.runtime.username = request.AuthenticationInfo.Username
+	}
+	if percentile, floor, ceiling := config.GetAdaptiveTimeout(); percentile > 0 {
+		client.adaptiveTimeout = newAdaptiveTimeout(percentile, floor, ceiling)
+	}
+	if breaker := config.GetCircuitBreaker(); breaker != nil {
+		client.circuitBreaker = newCircuitBreaker(breaker)
+	}
+	// connect creates a new core client from request, replacing its seed addresses with the given ones if not nil and
+	// if the client is created. Calls are serialized, since the heartbeat and the refresh of the seeds share request.
+	var requestMu sync.Mutex
+	connect := func(addresses []*protobuf.NodeAddress) (unsafe.Pointer, error) {
+		requestMu.Lock()
+		defer requestMu.Unlock()
+		previous := request.Addresses
+		if addresses != nil {
+			request.Addresses = addresses
+		}
+		coreClient, err := createCoreClient(request, &clientType)
+		if err != nil {
+			request.Addresses = previous
+		}
+		return coreClient, err
+	}
+	if resolver != nil {
+		resolver.connect = connect
+	}
+	if interval, failureThreshold := config.GetHeartbeat(); interval > 0 {
+		reconnect := func() (unsafe.Pointer, error) { return connect(nil) }
+		client.heartbeat = newHeartbeat(interval, failureThreshold, request.ClusterModeEnabled, reconnect)
+	}
+
+	coreClient, err := createCoreClient(request, &clientType)
+	var connectionErr *ConnectionError
+	if errors.As(err, &connectionErr) && resolveHere {
+		// Dual-stack fallback: retry with the addresses of the other family, if any hostname resolved to both.
+		if fallback := resolver.fallbackAddresses(); fallback != nil {
+			request.Addresses = fallback
+			coreClient, err = createCoreClient(request, &clientType)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client.coreClient = coreClient
+
+	// Register the client in our registry using the pointer value from C
+	registerClient(client, uintptr(coreClient))
+
+	return client, nil
+}
+
+// resolvesSeeds reports whether the configured hostnames are resolved by the client instead of by the core.
+func resolvesSeeds(cfg clientConfiguration) bool {
+	return cfg.GetResolver() != nil || cfg.GetIPPreference() != config.IPPreferenceNone
+}
+
+// createCoreClient creates the client of the core for the given connection request.
+func createCoreClient(request *protobuf.ConnectionRequest, clientType *C.ClientType) (unsafe.Pointer, error) {
+	msg, err := proto.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	byteCount := len(msg)
+	requestBytes := cBytes(msg)
+	defer freeCBuffer(requestBytes)
 
 	cResponse := (*C.struct_ConnectionResponse)(
 		C.create_client(
 			(*C.uchar)(requestBytes),
 			C.uintptr_t(byteCount),
-			&clientType,
+			clientType,
 			(C.PubSubCallback)(unsafe.Pointer(C.pubSubCallback)),
 		),
 	)
@@ -169,17 +342,16 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 		message := C.GoString(cErr)
 		return nil, NewConnectionError(message)
 	}
-
-	client.coreClient = cResponse.conn_ptr
-
-	// Register the client in our registry using the pointer value from C
-	registerClient(client, uintptr(cResponse.conn_ptr))
-
-	return client, nil
+	return cResponse.conn_ptr, nil
 }
 
 // Close terminates the client by closing all associated resources.
 func (client *baseClient) Close() {
+	if client.derived {
+		client.closeDerived()
+		return
+	}
+
 	client.mu.Lock()
 	defer client.mu.Unlock()
 
@@ -191,6 +363,19 @@ func (client *baseClient) Close() {
 
 	C.close_client(client.coreClient)
 	client.coreClient = nil
+	client.subscribers.detachAll()
+	if client.seedResolver != nil {
+		client.seedResolver.close()
+	}
+	if client.heartbeat != nil {
+		client.heartbeat.close()
+	}
+	if client.replicaLag != nil {
+		client.replicaLag.close()
+	}
+	if client.nodeStats != nil {
+		client.nodeStats.close()
+	}
 
 	// iterating the channel map while holding the lock guarantees those unsafe.Pointers is still valid
 	// because holding the lock guarantees the owner of the unsafe.Pointer hasn't exit.
@@ -206,9 +391,87 @@ func (client *baseClient) executeCommand(
 	requestType C.RequestType,
 	args []string,
 ) (*C.struct_CommandResponse, error) {
+	args, routeArgs, err := client.encodeCommand(requestType, args)
+	if err != nil {
+		return nil, err
+	}
+	if route := client.keyRoute(requestType, routeArgs); route != nil {
+		return client.executeCommandWithRoute(ctx, requestType, args, route)
+	}
+	if key, ok := client.hedgeKey(requestType, routeArgs); ok && debugNodeFrom(ctx) == nil {
+		return client.executeHedged(ctx, requestType, args, key)
+	}
 	return client.executeCommandWithRoute(ctx, requestType, args, nil)
 }
 
+// submitCommand sends a command like executeCommand, without waiting for its response. Reads are not hedged, since
+// hedging waits for the responses.
+func (client *baseClient) submitCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+) (*pendingCommand, error) {
+	args, routeArgs, err := client.encodeCommand(requestType, args)
+	if err != nil {
+		return nil, err
+	}
+	return client.submitCommandWithRoute(ctx, requestType, args, client.keyRoute(requestType, routeArgs))
+}
+
+// keyRoute returns the route of a command routed by its keys: the node its keys are pinned to, or the route of a
+// single-key read, or nil if the command uses the default routing.
+func (client *baseClient) keyRoute(requestType C.RequestType, args []string) config.Route {
+	if client.routingOverrides != nil {
+		if route := client.routingOverrides.route(requestType, args); route != nil {
+			return route
+		}
+	}
+	return client.readRoute(requestType, args)
+}
+
+// readRoute returns the route of a single-key read, following the read-your-writes window, the read strategy set at
+// runtime, or the replica lag threshold of the client, or nil if the command uses the default routing.
+func (client *baseClient) readRoute(requestType C.RequestType, args []string) config.Route {
+	if client.readYourWrites != nil {
+		if key, ok := readKey(requestType, args); ok {
+			if route := client.readYourWrites.readRoute(key); route != nil {
+				return route
+			}
+		}
+	}
+	route := client.runtime.readRoute(requestType, args)
+	if client.replicaLag == nil {
+		return route
+	}
+	if slotRoute, ok := route.(*config.SlotKeyRoute); ok && slotRoute.SlotType == config.SlotTypePrimary {
+		return route
+	}
+	if key, ok := readKey(requestType, args); ok {
+		return client.replicaLag.readRoute(key)
+	}
+	return route
+}
+
+// encodeCommand encodes the arguments of a command with the transformers of the client. It also returns the arguments
+// the route of the command depends on: the keys of tenant clients are prefixed by submitCommandWithRoute, but the route
+// and hedging of reads depend on the prefixed keys.
+func (client *baseClient) encodeCommand(requestType C.RequestType, args []string) ([]string, []string, error) {
+	if len(client.transformers) > 0 {
+		var err error
+		if args, err = client.encodeArgs(requestType, args); err != nil {
+			return nil, nil, err
+		}
+	}
+	routeArgs := args
+	if client.tenant != nil {
+		var err error
+		if routeArgs, err = client.tenant.scope(requestType, args); err != nil {
+			return nil, nil, err
+		}
+	}
+	return args, routeArgs, nil
+}
+
 func slotTypeToProtobuf(slotType config.SlotType) (protobuf.SlotTypes, error) {
 	switch slotType {
 	case config.SlotTypePrimary:
@@ -259,6 +522,8 @@ func routeToProtobuf(route config.Route) (*protobuf.Routes, error) {
 				},
 			}, nil
 		}
+	case config.ByAddressRoute:
+		return routeToProtobuf(&route)
 	case *config.ByAddressRoute:
 		{
 			return &protobuf.Routes{
@@ -275,12 +540,92 @@ func routeToProtobuf(route config.Route) (*protobuf.Routes, error) {
 	}
 }
 
+// routeAddress returns the address of the node a route targets, as "host:port", or an empty string if the node is not
+// known to the client, which is the case for every route but the routes by address.
+func routeAddress(route config.Route) string {
+	switch r := route.(type) {
+	case config.ByAddressRoute:
+		return fmt.Sprintf("%s:%d", r.Host, r.Port)
+	case *config.ByAddressRoute:
+		return fmt.Sprintf("%s:%d", r.Host, r.Port)
+	}
+	return ""
+}
+
+// routeToCBytes serializes a route into a native buffer for the core, which copies it before C.command returns. The
+// buffer must be released with freeCBuffer. A nil route is serialized as a nil buffer.
+func routeToCBytes(route config.Route) (*C.uchar, C.uintptr_t, error) {
+	if route == nil {
+		return nil, 0, nil
+	}
+	routeProto, err := routeToProtobuf(route)
+	if err != nil {
+		return nil, 0, err
+	}
+	msg, err := proto.Marshal(routeProto)
+	if err != nil {
+		return nil, 0, err
+	}
+	return (*C.uchar)(cBytes(msg)), C.uintptr_t(len(msg)), nil
+}
+
 func (client *baseClient) executeCommandWithRoute(
 	ctx context.Context,
 	requestType C.RequestType,
 	args []string,
 	route config.Route,
 ) (*C.struct_CommandResponse, error) {
+	if client.profilerLabels {
+		var restore func()
+		ctx, restore = withProfilerLabels(ctx, latencyCommandName(requestType, args))
+		defer restore()
+	}
+	pending, err := client.submitCommandWithRoute(ctx, requestType, args, route)
+	if err != nil {
+		return nil, err
+	}
+	return pending.await()
+}
+
+// pendingCommand is a command sent to the core, whose response is delivered to its result channel by the callbacks.
+type pendingCommand struct {
+	client           *baseClient
+	ctx              context.Context
+	parentCtx        context.Context
+	requestType      C.RequestType
+	args             []string
+	route            config.Route
+	family           string
+	started          time.Time
+	adaptiveLimit    time.Duration
+	requestLimit     time.Duration
+	resultChannel    chan payload
+	resultChannelPtr unsafe.Pointer
+	// internal is set on the commands issued by the client itself, such as heartbeats or the hedges of reads, which are
+	// not recorded.
+	internal bool
+	// cleanups are run in reverse order with the final error of the command, once it completes or fails to be sent.
+	cleanups []func(err error)
+}
+
+func (pending *pendingCommand) onFinish(cleanup func(err error)) {
+	pending.cleanups = append(pending.cleanups, cleanup)
+}
+
+func (pending *pendingCommand) finish(err error) {
+	for idx := len(pending.cleanups) - 1; idx >= 0; idx-- {
+		pending.cleanups[idx](err)
+	}
+	pending.cleanups = nil
+}
+
+// submitCommandWithRoute sends a command to the core without waiting for its response, which is received by await.
+func (client *baseClient) submitCommandWithRoute(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (_ *pendingCommand, err error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
@@ -288,6 +633,78 @@ func (client *baseClient) executeCommandWithRoute(
 	default:
 		// Continue with execution
 	}
+	debug := debugNodeFrom(ctx)
+	if debug != nil {
+		if !client.runtime.clusterMode {
+			return nil, NewRequestError("a debug node can only be given to a cluster client")
+		}
+		route = &debug.node
+	}
+	pending := &pendingCommand{client: client, parentCtx: ctx, requestType: requestType, route: route}
+	defer func() {
+		if err != nil {
+			pending.finish(err)
+		}
+	}()
+	if debug != nil {
+		pending.onFinish(debug.record)
+	}
+	if client.tenant != nil {
+		if args, err = client.tenant.scope(requestType, args); err != nil {
+			return nil, err
+		}
+	}
+	args = clampBlockingTimeout(ctx, requestType, args)
+	pending.args = args
+	if client.commandFilter != nil {
+		if err = client.commandFilter.check(requestType, args); err != nil {
+			return nil, err
+		}
+	}
+	if client.auditHook != nil {
+		pending.onFinish(func(err error) { client.audit(requestType, args, false, err) })
+	}
+	if client.strictValidation {
+		if err := validateStrict(requestType, args); err != nil {
+			return nil, err
+		}
+	}
+	if err := client.checkRequestSize(args); err != nil {
+		return nil, err
+	}
+	if client.quotaHook != nil {
+		name, _ := commandName(requestType, args)
+		if err := client.checkQuota(ctx, name, commandFamily(uint32(requestType)), 1, argsSize(args)); err != nil {
+			return nil, err
+		}
+	}
+	if client.circuitBreaker != nil {
+		done, openErr := client.circuitBreaker.allow(route)
+		if openErr != nil {
+			return nil, openErr
+		}
+		pending.onFinish(done)
+	}
+	// The rate limit of the tenant is taken after the other checks, so that the commands they reject do not use it.
+	if client.tenant != nil {
+		if err = client.tenant.allow(1); err != nil {
+			return nil, err
+		}
+	}
+	if client.nodeStats != nil {
+		if done := client.nodeStats.track(requestType, args, route); done != nil {
+			pending.onFinish(done)
+		}
+	}
+	pending.family = commandFamily(uint32(requestType))
+	if client.adaptiveTimeout != nil && adaptiveTimeoutApplies(requestType) {
+		var cancel context.CancelFunc
+		ctx, cancel, pending.adaptiveLimit = client.adaptiveTimeout.withDeadline(ctx, pending.family)
+		pending.onFinish(func(error) { cancel() })
+	}
+	ctx, cancelRequestTimeout, requestLimit := client.runtime.withRequestTimeout(ctx, requestType)
+	pending.onFinish(func(error) { cancelRequestTimeout() })
+	pending.ctx, pending.requestLimit = ctx, requestLimit
 	// Create span if OpenTelemetry is enabled and sampling is configured
 	var spanPtr uint64
 	otelInstance := GetOtelInstance()
@@ -295,8 +712,46 @@ func (client *baseClient) executeCommandWithRoute(
 		// Pass the request type to determine the descriptive name of the command
 		// to use as the span name
 		spanPtr = otelInstance.createSpan(requestType)
-		defer otelInstance.dropSpan(spanPtr)
+		pending.onFinish(func(error) { otelInstance.dropSpan(spanPtr) })
+	}
+	if err = client.send(pending, spanPtr); err != nil {
+		return nil, err
+	}
+	// Writes are only recorded once sent, so that the commands rejected by the client do not pin reads to the primary.
+	if client.readYourWrites != nil {
+		client.readYourWrites.recordWrite(requestType, args)
+	}
+	return pending, nil
+}
+
+// executeInternalCommand executes a command issued by the client itself, such as a heartbeat or the hedge of a read. It
+// bypasses the hooks, limits and statistics applied to the commands of the user, except for the cap on pending
+// commands.
+func (client *baseClient) executeInternalCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	pending := &pendingCommand{
+		client:      client,
+		ctx:         ctx,
+		parentCtx:   ctx,
+		requestType: requestType,
+		args:        args,
+		route:       route,
+		internal:    true,
+	}
+	if err := client.send(pending, 0); err != nil {
+		pending.finish(err)
+		return nil, err
 	}
+	return pending.await()
+}
+
+// send passes a pending command to the core, which delivers its response to the result channel of the command.
+func (client *baseClient) send(pending *pendingCommand, spanPtr uint64) error {
+	args, requestType := pending.args, pending.requestType
 	var cArgsPtr *C.uintptr_t = nil
 	var argLengthsPtr *C.ulong = nil
 	if len(args) > 0 {
@@ -304,37 +759,29 @@ func (client *baseClient) executeCommandWithRoute(
 		cArgsPtr = &cArgs[0]
 		argLengthsPtr = &argLengths[0]
 	}
-	var routeBytesPtr *C.uchar = nil
-	var routeBytesCount C.uintptr_t = 0
-	if route != nil {
-		routeProto, err := routeToProtobuf(route)
-		if err != nil {
-			return nil, errors.New("executeCommand failed due to invalid route")
-		}
-		msg, err := proto.Marshal(routeProto)
-		if err != nil {
-			return nil, err
-		}
-
-		routeBytesCount = C.uintptr_t(len(msg))
-		routeCBytes := C.CBytes(msg)
-		defer C.free(routeCBytes)
-		routeBytesPtr = (*C.uchar)(routeCBytes)
+	routeBytesPtr, routeBytesCount, err := routeToCBytes(pending.route)
+	if err != nil {
+		return errors.New("executeCommand failed due to invalid route")
 	}
+	defer freeCBuffer(unsafe.Pointer(routeBytesPtr))
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
-	resultChannel := make(chan payload, 1)
-	resultChannelPtr := unsafe.Pointer(&resultChannel)
+	pending.resultChannel = make(chan payload, 1)
+	pending.resultChannelPtr = unsafe.Pointer(&pending.resultChannel)
 
 	pinner := pinner{}
-	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
+	pinnedChannelPtr := uintptr(pinner.Pin(pending.resultChannelPtr))
+	pending.onFinish(func(error) { pinner.Unpin() })
 
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
-		return nil, NewClosingError("executeCommand failed: the client is closed")
+		return NewClosingError("executeCommand failed: the client is closed")
 	}
-	client.pending[resultChannelPtr] = struct{}{}
+	if err := client.registerPending(pending.resultChannelPtr); err != nil {
+		client.mu.Unlock()
+		return err
+	}
+	pending.started = time.Now()
 	C.command(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
@@ -346,37 +793,77 @@ func (client *baseClient) executeCommandWithRoute(
 		routeBytesCount,
 		C.uint64_t(spanPtr),
 	)
+	// The core copies the arguments before C.command returns. Until then, their bytes are only referenced through
+	// uintptrs, which do not keep them alive.
+	runtime.KeepAlive(args)
 	client.mu.Unlock()
+	return nil
+}
+
+// await waits for the response of a command sent by submitCommandWithRoute, or for the cancellation of its context.
+func (pending *pendingCommand) await() (response *C.struct_CommandResponse, err error) {
+	defer func() { pending.finish(err) }()
+	client := pending.client
+	resultChannel := pending.resultChannel
 	// Wait for result or context cancellation
 	var payload payload
 	select {
-	case <-ctx.Done():
+	case <-pending.ctx.Done():
 		client.mu.Lock()
 		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
+			delete(client.pending, pending.resultChannelPtr)
 		}
 		client.mu.Unlock()
 		// Start cleanup goroutine
 		go func() {
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
-				C.free_command_response(payload.value)
+				freeCommandResponse(payload.value)
 			}
 		}()
-		return nil, ctx.Err()
+		if pending.parentCtx.Err() == nil {
+			adaptiveLimit, requestLimit := pending.adaptiveLimit, pending.requestLimit
+			if adaptiveLimit > 0 && (requestLimit == 0 || adaptiveLimit <= requestLimit) {
+				return nil, NewTimeoutError(fmt.Sprintf("the adaptive timeout of %v was exceeded", adaptiveLimit))
+			}
+			if requestLimit > 0 {
+				return nil, NewTimeoutError(fmt.Sprintf("the request timeout of %v was exceeded", requestLimit))
+			}
+		}
+		return nil, pending.ctx.Err()
 	case payload = <-resultChannel:
 		// Continue with normal processing
 	}
 
 	client.mu.Lock()
 	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
+		delete(client.pending, pending.resultChannelPtr)
 	}
 	client.mu.Unlock()
+	if !pending.internal {
+		if client.adaptiveTimeout != nil && payload.error == nil && adaptiveTimeoutApplies(pending.requestType) {
+			client.adaptiveTimeout.observe(pending.family, time.Since(pending.started))
+		}
+		client.recordCommand(
+			pending.family,
+			latencyCommandName(pending.requestType, pending.args),
+			argsSize(pending.args),
+			payload.value,
+			pending.started,
+			payload.error,
+		)
+		client.recordSlowCommand(pending.requestType, pending.args, pending.route, pending.started)
+	}
 
 	if payload.error != nil {
 		return nil, payload.error
 	}
+	if client.mapKeyPolicy != config.LastKeyWins && pending.ctx.Value(rawResponseKey{}) == nil {
+		if err := applyMapKeyPolicy(payload.value, client.mapKeyPolicy); err != nil {
+			freeCommandResponse(payload.value)
+			return nil, err
+		}
+	}
 	return payload.value, nil
 }
 
@@ -396,12 +883,32 @@ func toCStrings(args []string) ([]C.uintptr_t, []C.ulong) {
 	return cStrings, stringLengths
 }
 
+// checkRequestSize returns a RequestTooLargeError if the total size of the given arguments exceeds the maximum request
+// size, before they are copied to the core.
+func (client *baseClient) checkRequestSize(args ...[]string) error {
+	if client.maxRequestSize == 0 {
+		return nil
+	}
+	size := 0
+	for _, group := range args {
+		for _, arg := range group {
+			size += len(arg)
+		}
+	}
+	if size > client.maxRequestSize {
+		return NewRequestTooLargeError(fmt.Sprintf(
+			"request of %d bytes exceeds the maximum request size of %d bytes", size, client.maxRequestSize,
+		))
+	}
+	return nil
+}
+
 func (client *baseClient) executeBatch(
 	ctx context.Context,
 	batch internal.Batch,
 	raiseOnError bool,
 	options *internal.BatchOptions,
-) ([]any, error) {
+) (result []any, err error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
@@ -409,9 +916,56 @@ func (client *baseClient) executeBatch(
 	default:
 		// Continue with execution
 	}
+	if client.tenant != nil {
+		if batch, err = client.tenant.scopeBatch(batch); err != nil {
+			return nil, err
+		}
+	}
+	if client.commandFilter != nil {
+		for _, cmd := range batch.Commands {
+			if err = client.commandFilter.check(C.RequestType(cmd.RequestType), cmd.Args); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var batchRoute config.Route
+	if options != nil {
+		batchRoute = options.Route
+	}
+	if client.circuitBreaker != nil {
+		done, openErr := client.circuitBreaker.allow(batchRoute)
+		if openErr != nil {
+			return nil, openErr
+		}
+		defer func() { done(err) }()
+	}
+	if client.auditHook != nil {
+		defer func() { client.auditBatch(batch, err) }()
+	}
 	if len(batch.Errors) > 0 {
 		return nil, NewBatchError(batch.Errors)
 	}
+	batchArgs := make([][]string, len(batch.Commands))
+	for idx, cmd := range batch.Commands {
+		batchArgs[idx] = cmd.Args
+	}
+	if err := client.checkRequestSize(batchArgs...); err != nil {
+		return nil, err
+	}
+	if client.quotaHook != nil {
+		var size int64
+		for _, args := range batchArgs {
+			size += argsSize(args)
+		}
+		if err := client.checkQuota(ctx, "BATCH", batchFamily, len(batch.Commands), size); err != nil {
+			return nil, err
+		}
+	}
+	if client.tenant != nil {
+		if err := client.tenant.allow(len(batch.Commands)); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create span if OpenTelemetry is enabled and sampling is configured
 	var spanPtr uint64
@@ -436,15 +990,19 @@ func (client *baseClient) executeBatch(
 		client.mu.Unlock()
 		return nil, NewClosingError("ExecuteBatch failed. The client is closed.")
 	}
-	client.pending[resultChannelPtr] = struct{}{}
+	if err := client.registerPending(resultChannelPtr); err != nil {
+		client.mu.Unlock()
+		return nil, err
+	}
 
-	batchInfo := createBatchInfo(pinner, batch)
+	batchInfo := createBatchInfo(&pinner, batch)
 	var optionsPtr *C.BatchOptionsInfo
 	if options != nil {
-		batchOptionsInfo := createBatchOptionsInfo(pinner, *options)
+		batchOptionsInfo := createBatchOptionsInfo(&pinner, *options)
 		optionsPtr = &batchOptionsInfo
 	}
 
+	started := time.Now()
 	C.batch(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
@@ -468,7 +1026,7 @@ func (client *baseClient) executeBatch(
 		go func() {
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
-				C.free_command_response(payload.value)
+				freeCommandResponse(payload.value)
 			}
 		}()
 		return nil, ctx.Err()
@@ -481,10 +1039,20 @@ func (client *baseClient) executeBatch(
 		delete(client.pending, resultChannelPtr)
 	}
 	client.mu.Unlock()
+	var sent int64
+	for _, cmd := range batch.Commands {
+		sent += argsSize(cmd.Args)
+	}
+	client.recordCommand(batchFamily, "BATCH", sent, payload.value, started, payload.error)
+	client.recordSlowBatch(batch, batchRoute, started)
 
 	if payload.error != nil {
 		return nil, payload.error
 	}
+	if err := applyMapKeyPolicy(payload.value, client.mapKeyPolicy); err != nil {
+		freeCommandResponse(payload.value)
+		return nil, err
+	}
 	response, err := handleAnyArrayOrNilResponse(payload.value)
 	if err != nil {
 		return nil, err
@@ -495,7 +1063,7 @@ func (client *baseClient) executeBatch(
 	return batch.Convert(response)
 }
 
-func createBatchOptionsInfo(pinner pinner, options internal.BatchOptions) C.BatchOptionsInfo {
+func createBatchOptionsInfo(pinner *pinner, options internal.BatchOptions) C.BatchOptionsInfo {
 	info := C.BatchOptionsInfo{}
 	info.retry_server_error = C._Bool(false)
 	info.retry_connection_error = C._Bool(false)
@@ -520,7 +1088,7 @@ func createBatchOptionsInfo(pinner pinner, options internal.BatchOptions) C.Batc
 }
 
 // TODO align with others to return struct, not a pointer
-func createRouteInfo(pinner pinner, route config.Route) *C.RouteInfo {
+func createRouteInfo(pinner *pinner, route config.Route) *C.RouteInfo {
 	if route != nil {
 		routeInfo := C.RouteInfo{}
 		switch r := route.(type) {
@@ -538,14 +1106,12 @@ func createRouteInfo(pinner pinner, route config.Route) *C.RouteInfo {
 			routeInfo.slot_type = uint32(r.SlotType)
 		case *config.SlotKeyRoute:
 			routeInfo.route_type = C.SlotKey
-			// when converting string to []byte, it is converted to an UTF8 string (not a binary string)
-			routeInfo.hostname = (*C.char)(pinner.Pin(unsafe.Pointer(&[]byte(r.SlotKey)[0])))
+			routeInfo.slot_key = pinCString(pinner, r.SlotKey)
 			// enum variants have the same ordinals
 			routeInfo.slot_type = uint32(r.SlotType)
 		case *config.ByAddressRoute:
 			routeInfo.route_type = C.ByAddress
-			// when converting string to []byte, it is converted to an UTF8 string (not a binary string)
-			routeInfo.hostname = (*C.char)(pinner.Pin(unsafe.Pointer(&[]byte(r.Host)[0])))
+			routeInfo.hostname = pinCString(pinner, r.Host)
 			routeInfo.port = C.int(r.Port)
 		}
 		return &routeInfo
@@ -553,7 +1119,15 @@ func createRouteInfo(pinner pinner, route config.Route) *C.RouteInfo {
 	return nil
 }
 
-func createBatchInfo(pinner pinner, batch internal.Batch) C.BatchInfo {
+// pinCString copies a string into a pinned, NUL-terminated Go buffer, which the core reads as a C string. Unlike the
+// bytes of the string itself, the copy is never empty, so it can always be pinned.
+func pinCString(pinner *pinner, str string) *C.char {
+	buffer := make([]byte, len(str)+1)
+	copy(buffer, str)
+	return (*C.char)(pinner.Pin(unsafe.Pointer(&buffer[0])))
+}
+
+func createBatchInfo(pinner *pinner, batch internal.Batch) C.BatchInfo {
 	numCommands := len(batch.Commands)
 	info := C.BatchInfo{}
 	info.is_atomic = C._Bool(batch.IsAtomic)
@@ -573,16 +1147,18 @@ func createBatchInfo(pinner pinner, batch internal.Batch) C.BatchInfo {
 	return info
 }
 
-func createCmdInfo(pinner pinner, cmd internal.Cmd) C.CmdInfo {
+func createCmdInfo(pinner *pinner, cmd internal.Cmd) C.CmdInfo {
 	numArgs := len(cmd.Args)
 	info := C.CmdInfo{}
 	info.request_type = cmd.RequestType
 	cArgsPtr := make([]*C.uchar, numArgs)
 	argLengthsPtr := make([]C.ulong, numArgs)
 	for i, str := range cmd.Args {
-		// TODO do we need to pin there too?
-		// cArgsPtr[i] = (*C.uchar)(pinner.Pin(unsafe.Pointer(unsafe.StringData((str)))))
-		cArgsPtr[i] = (*C.uchar)(unsafe.Pointer(unsafe.StringData((str))))
+		// The pinned array of arguments holds Go pointers to their bytes, which must be pinned too. The data of empty
+		// strings may be nil, and cannot be pinned.
+		if len(str) > 0 {
+			cArgsPtr[i] = (*C.uchar)(pinner.Pin(unsafe.Pointer(unsafe.StringData(str))))
+		}
 		argLengthsPtr[i] = C.size_t(len(str))
 	}
 	info.arg_count = C.ulong(numArgs)
@@ -605,6 +1181,14 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 	default:
 		// Continue with execution
 	}
+	if client.tenant != nil {
+		return models.DefaultStringResponse, client.tenant.unsupported("UpdateConnectionPassword")
+	}
+	if client.commandFilter != nil {
+		if err := client.commandFilter.checkName("AUTH"); err != nil {
+			return models.DefaultStringResponse, err
+		}
+	}
 
 	// Create a channel to receive the result
 	resultChannel := make(chan payload, 1)
@@ -619,10 +1203,13 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 		client.mu.Unlock()
 		return models.DefaultStringResponse, NewClosingError("UpdatePassword failed. The client is closed.")
 	}
-	client.pending[resultChannelPtr] = struct{}{}
+	if err := client.registerPending(resultChannelPtr); err != nil {
+		client.mu.Unlock()
+		return models.DefaultStringResponse, err
+	}
 
-	password_cstring := C.CString(password)
-	defer C.free(unsafe.Pointer(password_cstring))
+	password_cstring := cString(password)
+	defer freeCBuffer(unsafe.Pointer(password_cstring))
 	C.update_connection_password(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
@@ -644,7 +1231,7 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 		go func() {
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
-				C.free_command_response(payload.value)
+				freeCommandResponse(payload.value)
 			}
 		}()
 		return models.DefaultStringResponse, ctx.Err()
@@ -779,7 +1366,7 @@ func (client *baseClient) SetWithOptions(
 		return models.CreateNilStringResult(), err
 	}
 
-	return handleOkOrStringOrNilResponse(result)
+	return client.decodeResult(handleOkOrStringOrNilResponse(result))
 }
 
 // Get string value associated with the given key, or models.CreateNilStringResult() is returned if no such key
@@ -798,12 +1385,22 @@ func (client *baseClient) SetWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/get/
 func (client *baseClient) Get(ctx context.Context, key string) (models.Result[string], error) {
+	if client.coalescer != nil && debugNodeFrom(ctx) == nil {
+		result, shared, err := coalesce(ctx, client.coalescer, "GET\x00"+key,
+			func(ctx context.Context) (models.Result[string], error) { return client.get(ctx, key) })
+		client.recordCoalesced(shared)
+		return result, err
+	}
+	return client.get(ctx, key)
+}
+
+func (client *baseClient) get(ctx context.Context, key string) (models.Result[string], error) {
 	result, err := client.executeCommand(ctx, C.Get, []string{key})
 	if err != nil {
 		return models.CreateNilStringResult(), err
 	}
 
-	return handleStringOrNilResponse(result)
+	return client.decodeResult(handleStringOrNilResponse(result))
 }
 
 // Get string value associated with the given key, or an empty string is returned [models.CreateNilStringResult()] if no such
@@ -831,7 +1428,46 @@ func (client *baseClient) GetEx(ctx context.Context, key string) (models.Result[
 		return models.CreateNilStringResult(), err
 	}
 
-	return handleStringOrNilResponse(result)
+	return client.decodeResult(handleStringOrNilResponse(result))
+}
+
+// GetWithTTL returns the value of key along with its remaining time to live, e.g. to refresh cache entries before they
+// expire. GET and PTTL are sent in a single transaction, so that the value and its time to live are consistent.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to be retrieved from the database.
+//
+// Return value:
+//
+//	If key exists, returns the value of key as a models.Result[string] and its remaining time to live, or `-1` if the key
+//	has no expiry. Otherwise, returns [models.CreateNilStringResult()] and `0`.
+func (client *baseClient) GetWithTTL(ctx context.Context, key string) (models.Result[string], time.Duration, error) {
+	batch := pipeline.NewClusterBatch(true).Get(key).PTTL(key)
+	results, err := client.executeBatch(ctx, batch.Batch, true, nil)
+	if err != nil {
+		return models.CreateNilStringResult(), 0, err
+	}
+	if len(results) != 2 {
+		return models.CreateNilStringResult(), 0, fmt.Errorf("unexpected number of responses: %d", len(results))
+	}
+	if results[0] == nil {
+		return models.CreateNilStringResult(), 0, nil
+	}
+	value, ok := results[0].(string)
+	if !ok {
+		return models.CreateNilStringResult(), 0, fmt.Errorf("unexpected response of GET: %v", results[0])
+	}
+	value, err = client.decodeValue(value)
+	if err != nil {
+		return models.CreateNilStringResult(), 0, err
+	}
+	ttl, _ := results[1].(int64)
+	if ttl < 0 {
+		return models.CreateStringResult(value), -1, nil
+	}
+	return models.CreateStringResult(value), time.Duration(ttl) * time.Millisecond, nil
 }
 
 // Get string value associated with the given key and optionally sets the expiration of the key.
@@ -864,7 +1500,7 @@ func (client *baseClient) GetExWithOptions(
 		return models.CreateNilStringResult(), err
 	}
 
-	return handleStringOrNilResponse(result)
+	return client.decodeResult(handleStringOrNilResponse(result))
 }
 
 // Sets multiple keys to multiple values in a single operation.
@@ -956,7 +1592,7 @@ func (client *baseClient) MGet(ctx context.Context, keys []string) ([]models.Res
 		return nil, err
 	}
 
-	return handleStringOrNilArrayResponse(result)
+	return client.decodeResults(handleStringOrNilArrayResponse(result))
 }
 
 // Increments the number stored at key by one. If key does not exist, it is set to 0 before performing the operation.
@@ -1024,6 +1660,9 @@ func (client *baseClient) IncrBy(ctx context.Context, key string, amount int64)
 //
 // [valkey.io]: https://valkey.io/commands/incrbyfloat/
 func (client *baseClient) IncrByFloat(ctx context.Context, key string, amount float64) (float64, error) {
+	if err := validateFloat("INCRBYFLOAT", amount, false); err != nil {
+		return models.DefaultFloatResponse, err
+	}
 	result, err := client.executeCommand(ctx,
 		C.IncrByFloat,
 		[]string{key, utils.FloatToString(amount)},
@@ -1162,6 +1801,35 @@ func (client *baseClient) GetRange(ctx context.Context, key string, start int, e
 	return handleStringResponse(result)
 }
 
+// Returns the substring of the string value stored at key, determined by the byte's offsets start and end (both are
+// inclusive). SUBSTR is the deprecated alias of GETRANGE, kept for code migrating from other clients.
+//
+// Deprecated: Use [Client.GetRange] or [ClusterClient.GetRange] instead.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the string.
+//	start - The starting offset.
+//	end   - The ending offset.
+//
+// Return value:
+//
+//	A substring extracted from the value stored at key. Returns empty string if the offset is out of bounds.
+//
+// [valkey.io]: https://valkey.io/commands/substr/
+func (client *baseClient) Substr(ctx context.Context, key string, start int, end int) (string, error) {
+	// The core has no command mapping for SUBSTR, so it is sent as a custom command.
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"SUBSTR", key, strconv.Itoa(start), strconv.Itoa(end)})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+
+	return handleStringResponse(result)
+}
+
 // Appends a value to a key. If key does not exist it is created and set as an empty string, so APPEND will be similar to
 // SET in this special case.
 //
@@ -1315,14 +1983,223 @@ func (client *baseClient) LCSWithOptions(
 //	If key exists, returns the value of the key as a String and deletes the key.
 //	If key does not exist, returns a [models.Result[string]](models.CreateNilStringResult()).
 //
-// [valkey.io]: https://valkey.io/commands/getdel/
-func (client *baseClient) GetDel(ctx context.Context, key string) (models.Result[string], error) {
-	result, err := client.executeCommand(ctx, C.GetDel, []string{key})
+// [valkey.io]: https://valkey.io/commands/getdel/
+func (client *baseClient) GetDel(ctx context.Context, key string) (models.Result[string], error) {
+	result, err := client.executeCommand(ctx, C.GetDel, []string{key})
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+
+	return client.decodeResult(handleStringOrNilResponse(result))
+}
+
+const (
+	compareAndSetScript = `if redis.call('GET', KEYS[1]) == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[2], 'KEEPTTL')
+	return 1
+end
+return 0`
+	compareAndDeleteScript = `if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0`
+	mGetDelScript = `local values = {}
+for i, key in ipairs(KEYS) do
+	values[i] = false
+	if redis.call('TYPE', key).ok == 'string' then
+		values[i] = redis.call('GET', key)
+		redis.call('DEL', key)
+	end
+end
+return values`
+	setKeepTTLScript = `local ttl = redis.call('PTTL', KEYS[1])
+if ARGV[2] == 'XX' and ttl == -2 then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1])
+if ttl > 0 then
+	redis.call('PEXPIRE', KEYS[1], ttl)
+end
+return 1`
+)
+
+// The compare-and-swap scripts are stored on first use, as storing a script requires the native library.
+var (
+	compareAndSetOnce    sync.Once
+	compareAndSet        *options.Script
+	compareAndDeleteOnce sync.Once
+	compareAndDelete     *options.Script
+	mGetDelOnce          sync.Once
+	mGetDel              *options.Script
+	setKeepTTLOnce       sync.Once
+	setKeepTTL           *options.Script
+)
+
+// CompareAndSet atomically sets key to newValue, only if its current value equals expected. The time to live of the
+// key is retained. The comparison and update are performed by a Lua script, which is loaded once and then invoked with
+// EVALSHA.
+//
+// Parameters:
+//
+//	ctx      - The context for controlling the command execution.
+//	key      - The key to update.
+//	expected - The value the key must currently hold.
+//	newValue - The value to set.
+//
+// Return value:
+//
+//	true if the value was updated, false if the key does not exist or holds a different value.
+func (client *baseClient) CompareAndSet(ctx context.Context, key string, expected string, newValue string) (bool, error) {
+	compareAndSetOnce.Do(func() { compareAndSet = options.NewScript(compareAndSetScript) })
+	response, err := client.executeScriptWithRoute(
+		ctx,
+		compareAndSet.GetHash(),
+		[]string{key},
+		[]string{expected, newValue},
+		nil,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := handleIntResponse(response)
+	return result == 1, err
+}
+
+// CompareAndDelete atomically deletes key, only if its current value equals expected. This complements the IFEQ
+// condition of [SET], which cannot express a conditional delete. The comparison and deletion are performed by a Lua
+// script, which is loaded once and then invoked with EVALSHA.
+//
+// Parameters:
+//
+//	ctx      - The context for controlling the command execution.
+//	key      - The key to delete.
+//	expected - The value the key must currently hold.
+//
+// Return value:
+//
+//	true if the key was deleted, false if the key does not exist or holds a different value.
+//
+// [SET]: https://valkey.io/commands/set/
+func (client *baseClient) CompareAndDelete(ctx context.Context, key string, expected string) (bool, error) {
+	compareAndDeleteOnce.Do(func() { compareAndDelete = options.NewScript(compareAndDeleteScript) })
+	response, err := client.executeScriptWithRoute(
+		ctx,
+		compareAndDelete.GetHash(),
+		[]string{key},
+		[]string{expected},
+		nil,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	result, err := handleIntResponse(response)
+	return result == 1, err
+}
+
+// MGetDel atomically gets the values of all the given keys and deletes them, in a single round trip. This is the
+// multi-key form of [GETDEL], e.g. for claiming several jobs at once. The reads and deletions are performed by a Lua
+// script, which is loaded once and then invoked with EVALSHA.
+//
+// Note:
+//
+//	In cluster mode, all keys must map to the same hash slot.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys to get and delete.
+//
+// Return value:
+//
+//	An array of values corresponding to the provided keys. If a key does not exist or does not hold a string, its
+//	value is a [models.Result] containing nil, and the key is left untouched.
+//
+// [GETDEL]: https://valkey.io/commands/getdel/
+func (client *baseClient) MGetDel(ctx context.Context, keys []string) ([]models.Result[string], error) {
+	if len(keys) == 0 {
+		return []models.Result[string]{}, nil
+	}
+	mGetDelOnce.Do(func() { mGetDel = options.NewScript(mGetDelScript) })
+	response, err := client.executeScriptWithRoute(ctx, mGetDel.GetHash(), keys, []string{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.decodeResults(handleStringOrNilArrayResponse(response))
+}
+
+// SetPreservingTTL sets key to value, retaining the time to live of the key if it already exists, by sending SET with
+// KEEPTTL. On servers that do not support KEEPTTL, it falls back to a Lua script that reads the remaining time to live
+// with PTTL, sets the value and restores the expiry with PEXPIRE, so that callers need not depend on the server
+// version.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key to set.
+//	value - The value to set.
+//
+// Return value:
+//
+//	"OK" if the value was set.
+func (client *baseClient) SetPreservingTTL(ctx context.Context, key string, value string) (string, error) {
+	if _, err := client.setKeepTTL(ctx, key, value, false); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return "OK", nil
+}
+
+// ReplaceValueKeepTTL replaces the value of key, only if the key exists, and retains its time to live, by sending SET
+// with XX and KEEPTTL. Like [baseClient.SetPreservingTTL], it falls back to a Lua script on servers that do not support
+// KEEPTTL.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key to update.
+//	value - The new value.
+//
+// Return value:
+//
+//	true if the value was replaced, false if the key does not exist.
+func (client *baseClient) ReplaceValueKeepTTL(ctx context.Context, key string, value string) (bool, error) {
+	return client.setKeepTTL(ctx, key, value, true)
+}
+
+func (client *baseClient) setKeepTTL(ctx context.Context, key string, value string, onlyIfExists bool) (bool, error) {
+	args := []string{key, value}
+	if onlyIfExists {
+		args = append(args, string(constants.OnlyIfExists))
+	}
+	result, err := client.executeCommand(ctx, C.Set, append(args, string(constants.KeepExisting)))
+	if err == nil {
+		set, err := handleOkOrStringOrNilResponse(result)
+		return !set.IsNil(), err
+	}
+	if !isKeepTTLUnsupported(err) {
+		return false, err
+	}
+
+	condition := ""
+	if onlyIfExists {
+		condition = string(constants.OnlyIfExists)
+	}
+	setKeepTTLOnce.Do(func() { setKeepTTL = options.NewScript(setKeepTTLScript) })
+	response, err := client.executeScriptWithRoute(ctx, setKeepTTL.GetHash(), []string{key}, []string{value, condition}, nil)
 	if err != nil {
-		return models.CreateNilStringResult(), err
+		return false, err
 	}
+	set, err := handleIntResponse(response)
+	return set == 1, err
+}
 
-	return handleStringOrNilResponse(result)
+// isKeepTTLUnsupported reports whether SET was rejected because the server does not know the KEEPTTL option, which was
+// added in Redis 6.0.
+func isKeepTTLUnsupported(err error) bool {
+	var requestErr *RequestError
+	return errors.As(err, &requestErr) && strings.Contains(strings.ToLower(requestErr.Error()), "syntax error")
 }
 
 // HGet returns the value associated with field in the hash stored at key.
@@ -1347,7 +2224,7 @@ func (client *baseClient) HGet(ctx context.Context, key string, field string) (m
 		return models.CreateNilStringResult(), err
 	}
 
-	return handleStringOrNilResponse(result)
+	return client.decodeResult(handleStringOrNilResponse(result))
 }
 
 // HGetAll returns all fields and values of the hash stored at key.
@@ -1365,12 +2242,23 @@ func (client *baseClient) HGet(ctx context.Context, key string, field string) (m
 //
 // [valkey.io]: https://valkey.io/commands/hgetall/
 func (client *baseClient) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	if client.coalescer != nil && debugNodeFrom(ctx) == nil {
+		result, shared, err := coalesce(ctx, client.coalescer, "HGETALL\x00"+key,
+			func(ctx context.Context) (map[string]string, error) { return client.hGetAll(ctx, key) })
+		client.recordCoalesced(shared)
+		// Each caller receives its own copy of the shared map.
+		return maps.Clone(result), err
+	}
+	return client.hGetAll(ctx, key)
+}
+
+func (client *baseClient) hGetAll(ctx context.Context, key string) (map[string]string, error) {
 	result, err := client.executeCommand(ctx, C.HGetAll, []string{key})
 	if err != nil {
 		return nil, err
 	}
 
-	return handleStringToStringMapResponse(result)
+	return client.decodeMap(handleStringToStringMapResponse(result))
 }
 
 // HMGet returns the values associated with the specified fields in the hash stored at key.
@@ -1396,7 +2284,7 @@ func (client *baseClient) HMGet(ctx context.Context, key string, fields []string
 		return nil, err
 	}
 
-	return handleStringOrNilArrayResponse(result)
+	return client.decodeResults(handleStringOrNilArrayResponse(result))
 }
 
 // HSet sets the specified fields to their respective values in the hash stored at key.
@@ -1523,7 +2411,7 @@ func (client *baseClient) HVals(ctx context.Context, key string) ([]string, erro
 		return nil, err
 	}
 
-	return handleStringArrayResponse(result)
+	return client.decodeStrings(handleStringArrayResponse(result))
 }
 
 // HExists returns if field is an existing field in the hash stored at key.
@@ -1645,6 +2533,9 @@ func (client *baseClient) HIncrBy(ctx context.Context, key string, field string,
 //
 // [valkey.io]: https://valkey.io/commands/hincrbyfloat/
 func (client *baseClient) HIncrByFloat(ctx context.Context, key string, field string, increment float64) (float64, error) {
+	if err := validateFloat("HINCRBYFLOAT", increment, false); err != nil {
+		return models.DefaultFloatResponse, err
+	}
 	result, err := client.executeCommand(ctx, C.HIncrByFloat, []string{key, field, utils.FloatToString(increment)})
 	if err != nil {
 		return models.DefaultFloatResponse, err
@@ -1811,6 +2702,73 @@ func (client *baseClient) HRandFieldWithCountWithValues(ctx context.Context, key
 	return handle2DStringArrayResponse(result)
 }
 
+// Retrieves random field names from the hash value stored at `key`, as selected by `count`.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	count - The number of field names to return, created with [options.Unique] for distinct field names or with
+//	  [options.AllowRepeats] to allow duplicates.
+//
+// Return value:
+//
+//	An array of random field names from the hash stored at `key`,
+//	or an empty array when the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/hrandfield/
+func (client *baseClient) HRandFieldWithRandomCount(
+	ctx context.Context,
+	key string,
+	count options.RandomCount,
+) ([]string, error) {
+	signed, err := count.SignedCount()
+	if err != nil {
+		return nil, err
+	}
+	return client.HRandFieldWithCount(ctx, key, signed)
+}
+
+// Retrieves random field names along with their values from the hash value stored at `key`, as selected by
+// `count`.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	count - The number of field names to return, created with [options.Unique] for distinct field names or with
+//	  [options.AllowRepeats] to allow duplicates.
+//
+// Return value:
+//
+//	A 2D array of `[field, value]` arrays, where field names and values are from the hash stored at `key`,
+//	or an empty array when the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/hrandfield/
+func (client *baseClient) HRandFieldWithRandomCountWithValues(
+	ctx context.Context,
+	key string,
+	count options.RandomCount,
+) ([][]string, error) {
+	signed, err := count.SignedCount()
+	if err != nil {
+		return nil, err
+	}
+	return client.HRandFieldWithCountWithValues(ctx, key, signed)
+}
+
 // Inserts all the specified values at the head of the list stored at key. elements are inserted one after the other to the
 // head of the list, from the leftmost element to the rightmost element. If key does not exist, it is created as an empty
 // list before performing the push operation.
@@ -1876,9 +2834,13 @@ func (client *baseClient) LPop(ctx context.Context, key string) (models.Result[s
 //
 //	An array of the popped elements as strings will be returned depending on the list's length
 //	If key does not exist, nil will be returned.
+//	A [RequestError] is returned without sending the command if count is not positive.
 //
 // [valkey.io]: https://valkey.io/commands/lpop/
 func (client *baseClient) LPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	if err := validatePopCount("LPOP", count); err != nil {
+		return nil, err
+	}
 	result, err := client.executeCommand(ctx, C.LPop, []string{key, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
@@ -1887,6 +2849,33 @@ func (client *baseClient) LPopCount(ctx context.Context, key string, count int64
 	return handleStringArrayOrNilResponse(result)
 }
 
+// LPopAll removes and returns all the elements of the list stored at key, from its head, e.g. to drain a work queue. The
+// length of the list is read with LLEN, and the elements are then popped with LPOP and a count, so the elements pushed in
+// between are left in the list for the next call.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list.
+//
+// Return value:
+//
+//	The popped elements, in the order they were popped, or an empty array if key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/lpop/
+func (client *baseClient) LPopAll(ctx context.Context, key string) ([]string, error) {
+	length, err := client.LLen(ctx, key)
+	if err != nil || length == 0 {
+		return []string{}, err
+	}
+	elements, err := client.LPopCount(ctx, key, length)
+	if elements == nil && err == nil {
+		// The list was emptied in between.
+		elements = []string{}
+	}
+	return elements, err
+}
+
 // Returns the index of the first occurrence of element inside the list specified by key. If no match is found,
 // [models.CreateNilInt64Result()] is returned.
 //
@@ -2425,6 +3414,35 @@ func (client *baseClient) SRandMemberCount(ctx context.Context, key string, coun
 	return handleStringArrayResponse(result)
 }
 
+// Returns random members from the set value stored at `key`, as selected by `count`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key from which to retrieve the set members.
+//	count - The number of members to return, created with [options.Unique] for distinct members or with
+//	  [options.AllowRepeats] to allow duplicates.
+//
+// Return value:
+//
+//	An array of random members from the set stored at `key`,
+//	or an empty array when the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/srandmember/
+func (client *baseClient) SRandMemberWithRandomCount(
+	ctx context.Context,
+	key string,
+	count options.RandomCount,
+) ([]string, error) {
+	signed, err := count.SignedCount()
+	if err != nil {
+		return nil, err
+	}
+	return client.SRandMemberCount(ctx, key, signed)
+}
+
 // SPop removes and returns one random member from the set stored at key.
 //
 // See [valkey.io] for details.
@@ -2800,9 +3818,13 @@ func (client *baseClient) RPop(ctx context.Context, key string) (models.Result[s
 //
 //	An array of popped elements as strings will be returned depending on the list's length.
 //	If key does not exist, nil will be returned.
+//	A [RequestError] is returned without sending the command if count is not positive.
 //
 // [valkey.io]: https://valkey.io/commands/rpop/
 func (client *baseClient) RPopCount(ctx context.Context, key string, count int64) ([]string, error) {
+	if err := validatePopCount("RPOP", count); err != nil {
+		return nil, err
+	}
 	result, err := client.executeCommand(ctx, C.RPop, []string{key, utils.IntToString(count)})
 	if err != nil {
 		return nil, err
@@ -2811,6 +3833,33 @@ func (client *baseClient) RPopCount(ctx context.Context, key string, count int64
 	return handleStringArrayOrNilResponse(result)
 }
 
+// RPopAll removes and returns all the elements of the list stored at key, from its tail, e.g. to drain a work queue. The
+// length of the list is read with LLEN, and the elements are then popped with RPOP and a count, so the elements pushed in
+// between are left in the list for the next call.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the list.
+//
+// Return value:
+//
+//	The popped elements, in the order they were popped, or an empty array if key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/rpop/
+func (client *baseClient) RPopAll(ctx context.Context, key string) ([]string, error) {
+	length, err := client.LLen(ctx, key)
+	if err != nil || length == 0 {
+		return []string{}, err
+	}
+	elements, err := client.RPopCount(ctx, key, length)
+	if elements == nil && err == nil {
+		// The list was emptied in between.
+		elements = []string{}
+	}
+	return elements, err
+}
+
 // Inserts element in the list at key either before or after the pivot.
 //
 // See [valkey.io] for details.
@@ -3320,6 +4369,112 @@ func (client *baseClient) BLMove(
 	return handleStringOrNilResponse(result)
 }
 
+// Atomically removes the last element of the list stored at `source`, and pushes it as the first element of the list
+// stored at `destination`. This is the legacy form of [Client.LMove] and [ClusterClient.LMove] with
+// [constants.Right] and [constants.Left], kept for servers older than 6.2.0 and for code migrating from other clients.
+//
+// Note:
+//
+//	When in cluster mode, `source` and `destination` must map to the same hash slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx         - The context for controlling the command execution.
+//	source      - The key to the source list.
+//	destination - The key to the destination list.
+//
+// Return value:
+//
+//	A models.Result[string] containing the moved element or models.CreateNilStringResult() if `source` does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/rpoplpush/
+func (client *baseClient) RPopLPush(ctx context.Context, source string, destination string) (models.Result[string], error) {
+	// The core has no command mapping for RPOPLPUSH, so it is sent as a custom command.
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"RPOPLPUSH", source, destination})
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+
+	return handleStringOrNilResponse(result)
+}
+
+// Blocks the connection until it atomically removes the last element of the list stored at `source`, and pushes it as
+// the first element of the list stored at `destination`. `BRPopLPush` is the blocking variant of [Client.RPopLPush] and
+// [ClusterClient.RPopLPush], and the legacy form of [Client.BLMove] and [ClusterClient.BLMove].
+//
+// Note:
+//   - When in cluster mode, `source` and `destination` must map to the same hash slot.
+//   - `BRPopLPush` is a client blocking command, see [Blocking Commands] for more details and best practices.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx         - The context for controlling the command execution.
+//	source      - The key to the source list.
+//	destination - The key to the destination list.
+//	timeout     - The duration to wait for a blocking operation to complete. A value of `0` will block indefinitely.
+//
+// Return value:
+//
+//	A models.Result[string] containing the moved element or models.CreateNilStringResult() if the operation timed-out.
+//
+// [valkey.io]: https://valkey.io/commands/brpoplpush/
+// [Blocking Commands]: https://github.com/valkey-io/valkey-glide/wiki/General-Concepts#blocking-commands
+func (client *baseClient) BRPopLPush(
+	ctx context.Context,
+	source string,
+	destination string,
+	timeout time.Duration,
+) (models.Result[string], error) {
+	// The core has no command mapping for BRPOPLPUSH, so it is sent as a custom command.
+	result, err := client.executeCommand(
+		ctx,
+		C.CustomCommand,
+		[]string{"BRPOPLPUSH", source, destination, utils.FloatToString(timeout.Seconds())},
+	)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+
+	return handleStringOrNilResponse(result)
+}
+
+// RotateList atomically moves an element of the list stored at `key` from one end of the list to the other, with
+// LMOVE from `key` to itself. [constants.Left] moves the first element to the end of the list, and
+// [constants.Right] moves the last element to the head of the list, e.g. to serve the elements in a round-robin.
+//
+// Since:
+//
+//	Valkey 6.2.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx       - The context for controlling the command execution.
+//	key       - The key of the list.
+//	direction - The end of the list the element is taken from.
+//
+// Return value:
+//
+//	A models.Result[string] containing the rotated element or models.CreateNilStringResult() if `key` does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/lmove/
+func (client *baseClient) RotateList(
+	ctx context.Context,
+	key string,
+	direction constants.ListDirection,
+) (models.Result[string], error) {
+	whereTo := constants.Right
+	if direction == constants.Right {
+		whereTo = constants.Left
+	}
+	return client.LMove(ctx, key, key, direction, whereTo)
+}
+
 // Del removes the specified keys from the database. A key is ignored if it does not exist.
 //
 // Note:
@@ -3738,6 +4893,28 @@ func (client *baseClient) TTL(ctx context.Context, key string) (int64, error) {
 	return handleIntResponse(result)
 }
 
+// TTLs returns the remaining time to live of each of the given keys, in seconds. The TTL commands are sent in a single
+// non-atomic batch, which is split by hash slot in cluster mode, so the keys may map to different hash slots.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys to return the timeout of.
+//
+// Return value:
+//
+//	The TTL of each key in seconds, in the order of keys,
+//	`-2` if the key does not exist, or `-1` if the key exists but has no associated expiration.
+//
+// [valkey.io]: https://valkey.io/commands/ttl/
+func (client *baseClient) TTLs(ctx context.Context, keys []string) ([]int64, error) {
+	batch := pipeline.NewClusterBatch(false)
+	for _, key := range keys {
+		batch.TTL(key)
+	}
+	return executeBulk[int64](ctx, client, batch)
+}
+
 // PTTL returns the remaining time to live of key that has a timeout, in milliseconds.
 //
 // Parameters:
@@ -3791,12 +4968,8 @@ func (client *baseClient) PfAdd(ctx context.Context, key string, elements []stri
 //
 // Note:
 //
-//	In cluster mode, if keys in `keyValueMap` map to different hash slots, the command
-//	will be split across these slots and executed separately for each. This means the command
-//	is atomic only at the slot level. If one or more slot-specific requests fail, the entire
-//	call will return the first encountered error, even though some requests may have succeeded
-//	while others did not. If this behavior impacts your application logic, consider splitting
-//	the request into sub-requests per slot to ensure atomicity.
+//	When in cluster mode, all keys must map to the same hash slot. Use [ClusterClient.PfCountAcrossSlots] for keys
+//	mapping to different hash slots.
 //
 // Parameters:
 //
@@ -3824,7 +4997,8 @@ func (client *baseClient) PfCount(ctx context.Context, keys []string) (int64, er
 //
 // Note:
 //
-//	When in cluster mode, `sourceKeys` and `destination` must map to the same hash slot.
+//	When in cluster mode, `sourceKeys` and `destination` must map to the same hash slot. Use
+//	[ClusterClient.PfMergeAcrossSlots] for keys mapping to different hash slots.
 //
 // Parameters:
 //
@@ -3898,6 +5072,32 @@ func (client *baseClient) Type(ctx context.Context, key string) (string, error)
 	return handleStringResponse(result)
 }
 
+// KeyInfo returns the metadata of key: whether it exists, its type, its time to live, its encoding and its approximate
+// size. TYPE, PTTL, OBJECT ENCODING and MEMORY USAGE are sent in a single transaction, so that the metadata are
+// consistent with each other. This is intended for tools that browse keys, such as admin UIs.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to describe.
+//
+// Return value:
+//
+//	The metadata of the key. The encoding and size are left empty if the server does not report them, e.g. if MEMORY
+//	USAGE is not permitted to the user.
+func (client *baseClient) KeyInfo(ctx context.Context, key string) (models.KeyInfo, error) {
+	batch := pipeline.NewClusterBatch(true)
+	addKeyInfoCommands(batch, key)
+	results, err := client.executeBatch(ctx, batch.Batch, false, nil)
+	if err != nil {
+		return models.KeyInfo{}, err
+	}
+	if len(results) != keyInfoCommands {
+		return models.KeyInfo{}, fmt.Errorf("unexpected number of responses of the key metadata: %d", len(results))
+	}
+	return parseKeyInfo(key, results)
+}
+
 // Alters the last access time of a key(s). A key is ignored if it does not exist.
 //
 // Note:
@@ -3922,18 +5122,44 @@ func (client *baseClient) Type(ctx context.Context, key string) (string, error)
 func (client *baseClient) Touch(ctx context.Context, keys []string) (int64, error) {
 	result, err := client.executeCommand(ctx, C.Touch, keys)
 	if err != nil {
-		return models.DefaultIntResponse, err
+		return models.DefaultIntResponse, err
+	}
+
+	return handleIntResponse(result)
+}
+
+// Renames `key` to `newKey`.
+// If `newKey` already exists it is overwritten.
+//
+// Note:
+//
+//	When in cluster mode, both `key` and `newKey` must map to the same hash slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to rename.
+//	newKey - The new name of the key.
+//
+// Return value:
+//
+//	If the key was successfully renamed, return "OK". If key does not exist, an error is thrown.
+//
+// [valkey.io]: https://valkey.io/commands/rename/
+func (client *baseClient) Rename(ctx context.Context, key string, newKey string) (string, error) {
+	result, err := client.executeCommand(ctx, C.Rename, []string{key, newKey})
+	if err != nil {
+		return models.DefaultStringResponse, err
 	}
-
-	return handleIntResponse(result)
+	return handleOkResponse(result)
 }
 
-// Renames `key` to `newKey`.
+// RenameAcrossSlots renames `key` to `newKey`, even if they map to different hash slots in cluster mode.
 // If `newKey` already exists it is overwritten.
 //
-// Note:
-//
-//	When in cluster mode, both `key` and `newKey` must map to the same hash slot.
+// RENAME is tried first. If it fails with a CROSSSLOT error, the key is copied with DUMP and RESTORE, keeping its
+// remaining time to live, and then deleted. Unlike RENAME, the fallback is not atomic: the value is briefly stored under
+// both names, and changes made to `key` between the DUMP and the DEL are lost.
 //
 // Parameters:
 //
@@ -3946,12 +5172,41 @@ func (client *baseClient) Touch(ctx context.Context, keys []string) (int64, erro
 //	If the key was successfully renamed, return "OK". If key does not exist, an error is thrown.
 //
 // [valkey.io]: https://valkey.io/commands/rename/
-func (client *baseClient) Rename(ctx context.Context, key string, newKey string) (string, error) {
-	result, err := client.executeCommand(ctx, C.Rename, []string{key, newKey})
+func (client *baseClient) RenameAcrossSlots(ctx context.Context, key string, newKey string) (string, error) {
+	result, err := client.Rename(ctx, key, newKey)
+	if err == nil || !isCrossSlotError(err) {
+		return result, err
+	}
+	ttl, err := client.PTTL(ctx, key)
 	if err != nil {
 		return models.DefaultStringResponse, err
 	}
-	return handleOkResponse(result)
+	dump, err := client.Dump(ctx, key)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	if dump.IsNil() || ttl == -2 {
+		return models.DefaultStringResponse, NewRequestError(fmt.Sprintf("cannot rename key %q: no such key", key))
+	}
+	// A TTL of -1 means the key has no expiry, which RESTORE expects as 0.
+	restoreTTL := time.Duration(max(ttl, 0)) * time.Millisecond
+	if ttl >= 0 && restoreTTL == 0 {
+		// The key is about to expire, but RESTORE would persist it with a TTL of 0.
+		restoreTTL = time.Millisecond
+	}
+	restoreOptions := options.NewRestoreOptions().SetReplace()
+	if _, err := client.RestoreWithOptions(ctx, newKey, restoreTTL, dump.Value(), *restoreOptions); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	if _, err := client.Del(ctx, []string{key}); err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return "OK", nil
+}
+
+// isCrossSlotError returns whether err was returned because the keys of a command map to different hash slots.
+func isCrossSlotError(err error) bool {
+	return strings.Contains(strings.ToUpper(err.Error()), "CROSSSLOT")
 }
 
 // Renames `key` to `newkey` if `newKey` does not yet exist.
@@ -4151,6 +5406,9 @@ func (client *baseClient) XReadGroup(
 // Note:
 //
 //	When in cluster mode, all keys in `keysAndIds` must map to the same hash slot.
+//	If ctx has a deadline, the BLOCK duration is capped to the time remaining until the deadline, including when the
+//	read would block indefinitely, so that the server stops blocking the connection once ctx expires. A read cancelled
+//	without a deadline returns immediately, but keeps blocking the connection until it completes on the server.
 //
 // See [valkey.io] for details.
 //
@@ -4160,7 +5418,8 @@ func (client *baseClient) XReadGroup(
 //	group - The consumer group name.
 //	consumer - The group consumer.
 //	keysAndIds - A map of keys and entry IDs to read from.
-//	opts - Options detailing how to read the stream.
+//	opts - Options detailing how to read the stream, e.g. whether it blocks with [options.XReadGroupOptions.SetBlock],
+//	  and whether the entries are acknowledged on delivery with [options.XReadGroupOptions.SetNoAck].
 //
 // Return value:
 //
@@ -4377,6 +5636,9 @@ func (client *baseClient) ZAddIncrWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/zincrby/
 func (client *baseClient) ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error) {
+	if err := validateFloat("ZINCRBY", increment, true); err != nil {
+		return models.DefaultFloatResponse, err
+	}
 	result, err := client.executeCommand(ctx, C.ZIncrBy, []string{key, utils.FloatToString(increment), member})
 	if err != nil {
 		return models.DefaultFloatResponse, err
@@ -4857,6 +6119,100 @@ func (client *baseClient) ZRangeStore(
 	return handleIntResponse(result)
 }
 
+// Stores the elements of the sorted set at `key` with a score between `min` and `max` into a new sorted set at
+// `destination`, like [ZRangeStore] with a [options.RangeByScore] query. If `destination` doesn't exist, a new sorted
+// set is created; if it exists, it's overwritten.
+//
+// Unlike a reversed [options.RangeByScore] query, which takes the maximum first, the boundaries are given in the same
+// order whether the range is reversed or not.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destination - The key for the destination sorted set.
+//	key - The key of the source sorted set.
+//	min - The minimum score of the range, see [options.NewScoreBoundary].
+//	max - The maximum score of the range, see [options.NewScoreBoundary].
+//	reverse - Whether the elements are ordered from the highest score to the lowest, which matters with a limit.
+//	limit - The offset and count of the elements to store, or nil to store every element of the range.
+//
+// Return value:
+//
+//	The number of elements in the resulting sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/zrangestore/
+func (client *baseClient) ZRangeStoreByScore(
+	ctx context.Context,
+	destination string,
+	key string,
+	min options.ScoreBound,
+	max options.ScoreBound,
+	reverse bool,
+	limit *options.Limit,
+) (int64, error) {
+	query := options.NewRangeByScoreQuery(min, max)
+	if reverse {
+		query = options.NewRangeByScoreQuery(max, min).SetReverse()
+	}
+	if limit != nil {
+		query.SetLimit(limit.Offset, limit.Count)
+	}
+	return client.ZRangeStore(ctx, destination, key, query)
+}
+
+// Stores the elements of the sorted set at `key` between `min` and `max` in lexicographical order into a new sorted set
+// at `destination`, like [ZRangeStore] with a [options.RangeByLex] query. If `destination` doesn't exist, a new sorted
+// set is created; if it exists, it's overwritten. The elements of the sorted set are expected to have the same score.
+//
+// Unlike a reversed [options.RangeByLex] query, which takes the maximum first, the boundaries are given in the same
+// order whether the range is reversed or not.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
+//
+// See [valkey.io] for more details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destination - The key for the destination sorted set.
+//	key - The key of the source sorted set.
+//	min - The lexicographical minimum of the range, see [options.NewLexBoundary].
+//	max - The lexicographical maximum of the range, see [options.NewLexBoundary].
+//	reverse - Whether the elements are ordered from the last to the first, which matters with a limit.
+//	limit - The offset and count of the elements to store, or nil to store every element of the range.
+//
+// Return value:
+//
+//	The number of elements in the resulting sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/zrangestore/
+func (client *baseClient) ZRangeStoreByLex(
+	ctx context.Context,
+	destination string,
+	key string,
+	min options.LexBound,
+	max options.LexBound,
+	reverse bool,
+	limit *options.Limit,
+) (int64, error) {
+	query := options.NewRangeByLexQuery(min, max)
+	if reverse {
+		query = options.NewRangeByLexQuery(max, min).SetReverse()
+	}
+	if limit != nil {
+		query.SetLimit(limit.Offset, limit.Count)
+	}
+	return client.ZRangeStore(ctx, destination, key, query)
+}
+
 // Removes the existing timeout on key, turning the key from volatile
 // (a key with an expire set) to persistent (a key that will never expire as no timeout is associated).
 //
@@ -4878,6 +6234,49 @@ func (client *baseClient) Persist(ctx context.Context, key string) (bool, error)
 	return handleBoolResponse(result)
 }
 
+// PersistAll removes the existing timeout of each of the given keys. The PERSIST commands are sent in a single
+// non-atomic batch, which is split by hash slot in cluster mode, so the keys may map to different hash slots.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	keys - The keys to remove the existing timeout on.
+//
+// Return value:
+//
+//	For each key in the order of keys, `false` if the key does not exist or does not have an associated timeout,
+//	`true` if the timeout has been removed.
+//
+// [valkey.io]: https://valkey.io/commands/persist/
+func (client *baseClient) PersistAll(ctx context.Context, keys []string) ([]bool, error) {
+	batch := pipeline.NewClusterBatch(false)
+	for _, key := range keys {
+		batch.Persist(key)
+	}
+	return executeBulk[bool](ctx, client, batch)
+}
+
+// executeBulk sends the commands of batch as a pipeline, and returns their results, which must all be of type T. A
+// cluster batch is used for both standalone and cluster clients, since a non-atomic batch does not depend on the mode.
+func executeBulk[T any](ctx context.Context, client *baseClient, batch *pipeline.ClusterBatch) ([]T, error) {
+	if len(batch.Commands) == 0 {
+		return []T{}, nil
+	}
+	results, err := client.executeBatch(ctx, batch.Batch, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]T, len(results))
+	for idx, result := range results {
+		value, ok := result.(T)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response in batch at index %d: %v", idx, result)
+		}
+		values[idx] = value
+	}
+	return values, nil
+}
+
 // Returns the number of members in the sorted set stored at `key` with scores between `min` and `max` score.
 //
 // See [valkey.io] for details.
@@ -5845,6 +7244,62 @@ func (client *baseClient) ZRandMemberWithCountWithScores(
 	return handleMemberAndScoreArrayResponse(result)
 }
 
+// Returns random members from the sorted set stored at `key`, as selected by `count`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	count - The number of members to return, created with [options.Unique] for distinct members or with
+//	  [options.AllowRepeats] to allow duplicates.
+//
+// Return value:
+//
+//	An array of members from the sorted set, or an empty array when the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/zrandmember/
+func (client *baseClient) ZRandMemberWithRandomCount(
+	ctx context.Context,
+	key string,
+	count options.RandomCount,
+) ([]string, error) {
+	signed, err := count.SignedCount()
+	if err != nil {
+		return nil, err
+	}
+	return client.ZRandMemberWithCount(ctx, key, signed)
+}
+
+// Returns random members with their scores from the sorted set stored at `key`, as selected by `count`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	count - The number of members to return, created with [options.Unique] for distinct members or with
+//	  [options.AllowRepeats] to allow duplicates.
+//
+// Return value:
+//
+//	An array of `models.MemberAndScore` objects, or an empty array when the key does not exist.
+//
+// [valkey.io]: https://valkey.io/commands/zrandmember/
+func (client *baseClient) ZRandMemberWithRandomCountWithScores(
+	ctx context.Context,
+	key string,
+	count options.RandomCount,
+) ([]models.MemberAndScore, error) {
+	signed, err := count.SignedCount()
+	if err != nil {
+		return nil, err
+	}
+	return client.ZRandMemberWithCountWithScores(ctx, key, signed)
+}
+
 // Returns the scores associated with the specified `members` in the sorted set stored at `key`.
 //
 // Since:
@@ -6985,6 +8440,54 @@ func (client *baseClient) XInfoGroups(ctx context.Context, key string) ([]models
 	return handleXInfoGroupsResponse(response)
 }
 
+// StreamLag returns the backlog of a consumer group of the stream stored at `key`, combining the group's attributes
+// returned by XINFO GROUPS, the summary of its pending messages returned by XPENDING, and the last entry ID returned by
+// XINFO STREAM. The commands are sent one after the other, so the result is not an atomic snapshot of the stream.
+//
+// Parameters:
+//
+//	ctx   - The context for controlling the command execution.
+//	key   - The key of the stream.
+//	group - The consumer group name.
+//
+// Return value:
+//
+//	A [models.StreamLag] describing the backlog of the group.
+func (client *baseClient) StreamLag(ctx context.Context, key string, group string) (models.StreamLag, error) {
+	pending, err := client.XPending(ctx, key, group)
+	if err != nil {
+		return models.StreamLag{}, err
+	}
+	stream, err := client.XInfoStream(ctx, key)
+	if err != nil {
+		return models.StreamLag{}, err
+	}
+	groups, err := client.XInfoGroups(ctx, key)
+	if err != nil {
+		return models.StreamLag{}, err
+	}
+	for _, info := range groups {
+		if info.Name != group {
+			continue
+		}
+		return models.StreamLag{
+			Group:           group,
+			Consumers:       info.Consumers,
+			Lag:             info.Lag,
+			Pending:         info.Pending,
+			OldestPendingId: pending.StartId,
+			NewestPendingId: pending.EndId,
+			ConsumerPending: pending.ConsumerMessages,
+			LastDeliveredId: info.LastDeliveredId,
+			LastEntryId:     stream.LastGeneratedID,
+			StreamLength:    stream.Length,
+			CaughtUp:        info.LastDeliveredId == stream.LastGeneratedID,
+		}, nil
+	}
+	// The group was destroyed after its pending messages were read.
+	return models.StreamLag{}, NewRequestError(fmt.Sprintf("consumer group %q does not exist on stream %q", group, key))
+}
+
 // Reads or modifies the array of bits representing the string that is held at key
 // based on the specified sub commands.
 //
@@ -7616,6 +9119,9 @@ func (client *baseClient) ZInterCardWithOptions(
 //
 // [valkey.io]: https://valkey.io/commands/zlexcount/
 func (client *baseClient) ZLexCount(ctx context.Context, key string, rangeQuery options.RangeByLex) (int64, error) {
+	if err := rangeQuery.Validate(); err != nil {
+		return models.DefaultIntResponse, err
+	}
 	args := []string{key}
 	args = append(args, rangeQuery.ToArgsLexCount()...)
 	result, err := client.executeCommand(ctx, C.ZLexCount, args)
@@ -7831,6 +9337,65 @@ func (client *baseClient) GeoAddWithOptions(
 	return handleIntResponse(result)
 }
 
+// Adds geospatial members with their positions to the specified sorted set stored at `key`, in the order of `members`.
+// If a member is already a part of the sorted set, its position is updated.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	members - The members and their positions. See [options.GeoMember].
+//	  The command will report an error when index coordinates are out of the specified range.
+//
+// Return value:
+//
+//	The number of elements added to the sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/geoadd/
+func (client *baseClient) GeoAddMembers(ctx context.Context, key string, members []options.GeoMember) (int64, error) {
+	return client.GeoAddMembersWithOptions(ctx, key, members, *options.NewGeoAddOptions())
+}
+
+// Adds geospatial members with their positions to the specified sorted set stored at `key`, in the order of `members`.
+// If a member is already a part of the sorted set, its position is updated.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the sorted set.
+//	members - The members and their positions. See [options.GeoMember].
+//	  The command will report an error when index coordinates are out of the specified range.
+//	geoAddOptions - The options for the GeoAdd command, see - [options.GeoAddOptions].
+//
+// Return value:
+//
+//	The number of elements added to the sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/geoadd/
+func (client *baseClient) GeoAddMembersWithOptions(
+	ctx context.Context,
+	key string,
+	members []options.GeoMember,
+	geoAddOptions options.GeoAddOptions,
+) (int64, error) {
+	args := []string{key}
+	optionsArgs, err := geoAddOptions.ToArgs()
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, options.GeoMembersToArray(members)...)
+	result, err := client.executeCommand(ctx, C.GeoAdd, args)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
 // Returns the GeoHash strings representing the positions of all the specified
 // `members` in the sorted set stored at the `key`.
 //
@@ -8021,7 +9586,7 @@ func (client *baseClient) GeoSearchWithFullOptions(
 	if err != nil {
 		return nil, err
 	}
-	return handleLocationArrayResponse(result)
+	return handleLocationArrayResponse(result, searchByShape.Unit)
 }
 
 // Returns the members of a sorted set populated with geospatial information using [Client.GeoAdd] or [ClusterClient.GeoAdd],
@@ -8848,7 +10413,7 @@ func (client *baseClient) executeScriptWithRoute(
 	keys []string,
 	args []string,
 	route config.Route,
-) (*C.struct_CommandResponse, error) {
+) (response *C.struct_CommandResponse, err error) {
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
@@ -8856,6 +10421,33 @@ func (client *baseClient) executeScriptWithRoute(
 	default:
 		// Continue with execution
 	}
+	if client.tenant != nil {
+		return nil, client.tenant.unsupported("InvokeScript")
+	}
+	if client.commandFilter != nil {
+		if err := client.commandFilter.checkName("EVALSHA"); err != nil {
+			return nil, err
+		}
+	}
+	if client.circuitBreaker != nil {
+		done, openErr := client.circuitBreaker.allow(route)
+		if openErr != nil {
+			return nil, openErr
+		}
+		defer func() { done(err) }()
+	}
+	if client.auditHook != nil {
+		defer func() { client.auditScript(hash, keys, args, err) }()
+	}
+	if err := client.checkRequestSize(keys, args); err != nil {
+		return nil, err
+	}
+	if client.quotaHook != nil {
+		size := argsSize(keys) + argsSize(args)
+		if err := client.checkQuota(ctx, "EVALSHA", commandFamily(uint32(C.EvalSha)), 1, size); err != nil {
+			return nil, err
+		}
+	}
 	var cKeysPtr *C.uintptr_t = nil
 	var keysLengthsPtr *C.ulong = nil
 	if len(keys) > 0 {
@@ -8872,23 +10464,11 @@ func (client *baseClient) executeScriptWithRoute(
 		argsLengthsPtr = &argsLengths[0]
 	}
 
-	var routeBytesPtr *C.uchar = nil
-	var routeBytesCount C.uintptr_t = 0
-	if route != nil {
-		routeProto, err := routeToProtobuf(route)
-		if err != nil {
-			return nil, errors.New("ExecuteScript failed due to invalid route")
-		}
-		msg, err := proto.Marshal(routeProto)
-		if err != nil {
-			return nil, err
-		}
-
-		routeBytesCount = C.uintptr_t(len(msg))
-		routeCBytes := C.CBytes(msg)
-		defer C.free(routeCBytes)
-		routeBytesPtr = (*C.uchar)(routeCBytes)
+	routeBytesPtr, routeBytesCount, err := routeToCBytes(route)
+	if err != nil {
+		return nil, errors.New("ExecuteScript failed due to invalid route")
 	}
+	defer freeCBuffer(unsafe.Pointer(routeBytesPtr))
 
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
@@ -8903,9 +10483,13 @@ func (client *baseClient) executeScriptWithRoute(
 		client.mu.Unlock()
 		return nil, NewClosingError("ExecuteScript failed. The client is closed.")
 	}
-	client.pending[resultChannelPtr] = struct{}{}
-	hash_cstring := C.CString(hash)
-	defer C.free(unsafe.Pointer(hash_cstring))
+	if err := client.registerPending(resultChannelPtr); err != nil {
+		client.mu.Unlock()
+		return nil, err
+	}
+	hash_cstring := cString(hash)
+	defer freeCBuffer(unsafe.Pointer(hash_cstring))
+	started := time.Now()
 	C.invoke_script(
 		client.coreClient,
 		C.uintptr_t(pinnedChannelPtr),
@@ -8919,6 +10503,9 @@ func (client *baseClient) executeScriptWithRoute(
 		routeBytesPtr,
 		routeBytesCount,
 	)
+	// The core copies the keys and arguments before C.invoke_script returns, see executeCommandWithRoute.
+	runtime.KeepAlive(keys)
+	runtime.KeepAlive(args)
 	client.mu.Unlock()
 
 	// Wait for result or context cancellation
@@ -8934,7 +10521,7 @@ func (client *baseClient) executeScriptWithRoute(
 		go func() {
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
-				C.free_command_response(payload.value)
+				freeCommandResponse(payload.value)
 			}
 		}()
 		return nil, ctx.Err()
@@ -8947,10 +10534,17 @@ func (client *baseClient) executeScriptWithRoute(
 		delete(client.pending, resultChannelPtr)
 	}
 	client.mu.Unlock()
+	sent := argsSize(keys) + argsSize(args)
+	client.recordCommand(commandFamily(uint32(C.EvalSha)), "EVALSHA", sent, payload.value, started, payload.error)
+	client.recordSlowScript(hash, keys, args, route, started)
 
 	if payload.error != nil {
 		return nil, payload.error
 	}
+	if err := applyMapKeyPolicy(payload.value, client.mapKeyPolicy); err != nil {
+		freeCommandResponse(payload.value)
+		return nil, err
+	}
 	return payload.value, nil
 }
 
@@ -9108,3 +10702,29 @@ func (client *baseClient) Watch(ctx context.Context, keys []string) (string, err
 	}
 	return handleOkResponse(result)
 }
+
+// Extracts the keys of a command, as determined by the server from the command's key specifications. This allows
+// routing or auditing layers to find the keys of arbitrary commands, including those of loaded modules.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx  - The context for controlling the command execution.
+//	args - The command to extract the keys from, including the command name, e.g. `[]string{"SET", "key", "value"}`.
+//
+// Return value:
+//
+//	The keys of the command, in the order they appear in args.
+//
+// [valkey.io]: https://valkey.io/commands/command-getkeys/
+func (client *baseClient) CommandGetKeys(ctx context.Context, args []string) ([]string, error) {
+	if len(args) == 0 {
+		return nil, errors.New("COMMAND GETKEYS requires the command name")
+	}
+	result, err := client.executeCommand(ctx, C.CustomCommand, append([]string{"COMMAND", "GETKEYS"}, args...))
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
@@ -0,0 +1,365 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+const (
+	// DefaultBlobChunkSize is the size of the chunks of a blob, if not explicitly set.
+	DefaultBlobChunkSize = 1 << 20
+	// DefaultBlobParallelism is the number of chunks of a blob uploaded concurrently, if not explicitly set.
+	DefaultBlobParallelism = 4
+)
+
+// Fields of the manifest of a blob.
+const (
+	blobSizeField       = "size"
+	blobChunkSizeField  = "chunk_size"
+	blobGenerationField = "generation"
+)
+
+// ErrBlobNotFound is returned by the methods of [Blob] reading a blob that does not exist.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// Blob stores a large binary object across several string keys of a fixed size, the chunks, since a single string
+// value is limited to 512 MB and large values block the server while they are transferred. A hash at the key of the
+// blob, the manifest, holds the size of the blob, the size of its chunks, and the generation of the chunks, which
+// changes with every upload. The chunks are stored at "<key>:chunk:<generation>:<index>", so that they spread across
+// the nodes of a cluster, unless the key holds a hash tag.
+//
+// An upload writes the chunks of a new generation before switching the manifest to it, so that readers never observe
+// a partially uploaded blob. Appends extend the chunks in place, so a blob must only be appended to by a single writer
+// at a time, and readers may observe a partial append.
+type Blob struct {
+	client      *baseClient
+	key         string
+	chunkSize   int64
+	parallelism int
+}
+
+// blobManifest is the content of the manifest of a blob.
+type blobManifest struct {
+	size       int64
+	chunkSize  int64
+	generation string
+}
+
+// Blob returns the blob stored at key, see [Blob].
+//
+// Parameters:
+//
+//	key - The key of the manifest of the blob.
+func (client *baseClient) Blob(key string) *Blob {
+	return &Blob{client: client, key: key, chunkSize: DefaultBlobChunkSize, parallelism: DefaultBlobParallelism}
+}
+
+// WithChunkSize sets the size of the chunks written by [Blob.Upload], and by [Blob.Append] to a new blob. The chunks
+// of an existing blob keep the size they were uploaded with. If not explicitly set, [DefaultBlobChunkSize] is used.
+func (blob *Blob) WithChunkSize(chunkSize int64) *Blob {
+	blob.chunkSize = chunkSize
+	return blob
+}
+
+// WithParallelism sets the number of chunks uploaded concurrently by [Blob.Upload]. If not explicitly set,
+// [DefaultBlobParallelism] is used.
+func (blob *Blob) WithParallelism(parallelism int) *Blob {
+	blob.parallelism = parallelism
+	return blob
+}
+
+func (blob *Blob) chunkKey(generation string, index int64) string {
+	return blob.key + ":chunk:" + generation + ":" + strconv.FormatInt(index, 10)
+}
+
+func (manifest blobManifest) chunks() int64 {
+	return (manifest.size + manifest.chunkSize - 1) / manifest.chunkSize
+}
+
+func newBlobGeneration() (string, error) {
+	generation := make([]byte, 8)
+	if _, err := rand.Read(generation); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(generation), nil
+}
+
+// manifest reads the manifest of the blob, returning ErrBlobNotFound if the blob does not exist.
+func (blob *Blob) manifest(ctx context.Context) (blobManifest, error) {
+	fields, err := blob.client.HMGet(ctx, blob.key, []string{blobSizeField, blobChunkSizeField, blobGenerationField})
+	if err != nil {
+		return blobManifest{}, err
+	}
+	if fields[0].IsNil() || fields[1].IsNil() || fields[2].IsNil() {
+		return blobManifest{}, ErrBlobNotFound
+	}
+	size, err := strconv.ParseInt(fields[0].Value(), 10, 64)
+	if err != nil {
+		return blobManifest{}, fmt.Errorf("invalid size in the manifest of blob %q: %w", blob.key, err)
+	}
+	chunkSize, err := strconv.ParseInt(fields[1].Value(), 10, 64)
+	if err != nil || chunkSize <= 0 {
+		return blobManifest{}, fmt.Errorf("invalid chunk size in the manifest of blob %q", blob.key)
+	}
+	return blobManifest{size: size, chunkSize: chunkSize, generation: fields[2].Value()}, nil
+}
+
+func (blob *Blob) writeManifest(ctx context.Context, manifest blobManifest) error {
+	_, err := blob.client.HSet(ctx, blob.key, map[string]string{
+		blobSizeField:       strconv.FormatInt(manifest.size, 10),
+		blobChunkSizeField:  strconv.FormatInt(manifest.chunkSize, 10),
+		blobGenerationField: manifest.generation,
+	})
+	return err
+}
+
+// deleteChunks deletes the chunks of a generation. DEL is split by hash slot in cluster mode.
+func (blob *Blob) deleteChunks(ctx context.Context, generation string, chunks int64) error {
+	if chunks == 0 {
+		return nil
+	}
+	keys := make([]string, chunks)
+	for idx := range keys {
+		keys[idx] = blob.chunkKey(generation, int64(idx))
+	}
+	_, err := blob.client.Del(ctx, keys)
+	return err
+}
+
+// Upload replaces the content of the blob with the content of r. The chunks are uploaded concurrently, and the
+// manifest is switched to them once they are all written, after which the chunks of the previous content are deleted.
+// If the upload fails, the blob keeps its previous content.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	r - The content of the blob.
+//
+// Return value:
+//
+//	The size of the blob.
+func (blob *Blob) Upload(ctx context.Context, r io.Reader) (int64, error) {
+	if blob.chunkSize <= 0 || blob.parallelism <= 0 {
+		return 0, errors.New("the chunk size and parallelism of a blob must be positive")
+	}
+	generation, err := newBlobGeneration()
+	if err != nil {
+		return 0, err
+	}
+	manifest := blobManifest{chunkSize: blob.chunkSize, generation: generation}
+
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	var errOnce sync.Once
+	var uploadErr error
+	fail := func(err error) {
+		errOnce.Do(func() {
+			uploadErr = err
+			cancel()
+		})
+	}
+	slots := make(chan struct{}, blob.parallelism)
+	for index := int64(0); uploadCtx.Err() == nil; index++ {
+		chunk := make([]byte, blob.chunkSize)
+		n, err := io.ReadFull(r, chunk)
+		if n > 0 {
+			manifest.size += int64(n)
+			slots <- struct{}{}
+			wg.Add(1)
+			go func(index int64, data string) {
+				defer func() { <-slots; wg.Done() }()
+				if _, err := blob.client.SetRange(uploadCtx, blob.chunkKey(generation, index), 0, data); err != nil {
+					fail(err)
+				}
+			}(index, string(chunk[:n]))
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			fail(err)
+		}
+	}
+	wg.Wait()
+	if uploadErr == nil {
+		uploadErr = ctx.Err()
+	}
+	if uploadErr != nil {
+		_ = blob.deleteChunks(context.WithoutCancel(ctx), generation, manifest.chunks())
+		return 0, uploadErr
+	}
+
+	previous, err := blob.manifest(ctx)
+	if err != nil && !errors.Is(err, ErrBlobNotFound) {
+		return 0, err
+	}
+	if err := blob.writeManifest(ctx, manifest); err != nil {
+		return 0, err
+	}
+	if previous.generation != "" {
+		_ = blob.deleteChunks(ctx, previous.generation, previous.chunks())
+	}
+	return manifest.size, nil
+}
+
+// Append adds data at the end of the blob, creating it if it does not exist. The last chunk is filled with SETRANGE
+// before new chunks are written, and the manifest is then updated with the new size.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	data - The data to append.
+//
+// Return value:
+//
+//	The size of the blob after the append.
+func (blob *Blob) Append(ctx context.Context, data []byte) (int64, error) {
+	manifest, err := blob.manifest(ctx)
+	if errors.Is(err, ErrBlobNotFound) {
+		if blob.chunkSize <= 0 {
+			return 0, errors.New("the chunk size of a blob must be positive")
+		}
+		manifest = blobManifest{chunkSize: blob.chunkSize}
+		manifest.generation, err = newBlobGeneration()
+	}
+	if err != nil {
+		return 0, err
+	}
+	for len(data) > 0 {
+		index, offset := manifest.size/manifest.chunkSize, manifest.size%manifest.chunkSize
+		n := min(int64(len(data)), manifest.chunkSize-offset)
+		chunkKey := blob.chunkKey(manifest.generation, index)
+		if _, err := blob.client.SetRange(ctx, chunkKey, int(offset), string(data[:n])); err != nil {
+			return 0, err
+		}
+		manifest.size += n
+		data = data[n:]
+	}
+	if err := blob.writeManifest(ctx, manifest); err != nil {
+		return 0, err
+	}
+	return manifest.size, nil
+}
+
+// ReadRange reads length bytes of the blob, starting at offset. The chunks holding the range are read with GETRANGE in
+// a single non-atomic batch, which is split by hash slot in cluster mode.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	offset - The offset of the first byte to read.
+//	length - The number of bytes to read.
+//
+// Return value:
+//
+//	The bytes read, which are fewer than length if the range extends past the end of the blob.
+func (blob *Blob) ReadRange(ctx context.Context, offset int64, length int64) ([]byte, error) {
+	if offset < 0 || length < 0 {
+		return nil, errors.New("the offset and length of a blob range cannot be negative")
+	}
+	manifest, err := blob.manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	end := min(offset+length, manifest.size)
+	if offset >= end {
+		return []byte{}, nil
+	}
+	batch := pipeline.NewClusterBatch(false)
+	for index := offset / manifest.chunkSize; index*manifest.chunkSize < end; index++ {
+		start := max(offset-index*manifest.chunkSize, 0)
+		last := min(end-index*manifest.chunkSize, manifest.chunkSize) - 1
+		batch.GetRange(blob.chunkKey(manifest.generation, index), int(start), int(last))
+	}
+	results, err := blob.client.executeBatch(ctx, batch.Batch, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, end-offset)
+	for _, result := range results {
+		chunk, ok := result.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response of GETRANGE for blob %q: %v", blob.key, result)
+		}
+		data = append(data, chunk...)
+	}
+	if int64(len(data)) != end-offset {
+		return nil, fmt.Errorf("the chunks of blob %q are incomplete, it may have been replaced while being read", blob.key)
+	}
+	return data, nil
+}
+
+// Download writes the content of the blob to w, one chunk at a time.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	w - The writer receiving the content of the blob.
+//
+// Return value:
+//
+//	The number of bytes written.
+func (blob *Blob) Download(ctx context.Context, w io.Writer) (int64, error) {
+	manifest, err := blob.manifest(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var written int64
+	for index := range manifest.chunks() {
+		chunk, err := blob.client.GetRange(ctx, blob.chunkKey(manifest.generation, index), 0, -1)
+		if err != nil {
+			return written, err
+		}
+		expected := min(manifest.chunkSize, manifest.size-index*manifest.chunkSize)
+		if int64(len(chunk)) < expected {
+			return written, fmt.Errorf(
+				"the chunks of blob %q are incomplete, it may have been replaced while being read", blob.key)
+		}
+		n, err := io.WriteString(w, chunk[:expected])
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// Size returns the size of the blob in bytes, or [ErrBlobNotFound] if it does not exist.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+func (blob *Blob) Size(ctx context.Context) (int64, error) {
+	manifest, err := blob.manifest(ctx)
+	return manifest.size, err
+}
+
+// Delete deletes the manifest of the blob, and then its chunks. Deleting a blob that does not exist does nothing.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+func (blob *Blob) Delete(ctx context.Context) error {
+	manifest, err := blob.manifest(ctx)
+	if errors.Is(err, ErrBlobNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := blob.client.Del(ctx, []string{blob.key}); err != nil {
+		return err
+	}
+	return blob.deleteChunks(ctx, manifest.generation, manifest.chunks())
+}
@@ -0,0 +1,31 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobManifest_Chunks(t *testing.T) {
+	assert.Equal(t, int64(0), blobManifest{size: 0, chunkSize: 4}.chunks())
+	assert.Equal(t, int64(1), blobManifest{size: 4, chunkSize: 4}.chunks())
+	assert.Equal(t, int64(2), blobManifest{size: 5, chunkSize: 4}.chunks())
+}
+
+func TestBlob_ChunkKey(t *testing.T) {
+	blob := (&baseClient{}).Blob("{user}:avatar")
+	assert.Equal(t, "{user}:avatar:chunk:0a1b:3", blob.chunkKey("0a1b", 3))
+}
+
+func TestBlob_InvalidOptions(t *testing.T) {
+	blob := (&baseClient{}).Blob("avatar").WithChunkSize(0)
+	_, err := blob.Upload(context.Background(), bytes.NewReader([]byte("data")))
+	assert.EqualError(t, err, "the chunk size and parallelism of a blob must be positive")
+
+	_, err = (&baseClient{}).Blob("avatar").ReadRange(context.Background(), -1, 1)
+	assert.EqualError(t, err, "the offset and length of a blob range cannot be negative")
+}
@@ -0,0 +1,121 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// blockingTimeoutArg locates the server-side timeout of a blocking command in its arguments.
+type blockingTimeoutArg struct {
+	// index returns the index of the timeout argument, or -1 if the command does not block.
+	index func(args []string) int
+	// millis is set if the timeout is an integer number of milliseconds, rather than a number of seconds.
+	millis bool
+}
+
+// timeoutFirstArg and timeoutLastArg locate the timeout of the commands taking it first or last.
+func timeoutFirstArg(args []string) int { return 0 }
+
+func timeoutLastArg(args []string) int { return len(args) - 1 }
+
+// xreadBlockArg returns the index of the milliseconds following the BLOCK option of XREAD, or -1 if the option is not
+// given.
+func xreadBlockArg(args []string) int {
+	return blockOptionArg(args, 0)
+}
+
+// xreadGroupBlockArg returns the index of the milliseconds following the BLOCK option of XREADGROUP, or -1 if the
+// option is not given. The options follow GROUP and the names of the group and the consumer, which may be BLOCK.
+func xreadGroupBlockArg(args []string) int {
+	return blockOptionArg(args, 3)
+}
+
+// blockOptionArg returns the index of the milliseconds following the BLOCK option among the options starting at start
+// and ending at STREAMS, or -1 if the option is not given.
+func blockOptionArg(args []string, start int) int {
+	for idx := start; idx < len(args); idx++ {
+		if strings.EqualFold(args[idx], "STREAMS") {
+			break
+		}
+		if strings.EqualFold(args[idx], "BLOCK") && idx+1 < len(args) {
+			return idx + 1
+		}
+	}
+	return -1
+}
+
+// blockingTimeoutArgs are the blocking commands whose server-side timeout is clamped to the deadline of their context.
+var blockingTimeoutArgs = map[C.RequestType]blockingTimeoutArg{
+	C.BLMove:     {index: timeoutLastArg},
+	C.BLMPop:     {index: timeoutFirstArg},
+	C.BLPop:      {index: timeoutLastArg},
+	C.BRPop:      {index: timeoutLastArg},
+	C.BZMPop:     {index: timeoutFirstArg},
+	C.BZPopMax:   {index: timeoutLastArg},
+	C.BZPopMin:   {index: timeoutLastArg},
+	C.XRead:      {index: xreadBlockArg, millis: true},
+	C.XReadGroup: {index: xreadGroupBlockArg, millis: true},
+	C.Wait:       {index: timeoutLastArg, millis: true},
+}
+
+// blockingCustomCommandArgs are the blocking commands sent as custom commands, by name, whose server-side timeout is
+// clamped like those of blockingTimeoutArgs. Their arguments start with the command name.
+var blockingCustomCommandArgs = map[string]blockingTimeoutArg{
+	"BRPOPLPUSH": {index: timeoutLastArg},
+}
+
+// clampBlockingTimeout lowers the server-side timeout of a blocking command to the time left before the deadline of its
+// context, so that the server does not keep blocking for a caller that already gave up. A timeout of 0, which blocks
+// indefinitely, is clamped as well. It returns the arguments unchanged if the command does not block, if the context has
+// no deadline, or if the timeout is already shorter. Custom commands are only clamped if they are listed in
+// blockingCustomCommandArgs, such as BRPOPLPUSH which the core has no request type for. The commands of batches are
+// not clamped.
+func clampBlockingTimeout(ctx context.Context, requestType C.RequestType, args []string) []string {
+	timeoutArg, ok := blockingTimeoutArgs[requestType]
+	if requestType == C.CustomCommand && len(args) > 0 {
+		timeoutArg, ok = blockingCustomCommandArgs[strings.ToUpper(args[0])]
+	}
+	if !ok {
+		return args
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return args
+	}
+	idx := timeoutArg.index(args)
+	if idx < 0 || idx >= len(args) {
+		return args
+	}
+	// The remaining time is rounded up to a millisecond, since a timeout of 0 would block indefinitely.
+	remaining := (time.Until(deadline) + time.Millisecond - 1).Truncate(time.Millisecond)
+	if remaining <= 0 {
+		return args
+	}
+	var clamped string
+	if timeoutArg.millis {
+		timeout, err := strconv.ParseInt(args[idx], 10, 64)
+		if err != nil || timeout < 0 || (timeout > 0 && timeout <= remaining.Milliseconds()) {
+			return args
+		}
+		clamped = utils.IntToString(remaining.Milliseconds())
+	} else {
+		timeout, err := strconv.ParseFloat(args[idx], 64)
+		if err != nil || timeout < 0 || (timeout > 0 && timeout <= remaining.Seconds()) {
+			return args
+		}
+		clamped = utils.FloatToString(remaining.Seconds())
+	}
+	clampedArgs := make([]string, len(args))
+	copy(clampedArgs, args)
+	clampedArgs[idx] = clamped
+	return clampedArgs
+}
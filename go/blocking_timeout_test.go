@@ -0,0 +1,69 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClampBlockingTimeout(t *testing.T) {
+	requestTypes := requestTypesByName()
+	blpop, blmpop, xread, get := requestTypes["BLPOP"], requestTypes["BLMPOP"], requestTypes["XREAD"], requestTypes["GET"]
+	xreadgroup := requestTypes["XREADGROUP"]
+
+	args := []string{"key", "10"}
+	assert.Equal(t, args, clampBlockingTimeout(context.Background(), blpop, args))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	seconds := func(arg string) float64 {
+		value, err := strconv.ParseFloat(arg, 64)
+		require.NoError(t, err)
+		return value
+	}
+
+	clamped := clampBlockingTimeout(ctx, blpop, args)
+	assert.InDelta(t, 0.5, seconds(clamped[1]), 0.1)
+	assert.Equal(t, "10", args[1])
+	assert.InDelta(t, 0.5, seconds(clampBlockingTimeout(ctx, blpop, []string{"key", "0"})[1]), 0.1)
+	assert.Equal(t, []string{"key", "0.1"}, clampBlockingTimeout(ctx, blpop, []string{"key", "0.1"}))
+	assert.Equal(t, []string{"key", "-1"}, clampBlockingTimeout(ctx, blpop, []string{"key", "-1"}))
+
+	clamped = clampBlockingTimeout(ctx, blmpop, []string{"10", "1", "key", "LEFT"})
+	assert.InDelta(t, 0.5, seconds(clamped[0]), 0.1)
+
+	clamped = clampBlockingTimeout(ctx, xread, []string{"COUNT", "1", "block", "0", "STREAMS", "block", "0"})
+	millis, err := strconv.ParseInt(clamped[3], 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 500, millis, 100)
+	assert.Equal(t, "0", clamped[6])
+	noBlock := []string{"STREAMS", "BLOCK", "0"}
+	assert.Equal(t, noBlock, clampBlockingTimeout(ctx, xread, noBlock))
+
+	// The names of the group and the consumer are not mistaken for the BLOCK option.
+	groupArgs := []string{"GROUP", "BLOCK", "BLOCK", "BLOCK", "0", "STREAMS", "key", ">"}
+	clamped = clampBlockingTimeout(ctx, xreadgroup, groupArgs)
+	assert.Equal(t, []string{"GROUP", "BLOCK", "BLOCK", "BLOCK"}, clamped[:4])
+	millis, err = strconv.ParseInt(clamped[4], 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, 500, millis, 100)
+	noGroupBlock := []string{"GROUP", "BLOCK", "BLOCK", "STREAMS", "key", ">"}
+	assert.Equal(t, noGroupBlock, clampBlockingTimeout(ctx, xreadgroup, noGroupBlock))
+
+	assert.Equal(t, []string{"10"}, clampBlockingTimeout(ctx, get, []string{"10"}))
+
+	// BRPOPLPUSH is sent as a custom command, and is clamped by name.
+	customCommand := get
+	customCommand = 1 // C.CustomCommand, which tests cannot refer to
+	clamped = clampBlockingTimeout(ctx, customCommand, []string{"brpoplpush", "src", "dst", "0"})
+	assert.Equal(t, []string{"brpoplpush", "src", "dst"}, clamped[:3])
+	assert.InDelta(t, 0.5, seconds(clamped[3]), 0.1)
+	assert.Equal(t, []string{"BLPOP", "key", "0"}, clampBlockingTimeout(ctx, customCommand, []string{"BLPOP", "key", "0"}))
+	assert.Empty(t, clampBlockingTimeout(ctx, customCommand, nil))
+}
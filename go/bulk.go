@@ -0,0 +1,232 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// BulkLoad sets a large number of keys, e.g. to warm up a cache, by splitting them into chunks sent as MSET commands
+// with bounded parallelism. Unlike a single MSET, the keys are not set atomically. Once a chunk fails, no further
+// chunks are sent, and the chunks in flight are awaited before returning.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keyValueMap - A key-value map consisting of keys and their respective values to set.
+//	opts - The chunk size, parallelism and progress callback. See [options.BulkOptions].
+//
+// Return value:
+//
+//	The number of keys set, which is less than the number of keys in case of an error.
+func (client *baseClient) BulkLoad(
+	ctx context.Context,
+	keyValueMap map[string]string,
+	opts options.BulkOptions,
+) (int, error) {
+	keys := make([]string, 0, len(keyValueMap))
+	for key := range keyValueMap {
+		keys = append(keys, key)
+	}
+	return runBulk(ctx, keys, opts, func(ctx context.Context, _ int, chunk []string) error {
+		values := make(map[string]string, len(chunk))
+		for _, key := range chunk {
+			values[key] = keyValueMap[key]
+		}
+		_, err := client.MSet(ctx, values)
+		return err
+	})
+}
+
+// BulkGet gets the values of a large number of keys by splitting them into chunks sent as MGET commands with bounded
+// parallelism. Unlike a single MGET, the values are not read at the same point in time. Once a chunk fails, no further
+// chunks are sent, and the chunks in flight are awaited before returning.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys to get the values of.
+//	opts - The chunk size, parallelism and progress callback. See [options.BulkOptions].
+//
+// Return value:
+//
+//	The values of the keys, in the order of keys. If a key does not exist, its value is [models.CreateNilStringResult].
+func (client *baseClient) BulkGet(
+	ctx context.Context,
+	keys []string,
+	opts options.BulkOptions,
+) ([]models.Result[string], error) {
+	values := make([]models.Result[string], len(keys))
+	_, err := runBulk(ctx, keys, opts, func(ctx context.Context, offset int, chunk []string) error {
+		result, err := client.MGet(ctx, chunk)
+		if err != nil {
+			return err
+		}
+		copy(values[offset:], result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// runBulk calls send for consecutive chunks of keys, along with the offset of each chunk in keys, with at most
+// opts.Parallelism calls in flight. It returns the number of keys of the chunks which succeeded along with the first
+// error.
+func runBulk(
+	ctx context.Context,
+	keys []string,
+	opts options.BulkOptions,
+	send func(ctx context.Context, offset int, chunk []string) error,
+) (int, error) {
+	if opts.ChunkSize < 1 {
+		return 0, errors.New("the chunk size of a bulk operation must be at least 1")
+	}
+	if opts.Parallelism < 1 {
+		return 0, errors.New("the parallelism of a bulk operation must be at least 1")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		done     int
+		firstErr error
+	)
+	slots := make(chan struct{}, opts.Parallelism)
+	for start := 0; start < len(keys); start += opts.ChunkSize {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		offset, chunk := start, keys[start:min(start+opts.ChunkSize, len(keys))]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			err := send(ctx, offset, chunk)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			done += len(chunk)
+			if opts.Progress != nil {
+				opts.Progress(done, len(keys))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+	return done, firstErr
+}
+
+// ExecAll runs functions sending commands with the client concurrently, with at most
+// [options.DefaultExecAllParallelism] of them running at once, e.g. to fan out reads. Every function is run even if
+// others fail, unless ctx is done before it starts.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the execution. The functions are not started anymore once it is done.
+//	fns - The functions to run, which are given the client.
+//
+// Return value:
+//
+//	The errors returned by the functions joined in the order of fns, followed by the error of ctx if some functions
+//	were not started, or nil if all the functions succeeded.
+func (client *Client) ExecAll(ctx context.Context, fns ...func(client interfaces.BaseClientCommands) error) error {
+	return execAll(ctx, client, options.DefaultExecAllParallelism, fns)
+}
+
+// ExecAllWithParallelism runs functions sending commands with the client concurrently, like ExecAll, with at most
+// parallelism of them running at once.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the execution. The functions are not started anymore once it is done.
+//	parallelism - The maximum number of functions running at once, at least 1.
+//	fns - The functions to run, which are given the client.
+//
+// Return value:
+//
+//	The errors returned by the functions joined in the order of fns, followed by the error of ctx if some functions
+//	were not started, or nil if all the functions succeeded.
+func (client *Client) ExecAllWithParallelism(
+	ctx context.Context,
+	parallelism int,
+	fns ...func(client interfaces.BaseClientCommands) error,
+) error {
+	return execAll(ctx, client, parallelism, fns)
+}
+
+// ExecAll runs functions sending commands with the client concurrently, see [Client.ExecAll]. The functions may
+// assert the client to a *ClusterClient for the cluster commands.
+func (client *ClusterClient) ExecAll(ctx context.Context, fns ...func(client interfaces.BaseClientCommands) error) error {
+	return execAll(ctx, client, options.DefaultExecAllParallelism, fns)
+}
+
+// ExecAllWithParallelism runs functions sending commands with the client concurrently, with at most parallelism of
+// them running at once, see [Client.ExecAllWithParallelism].
+func (client *ClusterClient) ExecAllWithParallelism(
+	ctx context.Context,
+	parallelism int,
+	fns ...func(client interfaces.BaseClientCommands) error,
+) error {
+	return execAll(ctx, client, parallelism, fns)
+}
+
+// execAll runs fns with at most parallelism of them at once, and joins their errors.
+func execAll(
+	ctx context.Context,
+	client interfaces.BaseClientCommands,
+	parallelism int,
+	fns []func(client interfaces.BaseClientCommands) error,
+) error {
+	if parallelism < 1 {
+		return errors.New("the parallelism of ExecAll must be at least 1")
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, len(fns), len(fns)+1)
+	slots := make(chan struct{}, parallelism)
+	started := 0
+	for idx, fn := range fns {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		started++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			errs[idx] = fn(client)
+		}()
+	}
+	wg.Wait()
+
+	if started < len(fns) {
+		errs = append(errs, ctx.Err())
+	}
+	return errors.Join(errs...)
+}
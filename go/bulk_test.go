@@ -0,0 +1,125 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func TestRunBulk(t *testing.T) {
+	keys := []string{"a", "b", "c", "d", "e", "f", "g"}
+	var inFlight, maxInFlight atomic.Int32
+	seen := make([]string, len(keys))
+	var progress []int
+	opts := options.NewBulkOptions().SetChunkSize(3).SetParallelism(2).
+		SetProgress(func(done int, total int) {
+			assert.Equal(t, len(keys), total)
+			progress = append(progress, done)
+		})
+
+	done, err := runBulk(context.Background(), keys, *opts, func(ctx context.Context, offset int, chunk []string) error {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			peak := maxInFlight.Load()
+			if current <= peak || maxInFlight.CompareAndSwap(peak, current) {
+				break
+			}
+		}
+		assert.LessOrEqual(t, len(chunk), 3)
+		copy(seen[offset:], chunk)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(keys), done)
+	assert.Equal(t, keys, seen)
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+	assert.Len(t, progress, 3)
+	assert.Equal(t, len(keys), progress[len(progress)-1])
+}
+
+func TestRunBulk_StopsOnError(t *testing.T) {
+	keys := []string{"a", "b", "c", "d"}
+	sendErr := errors.New("failed")
+	var calls atomic.Int32
+	opts := options.NewBulkOptions().SetChunkSize(1).SetParallelism(1)
+
+	done, err := runBulk(context.Background(), keys, *opts, func(ctx context.Context, offset int, chunk []string) error {
+		calls.Add(1)
+		if offset == 1 {
+			return sendErr
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, sendErr)
+	assert.Equal(t, 1, done)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRunBulk_InvalidOptions(t *testing.T) {
+	send := func(ctx context.Context, offset int, chunk []string) error { return nil }
+	_, err := runBulk(context.Background(), []string{"a"}, *options.NewBulkOptions().SetChunkSize(0), send)
+	assert.Error(t, err)
+	_, err = runBulk(context.Background(), []string{"a"}, *options.NewBulkOptions().SetParallelism(0), send)
+	assert.Error(t, err)
+}
+
+func TestExecAll(t *testing.T) {
+	var inFlight, maxInFlight, calls atomic.Int32
+	firstErr, lastErr := errors.New("first"), errors.New("last")
+	fns := make([]func(client interfaces.BaseClientCommands) error, 10)
+	for idx := range fns {
+		fns[idx] = func(client interfaces.BaseClientCommands) error {
+			calls.Add(1)
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				peak := maxInFlight.Load()
+				if current <= peak || maxInFlight.CompareAndSwap(peak, current) {
+					break
+				}
+			}
+			switch idx {
+			case 2:
+				return firstErr
+			case 9:
+				return lastErr
+			}
+			return nil
+		}
+	}
+
+	err := execAll(context.Background(), nil, 3, fns)
+
+	assert.ErrorIs(t, err, firstErr)
+	assert.ErrorIs(t, err, lastErr)
+	assert.Equal(t, "first\nlast", err.Error())
+	assert.Equal(t, int32(10), calls.Load())
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+	assert.NoError(t, execAll(context.Background(), nil, 3, fns[:2]))
+}
+
+func TestExecAll_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	fn := func(client interfaces.BaseClientCommands) error {
+		calls.Add(1)
+		cancel()
+		return nil
+	}
+
+	err := execAll(ctx, nil, 1, []func(client interfaces.BaseClientCommands) error{fn, fn, fn})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Error(t, execAll(context.Background(), nil, 0, nil))
+}
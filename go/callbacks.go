@@ -43,6 +43,9 @@ func getClientByPtr(ptrValue uintptr) *baseClient {
 //export successCallback
 func successCallback(channelPtr unsafe.Pointer, cResponse *C.struct_CommandResponse) {
 	response := cResponse
+	if response != nil {
+		responsesReceived.Add(1)
+	}
 	resultChannel := *(*chan payload)(getPinnedPtr(channelPtr))
 	resultChannel <- payload{value: response, error: nil}
 }
@@ -77,23 +80,31 @@ func pubSubCallback(
 		pat = models.CreateStringResult(string(C.GoBytes(pattern, pattern_len)))
 	}
 
+	// Look up the client in our registry using the pointer address
+	ptrValue := uintptr(clientPtr)
+	client := getClientByPtr(ptrValue)
+	if client == nil {
+		log.Printf("Client not found for pointer: %v\n", ptrValue)
+		return
+	}
+	switch pushKind {
+	case C.PushDisconnection:
+		client.restoreSubscriptions()
+		return
+	case C.PushUnsubscribe, C.PushPUnsubscribe, C.PushSUnsubscribe:
+		// The server unsubscribes the sharded channels of the slots moved to other nodes.
+		if client.subscribers.held(cha) {
+			client.restoreSubscriptions()
+		}
+		return
+	}
+	// The message is counted before being handed over, so that a client draining its messages on close waits for it.
+	client.subscribers.inFlight.Add(1)
+
 	go func() {
+		defer client.subscribers.inFlight.Add(-1)
 		// Process different types of push messages
 		message := models.NewPubSubMessageWithPattern(msg, cha, pat)
-
-		if clientPtr != nil {
-			// Look up the client in our registry using the pointer address
-			ptrValue := uintptr(clientPtr)
-			client := getClientByPtr(ptrValue)
-
-			if client != nil {
-				// If the client has a message handler, use it
-				if handler := client.getMessageHandler(); handler != nil {
-					handler.handleMessage(message)
-				}
-			} else {
-				log.Printf("Client not found for pointer: %v\n", ptrValue)
-			}
-		}
+		client.dispatchPubSubMessage(message)
 	}()
 }
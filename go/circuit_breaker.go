@@ -0,0 +1,184 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitOutcome classifies the result of a command for the circuit breaker.
+type circuitOutcome int
+
+const (
+	// outcomeSuccess means the endpoint answered, even if the answer is a server error such as WRONGTYPE.
+	outcomeSuccess circuitOutcome = iota
+	// outcomeFailure means the endpoint did not answer in time or the connection to it failed.
+	outcomeFailure
+	// outcomeIgnored means the result says nothing about the endpoint, e.g. the command was cancelled by the caller.
+	outcomeIgnored
+)
+
+func classifyCircuitOutcome(err error) circuitOutcome {
+	if err == nil {
+		return outcomeSuccess
+	}
+	// Requests rejected by the quota hook or the rate limit of a tenant never reached the endpoint.
+	var quotaErr *QuotaExceededError
+	var rateErr *RateLimitError
+	if errors.As(err, &quotaErr) || errors.As(err, &rateErr) {
+		return outcomeIgnored
+	}
+	var timeoutErr *TimeoutError
+	var disconnectErr *DisconnectError
+	var connectionErr *ConnectionError
+	switch {
+	case errors.As(err, &timeoutErr), errors.As(err, &disconnectErr), errors.As(err, &connectionErr),
+		errors.Is(err, context.DeadlineExceeded):
+		return outcomeFailure
+	case errors.Is(err, context.Canceled):
+		return outcomeIgnored
+	}
+	var closingErr *ClosingError
+	var pendingErr *PendingLimitError
+	if errors.As(err, &closingErr) || errors.As(err, &pendingErr) {
+		return outcomeIgnored
+	}
+	return outcomeSuccess
+}
+
+// circuit holds the state of a single circuit, either of the whole client or of a single node.
+type circuit struct {
+	state       circuitState
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probes      int
+	successes   int
+}
+
+// circuitBreaker fails commands fast while the endpoint they target is failing. It is shared by pointer between the
+// copies of a client.
+type circuitBreaker struct {
+	errorRateThreshold float64
+	openTimeout        time.Duration
+	minRequests        int
+	window             time.Duration
+	halfOpenRequests   int
+	perNode            bool
+	now                func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+func newCircuitBreaker(cfg *config.CircuitBreaker) *circuitBreaker {
+	return &circuitBreaker{
+		errorRateThreshold: cfg.GetErrorRateThreshold(),
+		openTimeout:        cfg.GetOpenTimeout(),
+		minRequests:        cfg.GetMinRequests(),
+		window:             cfg.GetWindow(),
+		halfOpenRequests:   cfg.GetHalfOpenRequests(),
+		perNode:            cfg.IsPerNode(),
+		now:                time.Now,
+		circuits:           make(map[string]*circuit),
+	}
+}
+
+// key returns the circuit used by commands sent with the given route. Only commands routed by address have a known
+// target node, all others share the circuit of the client.
+func (breaker *circuitBreaker) key(route config.Route) string {
+	if !breaker.perNode {
+		return ""
+	}
+	return routeAddress(route)
+}
+
+// allow admits a command through the circuit of the given route, or fails with a [CircuitOpenError] if the circuit
+// is open. The returned function must be called with the result of an admitted command.
+func (breaker *circuitBreaker) allow(route config.Route) (func(error), error) {
+	key := breaker.key(route)
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	c, ok := breaker.circuits[key]
+	if !ok {
+		c = &circuit{windowStart: breaker.now()}
+		breaker.circuits[key] = c
+	}
+	now := breaker.now()
+	switch c.state {
+	case circuitClosed:
+		if now.Sub(c.windowStart) >= breaker.window {
+			c.windowStart, c.requests, c.failures = now, 0, 0
+		}
+	case circuitOpen:
+		if remaining := breaker.openTimeout - now.Sub(c.openedAt); remaining > 0 {
+			return nil, breaker.openError(key, remaining)
+		}
+		c.state, c.probes, c.successes = circuitHalfOpen, 0, 0
+		fallthrough
+	case circuitHalfOpen:
+		if c.probes >= breaker.halfOpenRequests {
+			return nil, breaker.openError(key, 0)
+		}
+		c.probes++
+	}
+	return func(err error) { breaker.record(c, classifyCircuitOutcome(err)) }, nil
+}
+
+func (breaker *circuitBreaker) openError(key string, remaining time.Duration) *CircuitOpenError {
+	target := "the client"
+	if key != "" {
+		target = "node " + key
+	}
+	if remaining > 0 {
+		return NewCircuitOpenError(fmt.Sprintf("the circuit breaker of %s is open, retry in %v", target, remaining))
+	}
+	return NewCircuitOpenError(fmt.Sprintf("the circuit breaker of %s is half-open and awaiting probe results", target))
+}
+
+func (breaker *circuitBreaker) record(c *circuit, outcome circuitOutcome) {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	switch c.state {
+	case circuitClosed:
+		if outcome == outcomeIgnored {
+			return
+		}
+		c.requests++
+		if outcome == outcomeFailure {
+			c.failures++
+		}
+		if c.requests >= breaker.minRequests &&
+			float64(c.failures)/float64(c.requests) >= breaker.errorRateThreshold {
+			c.state, c.openedAt = circuitOpen, breaker.now()
+		}
+	case circuitHalfOpen:
+		switch outcome {
+		case outcomeFailure:
+			c.state, c.openedAt = circuitOpen, breaker.now()
+		case outcomeIgnored:
+			c.probes--
+		case outcomeSuccess:
+			c.successes++
+			if c.successes >= breaker.halfOpenRequests {
+				c.state, c.windowStart, c.requests, c.failures = circuitClosed, breaker.now(), 0, 0
+			}
+		}
+	}
+	// Results of commands admitted before the circuit opened are ignored while it is open.
+}
@@ -0,0 +1,80 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func newTestCircuitBreaker(cfg *config.CircuitBreaker) (*circuitBreaker, *time.Time) {
+	now := time.Unix(0, 0)
+	breaker := newCircuitBreaker(cfg)
+	breaker.now = func() time.Time { return now }
+	return breaker, &now
+}
+
+func TestCircuitBreakerOpensOnErrorRate(t *testing.T) {
+	breaker, _ := newTestCircuitBreaker(config.NewCircuitBreaker(0.5, time.Second).WithMinRequests(4))
+
+	for _, err := range []error{nil, NewTimeoutError("timeout"), errors.New("WRONGTYPE")} {
+		done, openErr := breaker.allow(nil)
+		assert.NoError(t, openErr)
+		done(err)
+	}
+	// cancelled commands say nothing about the endpoint and are not counted
+	done, _ := breaker.allow(nil)
+	done(context.Canceled)
+	_, openErr := breaker.allow(nil)
+	assert.NoError(t, openErr)
+
+	done, _ = breaker.allow(nil)
+	done(NewDisconnectError("disconnected"))
+	_, openErr = breaker.allow(nil)
+	var circuitErr *CircuitOpenError
+	assert.ErrorAs(t, openErr, &circuitErr)
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	breaker, now := newTestCircuitBreaker(config.NewCircuitBreaker(1, time.Second).WithMinRequests(1))
+	done, _ := breaker.allow(nil)
+	done(context.DeadlineExceeded)
+	_, openErr := breaker.allow(nil)
+	assert.ErrorContains(t, openErr, "is open")
+
+	*now = now.Add(time.Second)
+	probe, openErr := breaker.allow(nil)
+	assert.NoError(t, openErr)
+	_, openErr = breaker.allow(nil)
+	assert.ErrorContains(t, openErr, "half-open")
+	probe(NewTimeoutError("timeout"))
+	_, openErr = breaker.allow(nil)
+	assert.ErrorContains(t, openErr, "is open")
+
+	*now = now.Add(time.Second)
+	probe, openErr = breaker.allow(nil)
+	assert.NoError(t, openErr)
+	probe(nil)
+	done, openErr = breaker.allow(nil)
+	assert.NoError(t, openErr)
+	done(nil)
+}
+
+func TestCircuitBreakerPerNode(t *testing.T) {
+	breaker, _ := newTestCircuitBreaker(config.NewCircuitBreaker(1, time.Second).WithMinRequests(1).WithPerNode(true))
+	failing := config.NewByAddressRoute("10.0.0.1", 6379)
+	done, _ := breaker.allow(failing)
+	done(NewTimeoutError("timeout"))
+
+	_, openErr := breaker.allow(*failing)
+	assert.ErrorContains(t, openErr, "node 10.0.0.1:6379")
+	_, openErr = breaker.allow(config.NewByAddressRoute("10.0.0.2", 6379))
+	assert.NoError(t, openErr)
+	_, openErr = breaker.allow(config.RandomRoute)
+	assert.NoError(t, openErr)
+}
@@ -0,0 +1,94 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// CircularBuffer is a list holding up to a fixed number of the most recent elements pushed to it, e.g. the latest
+// events or searches of a user. Pushing to a full buffer drops its oldest elements.
+type CircularBuffer struct {
+	client   *baseClient
+	key      string
+	capacity int64
+}
+
+// CircularBuffer returns the circular buffer stored as a list at key, holding up to capacity elements, see
+// [CircularBuffer]. The list is created by the first push.
+//
+// Parameters:
+//
+//	key - The key of the list.
+//	capacity - The maximum number of elements of the buffer, which must be positive.
+func (client *baseClient) CircularBuffer(key string, capacity int64) *CircularBuffer {
+	return &CircularBuffer{client: client, key: key, capacity: capacity}
+}
+
+func (buffer *CircularBuffer) validate() error {
+	if buffer.capacity <= 0 {
+		return errors.New("the capacity of a circular buffer must be positive")
+	}
+	return nil
+}
+
+// Push adds elements to the buffer, and drops the oldest elements beyond its capacity. The elements are pushed with
+// LPUSH and the list is trimmed with LTRIM in a single transaction, so the buffer never holds more elements than its
+// capacity.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	elements - The elements to add, from the oldest to the newest.
+//
+// Return value:
+//
+//	The number of elements of the buffer after the push.
+func (buffer *CircularBuffer) Push(ctx context.Context, elements []string) (int64, error) {
+	if err := buffer.validate(); err != nil {
+		return 0, err
+	}
+	if len(elements) == 0 {
+		return buffer.Len(ctx)
+	}
+	batch := pipeline.NewClusterBatch(true).
+		LPush(buffer.key, elements).
+		LTrim(buffer.key, 0, buffer.capacity-1)
+	results, err := buffer.client.executeBatch(ctx, batch.Batch, true, nil)
+	if err != nil {
+		return 0, err
+	}
+	length, ok := results[0].(int64)
+	if !ok {
+		return 0, errors.New("unexpected response of LPUSH in circular buffer")
+	}
+	return min(length, buffer.capacity), nil
+}
+
+// Items returns the elements of the buffer, from the newest to the oldest.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The elements of the buffer, or an empty array if nothing was pushed.
+func (buffer *CircularBuffer) Items(ctx context.Context) ([]string, error) {
+	if err := buffer.validate(); err != nil {
+		return nil, err
+	}
+	return buffer.client.LRange(ctx, buffer.key, 0, buffer.capacity-1)
+}
+
+// Len returns the number of elements of the buffer.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+func (buffer *CircularBuffer) Len(ctx context.Context) (int64, error) {
+	return buffer.client.LLen(ctx, buffer.key)
+}
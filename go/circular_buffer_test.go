@@ -0,0 +1,18 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircularBuffer_InvalidCapacity(t *testing.T) {
+	buffer := (&baseClient{}).CircularBuffer("events", 0)
+	_, err := buffer.Push(context.Background(), []string{"e1"})
+	assert.EqualError(t, err, "the capacity of a circular buffer must be positive")
+	_, err = buffer.Items(context.Background())
+	assert.Error(t, err)
+}
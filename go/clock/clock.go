@@ -0,0 +1,180 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package clock abstracts the passing of time for the helper packages computing expirations and running periodic work,
+// such as lock leases, session creation times, counter buckets, stream retention and job scheduling. The helpers use
+// the [System] clock by default, and accept a [Fake] clock in unit tests, which simulate time by advancing it instead
+// of sleeping.
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock tells the current time and creates timers and tickers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer returns a [Timer] sending the current time on its channel once d elapsed.
+	NewTimer(d time.Duration) Timer
+	// NewTicker returns a [Ticker] sending the current time on its channel every d, which must be positive.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event created by a [Clock], like a time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is sent when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, and reports whether it was stopped before firing.
+	Stop() bool
+}
+
+// Ticker is a periodic event created by a [Clock], like a time.Ticker.
+type Ticker interface {
+	// C returns the channel on which the time is sent at every tick.
+	C() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// System returns the [Clock] of the operating system, backed by the time package.
+func System() Clock {
+	return systemClock{}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) NewTimer(d time.Duration) Timer { return systemTimer{time.NewTimer(d)} }
+
+func (systemClock) NewTicker(d time.Duration) Ticker { return systemTicker{time.NewTicker(d)} }
+
+type systemTimer struct{ timer *time.Timer }
+
+func (t systemTimer) C() <-chan time.Time { return t.timer.C }
+
+func (t systemTimer) Stop() bool { return t.timer.Stop() }
+
+type systemTicker struct{ ticker *time.Ticker }
+
+func (t systemTicker) C() <-chan time.Time { return t.ticker.C }
+
+func (t systemTicker) Stop() { t.ticker.Stop() }
+
+// Fake is a [Clock] whose time only changes when it is advanced, firing the timers and tickers that are due on the way.
+// Like the ones of the time package, the channels of its timers and tickers hold a single value, and ticks are dropped
+// if the previous one was not received yet. It is safe for concurrent use.
+type Fake struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// NewFake returns a [Fake] clock set to now.
+func NewFake(now time.Time) *Fake {
+	fake := &Fake{now: now}
+	fake.cond = sync.NewCond(&fake.mu)
+	return fake
+}
+
+// Now returns the current time of the fake clock.
+func (fake *Fake) Now() time.Time {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	return fake.now
+}
+
+// NewTimer returns a [Timer] firing once the fake clock is advanced by d. A timer with a non-positive duration fires
+// immediately.
+func (fake *Fake) NewTimer(d time.Duration) Timer {
+	return fake.schedule(d, 0)
+}
+
+// NewTicker returns a [Ticker] ticking every time the fake clock is advanced by d.
+func (fake *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for clock.Fake.NewTicker")
+	}
+	return fakeTicker{fake.schedule(d, d)}
+}
+
+func (fake *Fake) schedule(d time.Duration, period time.Duration) *fakeTimer {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	timer := &fakeTimer{fake: fake, when: fake.now.Add(d), period: period, ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.ch <- fake.now
+		return timer
+	}
+	fake.timers = append(fake.timers, timer)
+	fake.cond.Broadcast()
+	return timer
+}
+
+// Advance moves the fake clock forward by d, firing the timers and tickers that are due in chronological order, each
+// with the time it was due at.
+func (fake *Fake) Advance(d time.Duration) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	target := fake.now.Add(d)
+	for {
+		sort.SliceStable(fake.timers, func(i, j int) bool { return fake.timers[i].when.Before(fake.timers[j].when) })
+		if len(fake.timers) == 0 || fake.timers[0].when.After(target) {
+			break
+		}
+		timer := fake.timers[0]
+		fake.now = timer.when
+		select {
+		case timer.ch <- timer.when:
+		default:
+		}
+		if timer.period > 0 {
+			timer.when = timer.when.Add(timer.period)
+		} else {
+			fake.timers = fake.timers[1:]
+		}
+	}
+	fake.now = target
+}
+
+// BlockUntil waits until at least n timers and tickers of the fake clock are pending, e.g. until the code under test
+// waits on the clock, so that advancing the clock afterwards fires them.
+func (fake *Fake) BlockUntil(n int) {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	for len(fake.timers) < n {
+		fake.cond.Wait()
+	}
+}
+
+// remove removes a pending timer, and reports whether it was pending.
+func (fake *Fake) remove(timer *fakeTimer) bool {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	for idx, pending := range fake.timers {
+		if pending == timer {
+			fake.timers = append(fake.timers[:idx], fake.timers[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+type fakeTimer struct {
+	fake *Fake
+	when time.Time
+	// period is the interval of a ticker, or 0 for a timer.
+	period time.Duration
+	ch     chan time.Time
+}
+
+func (timer *fakeTimer) C() <-chan time.Time { return timer.ch }
+
+func (timer *fakeTimer) Stop() bool { return timer.fake.remove(timer) }
+
+type fakeTicker struct{ *fakeTimer }
+
+func (ticker fakeTicker) Stop() { ticker.fake.remove(ticker.fakeTimer) }
@@ -0,0 +1,65 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake_Timer(t *testing.T) {
+	start := time.Unix(1000, 0)
+	fake := NewFake(start)
+	timer := fake.NewTimer(time.Second)
+	stopped := fake.NewTimer(time.Second)
+	assert.True(t, stopped.Stop())
+	assert.False(t, stopped.Stop())
+
+	fake.Advance(999 * time.Millisecond)
+	assert.Empty(t, timer.C())
+	fake.Advance(time.Millisecond)
+	assert.Equal(t, start.Add(time.Second), <-timer.C())
+	assert.False(t, timer.Stop())
+	assert.Empty(t, stopped.C())
+	assert.Equal(t, start.Add(time.Second), fake.Now())
+
+	assert.Equal(t, fake.Now(), <-fake.NewTimer(0).C())
+}
+
+func TestFake_Ticker(t *testing.T) {
+	start := time.Unix(1000, 0)
+	fake := NewFake(start)
+	ticker := fake.NewTicker(time.Minute)
+	fake.Advance(90 * time.Second)
+	assert.Equal(t, start.Add(time.Minute), <-ticker.C())
+
+	// ticks are dropped while the previous one was not received
+	fake.Advance(10 * time.Minute)
+	assert.Equal(t, start.Add(2*time.Minute), <-ticker.C())
+	assert.Empty(t, ticker.C())
+
+	ticker.Stop()
+	fake.Advance(time.Hour)
+	assert.Empty(t, ticker.C())
+}
+
+func TestFake_BlockUntil(t *testing.T) {
+	fake := NewFake(time.Unix(0, 0))
+	done := make(chan time.Time)
+	go func() {
+		done <- <-fake.NewTimer(time.Second).C()
+	}()
+	fake.BlockUntil(1)
+	fake.Advance(time.Second)
+	assert.Equal(t, time.Unix(1, 0), <-done)
+}
+
+func TestSystem(t *testing.T) {
+	clock := System()
+	assert.WithinDuration(t, time.Now(), clock.Now(), time.Second)
+	timer := clock.NewTimer(time.Millisecond)
+	<-timer.C()
+	assert.False(t, timer.Stop())
+}
@@ -0,0 +1,284 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// maxHashSlot is the highest hash slot of a cluster.
+const maxHashSlot = 16383
+
+// ClusterAdminError is returned when a cluster administrative command is rejected, either by the client because of
+// invalid arguments, or by the node it was sent to.
+type ClusterAdminError struct {
+	// Command is the CLUSTER subcommand that failed, e.g. "MEET".
+	Command string
+	// Node is the address of the node the command was sent to.
+	Node string
+	err  error
+}
+
+func (e *ClusterAdminError) Error() string {
+	return fmt.Sprintf("CLUSTER %s on %s failed: %v", e.Command, e.Node, e.err)
+}
+
+func (e *ClusterAdminError) Unwrap() error { return e.err }
+
+func newClusterAdminError(command string, node config.ByAddressRoute, err error) *ClusterAdminError {
+	return &ClusterAdminError{Command: command, Node: fmt.Sprintf("%s:%d", node.Host, node.Port), err: err}
+}
+
+// ClusterAdmin exposes the commands that change the membership and slot assignment of a cluster. These commands are
+// kept off [ClusterClient] since misusing them can leave the cluster in an inconsistent state; they are intended for
+// tooling that manages the cluster topology.
+//
+// Every command is sent to a single node, identified by its address.
+type ClusterAdmin struct {
+	client *ClusterClient
+}
+
+// Admin returns the administrative commands of the cluster. See [ClusterAdmin].
+func (client *ClusterClient) Admin() *ClusterAdmin {
+	return &ClusterAdmin{client: client}
+}
+
+// executeOk sends a CLUSTER subcommand to a single node. The core has no dedicated request types for these
+// subcommands, so they are sent as custom commands.
+func (admin *ClusterAdmin) executeOk(
+	ctx context.Context,
+	command string,
+	args []string,
+	node config.ByAddressRoute,
+) (string, error) {
+	commandArgs := append([]string{"CLUSTER", command}, args...)
+	result, err := admin.client.executeCommandWithRoute(ctx, C.CustomCommand, commandArgs, node)
+	if err != nil {
+		return models.DefaultStringResponse, newClusterAdminError(command, node, err)
+	}
+	response, err := handleOkResponse(result)
+	if err != nil {
+		return models.DefaultStringResponse, newClusterAdminError(command, node, err)
+	}
+	return response, nil
+}
+
+func validateSlot(slot int64) error {
+	if slot < 0 || slot > maxHashSlot {
+		return fmt.Errorf("slot %d is out of range, must be between 0 and %d", slot, maxHashSlot)
+	}
+	return nil
+}
+
+func validateSlotRange(start int64, end int64) error {
+	if err := validateSlot(start); err != nil {
+		return err
+	}
+	if err := validateSlot(end); err != nil {
+		return err
+	}
+	if start > end {
+		return fmt.Errorf("slot range start %d is greater than end %d", start, end)
+	}
+	return nil
+}
+
+// Makes the node join the cluster of the node at the given address.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the command to.
+//	host - The IP address of the node to meet.
+//	port - The port of the node to meet.
+//
+// Return value:
+//
+//	OK if the handshake was started.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-meet/
+func (admin *ClusterAdmin) Meet(ctx context.Context, node config.ByAddressRoute, host string, port int64) (string, error) {
+	return admin.executeOk(ctx, "MEET", []string{host, utils.IntToString(port)}, node)
+}
+
+// Removes the node with the given ID from the nodes table of the node. To remove a node from the cluster, this
+// command must be sent to all the remaining nodes within 60 seconds.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the command to.
+//	nodeId - The ID of the node to forget.
+//
+// Return value:
+//
+//	OK if the node was forgotten.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-forget/
+func (admin *ClusterAdmin) Forget(ctx context.Context, node config.ByAddressRoute, nodeId string) (string, error) {
+	return admin.executeOk(ctx, "FORGET", []string{nodeId}, node)
+}
+
+// Resets the node. The node must not hold any keys.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the command to.
+//	mode - Whether to perform a soft or hard reset, see [options.ClusterResetMode].
+//
+// Return value:
+//
+//	OK if the node was reset.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-reset/
+func (admin *ClusterAdmin) Reset(
+	ctx context.Context,
+	node config.ByAddressRoute,
+	mode options.ClusterResetMode,
+) (string, error) {
+	return admin.executeOk(ctx, "RESET", []string{string(mode)}, node)
+}
+
+// Assigns hash slots to the node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the command to.
+//	slots - The hash slots to assign, each between 0 and 16383.
+//
+// Return value:
+//
+//	OK if the slots were assigned.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-addslots/
+func (admin *ClusterAdmin) AddSlots(ctx context.Context, node config.ByAddressRoute, slots []int64) (string, error) {
+	args := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		if err := validateSlot(slot); err != nil {
+			return models.DefaultStringResponse, newClusterAdminError("ADDSLOTS", node, err)
+		}
+		args = append(args, utils.IntToString(slot))
+	}
+	return admin.executeOk(ctx, "ADDSLOTS", args, node)
+}
+
+// Assigns a range of hash slots to the node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the command to.
+//	start - The first hash slot of the range.
+//	end - The last hash slot of the range, inclusive.
+//
+// Return value:
+//
+//	OK if the slots were assigned.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-addslotsrange/
+func (admin *ClusterAdmin) AddSlotsRange(
+	ctx context.Context,
+	node config.ByAddressRoute,
+	start int64,
+	end int64,
+) (string, error) {
+	if err := validateSlotRange(start, end); err != nil {
+		return models.DefaultStringResponse, newClusterAdminError("ADDSLOTSRANGE", node, err)
+	}
+	args := []string{utils.IntToString(start), utils.IntToString(end)}
+	return admin.executeOk(ctx, "ADDSLOTSRANGE", args, node)
+}
+
+// Changes the state of a hash slot in the node, e.g. to migrate the slot between nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the command to.
+//	slot - The hash slot, between 0 and 16383.
+//	state - The new state of the slot, see [options.SlotState].
+//	nodeId - The ID of the node the state refers to. Must be empty for [options.SlotStable].
+//
+// Return value:
+//
+//	OK if the state of the slot was changed.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-setslot/
+func (admin *ClusterAdmin) SetSlot(
+	ctx context.Context,
+	node config.ByAddressRoute,
+	slot int64,
+	state options.SlotState,
+	nodeId string,
+) (string, error) {
+	err := validateSlot(slot)
+	if err == nil && (state == options.SlotStable) != (nodeId == "") {
+		err = fmt.Errorf("a node ID must be given for every slot state but %s", options.SlotStable)
+	}
+	if err != nil {
+		return models.DefaultStringResponse, newClusterAdminError("SETSLOT", node, err)
+	}
+	args := []string{utils.IntToString(slot), string(state)}
+	if nodeId != "" {
+		args = append(args, nodeId)
+	}
+	return admin.executeOk(ctx, "SETSLOT", args, node)
+}
+
+// Asking sends a command to a node preceded by ASKING, so that the node serves it for a slot being imported, as after
+// an ASK redirection. The two commands are pipelined on the same connection to the node. This is intended for slot
+// migration tooling, e.g. to read the keys already moved to the importing node of a slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the command to.
+//	args - Arguments of the command, including the command name.
+//
+// Return value:
+//
+//	The response of the command.
+//
+// [valkey.io]: https://valkey.io/commands/asking/
+func (admin *ClusterAdmin) Asking(ctx context.Context, node config.ByAddressRoute, args []string) (any, error) {
+	if len(args) == 0 {
+		return nil, newClusterAdminError("ASKING", node, errors.New("a command must be given"))
+	}
+	batch := pipeline.NewClusterBatch(false).CustomCommand([]string{"ASKING"}).CustomCommand(args)
+	results, err := admin.client.executeBatch(ctx, batch.Batch, true, &internal.BatchOptions{Route: node})
+	if err != nil {
+		return nil, newClusterAdminError("ASKING", node, err)
+	}
+	if len(results) != 2 {
+		return nil, newClusterAdminError("ASKING", node, fmt.Errorf("unexpected number of responses: %d", len(results)))
+	}
+	return results[1], nil
+}
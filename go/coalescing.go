@@ -0,0 +1,73 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"sync"
+)
+
+// readCoalescer de-duplicates identical concurrent reads, so that a single request is sent to the server and its
+// result is shared by all callers. It is shared by pointer between the copies of a client.
+type readCoalescer struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+type coalescedCall struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+func newReadCoalescer() *readCoalescer {
+	return &readCoalescer{calls: make(map[string]*coalescedCall)}
+}
+
+// coalesce returns the result of read, sharing it with the concurrent calls using the same key. The read is detached
+// from the context of the caller starting it, so that cancelling one caller does not fail the others, and each
+// caller stops waiting once its own context is done. The returned flag is set if the result was shared.
+func coalesce[T any](
+	ctx context.Context,
+	coalescer *readCoalescer,
+	key string,
+	read func(ctx context.Context) (T, error),
+) (T, bool, error) {
+	coalescer.mu.Lock()
+	call, shared := coalescer.calls[key]
+	if !shared {
+		call = &coalescedCall{done: make(chan struct{})}
+		coalescer.calls[key] = call
+		go func() {
+			value, err := read(context.WithoutCancel(ctx))
+			call.value, call.err = value, err
+			coalescer.mu.Lock()
+			delete(coalescer.calls, key)
+			coalescer.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	coalescer.mu.Unlock()
+
+	select {
+	case <-call.done:
+		if call.err != nil {
+			var zero T
+			return zero, shared, call.err
+		}
+		return call.value.(T), shared, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, shared, ctx.Err()
+	}
+}
+
+// recordCoalesced counts a read answered with the result of an identical concurrent read.
+func (client *baseClient) recordCoalesced(shared bool) {
+	if !shared {
+		return
+	}
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.stats.coalescedReads++
+}
@@ -0,0 +1,77 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoalesce(t *testing.T) {
+	coalescer := newReadCoalescer()
+	release := make(chan struct{})
+	var reads atomic.Int32
+	read := func(ctx context.Context) (string, error) {
+		reads.Add(1)
+		<-release
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	var sharedCount atomic.Int32
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, shared, err := coalesce(context.Background(), coalescer, "key", read)
+			assert.NoError(t, err)
+			assert.Equal(t, "value", value)
+			if shared {
+				sharedCount.Add(1)
+			}
+		}()
+	}
+	assert.Eventually(t, func() bool {
+		coalescer.mu.Lock()
+		defer coalescer.mu.Unlock()
+		return len(coalescer.calls) == 1
+	}, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), reads.Load())
+	assert.Equal(t, int32(4), sharedCount.Load())
+	assert.Empty(t, coalescer.calls)
+}
+
+func TestCoalesce_CallerCancelled(t *testing.T) {
+	coalescer := newReadCoalescer()
+	release := make(chan struct{})
+	read := func(ctx context.Context) (string, error) {
+		<-release
+		return "value", ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err := coalesce(ctx, coalescer, "key", read)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// The read started by the cancelled caller is still shared with the other callers.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		value, shared, err := coalesce(context.Background(), coalescer, "key", read)
+		assert.NoError(t, err)
+		assert.True(t, shared)
+		assert.Equal(t, "value", value)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	<-done
+}
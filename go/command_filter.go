@@ -0,0 +1,69 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"fmt"
+	"strings"
+)
+
+// commandFilter rejects the commands which are not allowed, or which are denied, by the client configuration.
+type commandFilter struct {
+	// allowed is nil if all the commands which are not denied are allowed.
+	allowed map[string]struct{}
+	denied  map[string]struct{}
+}
+
+// newCommandFilter returns the filter of the given command names, or nil if no commands are allowed or denied.
+func newCommandFilter(allowed []string, denied []string) *commandFilter {
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil
+	}
+	filter := &commandFilter{denied: commandSet(denied)}
+	if len(allowed) > 0 {
+		filter.allowed = commandSet(allowed)
+	}
+	return filter
+}
+
+func commandSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[strings.Join(strings.Fields(strings.ToUpper(name)), " ")] = struct{}{}
+	}
+	return set
+}
+
+// check returns a ForbiddenCommandError if the command of a request may not be sent.
+func (filter *commandFilter) check(requestType C.RequestType, args []string) error {
+	name, rest := commandName(requestType, args)
+	if requestType == C.CustomCommand && len(rest) > 0 {
+		// The subcommand of a custom command is matched like the one of a built-in command, e.g. CONFIG SET.
+		name += " " + strings.ToUpper(rest[0])
+	}
+	return filter.checkName(name)
+}
+
+// checkName returns a ForbiddenCommandError if the named command may not be sent. A command with a subcommand, such
+// as CONFIG SET, matches both its full name and the name of its container command, CONFIG.
+func (filter *commandFilter) checkName(name string) error {
+	container, _, _ := strings.Cut(name, " ")
+	if _, denied := filter.denied[container]; denied {
+		return NewForbiddenCommandError(fmt.Sprintf("%s is denied by the client configuration", container))
+	}
+	if _, denied := filter.denied[name]; denied {
+		return NewForbiddenCommandError(fmt.Sprintf("%s is denied by the client configuration", name))
+	}
+	if filter.allowed == nil {
+		return nil
+	}
+	_, allowedName := filter.allowed[name]
+	_, allowedContainer := filter.allowed[container]
+	if !allowedName && !allowedContainer {
+		return NewForbiddenCommandError(fmt.Sprintf("%s is not allowed by the client configuration", container))
+	}
+	return nil
+}
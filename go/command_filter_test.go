@@ -0,0 +1,29 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandFilter(t *testing.T) {
+	assert.Nil(t, newCommandFilter(nil, nil))
+
+	var forbiddenErr *ForbiddenCommandError
+	denied := newCommandFilter(nil, []string{"flushall", "config  set", "DEBUG"})
+	assert.NoError(t, denied.checkName("GET"))
+	assert.NoError(t, denied.checkName("CONFIG GET"))
+	assert.ErrorAs(t, denied.checkName("FLUSHALL"), &forbiddenErr)
+	assert.EqualError(t, denied.checkName("CONFIG SET"), "CONFIG SET is denied by the client configuration")
+	assert.EqualError(t, denied.checkName("DEBUG SLEEP"), "DEBUG is denied by the client configuration")
+
+	allowed := newCommandFilter([]string{"GET", "CLIENT", "CONFIG GET"}, []string{"CLIENT KILL"})
+	assert.NoError(t, allowed.checkName("GET"))
+	assert.NoError(t, allowed.checkName("CLIENT ID"))
+	assert.NoError(t, allowed.checkName("CONFIG GET"))
+	assert.EqualError(t, allowed.checkName("CONFIG SET"), "CONFIG is not allowed by the client configuration")
+	assert.EqualError(t, allowed.checkName("SET"), "SET is not allowed by the client configuration")
+	assert.EqualError(t, allowed.checkName("CLIENT KILL"), "CLIENT KILL is denied by the client configuration")
+}
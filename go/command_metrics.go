@@ -0,0 +1,147 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// Command families, keyed by the hundreds of the request type values, which group the request types the same way
+// the command groups of the server documentation do.
+var commandFamilies = map[uint32]string{
+	0:  "Custom",
+	1:  "Bitmap",
+	2:  "Cluster",
+	3:  "Connection",
+	4:  "Generic",
+	5:  "Geo",
+	6:  "Hash",
+	7:  "HyperLogLog",
+	8:  "List",
+	9:  "PubSub",
+	10: "Scripting",
+	11: "Server",
+	12: "Set",
+	13: "SortedSet",
+	14: "Stream",
+	15: "String",
+	16: "Transaction",
+	20: "JSON",
+	21: "Search",
+}
+
+// batchFamily is the family all batches are accounted under.
+const batchFamily = "Batch"
+
+// CommandBytes holds the traffic totals of a command family.
+type CommandBytes struct {
+	// Commands is the number of commands executed.
+	Commands int64
+	// Sent is the total length of the command arguments.
+	Sent int64
+	// Received is the approximate total size of the response payloads, see [config.CommandMetrics].
+	Received int64
+}
+
+func commandFamily(requestType uint32) string {
+	if family, ok := commandFamilies[requestType/100]; ok {
+		return family
+	}
+	return "Other"
+}
+
+func argsSize(args []string) int64 {
+	var size int64
+	for _, arg := range args {
+		size += int64(len(arg))
+	}
+	return size
+}
+
+// responseSize returns the approximate size of a response payload: strings count their length, and numbers count
+// 8 bytes each.
+func responseSize(response *C.struct_CommandResponse) int64 {
+	if response == nil {
+		return 0
+	}
+	switch response.response_type {
+	case C.String, C.Error:
+		return int64(response.string_value_len)
+	case C.Int, C.Float:
+		return 8
+	case C.Bool:
+		return 1
+	case C.Ok:
+		return int64(len(OK))
+	case C.Array:
+		var size int64
+		if response.array_value != nil {
+			for _, element := range unsafe.Slice(response.array_value, response.array_value_len) {
+				size += responseSize(&element)
+			}
+		}
+		return size
+	case C.Map:
+		var size int64
+		if response.array_value != nil {
+			for _, entry := range unsafe.Slice(response.array_value, response.array_value_len) {
+				size += responseSize(entry.map_key) + responseSize(entry.map_value)
+			}
+		}
+		return size
+	case C.Sets:
+		var size int64
+		if response.sets_value != nil {
+			for _, element := range unsafe.Slice(response.sets_value, response.sets_value_len) {
+				size += responseSize(&element)
+			}
+		}
+		return size
+	default:
+		return 0
+	}
+}
+
+// recordCommand accumulates the traffic of a completed command, counts its latency in the histogram of the command,
+// and reports it to the metrics hook, if any.
+func (client *baseClient) recordCommand(
+	family string,
+	command string,
+	sent int64,
+	response *C.struct_CommandResponse,
+	started time.Time,
+	err error,
+) {
+	metrics := config.CommandMetrics{
+		Family:        family,
+		BytesSent:     sent,
+		BytesReceived: responseSize(response),
+		Duration:      time.Since(started),
+		Err:           err,
+	}
+
+	client.mu.Lock()
+	if client.stats.bytesByFamily == nil {
+		client.stats.bytesByFamily = make(map[string]*CommandBytes)
+	}
+	totals, ok := client.stats.bytesByFamily[family]
+	if !ok {
+		totals = &CommandBytes{}
+		client.stats.bytesByFamily[family] = totals
+	}
+	totals.Commands++
+	totals.Sent += metrics.BytesSent
+	totals.Received += metrics.BytesReceived
+	client.mu.Unlock()
+	recordLatency(command, metrics.Duration)
+
+	if client.metricsHook != nil {
+		client.metricsHook(metrics)
+	}
+}
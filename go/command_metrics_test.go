@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestCommandFamily(t *testing.T) {
+	assert.Equal(t, "Custom", commandFamily(1))
+	assert.Equal(t, "String", commandFamily(1504))
+	assert.Equal(t, "SortedSet", commandFamily(1304))
+	assert.Equal(t, "Search", commandFamily(2113))
+	assert.Equal(t, "Other", commandFamily(1901))
+}
+
+func TestRecordCommandAccumulatesPerFamily(t *testing.T) {
+	var reported []config.CommandMetrics
+	client := &baseClient{
+		pending: make(map[unsafe.Pointer]struct{}),
+		mu:      &sync.Mutex{},
+		stats:   &clientStats{},
+		metricsHook: func(metrics config.CommandMetrics) {
+			reported = append(reported, metrics)
+		},
+	}
+	commandErr := errors.New("failed")
+
+	client.recordCommand("String", "SET", argsSize([]string{"key", "value"}), nil, time.Now(), nil)
+	client.recordCommand("String", "GET", argsSize([]string{"key"}), nil, time.Now(), commandErr)
+	client.recordCommand(batchFamily, "BATCH", 10, nil, time.Now(), nil)
+
+	stats := client.Statistics()
+	assert.Equal(t, CommandBytes{Commands: 2, Sent: 11}, stats.BytesByFamily["String"])
+	assert.Equal(t, CommandBytes{Commands: 1, Sent: 10}, stats.BytesByFamily[batchFamily])
+	assert.Len(t, reported, 3)
+	assert.Equal(t, "String", reported[1].Family)
+	assert.Equal(t, int64(3), reported[1].BytesSent)
+	assert.Equal(t, commandErr, reported[1].Err)
+}
@@ -0,0 +1,274 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import "strings"
+
+// commandNames are the names of the commands sent for each request type, as mapped by the client core. Request
+// types without a mapping are sent as custom commands instead.
+var commandNames = map[C.RequestType]string{
+	C.Append:            "APPEND",
+	C.BLMPop:            "BLMPOP",
+	C.BLMove:            "BLMOVE",
+	C.BLPop:             "BLPOP",
+	C.BRPop:             "BRPOP",
+	C.BZMPop:            "BZMPOP",
+	C.BZPopMax:          "BZPOPMAX",
+	C.BZPopMin:          "BZPOPMIN",
+	C.BitCount:          "BITCOUNT",
+	C.BitField:          "BITFIELD",
+	C.BitFieldReadOnly:  "BITFIELD_RO",
+	C.BitOp:             "BITOP",
+	C.BitPos:            "BITPOS",
+	C.ClientGetName:     "CLIENT GETNAME",
+	C.ClientGetRedir:    "CLIENT GETREDIR",
+	C.ClientId:          "CLIENT ID",
+	C.ClientInfo:        "CLIENT INFO",
+	C.ClientKill:        "CLIENT KILL",
+	C.ClientList:        "CLIENT LIST",
+	C.ClientNoEvict:     "CLIENT NO-EVICT",
+	C.ClientNoTouch:     "CLIENT NO-TOUCH",
+	C.ClientPause:       "CLIENT PAUSE",
+	C.ClientReply:       "CLIENT REPLY",
+	C.ClientSetInfo:     "CLIENT SETINFO",
+	C.ClientSetName:     "CLIENT SETNAME",
+	C.ClientUnblock:     "CLIENT UNBLOCK",
+	C.ClientUnpause:     "CLIENT UNPAUSE",
+	C.ConfigGet:         "CONFIG GET",
+	C.ConfigResetStat:   "CONFIG RESETSTAT",
+	C.ConfigRewrite:     "CONFIG REWRITE",
+	C.ConfigSet:         "CONFIG SET",
+	C.Copy:              "COPY",
+	C.DBSize:            "DBSIZE",
+	C.Decr:              "DECR",
+	C.DecrBy:            "DECRBY",
+	C.Del:               "DEL",
+	C.Dump:              "DUMP",
+	C.Echo:              "ECHO",
+	C.Exists:            "EXISTS",
+	C.Expire:            "EXPIRE",
+	C.ExpireAt:          "EXPIREAT",
+	C.ExpireTime:        "EXPIRETIME",
+	C.FCall:             "FCALL",
+	C.FCallReadOnly:     "FCALL_RO",
+	C.FlushAll:          "FLUSHALL",
+	C.FlushDB:           "FLUSHDB",
+	C.FtAggregate:       "FT.AGGREGATE",
+	C.FtAliasAdd:        "FT.ALIASADD",
+	C.FtAliasDel:        "FT.ALIASDEL",
+	C.FtAliasList:       "FT._ALIASLIST",
+	C.FtAliasUpdate:     "FT.ALIASUPDATE",
+	C.FtCreate:          "FT.CREATE",
+	C.FtDropIndex:       "FT.DROPINDEX",
+	C.FtExplain:         "FT.EXPLAIN",
+	C.FtExplainCli:      "FT.EXPLAINCLI",
+	C.FtInfo:            "FT.INFO",
+	C.FtList:            "FT._LIST",
+	C.FtProfile:         "FT.PROFILE",
+	C.FtSearch:          "FT.SEARCH",
+	C.FunctionDelete:    "FUNCTION DELETE",
+	C.FunctionDump:      "FUNCTION DUMP",
+	C.FunctionFlush:     "FUNCTION FLUSH",
+	C.FunctionKill:      "FUNCTION KILL",
+	C.FunctionList:      "FUNCTION LIST",
+	C.FunctionLoad:      "FUNCTION LOAD",
+	C.FunctionRestore:   "FUNCTION RESTORE",
+	C.FunctionStats:     "FUNCTION STATS",
+	C.GeoAdd:            "GEOADD",
+	C.GeoDist:           "GEODIST",
+	C.GeoHash:           "GEOHASH",
+	C.GeoPos:            "GEOPOS",
+	C.GeoSearch:         "GEOSEARCH",
+	C.GeoSearchStore:    "GEOSEARCHSTORE",
+	C.Get:               "GET",
+	C.GetBit:            "GETBIT",
+	C.GetDel:            "GETDEL",
+	C.GetEx:             "GETEX",
+	C.GetRange:          "GETRANGE",
+	C.HDel:              "HDEL",
+	C.HExists:           "HEXISTS",
+	C.HGet:              "HGET",
+	C.HGetAll:           "HGETALL",
+	C.HIncrBy:           "HINCRBY",
+	C.HIncrByFloat:      "HINCRBYFLOAT",
+	C.HKeys:             "HKEYS",
+	C.HLen:              "HLEN",
+	C.HMGet:             "HMGET",
+	C.HMSet:             "HMSET",
+	C.HRandField:        "HRANDFIELD",
+	C.HScan:             "HSCAN",
+	C.HSet:              "HSET",
+	C.HSetNX:            "HSETNX",
+	C.HStrlen:           "HSTRLEN",
+	C.HVals:             "HVALS",
+	C.Incr:              "INCR",
+	C.IncrBy:            "INCRBY",
+	C.IncrByFloat:       "INCRBYFLOAT",
+	C.Info:              "INFO",
+	C.JsonArrAppend:     "JSON.ARRAPPEND",
+	C.JsonArrIndex:      "JSON.ARRINDEX",
+	C.JsonArrInsert:     "JSON.ARRINSERT",
+	C.JsonArrLen:        "JSON.ARRLEN",
+	C.JsonArrPop:        "JSON.ARRPOP",
+	C.JsonArrTrim:       "JSON.ARRTRIM",
+	C.JsonClear:         "JSON.CLEAR",
+	C.JsonDebug:         "JSON.DEBUG",
+	C.JsonDel:           "JSON.DEL",
+	C.JsonForget:        "JSON.FORGET",
+	C.JsonGet:           "JSON.GET",
+	C.JsonMGet:          "JSON.MGET",
+	C.JsonNumIncrBy:     "JSON.NUMINCRBY",
+	C.JsonNumMultBy:     "JSON.NUMMULTBY",
+	C.JsonObjKeys:       "JSON.OBJKEYS",
+	C.JsonObjLen:        "JSON.OBJLEN",
+	C.JsonResp:          "JSON.RESP",
+	C.JsonSet:           "JSON.SET",
+	C.JsonStrAppend:     "JSON.STRAPPEND",
+	C.JsonStrLen:        "JSON.STRLEN",
+	C.JsonToggle:        "JSON.TOGGLE",
+	C.JsonType:          "JSON.TYPE",
+	C.LCS:               "LCS",
+	C.LIndex:            "LINDEX",
+	C.LInsert:           "LINSERT",
+	C.LLen:              "LLEN",
+	C.LMPop:             "LMPOP",
+	C.LMove:             "LMOVE",
+	C.LPop:              "LPOP",
+	C.LPos:              "LPOS",
+	C.LPush:             "LPUSH",
+	C.LPushX:            "LPUSHX",
+	C.LRange:            "LRANGE",
+	C.LRem:              "LREM",
+	C.LSet:              "LSET",
+	C.LTrim:             "LTRIM",
+	C.LastSave:          "LASTSAVE",
+	C.Lolwut:            "LOLWUT",
+	C.MGet:              "MGET",
+	C.MSet:              "MSET",
+	C.MSetNX:            "MSETNX",
+	C.Move:              "MOVE",
+	C.ObjectEncoding:    "OBJECT ENCODING",
+	C.ObjectFreq:        "OBJECT FREQ",
+	C.ObjectIdleTime:    "OBJECT IDLETIME",
+	C.ObjectRefCount:    "OBJECT REFCOUNT",
+	C.PExpire:           "PEXPIRE",
+	C.PExpireAt:         "PEXPIREAT",
+	C.PExpireTime:       "PEXPIRETIME",
+	C.PTTL:              "PTTL",
+	C.Persist:           "PERSIST",
+	C.PfAdd:             "PFADD",
+	C.PfCount:           "PFCOUNT",
+	C.PfMerge:           "PFMERGE",
+	C.Ping:              "PING",
+	C.PubSubChannels:    "PUBSUB CHANNELS",
+	C.PubSubNumPat:      "PUBSUB NUMPAT",
+	C.PubSubNumSub:      "PUBSUB NUMSUB",
+	C.PubSubShardNumSub: "PUBSUB SHARDNUMSUB",
+	C.Publish:           "PUBLISH",
+	C.RPop:              "RPOP",
+	C.RPush:             "RPUSH",
+	C.RPushX:            "RPUSHX",
+	C.RandomKey:         "RANDOMKEY",
+	C.Rename:            "RENAME",
+	C.RenameNX:          "RENAMENX",
+	C.Restore:           "RESTORE",
+	C.SAdd:              "SADD",
+	C.SCard:             "SCARD",
+	C.SDiff:             "SDIFF",
+	C.SDiffStore:        "SDIFFSTORE",
+	C.SInter:            "SINTER",
+	C.SInterCard:        "SINTERCARD",
+	C.SInterStore:       "SINTERSTORE",
+	C.SIsMember:         "SISMEMBER",
+	C.SMIsMember:        "SMISMEMBER",
+	C.SMembers:          "SMEMBERS",
+	C.SMove:             "SMOVE",
+	C.SPop:              "SPOP",
+	C.SPublish:          "SPUBLISH",
+	C.SRandMember:       "SRANDMEMBER",
+	C.SRem:              "SREM",
+	C.SScan:             "SSCAN",
+	C.SUnion:            "SUNION",
+	C.SUnionStore:       "SUNIONSTORE",
+	C.Scan:              "SCAN",
+	C.ScriptExists:      "SCRIPT EXISTS",
+	C.ScriptFlush:       "SCRIPT FLUSH",
+	C.ScriptKill:        "SCRIPT KILL",
+	C.ScriptShow:        "SCRIPT SHOW",
+	C.Select:            "SELECT",
+	C.Set:               "SET",
+	C.SetBit:            "SETBIT",
+	C.SetRange:          "SETRANGE",
+	C.Sort:              "SORT",
+	C.SortReadOnly:      "SORT_RO",
+	C.Strlen:            "STRLEN",
+	C.TTL:               "TTL",
+	C.Time:              "TIME",
+	C.Touch:             "TOUCH",
+	C.Type:              "TYPE",
+	C.UnWatch:           "UNWATCH",
+	C.Unlink:            "UNLINK",
+	C.Wait:              "WAIT",
+	C.Watch:             "WATCH",
+	C.XAck:              "XACK",
+	C.XAdd:              "XADD",
+	C.XAutoClaim:        "XAUTOCLAIM",
+	C.XClaim:            "XCLAIM",
+	C.XDel:              "XDEL",
+	C.XGroupCreate:      "XGROUP CREATE",
+	C.XGroupDelConsumer: "XGROUP DELCONSUMER",
+	C.XGroupDestroy:     "XGROUP DESTROY",
+	C.XGroupSetId:       "XGROUP SETID",
+	C.XInfoConsumers:    "XINFO CONSUMERS",
+	C.XInfoGroups:       "XINFO GROUPS",
+	C.XInfoStream:       "XINFO STREAM",
+	C.XLen:              "XLEN",
+	C.XPending:          "XPENDING",
+	C.XRange:            "XRANGE",
+	C.XRead:             "XREAD",
+	C.XReadGroup:        "XREADGROUP",
+	C.XRevRange:         "XREVRANGE",
+	C.XTrim:             "XTRIM",
+	C.ZAdd:              "ZADD",
+	C.ZCard:             "ZCARD",
+	C.ZCount:            "ZCOUNT",
+	C.ZDiff:             "ZDIFF",
+	C.ZDiffStore:        "ZDIFFSTORE",
+	C.ZIncrBy:           "ZINCRBY",
+	C.ZInter:            "ZINTER",
+	C.ZInterCard:        "ZINTERCARD",
+	C.ZInterStore:       "ZINTERSTORE",
+	C.ZLexCount:         "ZLEXCOUNT",
+	C.ZMPop:             "ZMPOP",
+	C.ZMScore:           "ZMSCORE",
+	C.ZPopMax:           "ZPOPMAX",
+	C.ZPopMin:           "ZPOPMIN",
+	C.ZRandMember:       "ZRANDMEMBER",
+	C.ZRange:            "ZRANGE",
+	C.ZRangeStore:       "ZRANGESTORE",
+	C.ZRank:             "ZRANK",
+	C.ZRem:              "ZREM",
+	C.ZRemRangeByLex:    "ZREMRANGEBYLEX",
+	C.ZRemRangeByRank:   "ZREMRANGEBYRANK",
+	C.ZRemRangeByScore:  "ZREMRANGEBYSCORE",
+	C.ZRevRank:          "ZREVRANK",
+	C.ZScan:             "ZSCAN",
+	C.ZScore:            "ZSCORE",
+	C.ZUnion:            "ZUNION",
+	C.ZUnionStore:       "ZUNIONSTORE",
+}
+
+// commandName returns the name of the command sent for a request, and the arguments following the name. The name of
+// a custom command is its first argument, in upper case.
+func commandName(requestType C.RequestType, args []string) (string, []string) {
+	if requestType == C.CustomCommand {
+		if len(args) == 0 {
+			return "", args
+		}
+		return strings.ToUpper(args[0]), args[1:]
+	}
+	return commandNames[requestType], args
+}
@@ -0,0 +1,31 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// adaptiveTimeout holds the settings of the adaptive timeout mode. A zero percentile disables the mode.
+type adaptiveTimeout struct {
+	percentile float64
+	floor      time.Duration
+	ceiling    time.Duration
+}
+
+func (timeout adaptiveTimeout) validate() error {
+	if timeout.percentile == 0 {
+		return nil
+	}
+	if timeout.percentile < 0 || timeout.percentile > 100 {
+		return errors.New("adaptive timeout percentile must be between 0 and 100")
+	}
+	if timeout.floor <= 0 {
+		return errors.New("adaptive timeout floor must be positive")
+	}
+	if timeout.ceiling < timeout.floor {
+		return errors.New("adaptive timeout ceiling cannot be lower than the floor")
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import "strings"
+
+// DefaultRedactionMask replaces the redacted arguments reported to an [AuditHook], unless another mask is set with
+// [Redaction.WithMask].
+const DefaultRedactionMask = "<redacted>"
+
+// AuditRecord describes a command, as reported to an [AuditHook].
+type AuditRecord struct {
+	// Command is the name of the command in upper case, e.g. "SET" or "CONFIG GET". Scripts are reported as
+	// "EVALSHA".
+	Command string
+	// Args are the arguments following the command name, with the arguments not kept by the [Redaction] replaced by
+	// its mask.
+	Args []string
+	// Batch is set if the command was sent as part of a batch.
+	Batch bool
+	// Err is the error returned by the command, or by the batch it was part of, or nil if it succeeded.
+	Err error
+}
+
+// AuditHook is called by the client after every command, including the commands of batches and the commands rejected
+// before being sent. It is called synchronously on the goroutine that executed the command, so it must be safe for
+// concurrent use and should return quickly.
+type AuditHook func(record AuditRecord)
+
+// RedactionRule selects the arguments of a command that are reported as is. It returns whether the argument at idx,
+// out of count arguments, is kept.
+type RedactionRule func(idx int, count int) bool
+
+// KeepFirst keeps the first n arguments, e.g. the key of single-key commands, and redacts the others.
+func KeepFirst(n int) RedactionRule {
+	return func(idx int, count int) bool { return idx < n }
+}
+
+// KeepEvery keeps every stride-th argument starting from the first one, e.g. the keys of MSET, and redacts the
+// others.
+func KeepEvery(stride int) RedactionRule {
+	return func(idx int, count int) bool { return idx%stride == 0 }
+}
+
+// KeepAll keeps all arguments, e.g. for commands whose arguments are all keys.
+func KeepAll() RedactionRule {
+	return func(idx int, count int) bool { return true }
+}
+
+// RedactAll redacts all arguments.
+func RedactAll() RedactionRule {
+	return func(idx int, count int) bool { return false }
+}
+
+// Redaction holds the rules selecting the arguments reported to an [AuditHook]. By default the first argument of every
+// command, which is the key of most commands, is kept and the values are redacted. The arguments of the multi-key
+// commands DEL, EXISTS, MGET, PFCOUNT, SDIFF, SINTER, SUNION, TOUCH, UNLINK and WATCH are kept, as are the keys of
+// MSET and MSETNX. The arguments of AUTH and HELLO are redacted.
+type Redaction struct {
+	mask        string
+	defaultRule RedactionRule
+	rules       map[string]RedactionRule
+}
+
+// NewRedaction returns a new [Redaction] with the default rules.
+func NewRedaction() *Redaction {
+	redaction := &Redaction{
+		mask:        DefaultRedactionMask,
+		defaultRule: KeepFirst(1),
+		rules:       make(map[string]RedactionRule),
+	}
+	for _, command := range []string{"DEL", "EXISTS", "MGET", "PFCOUNT", "SDIFF", "SINTER", "SUNION", "TOUCH", "UNLINK",
+		"WATCH"} {
+		redaction.rules[command] = KeepAll()
+	}
+	redaction.rules["MSET"] = KeepEvery(2)
+	redaction.rules["MSETNX"] = KeepEvery(2)
+	redaction.rules["AUTH"] = RedactAll()
+	redaction.rules["HELLO"] = RedactAll()
+	return redaction
+}
+
+// WithMask sets the string replacing the redacted arguments.
+func (redaction *Redaction) WithMask(mask string) *Redaction {
+	redaction.mask = mask
+	return redaction
+}
+
+// WithRule sets the rule used for the given command, e.g. "SET" or "CONFIG SET". The command name is case-insensitive.
+func (redaction *Redaction) WithRule(command string, rule RedactionRule) *Redaction {
+	redaction.rules[strings.ToUpper(command)] = rule
+	return redaction
+}
+
+// WithDefaultRule sets the rule used for the commands without a rule of their own.
+func (redaction *Redaction) WithDefaultRule(rule RedactionRule) *Redaction {
+	redaction.defaultRule = rule
+	return redaction
+}
+
+// Redact returns a copy of the arguments of the given command, with the arguments not kept by its rule replaced by
+// the mask.
+func (redaction *Redaction) Redact(command string, args []string) []string {
+	rule, ok := redaction.rules[strings.ToUpper(command)]
+	if !ok {
+		rule = redaction.defaultRule
+	}
+	redacted := make([]string, len(args))
+	for idx, arg := range args {
+		if rule(idx, len(args)) {
+			redacted[idx] = arg
+		} else {
+			redacted[idx] = redaction.mask
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,345 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const maxPort = 65535
+
+// ValidationError describes a single invalid field of a client configuration.
+type ValidationError struct {
+	// Field is the name of the invalid field, e.g. "addresses[1].Port".
+	Field string
+	// Reason describes why the value of the field is invalid.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+func (config *baseClientConfiguration) validate() []error {
+	var errs []error
+	if len(config.addresses) == 0 {
+		errs = append(errs, &ValidationError{Field: "addresses", Reason: "at least one address must be provided"})
+	}
+	seen := make(map[NodeAddress]int, len(config.addresses))
+	for idx, address := range config.addresses {
+		if address.Port < 0 || address.Port > maxPort {
+			errs = append(errs, &ValidationError{
+				Field:  fmt.Sprintf("addresses[%d].Port", idx),
+				Reason: fmt.Sprintf("port %d is out of range, must be between 1 and %d", address.Port, maxPort),
+			})
+		}
+		normalized := address
+		if normalized.Host == "" {
+			normalized.Host = DefaultHost
+		}
+		if normalized.Port == 0 {
+			normalized.Port = DefaultPort
+		}
+		if first, ok := seen[normalized]; ok {
+			errs = append(errs, &ValidationError{
+				Field:  fmt.Sprintf("addresses[%d]", idx),
+				Reason: fmt.Sprintf("duplicates addresses[%d] (%s:%d)", first, normalized.Host, normalized.Port),
+			})
+		} else {
+			seen[normalized] = idx
+		}
+	}
+	if (config.readFrom == AzAffinity || config.readFrom == AzAffinityReplicaAndPrimary) && config.clientAZ == "" {
+		errs = append(errs, &ValidationError{Field: "clientAZ", Reason: "must be set when using AZ affinity read strategies"})
+	}
+	if config.requestTimeout < 0 {
+		errs = append(errs, &ValidationError{Field: "requestTimeout", Reason: "cannot be negative"})
+	}
+	if config.reconnectStrategy != nil {
+		errs = append(errs, config.reconnectStrategy.validate()...)
+	}
+	return errs
+}
+
+func (config *baseClientConfiguration) clone() baseClientConfiguration {
+	cloned := *config
+	cloned.addresses = slices.Clone(config.addresses)
+	if config.credentials != nil {
+		credentials := *config.credentials
+		cloned.credentials = &credentials
+	}
+	if config.reconnectStrategy != nil {
+		strategy := *config.reconnectStrategy
+		if config.reconnectStrategy.jitterPercent != nil {
+			jitter := *config.reconnectStrategy.jitterPercent
+			strategy.jitterPercent = &jitter
+		}
+		cloned.reconnectStrategy = &strategy
+	}
+	return cloned
+}
+
+func (config *AdvancedClientConfiguration) clone() AdvancedClientConfiguration {
+	cloned := *config
+	cloned.auditRedaction = config.auditRedaction.clone()
+	cloned.circuitBreaker = config.circuitBreaker.clone()
+	cloned.compression = config.compression.clone()
+	cloned.transformers = slices.Clone(config.transformers)
+	cloned.allowedCommands = slices.Clone(config.allowedCommands)
+	cloned.deniedCommands = slices.Clone(config.deniedCommands)
+	return cloned
+}
+
+func (config *AdvancedClusterClientConfiguration) clone() AdvancedClusterClientConfiguration {
+	cloned := *config
+	cloned.auditRedaction = config.auditRedaction.clone()
+	cloned.circuitBreaker = config.circuitBreaker.clone()
+	cloned.compression = config.compression.clone()
+	cloned.transformers = slices.Clone(config.transformers)
+	cloned.allowedCommands = slices.Clone(config.allowedCommands)
+	cloned.deniedCommands = slices.Clone(config.deniedCommands)
+	return cloned
+}
+
+func (redaction *Redaction) clone() *Redaction {
+	if redaction == nil {
+		return nil
+	}
+	cloned := *redaction
+	cloned.rules = maps.Clone(redaction.rules)
+	return &cloned
+}
+
+func (breaker *CircuitBreaker) clone() *CircuitBreaker {
+	if breaker == nil {
+		return nil
+	}
+	cloned := *breaker
+	return &cloned
+}
+
+func (compression *Compression) clone() *Compression {
+	if compression == nil {
+		return nil
+	}
+	cloned := *compression
+	return &cloned
+}
+
+func (strategy *BackoffStrategy) validate() []error {
+	var errs []error
+	if strategy.numOfRetries < 0 {
+		errs = append(errs, &ValidationError{Field: "reconnectStrategy.numOfRetries", Reason: "cannot be negative"})
+	}
+	if strategy.factor < 0 {
+		errs = append(errs, &ValidationError{Field: "reconnectStrategy.factor", Reason: "cannot be negative"})
+	}
+	if strategy.exponentBase < 0 {
+		errs = append(errs, &ValidationError{Field: "reconnectStrategy.exponentBase", Reason: "cannot be negative"})
+	}
+	if strategy.jitterPercent != nil && (*strategy.jitterPercent < 0 || *strategy.jitterPercent > 100) {
+		errs = append(errs, &ValidationError{
+			Field:  "reconnectStrategy.jitterPercent",
+			Reason: "must be between 0 and 100",
+		})
+	}
+	return errs
+}
+
+func (config *BaseSubscriptionConfig) clone() *BaseSubscriptionConfig {
+	cloned := *config
+	cloned.subscriptions = make(map[uint32][]string, len(config.subscriptions))
+	for mode, channels := range config.subscriptions {
+		cloned.subscriptions[mode] = slices.Clone(channels)
+	}
+	return &cloned
+}
+
+// Build validates the configuration eagerly and returns a frozen copy of it. Further changes made through the
+// With* methods of this configuration, or of the circuit breaker, compression and redaction it references, do not
+// affect the returned copy.
+//
+// Unlike the validation performed when the client is created, Build reports every invalid field at once. The
+// returned error joins one [ValidationError] per invalid field, which can be inspected with [errors.As].
+func (config *ClientConfiguration) Build() (*ClientConfiguration, error) {
+	errs := config.baseClientConfiguration.validate()
+	if config.databaseId < 0 {
+		errs = append(errs, &ValidationError{Field: "databaseId", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClientConfiguration.connectionTimeout < 0 {
+		errs = append(errs, &ValidationError{Field: "connectionTimeout", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClientConfiguration.maxPendingCommands < 0 {
+		errs = append(errs, &ValidationError{Field: "maxPendingCommands", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClientConfiguration.maxRequestSize < 0 {
+		errs = append(errs, &ValidationError{Field: "maxRequestSize", Reason: "cannot be negative"})
+	}
+	if slices.Contains(config.AdvancedClientConfiguration.allowedCommands, "") {
+		errs = append(errs, &ValidationError{Field: "allowedCommands", Reason: "cannot contain empty names"})
+	}
+	if slices.Contains(config.AdvancedClientConfiguration.deniedCommands, "") {
+		errs = append(errs, &ValidationError{Field: "deniedCommands", Reason: "cannot contain empty names"})
+	}
+	if !config.AdvancedClientConfiguration.mapKeyPolicy.valid() {
+		errs = append(errs, &ValidationError{Field: "mapKeyPolicy", Reason: "unknown policy"})
+	}
+	if config.AdvancedClientConfiguration.tcpKeepAliveInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "tcpKeepAliveInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClientConfiguration.dnsRefreshInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "dnsRefreshInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClientConfiguration.heartbeatInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "heartbeatInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClientConfiguration.heartbeatInterval > 0 && config.AdvancedClientConfiguration.heartbeatThreshold < 1 {
+		errs = append(errs, &ValidationError{Field: "heartbeatThreshold", Reason: "must be at least 1"})
+	}
+	if err := config.AdvancedClientConfiguration.adaptiveTimeout.validate(); err != nil {
+		errs = append(errs, &ValidationError{Field: "adaptiveTimeout", Reason: err.Error()})
+	}
+	if err := config.AdvancedClientConfiguration.circuitBreaker.validate(); err != nil {
+		errs = append(errs, &ValidationError{Field: "circuitBreaker", Reason: err.Error()})
+	}
+	if err := config.AdvancedClientConfiguration.compression.validate(); err != nil {
+		errs = append(errs, &ValidationError{Field: "compression", Reason: err.Error()})
+	}
+	if slices.Contains(config.AdvancedClientConfiguration.transformers, nil) {
+		errs = append(errs, &ValidationError{Field: "transformers", Reason: "cannot contain nil"})
+	}
+	if config.AdvancedClientConfiguration.resolver != nil && config.useTLS {
+		errs = append(errs, &ValidationError{
+			Field:  "resolver",
+			Reason: "cannot be used with TLS, since certificates are verified against the configured hostnames",
+		})
+	}
+	if config.AdvancedClientConfiguration.ipPreference != IPPreferenceNone && config.useTLS {
+		errs = append(errs, &ValidationError{
+			Field:  "ipPreference",
+			Reason: "cannot be used with TLS, since certificates are verified against the configured hostnames",
+		})
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	// Run the remaining checks, such as duration bounds, performed when the client is created.
+	if _, err := config.ToProtobuf(); err != nil {
+		return nil, err
+	}
+
+	frozen := &ClientConfiguration{
+		baseClientConfiguration:     config.baseClientConfiguration.clone(),
+		databaseId:                  config.databaseId,
+		AdvancedClientConfiguration: config.AdvancedClientConfiguration.clone(),
+	}
+	if config.subscriptionConfig != nil {
+		frozen.subscriptionConfig = &StandaloneSubscriptionConfig{config.subscriptionConfig.BaseSubscriptionConfig.clone()}
+	}
+	return frozen, nil
+}
+
+// Build validates the configuration eagerly and returns a frozen copy of it. Further changes made through the
+// With* methods of this configuration, or of the circuit breaker, compression and redaction it references, do not
+// affect the returned copy.
+//
+// Unlike the validation performed when the client is created, Build reports every invalid field at once. The
+// returned error joins one [ValidationError] per invalid field, which can be inspected with [errors.As].
+func (config *ClusterClientConfiguration) Build() (*ClusterClientConfiguration, error) {
+	errs := config.baseClientConfiguration.validate()
+	if config.AdvancedClusterClientConfiguration.connectionTimeout < 0 {
+		errs = append(errs, &ValidationError{Field: "connectionTimeout", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.maxPendingCommands < 0 {
+		errs = append(errs, &ValidationError{Field: "maxPendingCommands", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.maxRequestSize < 0 {
+		errs = append(errs, &ValidationError{Field: "maxRequestSize", Reason: "cannot be negative"})
+	}
+	if slices.Contains(config.AdvancedClusterClientConfiguration.allowedCommands, "") {
+		errs = append(errs, &ValidationError{Field: "allowedCommands", Reason: "cannot contain empty names"})
+	}
+	if slices.Contains(config.AdvancedClusterClientConfiguration.deniedCommands, "") {
+		errs = append(errs, &ValidationError{Field: "deniedCommands", Reason: "cannot contain empty names"})
+	}
+	if !config.AdvancedClusterClientConfiguration.mapKeyPolicy.valid() {
+		errs = append(errs, &ValidationError{Field: "mapKeyPolicy", Reason: "unknown policy"})
+	}
+	if config.AdvancedClusterClientConfiguration.tcpKeepAliveInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "tcpKeepAliveInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.dnsRefreshInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "dnsRefreshInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.heartbeatInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "heartbeatInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.heartbeatInterval > 0 &&
+		config.AdvancedClusterClientConfiguration.heartbeatThreshold < 1 {
+		errs = append(errs, &ValidationError{Field: "heartbeatThreshold", Reason: "must be at least 1"})
+	}
+	if err := config.AdvancedClusterClientConfiguration.adaptiveTimeout.validate(); err != nil {
+		errs = append(errs, &ValidationError{Field: "adaptiveTimeout", Reason: err.Error()})
+	}
+	if err := config.AdvancedClusterClientConfiguration.circuitBreaker.validate(); err != nil {
+		errs = append(errs, &ValidationError{Field: "circuitBreaker", Reason: err.Error()})
+	}
+	if err := config.AdvancedClusterClientConfiguration.compression.validate(); err != nil {
+		errs = append(errs, &ValidationError{Field: "compression", Reason: err.Error()})
+	}
+	if slices.Contains(config.AdvancedClusterClientConfiguration.transformers, nil) {
+		errs = append(errs, &ValidationError{Field: "transformers", Reason: "cannot contain nil"})
+	}
+	if config.AdvancedClusterClientConfiguration.hedgeDelay < 0 {
+		errs = append(errs, &ValidationError{Field: "hedgeDelay", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.maxReplicaLag < 0 {
+		errs = append(errs, &ValidationError{Field: "maxReplicaLag", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.replicaLagInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "replicaLagInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.replicaLagInterval > 0 && config.readFrom == Primary {
+		errs = append(errs, &ValidationError{
+			Field:  "readFrom",
+			Reason: "must read from replicas to use a replica lag threshold",
+		})
+	}
+	if config.AdvancedClusterClientConfiguration.readYourWritesWindow < 0 {
+		errs = append(errs, &ValidationError{Field: "readYourWritesWindow", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.nodeStatsInterval < 0 {
+		errs = append(errs, &ValidationError{Field: "nodeStatsInterval", Reason: "cannot be negative"})
+	}
+	if config.AdvancedClusterClientConfiguration.resolver != nil && config.useTLS {
+		errs = append(errs, &ValidationError{
+			Field:  "resolver",
+			Reason: "cannot be used with TLS, since certificates are verified against the configured hostnames",
+		})
+	}
+	if config.AdvancedClusterClientConfiguration.ipPreference != IPPreferenceNone && config.useTLS {
+		errs = append(errs, &ValidationError{
+			Field:  "ipPreference",
+			Reason: "cannot be used with TLS, since certificates are verified against the configured hostnames",
+		})
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	// Run the remaining checks, such as duration bounds, performed when the client is created.
+	if _, err := config.ToProtobuf(); err != nil {
+		return nil, err
+	}
+
+	frozen := &ClusterClientConfiguration{
+		baseClientConfiguration:            config.baseClientConfiguration.clone(),
+		AdvancedClusterClientConfiguration: config.AdvancedClusterClientConfiguration.clone(),
+	}
+	if config.subscriptionConfig != nil {
+		frozen.subscriptionConfig = &ClusterSubscriptionConfig{config.subscriptionConfig.BaseSubscriptionConfig.clone()}
+	}
+	return frozen, nil
+}
@@ -0,0 +1,125 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	defaultCircuitBreakerMinRequests      = 20
+	defaultCircuitBreakerWindow           = 10 * time.Second
+	defaultCircuitBreakerHalfOpenRequests = 1
+)
+
+// CircuitBreaker configures a circuit breaker that fails commands fast during outages, instead of letting them wait
+// for a dead endpoint until they time out.
+//
+// The breaker counts the commands that fail with a timeout or connection error within a window. Once at least the
+// minimum number of commands completed in the window and the share of failed commands reaches the error rate
+// threshold, the circuit opens and commands fail immediately with a CircuitOpenError. After the open timeout, the
+// circuit becomes half-open and lets a limited number of probe commands through: the circuit closes again if they
+// succeed, and reopens if any of them fails.
+type CircuitBreaker struct {
+	errorRateThreshold float64
+	openTimeout        time.Duration
+	minRequests        int
+	window             time.Duration
+	halfOpenRequests   int
+	perNode            bool
+}
+
+// NewCircuitBreaker returns a [CircuitBreaker] that opens once the share of failed commands reaches
+// errorRateThreshold, between 0 and 1, and stays open for openTimeout before probing the endpoint again.
+func NewCircuitBreaker(errorRateThreshold float64, openTimeout time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		errorRateThreshold: errorRateThreshold,
+		openTimeout:        openTimeout,
+		minRequests:        defaultCircuitBreakerMinRequests,
+		window:             defaultCircuitBreakerWindow,
+		halfOpenRequests:   defaultCircuitBreakerHalfOpenRequests,
+	}
+}
+
+// WithMinRequests sets the minimum number of commands that must complete within a window before the circuit can
+// open. If not explicitly set, a default value of 20 will be used.
+func (breaker *CircuitBreaker) WithMinRequests(minRequests int) *CircuitBreaker {
+	breaker.minRequests = minRequests
+	return breaker
+}
+
+// WithWindow sets the duration of the window in which failures are counted. If not explicitly set, a default value of
+// 10 seconds will be used.
+func (breaker *CircuitBreaker) WithWindow(window time.Duration) *CircuitBreaker {
+	breaker.window = window
+	return breaker
+}
+
+// WithHalfOpenRequests sets the number of probe commands let through while the circuit is half-open. If not
+// explicitly set, a default value of 1 will be used.
+func (breaker *CircuitBreaker) WithHalfOpenRequests(halfOpenRequests int) *CircuitBreaker {
+	breaker.halfOpenRequests = halfOpenRequests
+	return breaker
+}
+
+// WithPerNode keeps a separate circuit for each node that commands are explicitly routed to by address, so that an
+// outage of one node does not fail the commands sent to the others. Commands routed by the client core always use
+// the circuit of the client, since their target node is not known in advance.
+func (breaker *CircuitBreaker) WithPerNode(perNode bool) *CircuitBreaker {
+	breaker.perNode = perNode
+	return breaker
+}
+
+// GetErrorRateThreshold returns the share of failed commands at which the circuit opens.
+func (breaker *CircuitBreaker) GetErrorRateThreshold() float64 {
+	return breaker.errorRateThreshold
+}
+
+// GetOpenTimeout returns how long the circuit stays open before probing the endpoint again.
+func (breaker *CircuitBreaker) GetOpenTimeout() time.Duration {
+	return breaker.openTimeout
+}
+
+// GetMinRequests returns the minimum number of commands that must complete within a window before the circuit can
+// open.
+func (breaker *CircuitBreaker) GetMinRequests() int {
+	return breaker.minRequests
+}
+
+// GetWindow returns the duration of the window in which failures are counted.
+func (breaker *CircuitBreaker) GetWindow() time.Duration {
+	return breaker.window
+}
+
+// GetHalfOpenRequests returns the number of probe commands let through while the circuit is half-open.
+func (breaker *CircuitBreaker) GetHalfOpenRequests() int {
+	return breaker.halfOpenRequests
+}
+
+// IsPerNode returns whether a separate circuit is kept for each node that commands are routed to by address.
+func (breaker *CircuitBreaker) IsPerNode() bool {
+	return breaker.perNode
+}
+
+func (breaker *CircuitBreaker) validate() error {
+	if breaker == nil {
+		return nil
+	}
+	if breaker.errorRateThreshold <= 0 || breaker.errorRateThreshold > 1 {
+		return errors.New("circuit breaker error rate threshold must be greater than 0 and at most 1")
+	}
+	if breaker.openTimeout <= 0 {
+		return errors.New("circuit breaker open timeout must be positive")
+	}
+	if breaker.minRequests < 1 {
+		return errors.New("circuit breaker minimum requests must be at least 1")
+	}
+	if breaker.window <= 0 {
+		return errors.New("circuit breaker window must be positive")
+	}
+	if breaker.halfOpenRequests < 1 {
+		return errors.New("circuit breaker half-open requests must be at least 1")
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// compressionMagic prefixes compressed values, followed by the ID of the [Compressor]. Values without the prefix are
+// read as is, so that compressed and uncompressed values can be mixed.
+const compressionMagic = "\x00GLZ"
+
+// Compressor compresses and decompresses values for [Compression]. Gzip is provided by [NewGzipCompressor]. Other
+// algorithms, such as Zstandard or LZ4, can be provided by an adapter wrapping a third-party library, e.g.
+// github.com/klauspost/compress/zstd or github.com/pierrec/lz4, whose ID is chosen by the application.
+type Compressor interface {
+	// ID identifies the algorithm in the prefix of compressed values. It must be unique among the compressors used by
+	// the applications sharing the data.
+	ID() byte
+	// Compress returns the compressed form of data.
+	Compress(data []byte) ([]byte, error)
+	// Decompress returns the original form of data compressed by Compress. It must fail with
+	// [ErrDecompressedSizeExceeded] rather than produce more than maxSize bytes, so that values crafted to expand
+	// enormously do not exhaust the memory of the application.
+	Decompress(data []byte, maxSize int) ([]byte, error)
+}
+
+// ErrDecompressedSizeExceeded is returned when a value decompresses to more bytes than allowed by
+// [Compression.WithMaxDecompressedSize].
+var ErrDecompressedSizeExceeded = errors.New("decompressed value exceeds the maximum size")
+
+// DefaultMaxDecompressedSize is the largest size a value may decompress to, unless set otherwise with
+// [Compression.WithMaxDecompressedSize]. It matches the largest value the server accepts.
+const DefaultMaxDecompressedSize = 512 << 20
+
+// GzipCompressorID is the ID of the compressor returned by [NewGzipCompressor].
+const GzipCompressorID byte = 1
+
+type gzipCompressor struct {
+	level int
+}
+
+// NewGzipCompressor returns a [Compressor] using gzip with the given level, e.g. gzip.BestSpeed.
+func NewGzipCompressor(level int) Compressor {
+	return &gzipCompressor{level: level}
+}
+
+func (compressor *gzipCompressor) ID() byte {
+	return GzipCompressorID
+}
+
+func (compressor *gzipCompressor) Compress(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buffer, compressor.level)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func (compressor *gzipCompressor) Decompress(data []byte, maxSize int) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	decompressed, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > maxSize {
+		return nil, ErrDecompressedSizeExceeded
+	}
+	return decompressed, nil
+}
+
+// Compression configures the transparent compression of the values written by SET, MSET, MSETNX, HSET and HSETNX.
+// Values at least as long as the threshold are compressed, and prefixed with a marker identifying the [Compressor].
+// The values read by the GET and HGET families of commands are decompressed if they carry the marker, and returned as
+// is otherwise. Commands operating on parts of a value, such as APPEND, GETRANGE or INCR, see the compressed form.
+//
+// Values compressed by gzip, with [GzipCompressorID], are decompressed whatever the configured [Compressor], so that
+// the compressor can be changed while values written with gzip remain readable.
+//
+// Compression is a [Transformer], which is applied before any other configured transformer when values are written.
+type Compression struct {
+	compressor          Compressor
+	threshold           int
+	maxDecompressedSize int
+}
+
+// NewCompression returns a new [Compression] compressing the values of at least threshold bytes with the given
+// [Compressor].
+func NewCompression(compressor Compressor, threshold int) *Compression {
+	return &Compression{compressor: compressor, threshold: threshold, maxDecompressedSize: DefaultMaxDecompressedSize}
+}
+
+// WithMaxDecompressedSize sets the largest size, in bytes, a value may decompress to. Reading a larger value fails
+// with [ErrDecompressedSizeExceeded]. Defaults to [DefaultMaxDecompressedSize].
+func (compression *Compression) WithMaxDecompressedSize(maxSize int) *Compression {
+	compression.maxDecompressedSize = maxSize
+	return compression
+}
+
+// GetCompressor returns the [Compressor] used for values.
+func (compression *Compression) GetCompressor() Compressor {
+	return compression.compressor
+}
+
+// GetThreshold returns the length from which values are compressed.
+func (compression *Compression) GetThreshold() int {
+	return compression.threshold
+}
+
+// GetMaxDecompressedSize returns the largest size a value may decompress to.
+func (compression *Compression) GetMaxDecompressedSize() int {
+	return compression.maxDecompressedSize
+}
+
+// Encode returns the form of value written to the server: compressed and prefixed with the marker if it is at least as
+// long as the threshold, or as is otherwise. Shorter values which start with the marker are compressed as well, so
+// that they are not mistaken for compressed values when read.
+func (compression *Compression) Encode(value string) (string, error) {
+	if len(value) < compression.threshold && !strings.HasPrefix(value, compressionMagic) {
+		return value, nil
+	}
+	compressed, err := compression.compressor.Compress([]byte(value))
+	if err != nil {
+		return "", fmt.Errorf("failed to compress value: %w", err)
+	}
+	return compressionMagic + string([]byte{compression.compressor.ID()}) + string(compressed), nil
+}
+
+// Decode returns the original form of a value read from the server, decompressing it if it carries the marker.
+func (compression *Compression) Decode(value string) (string, error) {
+	if !strings.HasPrefix(value, compressionMagic) || len(value) <= len(compressionMagic) {
+		return value, nil
+	}
+	compressor := compression.compressor
+	if id := value[len(compressionMagic)]; id != compressor.ID() {
+		if id != GzipCompressorID {
+			return "", fmt.Errorf("value was compressed by an unknown compressor with ID %d", id)
+		}
+		compressor = NewGzipCompressor(gzip.DefaultCompression)
+	}
+	decompressed, err := compressor.Decompress([]byte(value[len(compressionMagic)+1:]), compression.maxDecompressedSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to decompress value: %w", err)
+	}
+	return string(decompressed), nil
+}
+
+func (compression *Compression) validate() error {
+	if compression == nil {
+		return nil
+	}
+	if compression.compressor == nil {
+		return errors.New("compressor cannot be nil")
+	}
+	if compression.threshold < 0 {
+		return errors.New("threshold cannot be negative")
+	}
+	if compression.maxDecompressedSize <= 0 {
+		return errors.New("maximum decompressed size must be positive")
+	}
+	return nil
+}
@@ -3,8 +3,10 @@
 package config
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
@@ -34,6 +36,47 @@ func (addr *NodeAddress) toProtobuf() *protobuf.NodeAddress {
 	return &protobuf.NodeAddress{Host: addr.Host, Port: uint32(addr.Port)}
 }
 
+// Resolver translates a hostname into network addresses. [net.Resolver] implements this interface.
+type Resolver interface {
+	// LookupHost returns the addresses of the given host.
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// IPPreference selects the addresses the client connects to for hostnames which resolve to both IPv4 and IPv6
+// addresses, e.g. in dual-stack Kubernetes clusters.
+type IPPreference int
+
+const (
+	// IPPreferenceNone - Hostnames are passed to the client core, which uses the addresses in the order returned by the
+	// system resolver.
+	IPPreferenceNone IPPreference = iota
+	// PreferIPv4 - Connect to the IPv4 addresses of a hostname, falling back to its IPv6 addresses.
+	PreferIPv4
+	// PreferIPv6 - Connect to the IPv6 addresses of a hostname, falling back to its IPv4 addresses.
+	PreferIPv6
+	// IPv4Only - Connect to the IPv4 addresses of a hostname only.
+	IPv4Only
+	// IPv6Only - Connect to the IPv6 addresses of a hostname only.
+	IPv6Only
+)
+
+// MapKeyPolicy selects how the client converts map responses holding the same key more than once, which servers don't
+// send but proxies and modules may.
+type MapKeyPolicy int
+
+const (
+	// LastKeyWins - The value of the last occurrence of a key is kept.
+	LastKeyWins MapKeyPolicy = iota
+	// FirstKeyWins - The value of the first occurrence of a key is kept.
+	FirstKeyWins
+	// DuplicateKeyError - The command fails with a ConversionError.
+	DuplicateKeyError
+)
+
+func (policy MapKeyPolicy) valid() bool {
+	return policy >= LastKeyWins && policy <= DuplicateKeyError
+}
+
 // ServerCredentials represents the credentials for connecting to servers.
 type ServerCredentials struct {
 	// The username that will be used for authenticating connections to the servers. If not supplied, "default"
@@ -240,6 +283,63 @@ func (config *ClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequest, er
 		}
 		request.ConnectionTimeout = connectionTimeout
 	}
+	if config.AdvancedClientConfiguration.maxPendingCommands < 0 {
+		return nil, errors.New("max pending commands cannot be negative")
+	}
+	if config.AdvancedClientConfiguration.maxRequestSize < 0 {
+		return nil, errors.New("max request size cannot be negative")
+	}
+	if slices.Contains(config.AdvancedClientConfiguration.allowedCommands, "") ||
+		slices.Contains(config.AdvancedClientConfiguration.deniedCommands, "") {
+		return nil, errors.New("allowed and denied command names cannot be empty")
+	}
+	if !config.AdvancedClientConfiguration.mapKeyPolicy.valid() {
+		return nil, errors.New("invalid map key policy")
+	}
+	request.TcpNodelay = config.AdvancedClientConfiguration.tcpNoDelay
+	if config.AdvancedClientConfiguration.tcpKeepAliveInterval < 0 {
+		return nil, errors.New("TCP keepalive interval cannot be negative")
+	}
+	if config.AdvancedClientConfiguration.tcpKeepAliveInterval != 0 {
+		keepAliveInterval, err := utils.DurationToMilliseconds(config.AdvancedClientConfiguration.tcpKeepAliveInterval)
+		if err != nil {
+			return nil, fmt.Errorf("setting TCP keepalive interval returned an error: %w", err)
+		}
+		request.TcpKeepaliveInterval = keepAliveInterval
+	}
+	if config.AdvancedClientConfiguration.dnsRefreshInterval < 0 {
+		return nil, errors.New("DNS refresh interval cannot be negative")
+	}
+	if config.AdvancedClientConfiguration.resolver != nil && config.useTLS {
+		return nil, errors.New("a custom resolver cannot be used with TLS")
+	}
+	if config.AdvancedClientConfiguration.ipPreference != IPPreferenceNone && config.useTLS {
+		return nil, errors.New("an IP preference cannot be used with TLS")
+	}
+	if config.AdvancedClientConfiguration.heartbeatInterval < 0 {
+		return nil, errors.New("heartbeat interval cannot be negative")
+	}
+	if config.AdvancedClientConfiguration.heartbeatInterval > 0 && config.AdvancedClientConfiguration.heartbeatThreshold < 1 {
+		return nil, errors.New("heartbeat failure threshold must be at least 1")
+	}
+	if config.AdvancedClientConfiguration.slowCommandThreshold < 0 {
+		return nil, errors.New("slow command threshold cannot be negative")
+	}
+	if config.AdvancedClientConfiguration.slowCommandCapacity < 0 {
+		return nil, errors.New("slow command log capacity cannot be negative")
+	}
+	if err := config.AdvancedClientConfiguration.adaptiveTimeout.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.AdvancedClientConfiguration.circuitBreaker.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.AdvancedClientConfiguration.compression.validate(); err != nil {
+		return nil, err
+	}
+	if slices.Contains(config.AdvancedClientConfiguration.transformers, nil) {
+		return nil, errors.New("transformers cannot contain nil")
+	}
 
 	return request, nil
 }
@@ -379,6 +479,82 @@ func (config *ClusterClientConfiguration) ToProtobuf() (*protobuf.ConnectionRequ
 		}
 		request.ConnectionTimeout = connectionTimeout
 	}
+	if config.AdvancedClusterClientConfiguration.maxPendingCommands < 0 {
+		return nil, errors.New("max pending commands cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.maxRequestSize < 0 {
+		return nil, errors.New("max request size cannot be negative")
+	}
+	if slices.Contains(config.AdvancedClusterClientConfiguration.allowedCommands, "") ||
+		slices.Contains(config.AdvancedClusterClientConfiguration.deniedCommands, "") {
+		return nil, errors.New("allowed and denied command names cannot be empty")
+	}
+	if !config.AdvancedClusterClientConfiguration.mapKeyPolicy.valid() {
+		return nil, errors.New("invalid map key policy")
+	}
+	request.TcpNodelay = config.AdvancedClusterClientConfiguration.tcpNoDelay
+	if config.AdvancedClusterClientConfiguration.tcpKeepAliveInterval < 0 {
+		return nil, errors.New("TCP keepalive interval cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.tcpKeepAliveInterval != 0 {
+		keepAliveInterval, err := utils.DurationToMilliseconds(config.AdvancedClusterClientConfiguration.tcpKeepAliveInterval)
+		if err != nil {
+			return nil, fmt.Errorf("setting TCP keepalive interval returned an error: %w", err)
+		}
+		request.TcpKeepaliveInterval = keepAliveInterval
+	}
+	if config.AdvancedClusterClientConfiguration.dnsRefreshInterval < 0 {
+		return nil, errors.New("DNS refresh interval cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.resolver != nil && config.useTLS {
+		return nil, errors.New("a custom resolver cannot be used with TLS")
+	}
+	if config.AdvancedClusterClientConfiguration.ipPreference != IPPreferenceNone && config.useTLS {
+		return nil, errors.New("an IP preference cannot be used with TLS")
+	}
+	if config.AdvancedClusterClientConfiguration.heartbeatInterval < 0 {
+		return nil, errors.New("heartbeat interval cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.heartbeatInterval > 0 &&
+		config.AdvancedClusterClientConfiguration.heartbeatThreshold < 1 {
+		return nil, errors.New("heartbeat failure threshold must be at least 1")
+	}
+	if config.AdvancedClusterClientConfiguration.slowCommandThreshold < 0 {
+		return nil, errors.New("slow command threshold cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.slowCommandCapacity < 0 {
+		return nil, errors.New("slow command log capacity cannot be negative")
+	}
+	if err := config.AdvancedClusterClientConfiguration.adaptiveTimeout.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.AdvancedClusterClientConfiguration.circuitBreaker.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.AdvancedClusterClientConfiguration.compression.validate(); err != nil {
+		return nil, err
+	}
+	if slices.Contains(config.AdvancedClusterClientConfiguration.transformers, nil) {
+		return nil, errors.New("transformers cannot contain nil")
+	}
+	if config.AdvancedClusterClientConfiguration.hedgeDelay < 0 {
+		return nil, errors.New("hedge delay cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.maxReplicaLag < 0 {
+		return nil, errors.New("max replica lag cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.replicaLagInterval < 0 {
+		return nil, errors.New("replica lag refresh interval cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.replicaLagInterval > 0 && config.readFrom == Primary {
+		return nil, errors.New("a replica lag threshold requires a read strategy reading from replicas")
+	}
+	if config.AdvancedClusterClientConfiguration.readYourWritesWindow < 0 {
+		return nil, errors.New("read-your-writes window cannot be negative")
+	}
+	if config.AdvancedClusterClientConfiguration.nodeStatsInterval < 0 {
+		return nil, errors.New("node statistics probe interval cannot be negative")
+	}
 	if config.subscriptionConfig != nil && len(config.subscriptionConfig.subscriptions) > 0 {
 		request.PubsubSubscriptions = config.subscriptionConfig.toProtobuf()
 	}
@@ -488,7 +664,32 @@ func (config *ClusterClientConfiguration) GetSubscription() *ClusterSubscription
 
 // Represents advanced configuration settings for a Standalone client used in [ClientConfiguration].
 type AdvancedClientConfiguration struct {
-	connectionTimeout time.Duration
+	connectionTimeout    time.Duration
+	maxPendingCommands   int
+	resolver             Resolver
+	dnsRefreshInterval   time.Duration
+	heartbeatInterval    time.Duration
+	heartbeatThreshold   int
+	metricsHook          MetricsHook
+	auditHook            AuditHook
+	auditRedaction       *Redaction
+	adaptiveTimeout      adaptiveTimeout
+	circuitBreaker       *CircuitBreaker
+	strictValidation     bool
+	compression          *Compression
+	transformers         []Transformer
+	readCoalescing       bool
+	maxRequestSize       int
+	tcpNoDelay           bool
+	tcpKeepAliveInterval time.Duration
+	ipPreference         IPPreference
+	allowedCommands      []string
+	deniedCommands       []string
+	quotaHook            QuotaHook
+	mapKeyPolicy         MapKeyPolicy
+	profilerLabels       bool
+	slowCommandThreshold time.Duration
+	slowCommandCapacity  int
 }
 
 // NewAdvancedClientConfiguration returns a new [AdvancedClientConfiguration] with default settings.
@@ -511,10 +712,388 @@ func (config *AdvancedClientConfiguration) WithConnectionTimeout(
 	return config
 }
 
+// WithMaxPendingCommands sets the maximum number of commands that may be awaiting a response from the client at
+// the same time. Once the cap is reached, new commands fail immediately with a PendingLimitError instead of
+// being queued, which surfaces run-away pending growth early. If not explicitly set, or set to 0, the number of
+// pending commands is not limited.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithMaxPendingCommands(maxPendingCommands int) *AdvancedClientConfiguration {
+	config.maxPendingCommands = maxPendingCommands
+	return config
+}
+
+// GetMaxPendingCommands returns the configured cap on pending commands, or 0 if the number is not limited.
+func (config *AdvancedClientConfiguration) GetMaxPendingCommands() int {
+	return config.maxPendingCommands
+}
+
+// WithResolver sets the [Resolver] used to translate the configured hostnames into the addresses the client connects
+// to, e.g. for service-mesh name resolution. Each configured hostname is replaced with its first resolved address.
+//
+// A resolver cannot be combined with TLS, since server certificates are verified against the configured hostnames.
+func (config *AdvancedClientConfiguration) WithResolver(resolver Resolver) *AdvancedClientConfiguration {
+	config.resolver = resolver
+	return config
+}
+
+// GetResolver returns the configured [Resolver], or nil if hostnames are resolved by the client core.
+func (config *AdvancedClientConfiguration) GetResolver() Resolver {
+	return config.resolver
+}
+
+// WithDNSRefreshInterval sets how often the configured hostnames are re-resolved after the client is created. When
+// their addresses change, the client connects again: with the new addresses if hostnames are resolved by the client,
+// or with the configured hostnames otherwise, so that the client core resolves them anew. Commands in flight complete
+// on the previous connection. The currently resolved addresses are reported by the client statistics. If no
+// [Resolver] is set, the default system resolver is used. If not explicitly set, or set to 0, hostnames are not
+// re-resolved.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithDNSRefreshInterval(interval time.Duration) *AdvancedClientConfiguration {
+	config.dnsRefreshInterval = interval
+	return config
+}
+
+// GetDNSRefreshInterval returns the interval at which hostnames are re-resolved, or 0 if they are not.
+func (config *AdvancedClientConfiguration) GetDNSRefreshInterval() time.Duration {
+	return config.dnsRefreshInterval
+}
+
+// WithIPPreference sets the address family preferred for hostnames which resolve to both IPv4 and IPv6 addresses.
+// The hostnames are resolved by the client, with the configured [Resolver] or the default system resolver, and each
+// one is replaced with its first address of the preferred family. If connecting fails, the client is created again
+// with the addresses of the other family, unless only one family is allowed. IP literals are used as is. If not
+// explicitly set, [IPPreferenceNone] will be used, meaning hostnames are resolved by the client core.
+//
+// An IP preference cannot be combined with TLS, since server certificates are verified against the configured
+// hostnames.
+func (config *AdvancedClientConfiguration) WithIPPreference(preference IPPreference) *AdvancedClientConfiguration {
+	config.ipPreference = preference
+	return config
+}
+
+// GetIPPreference returns the configured [IPPreference].
+func (config *AdvancedClientConfiguration) GetIPPreference() IPPreference {
+	return config.ipPreference
+}
+
+// WithHeartbeat enables health checks, sending a PING every interval to detect half-open TCP connections early.
+// After failureThreshold consecutive failed heartbeats the client is reported as unhealthy by its statistics and
+// reconnects, replacing its connections with new ones, which it does again after every further failed heartbeat until
+// a heartbeat succeeds. If not explicitly set, or set to an interval of 0, no heartbeats are sent.
+//
+// Using a negative interval, or a failure threshold lower than 1 with a positive interval, will lead to an invalid
+// configuration.
+func (config *AdvancedClientConfiguration) WithHeartbeat(
+	interval time.Duration,
+	failureThreshold int,
+) *AdvancedClientConfiguration {
+	config.heartbeatInterval = interval
+	config.heartbeatThreshold = failureThreshold
+	return config
+}
+
+// GetHeartbeat returns the heartbeat interval and failure threshold. The interval is 0 if heartbeats are disabled.
+func (config *AdvancedClientConfiguration) GetHeartbeat() (time.Duration, int) {
+	return config.heartbeatInterval, config.heartbeatThreshold
+}
+
+// WithMetricsHook sets a [MetricsHook] called after every command and batch, e.g. to export the bytes sent and
+// received per command family. The same totals are accumulated in the client statistics regardless of the hook.
+func (config *AdvancedClientConfiguration) WithMetricsHook(hook MetricsHook) *AdvancedClientConfiguration {
+	config.metricsHook = hook
+	return config
+}
+
+// GetMetricsHook returns the configured [MetricsHook], or nil if none is set.
+func (config *AdvancedClientConfiguration) GetMetricsHook() MetricsHook {
+	return config.metricsHook
+}
+
+// WithQuotaHook sets a [QuotaHook] called before every request is sent, e.g. to enforce per-caller quotas on the
+// number of commands or the bytes sent. Requests rejected by the hook fail with a QuotaExceededError.
+func (config *AdvancedClientConfiguration) WithQuotaHook(hook QuotaHook) *AdvancedClientConfiguration {
+	config.quotaHook = hook
+	return config
+}
+
+// GetQuotaHook returns the configured [QuotaHook], or nil if none is set.
+func (config *AdvancedClientConfiguration) GetQuotaHook() QuotaHook {
+	return config.quotaHook
+}
+
+// WithMapKeyPolicy sets how map responses holding the same key more than once are converted. If not explicitly set,
+// [LastKeyWins] will be used. Responses returned by ExecuteRaw keep all the occurrences.
+func (config *AdvancedClientConfiguration) WithMapKeyPolicy(policy MapKeyPolicy) *AdvancedClientConfiguration {
+	config.mapKeyPolicy = policy
+	return config
+}
+
+// GetMapKeyPolicy returns the configured [MapKeyPolicy].
+func (config *AdvancedClientConfiguration) GetMapKeyPolicy() MapKeyPolicy {
+	return config.mapKeyPolicy
+}
+
+// WithProfilerLabels sets whether goroutines waiting for a command are labeled with its name, under the
+// "valkey.command" pprof label, so that CPU and goroutine profiles attribute the time spent in commands, such as
+// long-running blocking commands, to the commands. Labels cost an allocation per command, so they are disabled by
+// default.
+func (config *AdvancedClientConfiguration) WithProfilerLabels(enabled bool) *AdvancedClientConfiguration {
+	config.profilerLabels = enabled
+	return config
+}
+
+// GetProfilerLabels returns whether goroutines waiting for a command are labeled with its name.
+func (config *AdvancedClientConfiguration) GetProfilerLabels() bool {
+	return config.profilerLabels
+}
+
+// WithSlowCommandLog records the commands taking longer than threshold, including batches and scripts, in a log of the
+// given capacity which is retrievable with the SlowCommands method of the client. Once the log is full, the oldest
+// entries are dropped. Unlike the SLOWLOG of the server, the recorded duration includes the network round trip and the
+// time spent queued in the client. The arguments are recorded with the default rules of [NewRedaction], so that values
+// are not retained. If not explicitly set, or set with a capacity of 0, no commands are recorded.
+//
+// Using a negative threshold or capacity will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithSlowCommandLog(
+	threshold time.Duration,
+	capacity int,
+) *AdvancedClientConfiguration {
+	config.slowCommandThreshold = threshold
+	config.slowCommandCapacity = capacity
+	return config
+}
+
+// GetSlowCommandLog returns the threshold and capacity of the slow command log. The capacity is 0 if the log is
+// disabled.
+func (config *AdvancedClientConfiguration) GetSlowCommandLog() (time.Duration, int) {
+	return config.slowCommandThreshold, config.slowCommandCapacity
+}
+
+// WithAuditHook sets an [AuditHook] called after every command with its arguments, e.g. for security auditing. The
+// arguments are redacted according to the given [Redaction], or to the default rules of [NewRedaction] if it is nil.
+func (config *AdvancedClientConfiguration) WithAuditHook(
+	hook AuditHook,
+	redaction *Redaction,
+) *AdvancedClientConfiguration {
+	config.auditHook = hook
+	config.auditRedaction = redaction
+	return config
+}
+
+// GetAuditHook returns the configured [AuditHook] and its [Redaction], or nil if no audit hook is set.
+func (config *AdvancedClientConfiguration) GetAuditHook() (AuditHook, *Redaction) {
+	if config.auditHook != nil && config.auditRedaction == nil {
+		return config.auditHook, NewRedaction()
+	}
+	return config.auditHook, config.auditRedaction
+}
+
+// WithAdaptiveTimeout enables the adaptive timeout mode. The client tracks the latencies of recent commands per command
+// family, e.g. "String" or "SortedSet", and gives each command a deadline equal to the given percentile of the
+// latencies of its family, bounded by floor and ceiling. Until enough latencies are observed, the ceiling is used.
+// A deadline already set on the context of a command is kept if it is earlier. Blocking commands, custom commands,
+// scripts and batches are not affected. A command that exceeds its adaptive deadline fails with a TimeoutError.
+//
+// The request timeout still applies. Using a percentile outside of (0, 100], a floor that is not positive, or a
+// ceiling lower than the floor will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithAdaptiveTimeout(
+	percentile float64,
+	floor time.Duration,
+	ceiling time.Duration,
+) *AdvancedClientConfiguration {
+	config.adaptiveTimeout = adaptiveTimeout{percentile: percentile, floor: floor, ceiling: ceiling}
+	return config
+}
+
+// GetAdaptiveTimeout returns the percentile, floor and ceiling of the adaptive timeout mode. The percentile is 0 if
+// the mode is disabled.
+func (config *AdvancedClientConfiguration) GetAdaptiveTimeout() (float64, time.Duration, time.Duration) {
+	return config.adaptiveTimeout.percentile, config.adaptiveTimeout.floor, config.adaptiveTimeout.ceiling
+}
+
+// WithCircuitBreaker enables a [CircuitBreaker], which fails commands fast with a CircuitOpenError during outages. If
+// not explicitly set, no circuit breaker is used.
+func (config *AdvancedClientConfiguration) WithCircuitBreaker(breaker *CircuitBreaker) *AdvancedClientConfiguration {
+	config.circuitBreaker = breaker
+	return config
+}
+
+// GetCircuitBreaker returns the configured [CircuitBreaker], or nil if none is used.
+func (config *AdvancedClientConfiguration) GetCircuitBreaker() *CircuitBreaker {
+	return config.circuitBreaker
+}
+
+// WithStrictValidation enables the strict validation mode, in which the arguments of common commands are checked
+// before they are sent. Empty keys, missing elements and out-of-range counts are rejected with a RequestError
+// instead of being sent to the server. Custom commands and batches are not checked. If not explicitly set, strict
+// validation is disabled.
+func (config *AdvancedClientConfiguration) WithStrictValidation(enabled bool) *AdvancedClientConfiguration {
+	config.strictValidation = enabled
+	return config
+}
+
+// GetStrictValidation returns whether the strict validation mode is enabled.
+func (config *AdvancedClientConfiguration) GetStrictValidation() bool {
+	return config.strictValidation
+}
+
+// WithReadCoalescing enables the de-duplication of identical concurrent GET and HGETALL calls, which reduces the load
+// caused by many callers reading the same hot key at once. Only one request is sent to the server, and its result is
+// shared by all callers waiting for it. The shared request is not cancelled with the context of the caller starting
+// it, and is bounded by the request timeout instead. If not explicitly set, reads are not coalesced.
+func (config *AdvancedClientConfiguration) WithReadCoalescing(enabled bool) *AdvancedClientConfiguration {
+	config.readCoalescing = enabled
+	return config
+}
+
+// GetReadCoalescing returns whether identical concurrent reads are coalesced.
+func (config *AdvancedClientConfiguration) GetReadCoalescing() bool {
+	return config.readCoalescing
+}
+
+// WithMaxRequestSize sets the maximum size in bytes of the arguments of a command, of a script invocation, or of all
+// the commands of a batch. Larger requests fail with a RequestTooLargeError before they are copied to the core, which
+// protects the client and the connection against accidentally huge payloads, such as an MSET of millions of keys. If
+// not explicitly set, a value of 0 will be used, meaning the size of requests is not limited.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithMaxRequestSize(maxBytes int) *AdvancedClientConfiguration {
+	config.maxRequestSize = maxBytes
+	return config
+}
+
+// GetMaxRequestSize returns the maximum size in bytes of a request, or 0 if it is not limited.
+func (config *AdvancedClientConfiguration) GetMaxRequestSize() int {
+	return config.maxRequestSize
+}
+
+// WithAllowedCommands restricts the client to the given commands, e.g. for handing out restricted clients to
+// application code. Any other command fails with a ForbiddenCommandError before it is sent. Names are case-insensitive,
+// and a command with subcommands, such as CONFIG, allows all of its subcommands, while a subcommand, such as
+// "CONFIG GET", allows only itself. Scripts are allowed by EVALSHA, cluster scans by SCAN, and password updates by
+// AUTH. Commands sent by the client itself, such as heartbeats, are restricted as well. If not explicitly set, all
+// commands which are not denied are allowed.
+//
+// Using an empty command name will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithAllowedCommands(commands ...string) *AdvancedClientConfiguration {
+	config.allowedCommands = commands
+	return config
+}
+
+// GetAllowedCommands returns the commands the client is restricted to, or nil if it is not restricted.
+func (config *AdvancedClientConfiguration) GetAllowedCommands() []string {
+	return config.allowedCommands
+}
+
+// WithDeniedCommands prevents the client from sending the given commands, e.g. FLUSHALL, KEYS or DEBUG, which fail with
+// a ForbiddenCommandError before they are sent. Names are matched like the ones of
+// [AdvancedClientConfiguration.WithAllowedCommands], and denied commands are rejected even if they are allowed.
+//
+// Using an empty command name will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithDeniedCommands(commands ...string) *AdvancedClientConfiguration {
+	config.deniedCommands = commands
+	return config
+}
+
+// GetDeniedCommands returns the commands the client may not send.
+func (config *AdvancedClientConfiguration) GetDeniedCommands() []string {
+	return config.deniedCommands
+}
+
+// WithTCPNoDelay sets TCP_NODELAY on the sockets of the connections, disabling Nagle's algorithm so that small
+// commands are sent immediately instead of being buffered, at the cost of more packets on the network. If not
+// explicitly set, the default of the operating system is kept.
+func (config *AdvancedClientConfiguration) WithTCPNoDelay(enabled bool) *AdvancedClientConfiguration {
+	config.tcpNoDelay = enabled
+	return config
+}
+
+// GetTCPNoDelay returns whether TCP_NODELAY is set on the sockets of the connections.
+func (config *AdvancedClientConfiguration) GetTCPNoDelay() bool {
+	return config.tcpNoDelay
+}
+
+// WithTCPKeepAliveInterval enables TCP keepalive on the sockets of the connections, probing idle connections every
+// interval so that connections dropped by the network, e.g. by a firewall or a load balancer, are detected by the
+// operating system. If not explicitly set, a value of 0 will be used, meaning the default of the operating system is
+// kept.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClientConfiguration) WithTCPKeepAliveInterval(interval time.Duration) *AdvancedClientConfiguration {
+	config.tcpKeepAliveInterval = interval
+	return config
+}
+
+// GetTCPKeepAliveInterval returns the interval of the TCP keepalive probes, or 0 if it is not set.
+func (config *AdvancedClientConfiguration) GetTCPKeepAliveInterval() time.Duration {
+	return config.tcpKeepAliveInterval
+}
+
+// WithCompression enables the transparent compression of large values, see [Compression]. If not explicitly set,
+// values are written as is.
+func (config *AdvancedClientConfiguration) WithCompression(compression *Compression) *AdvancedClientConfiguration {
+	config.compression = compression
+	return config
+}
+
+// GetCompression returns the configured [Compression], or nil if values are not compressed.
+func (config *AdvancedClientConfiguration) GetCompression() *Compression {
+	return config.compression
+}
+
+// WithTransformers sets the [Transformer] chain applied to values. Written values are passed through the transformers
+// in order, after being compressed if compression is enabled, and read values are passed through them in reverse
+// order. If not explicitly set, values are written as is.
+func (config *AdvancedClientConfiguration) WithTransformers(transformers ...Transformer) *AdvancedClientConfiguration {
+	config.transformers = transformers
+	return config
+}
+
+// GetTransformers returns the [Transformer] chain applied to values, including the configured [Compression] first.
+func (config *AdvancedClientConfiguration) GetTransformers() []Transformer {
+	var transformers []Transformer
+	if config.compression != nil {
+		transformers = append(transformers, config.compression)
+	}
+	return append(transformers, config.transformers...)
+}
+
 // Represents advanced configuration settings for a Cluster client used in
 // [ClusterClientConfiguration].
 type AdvancedClusterClientConfiguration struct {
-	connectionTimeout time.Duration
+	connectionTimeout    time.Duration
+	maxPendingCommands   int
+	resolver             Resolver
+	dnsRefreshInterval   time.Duration
+	heartbeatInterval    time.Duration
+	heartbeatThreshold   int
+	metricsHook          MetricsHook
+	auditHook            AuditHook
+	auditRedaction       *Redaction
+	adaptiveTimeout      adaptiveTimeout
+	circuitBreaker       *CircuitBreaker
+	hedgeDelay           time.Duration
+	strictValidation     bool
+	compression          *Compression
+	transformers         []Transformer
+	readCoalescing       bool
+	maxRequestSize       int
+	tcpNoDelay           bool
+	tcpKeepAliveInterval time.Duration
+	ipPreference         IPPreference
+	allowedCommands      []string
+	deniedCommands       []string
+	quotaHook            QuotaHook
+	mapKeyPolicy         MapKeyPolicy
+	profilerLabels       bool
+	slowCommandThreshold time.Duration
+	slowCommandCapacity  int
+	maxReplicaLag        int64
+	replicaLagInterval   time.Duration
+	readYourWritesWindow time.Duration
+	nodeStatsInterval    time.Duration
 }
 
 // NewAdvancedClusterClientConfiguration returns a new [AdvancedClusterClientConfiguration] with default settings.
@@ -532,3 +1111,444 @@ func (config *AdvancedClusterClientConfiguration) WithConnectionTimeout(
 	config.connectionTimeout = connectionTimeout
 	return config
 }
+
+// WithMaxPendingCommands sets the maximum number of commands that may be awaiting a response from the client at
+// the same time. Once the cap is reached, new commands fail immediately with a PendingLimitError instead of
+// being queued. If not explicitly set, or set to 0, the number of pending commands is not limited.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithMaxPendingCommands(
+	maxPendingCommands int,
+) *AdvancedClusterClientConfiguration {
+	config.maxPendingCommands = maxPendingCommands
+	return config
+}
+
+// GetMaxPendingCommands returns the configured cap on pending commands, or 0 if the number is not limited.
+func (config *AdvancedClusterClientConfiguration) GetMaxPendingCommands() int {
+	return config.maxPendingCommands
+}
+
+// WithResolver sets the [Resolver] used to translate the configured hostnames into the seed addresses the client
+// connects to, e.g. for service-mesh name resolution or cluster configuration endpoints. Every resolved address of a
+// hostname is used as a seed.
+//
+// A resolver cannot be combined with TLS, since server certificates are verified against the configured hostnames.
+func (config *AdvancedClusterClientConfiguration) WithResolver(resolver Resolver) *AdvancedClusterClientConfiguration {
+	config.resolver = resolver
+	return config
+}
+
+// GetResolver returns the configured [Resolver], or nil if hostnames are resolved by the client core.
+func (config *AdvancedClusterClientConfiguration) GetResolver() Resolver {
+	return config.resolver
+}
+
+// WithDNSRefreshInterval sets how often the configured hostnames, such as an ElastiCache configuration endpoint, are
+// re-resolved after the client is created. When their addresses change, the client connects again: with the new seed
+// addresses if hostnames are resolved by the client, or with the configured hostnames otherwise, so that the client
+// core resolves them anew. Commands in flight complete on the previous connection. The currently resolved seed
+// addresses are reported by the client statistics. If no [Resolver] is set, the default system resolver is used. If
+// not explicitly set, or set to 0, hostnames are not re-resolved.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithDNSRefreshInterval(
+	interval time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.dnsRefreshInterval = interval
+	return config
+}
+
+// GetDNSRefreshInterval returns the interval at which hostnames are re-resolved, or 0 if they are not.
+func (config *AdvancedClusterClientConfiguration) GetDNSRefreshInterval() time.Duration {
+	return config.dnsRefreshInterval
+}
+
+// WithIPPreference sets the address family preferred for hostnames which resolve to both IPv4 and IPv6 addresses.
+// The hostnames are resolved by the client, with the configured [Resolver] or the default system resolver, and their
+// addresses are used as seeds, the ones of the preferred family first, so that the client falls back to the other
+// family if none of them can be reached. IP literals are used as is. If not explicitly set, [IPPreferenceNone] will
+// be used, meaning hostnames are resolved by the client core.
+//
+// An IP preference cannot be combined with TLS, since server certificates are verified against the configured
+// hostnames.
+func (config *AdvancedClusterClientConfiguration) WithIPPreference(
+	preference IPPreference,
+) *AdvancedClusterClientConfiguration {
+	config.ipPreference = preference
+	return config
+}
+
+// GetIPPreference returns the configured [IPPreference].
+func (config *AdvancedClusterClientConfiguration) GetIPPreference() IPPreference {
+	return config.ipPreference
+}
+
+// WithHeartbeat enables health checks, sending a PING to every node each interval to detect half-open TCP connections early.
+// After failureThreshold consecutive failed heartbeats the client is reported as unhealthy by its statistics and
+// reconnects, replacing its connections with new ones, which it does again after every further failed heartbeat until
+// a heartbeat succeeds. If not explicitly set, or set to an interval of 0, no heartbeats are sent.
+//
+// Using a negative interval, or a failure threshold lower than 1 with a positive interval, will lead to an invalid
+// configuration.
+func (config *AdvancedClusterClientConfiguration) WithHeartbeat(
+	interval time.Duration,
+	failureThreshold int,
+) *AdvancedClusterClientConfiguration {
+	config.heartbeatInterval = interval
+	config.heartbeatThreshold = failureThreshold
+	return config
+}
+
+// GetHeartbeat returns the heartbeat interval and failure threshold. The interval is 0 if heartbeats are disabled.
+func (config *AdvancedClusterClientConfiguration) GetHeartbeat() (time.Duration, int) {
+	return config.heartbeatInterval, config.heartbeatThreshold
+}
+
+// WithMetricsHook sets a [MetricsHook] called after every command and batch, e.g. to export the bytes sent and
+// received per command family. The same totals are accumulated in the client statistics regardless of the hook.
+func (config *AdvancedClusterClientConfiguration) WithMetricsHook(hook MetricsHook) *AdvancedClusterClientConfiguration {
+	config.metricsHook = hook
+	return config
+}
+
+// GetMetricsHook returns the configured [MetricsHook], or nil if none is set.
+func (config *AdvancedClusterClientConfiguration) GetMetricsHook() MetricsHook {
+	return config.metricsHook
+}
+
+// WithQuotaHook sets a [QuotaHook] called before every request is sent, e.g. to enforce per-caller quotas on the
+// number of commands or the bytes sent. Requests rejected by the hook fail with a QuotaExceededError.
+func (config *AdvancedClusterClientConfiguration) WithQuotaHook(hook QuotaHook) *AdvancedClusterClientConfiguration {
+	config.quotaHook = hook
+	return config
+}
+
+// GetQuotaHook returns the configured [QuotaHook], or nil if none is set.
+func (config *AdvancedClusterClientConfiguration) GetQuotaHook() QuotaHook {
+	return config.quotaHook
+}
+
+// WithMapKeyPolicy sets how map responses holding the same key more than once are converted. If not explicitly set,
+// [LastKeyWins] will be used. Responses returned by ExecuteRaw keep all the occurrences.
+func (config *AdvancedClusterClientConfiguration) WithMapKeyPolicy(policy MapKeyPolicy) *AdvancedClusterClientConfiguration {
+	config.mapKeyPolicy = policy
+	return config
+}
+
+// GetMapKeyPolicy returns the configured [MapKeyPolicy].
+func (config *AdvancedClusterClientConfiguration) GetMapKeyPolicy() MapKeyPolicy {
+	return config.mapKeyPolicy
+}
+
+// WithProfilerLabels sets whether goroutines waiting for a command are labeled with its name, under the
+// "valkey.command" pprof label, so that CPU and goroutine profiles attribute the time spent in commands, such as
+// long-running blocking commands, to the commands. Labels cost an allocation per command, so they are disabled by
+// default.
+func (config *AdvancedClusterClientConfiguration) WithProfilerLabels(enabled bool) *AdvancedClusterClientConfiguration {
+	config.profilerLabels = enabled
+	return config
+}
+
+// GetProfilerLabels returns whether goroutines waiting for a command are labeled with its name.
+func (config *AdvancedClusterClientConfiguration) GetProfilerLabels() bool {
+	return config.profilerLabels
+}
+
+// WithSlowCommandLog records the commands taking longer than threshold, including batches and scripts, in a log of the
+// given capacity which is retrievable with the SlowCommands method of the client. Once the log is full, the oldest
+// entries are dropped. Unlike the SLOWLOG of the server, the recorded duration includes the network round trip and the
+// time spent queued in the client. The arguments are recorded with the default rules of [NewRedaction], so that values
+// are not retained. If not explicitly set, or set with a capacity of 0, no commands are recorded.
+//
+// Using a negative threshold or capacity will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithSlowCommandLog(
+	threshold time.Duration,
+	capacity int,
+) *AdvancedClusterClientConfiguration {
+	config.slowCommandThreshold = threshold
+	config.slowCommandCapacity = capacity
+	return config
+}
+
+// GetSlowCommandLog returns the threshold and capacity of the slow command log. The capacity is 0 if the log is
+// disabled.
+func (config *AdvancedClusterClientConfiguration) GetSlowCommandLog() (time.Duration, int) {
+	return config.slowCommandThreshold, config.slowCommandCapacity
+}
+
+// WithAuditHook sets an [AuditHook] called after every command with its arguments, e.g. for security auditing. The
+// arguments are redacted according to the given [Redaction], or to the default rules of [NewRedaction] if it is nil.
+func (config *AdvancedClusterClientConfiguration) WithAuditHook(
+	hook AuditHook,
+	redaction *Redaction,
+) *AdvancedClusterClientConfiguration {
+	config.auditHook = hook
+	config.auditRedaction = redaction
+	return config
+}
+
+// GetAuditHook returns the configured [AuditHook] and its [Redaction], or nil if no audit hook is set.
+func (config *AdvancedClusterClientConfiguration) GetAuditHook() (AuditHook, *Redaction) {
+	if config.auditHook != nil && config.auditRedaction == nil {
+		return config.auditHook, NewRedaction()
+	}
+	return config.auditHook, config.auditRedaction
+}
+
+// WithAdaptiveTimeout enables the adaptive timeout mode. The client tracks the latencies of recent commands per command
+// family, e.g. "String" or "SortedSet", and gives each command a deadline equal to the given percentile of the
+// latencies of its family, bounded by floor and ceiling. Until enough latencies are observed, the ceiling is used.
+// A deadline already set on the context of a command is kept if it is earlier. Blocking commands, custom commands,
+// scripts and batches are not affected. A command that exceeds its adaptive deadline fails with a TimeoutError.
+//
+// The request timeout still applies. Using a percentile outside of (0, 100], a floor that is not positive, or a
+// ceiling lower than the floor will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithAdaptiveTimeout(
+	percentile float64,
+	floor time.Duration,
+	ceiling time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.adaptiveTimeout = adaptiveTimeout{percentile: percentile, floor: floor, ceiling: ceiling}
+	return config
+}
+
+// GetAdaptiveTimeout returns the percentile, floor and ceiling of the adaptive timeout mode. The percentile is 0 if
+// the mode is disabled.
+func (config *AdvancedClusterClientConfiguration) GetAdaptiveTimeout() (float64, time.Duration, time.Duration) {
+	return config.adaptiveTimeout.percentile, config.adaptiveTimeout.floor, config.adaptiveTimeout.ceiling
+}
+
+// WithCircuitBreaker enables a [CircuitBreaker], which fails commands fast with a CircuitOpenError during outages. If
+// not explicitly set, no circuit breaker is used.
+func (config *AdvancedClusterClientConfiguration) WithCircuitBreaker(
+	breaker *CircuitBreaker,
+) *AdvancedClusterClientConfiguration {
+	config.circuitBreaker = breaker
+	return config
+}
+
+// GetCircuitBreaker returns the configured [CircuitBreaker], or nil if none is used.
+func (config *AdvancedClusterClientConfiguration) GetCircuitBreaker() *CircuitBreaker {
+	return config.circuitBreaker
+}
+
+// WithStrictValidation enables the strict validation mode, in which the arguments of common commands are checked
+// before they are sent. Empty keys, missing elements and out-of-range counts are rejected with a RequestError
+// instead of being sent to the server. Custom commands and batches are not checked. If not explicitly set, strict
+// validation is disabled.
+func (config *AdvancedClusterClientConfiguration) WithStrictValidation(enabled bool) *AdvancedClusterClientConfiguration {
+	config.strictValidation = enabled
+	return config
+}
+
+// GetStrictValidation returns whether the strict validation mode is enabled.
+func (config *AdvancedClusterClientConfiguration) GetStrictValidation() bool {
+	return config.strictValidation
+}
+
+// WithReadCoalescing enables the de-duplication of identical concurrent GET and HGETALL calls, which reduces the load
+// caused by many callers reading the same hot key at once. Only one request is sent to the server, and its result is
+// shared by all callers waiting for it. The shared request is not cancelled with the context of the caller starting
+// it, and is bounded by the request timeout instead. If not explicitly set, reads are not coalesced.
+func (config *AdvancedClusterClientConfiguration) WithReadCoalescing(enabled bool) *AdvancedClusterClientConfiguration {
+	config.readCoalescing = enabled
+	return config
+}
+
+// GetReadCoalescing returns whether identical concurrent reads are coalesced.
+func (config *AdvancedClusterClientConfiguration) GetReadCoalescing() bool {
+	return config.readCoalescing
+}
+
+// WithMaxRequestSize sets the maximum size in bytes of the arguments of a command, of a script invocation, or of all
+// the commands of a batch. Larger requests fail with a RequestTooLargeError before they are copied to the core, which
+// protects the client and the connection against accidentally huge payloads, such as an MSET of millions of keys. If
+// not explicitly set, a value of 0 will be used, meaning the size of requests is not limited.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithMaxRequestSize(maxBytes int) *AdvancedClusterClientConfiguration {
+	config.maxRequestSize = maxBytes
+	return config
+}
+
+// GetMaxRequestSize returns the maximum size in bytes of a request, or 0 if it is not limited.
+func (config *AdvancedClusterClientConfiguration) GetMaxRequestSize() int {
+	return config.maxRequestSize
+}
+
+// WithAllowedCommands restricts the client to the given commands, e.g. for handing out restricted clients to
+// application code. Any other command fails with a ForbiddenCommandError before it is sent. Names are case-insensitive,
+// and a command with subcommands, such as CONFIG, allows all of its subcommands, while a subcommand, such as
+// "CONFIG GET", allows only itself. Scripts are allowed by EVALSHA, cluster scans by SCAN, and password updates by
+// AUTH. Commands sent by the client itself, such as heartbeats, are restricted as well. If not explicitly set, all
+// commands which are not denied are allowed.
+//
+// Using an empty command name will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithAllowedCommands(commands ...string) *AdvancedClusterClientConfiguration {
+	config.allowedCommands = commands
+	return config
+}
+
+// GetAllowedCommands returns the commands the client is restricted to, or nil if it is not restricted.
+func (config *AdvancedClusterClientConfiguration) GetAllowedCommands() []string {
+	return config.allowedCommands
+}
+
+// WithDeniedCommands prevents the client from sending the given commands, e.g. FLUSHALL, KEYS or DEBUG, which fail with
+// a ForbiddenCommandError before they are sent. Names are matched like the ones of
+// [AdvancedClusterClientConfiguration.WithAllowedCommands], and denied commands are rejected even if they are allowed.
+//
+// Using an empty command name will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithDeniedCommands(commands ...string) *AdvancedClusterClientConfiguration {
+	config.deniedCommands = commands
+	return config
+}
+
+// GetDeniedCommands returns the commands the client may not send.
+func (config *AdvancedClusterClientConfiguration) GetDeniedCommands() []string {
+	return config.deniedCommands
+}
+
+// WithTCPNoDelay sets TCP_NODELAY on the sockets of the connections, disabling Nagle's algorithm so that small
+// commands are sent immediately instead of being buffered, at the cost of more packets on the network. If not
+// explicitly set, the default of the operating system is kept.
+func (config *AdvancedClusterClientConfiguration) WithTCPNoDelay(enabled bool) *AdvancedClusterClientConfiguration {
+	config.tcpNoDelay = enabled
+	return config
+}
+
+// GetTCPNoDelay returns whether TCP_NODELAY is set on the sockets of the connections.
+func (config *AdvancedClusterClientConfiguration) GetTCPNoDelay() bool {
+	return config.tcpNoDelay
+}
+
+// WithTCPKeepAliveInterval enables TCP keepalive on the sockets of the connections, probing idle connections every
+// interval so that connections dropped by the network, e.g. by a firewall or a load balancer, are detected by the
+// operating system. If not explicitly set, a value of 0 will be used, meaning the default of the operating system is
+// kept.
+//
+// Using a negative value will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithTCPKeepAliveInterval(
+	interval time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.tcpKeepAliveInterval = interval
+	return config
+}
+
+// GetTCPKeepAliveInterval returns the interval of the TCP keepalive probes, or 0 if it is not set.
+func (config *AdvancedClusterClientConfiguration) GetTCPKeepAliveInterval() time.Duration {
+	return config.tcpKeepAliveInterval
+}
+
+// WithCompression enables the transparent compression of large values, see [Compression]. If not explicitly set,
+// values are written as is.
+func (config *AdvancedClusterClientConfiguration) WithCompression(
+	compression *Compression,
+) *AdvancedClusterClientConfiguration {
+	config.compression = compression
+	return config
+}
+
+// GetCompression returns the configured [Compression], or nil if values are not compressed.
+func (config *AdvancedClusterClientConfiguration) GetCompression() *Compression {
+	return config.compression
+}
+
+// WithTransformers sets the [Transformer] chain applied to values. Written values are passed through the transformers
+// in order, after being compressed if compression is enabled, and read values are passed through them in reverse
+// order. If not explicitly set, values are written as is.
+func (config *AdvancedClusterClientConfiguration) WithTransformers(
+	transformers ...Transformer,
+) *AdvancedClusterClientConfiguration {
+	config.transformers = transformers
+	return config
+}
+
+// GetTransformers returns the [Transformer] chain applied to values, including the configured [Compression] first.
+func (config *AdvancedClusterClientConfiguration) GetTransformers() []Transformer {
+	var transformers []Transformer
+	if config.compression != nil {
+		transformers = append(transformers, config.compression)
+	}
+	return append(transformers, config.transformers...)
+}
+
+// WithHedgedReads enables hedged reads for latency-sensitive reads. When a single-key read-only command, such as GET
+// or HGETALL, has not completed after the given delay, a duplicate of it is sent to a replica of the key's shard, and
+// the first successful response is used. The number of hedged reads and of reads won by the hedge is reported by
+// the client statistics.
+//
+// Custom commands registered as read-only with glide.RegisterReadOnlyCommand are hedged as well. Hedged reads only
+// apply to commands routed by the client, and are disabled if not explicitly set. Using a negative
+// delay will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithHedgedReads(delay time.Duration) *AdvancedClusterClientConfiguration {
+	config.hedgeDelay = delay
+	return config
+}
+
+// GetHedgeDelay returns the delay after which a read is hedged, or 0 if hedged reads are disabled.
+func (config *AdvancedClusterClientConfiguration) GetHedgeDelay() time.Duration {
+	return config.hedgeDelay
+}
+
+// WithReplicaLagThreshold enables lag-aware replica reads. Every refresh interval, the client measures the replication
+// lag of each replica, as the number of bytes of the replication stream of its primary that it has not processed yet,
+// from the offsets reported by INFO replication. Single-key reads, such as GET or HGETALL, are then sent to a random
+// replica of the key's shard whose lag is at most maxLag, or to the primary if no replica qualifies. Until the first
+// measurement completes, and after a measurement fails, reads are sent to the primaries.
+//
+// The read strategy of the client must read from replicas, see [ClusterClientConfiguration.WithReadFrom]. Lag-aware
+// reads are disabled if not explicitly set. Using a negative lag or interval will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithReplicaLagThreshold(
+	maxLag int64,
+	refreshInterval time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.maxReplicaLag = maxLag
+	config.replicaLagInterval = refreshInterval
+	return config
+}
+
+// GetReplicaLagThreshold returns the maximum replication lag, in bytes, of the replicas reads are sent to, and the
+// interval at which the lag is measured, which is 0 if lag-aware replica reads are disabled.
+func (config *AdvancedClusterClientConfiguration) GetReplicaLagThreshold() (int64, time.Duration) {
+	return config.maxReplicaLag, config.replicaLagInterval
+}
+
+// WithReadYourWrites sends the single-key reads of a key, such as GET or HGETALL, to the primary of the key's shard for
+// the given window after the client sent a write of the key, such as SET or HSET, so that the client reads its own
+// writes even if it reads from replicas. Other reads follow the read strategy of the client. Only the writes of the
+// commands whose keys are known to the client are tracked, and the writes of batches and scripts are not.
+//
+// Read-your-writes is disabled if not explicitly set. Using a negative window will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithReadYourWrites(
+	window time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.readYourWritesWindow = window
+	return config
+}
+
+// GetReadYourWritesWindow returns the window after a write during which the reads of the written key are sent to its
+// primary, or 0 if read-your-writes is disabled.
+func (config *AdvancedClusterClientConfiguration) GetReadYourWritesWindow() time.Duration {
+	return config.readYourWritesWindow
+}
+
+// WithNodeStats enables the statistics of the nodes of the cluster, returned by ClusterClient.NodeStats. Every probe
+// interval, the client reads the topology of the cluster, and sends CLIENT ID to every node to measure its round-trip
+// time, and to detect that its connection to the node was re-established. In between, the commands sent to a known
+// node are counted, along with their failures: the commands routed by address or to a primary, and the writes routed
+// by their keys.
+//
+// Node statistics are disabled if not explicitly set. Using a negative interval will lead to an invalid configuration.
+func (config *AdvancedClusterClientConfiguration) WithNodeStats(
+	probeInterval time.Duration,
+) *AdvancedClusterClientConfiguration {
+	config.nodeStatsInterval = probeInterval
+	return config
+}
+
+// GetNodeStatsInterval returns the interval at which the nodes are probed, or 0 if node statistics are disabled.
+func (config *AdvancedClusterClientConfiguration) GetNodeStatsInterval() time.Duration {
+	return config.nodeStatsInterval
+}
@@ -3,7 +3,12 @@
 package config
 
 import (
+	"compress/gzip"
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -296,3 +301,554 @@ func TestConfig_InvalidRequestAndConnectionTimeouts(t *testing.T) {
 	_, err8 := config8.ToProtobuf()
 	assert.EqualError(t, err8, "setting connection timeout returned an error: invalid duration was specified")
 }
+
+func TestConfig_MaxPendingCommands(t *testing.T) {
+	config := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithMaxPendingCommands(100))
+	_, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, 100, config.GetMaxPendingCommands())
+
+	clusterConfig := NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithMaxPendingCommands(100))
+	_, err = clusterConfig.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, 100, clusterConfig.GetMaxPendingCommands())
+
+	assert.Equal(t, 0, NewClientConfiguration().GetMaxPendingCommands())
+
+	invalidConfig := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithMaxPendingCommands(-1))
+	_, err = invalidConfig.ToProtobuf()
+	assert.EqualError(t, err, "max pending commands cannot be negative")
+
+	invalidClusterConfig := NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithMaxPendingCommands(-1))
+	_, err = invalidClusterConfig.ToProtobuf()
+	assert.EqualError(t, err, "max pending commands cannot be negative")
+}
+
+func TestConfig_BuildReportsAllInvalidFields(t *testing.T) {
+	config := NewClientConfiguration().
+		WithAddress(&NodeAddress{Host: "host1", Port: 70000}).
+		WithAddress(&NodeAddress{Host: "host2"}).
+		WithAddress(&NodeAddress{Host: "host2", Port: DefaultPort}).
+		WithReadFrom(AzAffinity).
+		WithDatabaseId(-1)
+
+	_, err := config.Build()
+	var validationErr *ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.EqualError(
+		t,
+		err,
+		"invalid addresses[0].Port: port 70000 is out of range, must be between 1 and 65535\n"+
+			"invalid addresses[2]: duplicates addresses[1] (host2:6379)\n"+
+			"invalid clientAZ: must be set when using AZ affinity read strategies\n"+
+			"invalid databaseId: cannot be negative",
+	)
+
+	_, err = NewClusterClientConfiguration().Build()
+	assert.EqualError(t, err, "invalid addresses: at least one address must be provided")
+}
+
+func TestConfig_BuildReturnsFrozenCopy(t *testing.T) {
+	breaker := NewCircuitBreaker(0.5, time.Second)
+	redaction := NewRedaction()
+	config := NewClusterClientConfiguration().
+		WithAddress(&NodeAddress{Host: "host1", Port: 1234}).
+		WithSubscriptionConfig(NewClusterSubscriptionConfig().WithSubscription(ExactClusterChannelMode, "channel")).
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().
+			WithCircuitBreaker(breaker).
+			WithAuditHook(func(AuditRecord) {}, redaction).
+			WithDeniedCommands("FLUSHALL"))
+
+	frozen, err := config.Build()
+	assert.NoError(t, err)
+
+	config.WithAddress(&NodeAddress{Host: "host2", Port: 1234}).WithClientName("changed")
+	config.GetSubscription().WithSubscription(PatternClusterChannelMode, "pattern")
+	breaker.WithMinRequests(100)
+	redaction.WithRule("GET", KeepFirst(0))
+	config.AdvancedClusterClientConfiguration.deniedCommands[0] = "KEYS"
+	assert.NotSame(t, breaker, frozen.GetCircuitBreaker())
+	assert.Equal(t, NewCircuitBreaker(0.5, time.Second), frozen.GetCircuitBreaker())
+	_, frozenRedaction := frozen.GetAuditHook()
+	assert.NotContains(t, frozenRedaction.rules, "GET")
+	assert.Equal(t, []string{"FLUSHALL"}, frozen.GetDeniedCommands())
+
+	request, err := frozen.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Len(t, request.Addresses, 1)
+	assert.Empty(t, request.ClientName)
+	assert.Equal(t, map[uint32][]string{0: {"channel"}}, frozen.GetSubscription().GetSubscriptions())
+}
+
+func TestConfig_ResolverWithTLS(t *testing.T) {
+	config := NewClientConfiguration().
+		WithUseTLS(true).
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithResolver(net.DefaultResolver))
+	_, err := config.ToProtobuf()
+	assert.EqualError(t, err, "a custom resolver cannot be used with TLS")
+
+	clusterConfig := NewClusterClientConfiguration().
+		WithAddress(&NodeAddress{Host: "endpoint"}).
+		WithUseTLS(true).
+		WithAdvancedConfiguration(
+			NewAdvancedClusterClientConfiguration().WithResolver(net.DefaultResolver).WithDNSRefreshInterval(-time.Second),
+		)
+	_, err = clusterConfig.Build()
+	assert.EqualError(
+		t,
+		err,
+		"invalid dnsRefreshInterval: cannot be negative\n"+
+			"invalid resolver: cannot be used with TLS, since certificates are verified against the configured hostnames",
+	)
+}
+
+func TestConfig_Heartbeat(t *testing.T) {
+	config := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithHeartbeat(5*time.Second, 3))
+	_, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	interval, threshold := config.GetHeartbeat()
+	assert.Equal(t, 5*time.Second, interval)
+	assert.Equal(t, 3, threshold)
+
+	clusterConfig := NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithHeartbeat(5*time.Second, 0))
+	_, err = clusterConfig.ToProtobuf()
+	assert.EqualError(t, err, "heartbeat failure threshold must be at least 1")
+}
+
+func TestConfig_DNSRefreshInterval(t *testing.T) {
+	config := NewClientConfiguration().
+		WithUseTLS(true).
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithDNSRefreshInterval(time.Minute))
+	_, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, config.GetDNSRefreshInterval())
+	assert.Zero(t, NewAdvancedClusterClientConfiguration().GetDNSRefreshInterval())
+
+	_, err = NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithDNSRefreshInterval(-time.Second)).
+		ToProtobuf()
+	assert.EqualError(t, err, "DNS refresh interval cannot be negative")
+}
+
+func TestConfig_AdaptiveTimeout(t *testing.T) {
+	config := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithAdaptiveTimeout(99, time.Millisecond, time.Second))
+	_, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	percentile, floor, ceiling := config.AdvancedClientConfiguration.GetAdaptiveTimeout()
+	assert.Equal(t, 99.0, percentile)
+	assert.Equal(t, time.Millisecond, floor)
+	assert.Equal(t, time.Second, ceiling)
+
+	advanced := NewAdvancedClusterClientConfiguration().WithAdaptiveTimeout(101, time.Millisecond, time.Second)
+	clusterConfig := NewClusterClientConfiguration().WithAdvancedConfiguration(advanced)
+	_, err = clusterConfig.ToProtobuf()
+	assert.ErrorContains(t, err, "percentile must be between 0 and 100")
+
+	advanced = NewAdvancedClusterClientConfiguration().WithAdaptiveTimeout(99, time.Second, time.Millisecond)
+	clusterConfig = NewClusterClientConfiguration().WithAddress(&NodeAddress{}).WithAdvancedConfiguration(advanced)
+	_, err = clusterConfig.Build()
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "adaptiveTimeout", validationErr.Field)
+}
+
+func TestConfig_CircuitBreaker(t *testing.T) {
+	breaker := NewCircuitBreaker(0.5, time.Second).WithMinRequests(10).WithWindow(time.Minute).WithPerNode(true)
+	config := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithCircuitBreaker(breaker))
+	_, err := config.ToProtobuf()
+	assert.NoError(t, err)
+	assert.Same(t, breaker, config.AdvancedClientConfiguration.GetCircuitBreaker())
+	assert.Equal(t, 10, breaker.GetMinRequests())
+	assert.Equal(t, time.Minute, breaker.GetWindow())
+	assert.Equal(t, 1, breaker.GetHalfOpenRequests())
+	assert.True(t, breaker.IsPerNode())
+
+	advanced := NewAdvancedClusterClientConfiguration().WithCircuitBreaker(NewCircuitBreaker(1.5, time.Second))
+	clusterConfig := NewClusterClientConfiguration().WithAdvancedConfiguration(advanced)
+	_, err = clusterConfig.ToProtobuf()
+	assert.ErrorContains(t, err, "error rate threshold must be greater than 0 and at most 1")
+
+	advanced = NewAdvancedClusterClientConfiguration().WithCircuitBreaker(NewCircuitBreaker(0.5, 0))
+	clusterConfig = NewClusterClientConfiguration().WithAddress(&NodeAddress{}).WithAdvancedConfiguration(advanced)
+	_, err = clusterConfig.Build()
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "circuitBreaker", validationErr.Field)
+}
+
+func TestConfig_HedgedReads(t *testing.T) {
+	advanced := NewAdvancedClusterClientConfiguration().WithHedgedReads(5 * time.Millisecond)
+	assert.Equal(t, 5*time.Millisecond, advanced.GetHedgeDelay())
+	_, err := NewClusterClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.NoError(t, err)
+
+	advanced = NewAdvancedClusterClientConfiguration().WithHedgedReads(-time.Millisecond)
+	_, err = NewClusterClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.ErrorContains(t, err, "hedge delay cannot be negative")
+	_, err = NewClusterClientConfiguration().WithAddress(&NodeAddress{}).WithAdvancedConfiguration(advanced).Build()
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "hedgeDelay", validationErr.Field)
+}
+
+func TestConfig_ReplicaLagThreshold(t *testing.T) {
+	advanced := NewAdvancedClusterClientConfiguration()
+	maxLag, interval := advanced.GetReplicaLagThreshold()
+	assert.Zero(t, maxLag)
+	assert.Zero(t, interval)
+
+	advanced.WithReplicaLagThreshold(1024, time.Second)
+	maxLag, interval = advanced.GetReplicaLagThreshold()
+	assert.Equal(t, int64(1024), maxLag)
+	assert.Equal(t, time.Second, interval)
+	_, err := NewClusterClientConfiguration().WithReadFrom(PreferReplica).WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.NoError(t, err)
+	_, err = NewClusterClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.ErrorContains(t, err, "a replica lag threshold requires a read strategy reading from replicas")
+	_, err = NewClusterClientConfiguration().WithAddress(&NodeAddress{}).WithAdvancedConfiguration(advanced).Build()
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "readFrom", validationErr.Field)
+
+	advanced = NewAdvancedClusterClientConfiguration().WithReplicaLagThreshold(-1, time.Second)
+	_, err = NewClusterClientConfiguration().WithReadFrom(PreferReplica).WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.ErrorContains(t, err, "max replica lag cannot be negative")
+
+	advanced = NewAdvancedClusterClientConfiguration().WithReplicaLagThreshold(0, -time.Second)
+	_, err = NewClusterClientConfiguration().WithReadFrom(PreferReplica).WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.ErrorContains(t, err, "replica lag refresh interval cannot be negative")
+}
+
+func TestConfig_ReadYourWrites(t *testing.T) {
+	assert.Zero(t, NewAdvancedClusterClientConfiguration().GetReadYourWritesWindow())
+	advanced := NewAdvancedClusterClientConfiguration().WithReadYourWrites(time.Second)
+	assert.Equal(t, time.Second, advanced.GetReadYourWritesWindow())
+	_, err := NewClusterClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.NoError(t, err)
+
+	advanced = NewAdvancedClusterClientConfiguration().WithReadYourWrites(-time.Second)
+	_, err = NewClusterClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.ErrorContains(t, err, "read-your-writes window cannot be negative")
+	_, err = NewClusterClientConfiguration().WithAddress(&NodeAddress{}).WithAdvancedConfiguration(advanced).Build()
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "readYourWritesWindow", validationErr.Field)
+}
+
+func TestConfig_NodeStats(t *testing.T) {
+	assert.Zero(t, NewAdvancedClusterClientConfiguration().GetNodeStatsInterval())
+	advanced := NewAdvancedClusterClientConfiguration().WithNodeStats(time.Second)
+	assert.Equal(t, time.Second, advanced.GetNodeStatsInterval())
+	_, err := NewClusterClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.NoError(t, err)
+
+	advanced = NewAdvancedClusterClientConfiguration().WithNodeStats(-time.Second)
+	_, err = NewClusterClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.ErrorContains(t, err, "node statistics probe interval cannot be negative")
+	_, err = NewClusterClientConfiguration().WithAddress(&NodeAddress{}).WithAdvancedConfiguration(advanced).Build()
+	var validationErr *ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "nodeStatsInterval", validationErr.Field)
+}
+
+func TestConfig_StrictValidation(t *testing.T) {
+	assert.False(t, NewAdvancedClientConfiguration().GetStrictValidation())
+	assert.True(t, NewAdvancedClientConfiguration().WithStrictValidation(true).GetStrictValidation())
+	assert.True(t, NewAdvancedClusterClientConfiguration().WithStrictValidation(true).GetStrictValidation())
+}
+
+func TestConfig_ReadCoalescing(t *testing.T) {
+	assert.False(t, NewAdvancedClientConfiguration().GetReadCoalescing())
+	assert.True(t, NewAdvancedClientConfiguration().WithReadCoalescing(true).GetReadCoalescing())
+	assert.True(t, NewAdvancedClusterClientConfiguration().WithReadCoalescing(true).GetReadCoalescing())
+}
+
+func TestConfig_MaxRequestSize(t *testing.T) {
+	assert.Equal(t, 0, NewAdvancedClientConfiguration().GetMaxRequestSize())
+	assert.Equal(t, 1024, NewAdvancedClientConfiguration().WithMaxRequestSize(1024).GetMaxRequestSize())
+	assert.Equal(t, 1024, NewAdvancedClusterClientConfiguration().WithMaxRequestSize(1024).GetMaxRequestSize())
+
+	_, err := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithMaxRequestSize(-1)).
+		ToProtobuf()
+	assert.EqualError(t, err, "max request size cannot be negative")
+	_, err = NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithMaxRequestSize(-1)).
+		Build()
+	assert.ErrorContains(t, err, "maxRequestSize")
+}
+
+func TestConfig_TCPSettings(t *testing.T) {
+	request, err := NewClientConfiguration().ToProtobuf()
+	assert.NoError(t, err)
+	assert.False(t, request.TcpNodelay)
+	assert.Equal(t, uint32(0), request.TcpKeepaliveInterval)
+
+	request, err = NewClientConfiguration().
+		WithAdvancedConfiguration(
+			NewAdvancedClientConfiguration().WithTCPNoDelay(true).WithTCPKeepAliveInterval(30 * time.Second),
+		).
+		ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.TcpNodelay)
+	assert.Equal(t, uint32(30000), request.TcpKeepaliveInterval)
+
+	clusterConfig := NewAdvancedClusterClientConfiguration().WithTCPNoDelay(true).WithTCPKeepAliveInterval(time.Minute)
+	assert.True(t, clusterConfig.GetTCPNoDelay())
+	assert.Equal(t, time.Minute, clusterConfig.GetTCPKeepAliveInterval())
+	request, err = NewClusterClientConfiguration().WithAdvancedConfiguration(clusterConfig).ToProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.TcpNodelay)
+	assert.Equal(t, uint32(60000), request.TcpKeepaliveInterval)
+
+	_, err = NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithTCPKeepAliveInterval(-time.Second)).
+		ToProtobuf()
+	assert.EqualError(t, err, "TCP keepalive interval cannot be negative")
+	_, err = NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithTCPKeepAliveInterval(-time.Second)).
+		Build()
+	assert.ErrorContains(t, err, "tcpKeepAliveInterval")
+}
+
+func TestConfig_IPPreference(t *testing.T) {
+	assert.Equal(t, IPPreferenceNone, NewAdvancedClientConfiguration().GetIPPreference())
+	assert.Equal(t, PreferIPv6, NewAdvancedClientConfiguration().WithIPPreference(PreferIPv6).GetIPPreference())
+	assert.Equal(t, IPv4Only, NewAdvancedClusterClientConfiguration().WithIPPreference(IPv4Only).GetIPPreference())
+
+	_, err := NewClientConfiguration().
+		WithUseTLS(true).
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithIPPreference(PreferIPv4)).
+		ToProtobuf()
+	assert.EqualError(t, err, "an IP preference cannot be used with TLS")
+	_, err = NewClusterClientConfiguration().
+		WithUseTLS(true).
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithIPPreference(PreferIPv4)).
+		Build()
+	assert.ErrorContains(t, err, "ipPreference")
+}
+
+func TestRedaction(t *testing.T) {
+	redaction := NewRedaction()
+	assert.Equal(t, []string{"key", DefaultRedactionMask, DefaultRedactionMask},
+		redaction.Redact("SET", []string{"key", "secret", "EX"}))
+	assert.Equal(t, []string{"k1", DefaultRedactionMask, "k2", DefaultRedactionMask},
+		redaction.Redact("mset", []string{"k1", "v1", "k2", "v2"}))
+	assert.Equal(t, []string{"k1", "k2"}, redaction.Redact("DEL", []string{"k1", "k2"}))
+	assert.Equal(t, []string{DefaultRedactionMask, DefaultRedactionMask},
+		redaction.Redact("AUTH", []string{"user", "password"}))
+
+	redaction.WithMask("*").WithRule("hset", KeepEvery(2)).WithDefaultRule(RedactAll())
+	assert.Equal(t, []string{"key", "*", "value"}, redaction.Redact("HSET", []string{"key", "field", "value"}))
+	assert.Equal(t, []string{"*"}, redaction.Redact("GET", []string{"key"}))
+
+	hook := func(record AuditRecord) {}
+	_, defaultRedaction := NewAdvancedClientConfiguration().WithAuditHook(hook, nil).GetAuditHook()
+	assert.NotNil(t, defaultRedaction)
+	_, noRedaction := NewAdvancedClusterClientConfiguration().GetAuditHook()
+	assert.Nil(t, noRedaction)
+}
+
+func TestReconfiguration(t *testing.T) {
+	reconfig := NewReconfiguration()
+	_, _, ok := reconfig.GetCredentials()
+	assert.False(t, ok)
+	_, ok = reconfig.GetRequestTimeout()
+	assert.False(t, ok)
+	assert.NoError(t, reconfig.Validate())
+
+	reconfig.WithCredentials(NewServerCredentials("user", "secret")).
+		WithRequestTimeout(time.Second).
+		WithReadFrom(PreferReplica)
+	username, password, ok := reconfig.GetCredentials()
+	assert.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "secret", password)
+	timeout, ok := reconfig.GetRequestTimeout()
+	assert.True(t, ok)
+	assert.Equal(t, time.Second, timeout)
+	readFrom, ok := reconfig.GetReadFrom()
+	assert.True(t, ok)
+	assert.Equal(t, PreferReplica, readFrom)
+	assert.NoError(t, reconfig.Validate())
+
+	assert.Error(t, NewReconfiguration().WithRequestTimeout(-time.Second).Validate())
+	assert.Error(t, NewReconfiguration().WithReadFrom(AzAffinity).Validate())
+}
+
+func TestCompression(t *testing.T) {
+	compression := NewCompression(NewGzipCompressor(gzip.BestSpeed), 16)
+	short, err := compression.Encode("short")
+	assert.NoError(t, err)
+	assert.Equal(t, "short", short)
+
+	long := strings.Repeat("compressible ", 100)
+	encoded, err := compression.Encode(long)
+	assert.NoError(t, err)
+	assert.Less(t, len(encoded), len(long))
+	decoded, err := compression.Decode(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, long, decoded)
+
+	// Uncompressed values are read as is, and short values looking compressed are compressed anyway.
+	decoded, err = compression.Decode("plain")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", decoded)
+	encoded, err = compression.Encode(compressionMagic + "x")
+	assert.NoError(t, err)
+	decoded, err = compression.Decode(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, compressionMagic+"x", decoded)
+
+	_, err = compression.Decode(compressionMagic + "\x09data")
+	assert.ErrorContains(t, err, "unknown compressor")
+
+	_, err = NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithCompression(NewCompression(nil, 0))).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "compressor cannot be nil")
+}
+
+func TestCompressionLimits(t *testing.T) {
+	long := strings.Repeat("compressible ", 100)
+	gzipped, err := NewCompression(NewGzipCompressor(gzip.BestSpeed), 16).Encode(long)
+	assert.NoError(t, err)
+
+	// Values compressed by gzip remain readable whatever the configured compressor.
+	decoded, err := NewCompression(prefixCompressor{}, 16).Decode(gzipped)
+	assert.NoError(t, err)
+	assert.Equal(t, long, decoded)
+
+	compression := NewCompression(NewGzipCompressor(gzip.BestSpeed), 16).WithMaxDecompressedSize(len(long) - 1)
+	assert.Equal(t, len(long)-1, compression.GetMaxDecompressedSize())
+	_, err = compression.Decode(gzipped)
+	assert.ErrorIs(t, err, ErrDecompressedSizeExceeded)
+	decoded, err = compression.WithMaxDecompressedSize(len(long)).Decode(gzipped)
+	assert.NoError(t, err)
+	assert.Equal(t, long, decoded)
+
+	_, err = NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithCompression(compression.WithMaxDecompressedSize(0))).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "maximum decompressed size must be positive")
+}
+
+// prefixCompressor stands for a third-party compressor, prefixing values instead of compressing them.
+type prefixCompressor struct{}
+
+func (compressor prefixCompressor) ID() byte {
+	return 42
+}
+
+func (compressor prefixCompressor) Compress(data []byte) ([]byte, error) {
+	return append([]byte("prefix:"), data...), nil
+}
+
+func (compressor prefixCompressor) Decompress(data []byte, maxSize int) ([]byte, error) {
+	if len(data)-len("prefix:") > maxSize {
+		return nil, ErrDecompressedSizeExceeded
+	}
+	return data[len("prefix:"):], nil
+}
+
+type prefixTransformer struct {
+	prefix string
+}
+
+func (transformer prefixTransformer) Encode(value string) (string, error) {
+	return transformer.prefix + value, nil
+}
+
+func (transformer prefixTransformer) Decode(value string) (string, error) {
+	return strings.TrimPrefix(value, transformer.prefix), nil
+}
+
+func TestTransformers(t *testing.T) {
+	compression := NewCompression(NewGzipCompressor(gzip.BestSpeed), 16)
+	transformer := prefixTransformer{prefix: "enc:"}
+	advanced := NewAdvancedClientConfiguration().WithTransformers(transformer).WithCompression(compression)
+	assert.Equal(t, []Transformer{compression, transformer}, advanced.GetTransformers())
+	assert.Empty(t, NewAdvancedClusterClientConfiguration().GetTransformers())
+
+	_, err := NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithTransformers(nil)).
+		ToProtobuf()
+	assert.ErrorContains(t, err, "transformers cannot contain nil")
+}
+
+func TestConfig_CommandLists(t *testing.T) {
+	advanced := NewAdvancedClientConfiguration().WithAllowedCommands("GET", "SET").WithDeniedCommands("FLUSHALL")
+	assert.Equal(t, []string{"GET", "SET"}, advanced.GetAllowedCommands())
+	assert.Equal(t, []string{"FLUSHALL"}, advanced.GetDeniedCommands())
+	_, err := NewClientConfiguration().WithAdvancedConfiguration(advanced).ToProtobuf()
+	assert.NoError(t, err)
+
+	_, err = NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithDeniedCommands("KEYS", "")).
+		ToProtobuf()
+	assert.EqualError(t, err, "allowed and denied command names cannot be empty")
+	_, err = NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithAllowedCommands("")).
+		Build()
+	assert.ErrorContains(t, err, "allowedCommands")
+}
+
+func TestConfig_QuotaHook(t *testing.T) {
+	assert.Nil(t, NewAdvancedClientConfiguration().GetQuotaHook())
+	hook := func(ctx context.Context, request QuotaRequest) error { return nil }
+	assert.NotNil(t, NewAdvancedClientConfiguration().WithQuotaHook(hook).GetQuotaHook())
+	assert.NotNil(t, NewAdvancedClusterClientConfiguration().WithQuotaHook(hook).GetQuotaHook())
+}
+
+func TestConfig_MapKeyPolicy(t *testing.T) {
+	assert.Equal(t, LastKeyWins, NewAdvancedClientConfiguration().GetMapKeyPolicy())
+	assert.Equal(t, FirstKeyWins, NewAdvancedClientConfiguration().WithMapKeyPolicy(FirstKeyWins).GetMapKeyPolicy())
+	assert.Equal(
+		t,
+		DuplicateKeyError,
+		NewAdvancedClusterClientConfiguration().WithMapKeyPolicy(DuplicateKeyError).GetMapKeyPolicy(),
+	)
+
+	_, err := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithMapKeyPolicy(MapKeyPolicy(7))).
+		ToProtobuf()
+	assert.EqualError(t, err, "invalid map key policy")
+	_, err = NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithMapKeyPolicy(MapKeyPolicy(-1))).
+		Build()
+	assert.ErrorContains(t, err, "mapKeyPolicy")
+}
+
+func TestConfig_ProfilerLabels(t *testing.T) {
+	assert.False(t, NewAdvancedClientConfiguration().GetProfilerLabels())
+	assert.True(t, NewAdvancedClientConfiguration().WithProfilerLabels(true).GetProfilerLabels())
+	assert.True(t, NewAdvancedClusterClientConfiguration().WithProfilerLabels(true).GetProfilerLabels())
+}
+
+func TestConfig_SlowCommandLog(t *testing.T) {
+	threshold, capacity := NewAdvancedClientConfiguration().GetSlowCommandLog()
+	assert.Equal(t, time.Duration(0), threshold)
+	assert.Equal(t, 0, capacity)
+	threshold, capacity = NewAdvancedClusterClientConfiguration().
+		WithSlowCommandLog(10*time.Millisecond, 128).
+		GetSlowCommandLog()
+	assert.Equal(t, 10*time.Millisecond, threshold)
+	assert.Equal(t, 128, capacity)
+
+	_, err := NewClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClientConfiguration().WithSlowCommandLog(-time.Millisecond, 128)).
+		ToProtobuf()
+	assert.EqualError(t, err, "slow command threshold cannot be negative")
+	_, err = NewClusterClientConfiguration().
+		WithAdvancedConfiguration(NewAdvancedClusterClientConfiguration().WithSlowCommandLog(time.Millisecond, -1)).
+		ToProtobuf()
+	assert.EqualError(t, err, "slow command log capacity cannot be negative")
+}
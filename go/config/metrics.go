@@ -0,0 +1,25 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+// CommandMetrics describes a completed command or batch, as reported to a [MetricsHook].
+type CommandMetrics struct {
+	// Family is the command family, e.g. "String" or "SortedSet". Custom commands are reported as "Custom" and
+	// batches as "Batch".
+	Family string
+	// BytesSent is the total length of the command arguments.
+	BytesSent int64
+	// BytesReceived is the approximate size of the response payload. Strings count their length, and numbers count
+	// 8 bytes each. It is 0 if the command failed.
+	BytesReceived int64
+	// Duration is the time from sending the command until its response was received.
+	Duration time.Duration
+	// Err is the error returned by the command, or nil if it succeeded.
+	Err error
+}
+
+// MetricsHook is called by the client after every command and batch completes. It is called synchronously on the
+// goroutine that executed the command, so it must be safe for concurrent use and should return quickly.
+type MetricsHook func(metrics CommandMetrics)
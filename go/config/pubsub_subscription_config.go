@@ -14,6 +14,7 @@ type BaseSubscriptionConfig struct {
 	callback      MessageCallback
 	context       any
 	subscriptions map[uint32][]string
+	replaySize    int
 }
 
 func NewBaseSubscriptionConfig() *BaseSubscriptionConfig {
@@ -49,6 +50,21 @@ func (config *BaseSubscriptionConfig) GetContext() any {
 	return config.context
 }
 
+// GetReplayBufferSize returns the number of messages kept per channel for replay, or 0 if messages are not kept.
+func (config *BaseSubscriptionConfig) GetReplayBufferSize() int {
+	return config.replaySize
+}
+
+// GetSubscriptions returns a copy of the configured channels and patterns, keyed by the numeric value of the
+// subscription mode ([PubSubChannelMode] or [PubSubClusterChannelMode]).
+func (config *BaseSubscriptionConfig) GetSubscriptions() map[uint32][]string {
+	subscriptions := make(map[uint32][]string, len(config.subscriptions))
+	for mode, channels := range config.subscriptions {
+		subscriptions[mode] = append([]string(nil), channels...)
+	}
+	return subscriptions
+}
+
 // *** StandaloneSubscriptionConfig ***
 
 type PubSubChannelMode int
@@ -78,6 +94,16 @@ func (config *StandaloneSubscriptionConfig) WithCallback(callback MessageCallbac
 	return config
 }
 
+// WithReplayBuffer keeps the last size messages received on each channel in the memory of the client, so that a
+// consumer that lagged behind, or missed messages while its callback was being replaced, can fetch them again with
+// ReplayMessages. The buffer is not durable: it only holds the messages received by this client, so the messages
+// published while the client was disconnected are not in it, and it is lost when the client is closed. A size of 0,
+// the default, disables the buffer.
+func (config *StandaloneSubscriptionConfig) WithReplayBuffer(size int) *StandaloneSubscriptionConfig {
+	config.replaySize = max(size, 0)
+	return config
+}
+
 func (config *StandaloneSubscriptionConfig) WithSubscription(
 	mode PubSubChannelMode,
 	channelOrPattern string,
@@ -124,6 +150,16 @@ func (config *ClusterSubscriptionConfig) WithCallback(callback MessageCallback,
 	return config
 }
 
+// WithReplayBuffer keeps the last size messages received on each channel in the memory of the client, so that a
+// consumer that lagged behind, or missed messages while its callback was being replaced, can fetch them again with
+// ReplayMessages. The buffer is not durable: it only holds the messages received by this client, so the messages
+// published while the client was disconnected are not in it, and it is lost when the client is closed. A size of 0,
+// the default, disables the buffer.
+func (config *ClusterSubscriptionConfig) WithReplayBuffer(size int) *ClusterSubscriptionConfig {
+	config.replaySize = max(size, 0)
+	return config
+}
+
 func (config *ClusterSubscriptionConfig) WithSubscription(
 	mode PubSubClusterChannelMode,
 	channelOrPattern string,
@@ -0,0 +1,26 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import "context"
+
+// QuotaRequest describes a request about to be sent, as reported to a [QuotaHook].
+type QuotaRequest struct {
+	// Command is the name of the command in upper case, e.g. "SET" or "CONFIG GET". Scripts are reported as "EVALSHA",
+	// cluster scans as "SCAN", and batches as "BATCH".
+	Command string
+	// Family is the command family, e.g. "String" or "SortedSet", as reported to a [MetricsHook]. Custom commands are
+	// reported as "Custom" and batches as "Batch".
+	Family string
+	// Commands is the number of commands of the request: the number of commands of a batch, or 1.
+	Commands int
+	// PayloadSize is the estimated size in bytes of the request: the total length of its arguments.
+	PayloadSize int64
+}
+
+// QuotaHook is called by the client before every command, script invocation, cluster scan and batch is sent, with the
+// context passed to the call, which can carry the identity of the caller. If it returns an error, e.g. because the
+// caller exceeded its commands per second or its bandwidth, the request fails with a QuotaExceededError wrapping the
+// error, without reaching the server. It is called synchronously on the goroutine that executes the request, so it
+// must be safe for concurrent use and should return quickly.
+type QuotaHook func(ctx context.Context, request QuotaRequest) error
@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+// Reconfiguration holds the configuration fields updated at runtime by the Reconfigure method of a client, without
+// recreating it. Only the fields that are set are updated.
+type Reconfiguration struct {
+	credentials    *ServerCredentials
+	requestTimeout *time.Duration
+	readFrom       *ReadFrom
+}
+
+// NewReconfiguration returns a new [Reconfiguration] updating no fields.
+func NewReconfiguration() *Reconfiguration {
+	return &Reconfiguration{}
+}
+
+// WithCredentials updates the password used to authenticate the connections of the client, which are authenticated
+// again immediately. The username cannot be changed at runtime, so it must match the configured username.
+func (reconfig *Reconfiguration) WithCredentials(credentials *ServerCredentials) *Reconfiguration {
+	reconfig.credentials = credentials
+	return reconfig
+}
+
+// WithRequestTimeout updates the duration the client waits for a request to complete. The core keeps enforcing the
+// request timeout the client was created with, so the updated timeout can only be shorter. A value of 0 restores the
+// request timeout the client was created with. As with adaptive timeouts, blocking commands, custom commands and
+// scripts are not affected.
+//
+// Using a negative value will lead to an invalid reconfiguration.
+func (reconfig *Reconfiguration) WithRequestTimeout(requestTimeout time.Duration) *Reconfiguration {
+	reconfig.requestTimeout = &requestTimeout
+	return reconfig
+}
+
+// WithReadFrom updates the [ReadFrom] strategy of a cluster client. Since the core cannot change its strategy at
+// runtime, only [Primary] and [PreferReplica] are supported, and they are applied by routing the single-key read
+// commands to the primary or to a replica of the key's shard. [PreferReplica] can only be applied to a client created
+// with a read strategy reading from replicas.
+func (reconfig *Reconfiguration) WithReadFrom(readFrom ReadFrom) *Reconfiguration {
+	reconfig.readFrom = &readFrom
+	return reconfig
+}
+
+// GetCredentials returns the updated username and password, and whether they are set.
+func (reconfig *Reconfiguration) GetCredentials() (string, string, bool) {
+	if reconfig.credentials == nil {
+		return "", "", false
+	}
+	return reconfig.credentials.username, reconfig.credentials.password, true
+}
+
+// GetRequestTimeout returns the updated request timeout, and whether it is set.
+func (reconfig *Reconfiguration) GetRequestTimeout() (time.Duration, bool) {
+	if reconfig.requestTimeout == nil {
+		return 0, false
+	}
+	return *reconfig.requestTimeout, true
+}
+
+// GetReadFrom returns the updated [ReadFrom] strategy, and whether it is set.
+func (reconfig *Reconfiguration) GetReadFrom() (ReadFrom, bool) {
+	if reconfig.readFrom == nil {
+		return Primary, false
+	}
+	return *reconfig.readFrom, true
+}
+
+// Validate checks the updated fields, independently of the client they are applied to.
+func (reconfig *Reconfiguration) Validate() error {
+	if reconfig.requestTimeout != nil && *reconfig.requestTimeout < 0 {
+		return errors.New("request timeout cannot be negative")
+	}
+	if reconfig.readFrom != nil && *reconfig.readFrom != Primary && *reconfig.readFrom != PreferReplica {
+		return errors.New("only the Primary and PreferReplica read strategies can be applied at runtime")
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package config
+
+// Transformer transforms the values written by SET, MSET, MSETNX, HSET and HSETNX, and restores the values read by the
+// GET and HGET families of commands, e.g. for client-side encryption with keys managed by a KMS. Unlike serialization,
+// which turns application objects into strings, a transformer maps strings to strings below it, so it applies to every
+// call without wrapping them.
+//
+// Values written before a transformer was configured are read through it as well, so Decode should return values it
+// did not encode as is, e.g. by recognizing a prefix added by Encode.
+type Transformer interface {
+	// Encode transforms a value before it is written.
+	Encode(value string) (string, error)
+	// Decode restores a value read from the server.
+	Decode(value string) (string, error)
+}
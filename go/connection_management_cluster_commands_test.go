@@ -5,6 +5,7 @@ package glide
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -138,3 +139,40 @@ func ExampleClusterClient_ClientGetNameWithOptions() {
 
 	// Output: true
 }
+
+func ExampleClusterClient_ClientPause() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.ClientPause(context.Background(), 10*time.Millisecond, options.PauseWrite)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	result1, err := client.ClientUnpause(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	fmt.Println(result1)
+
+	// Output:
+	// OK
+	// OK
+}
+
+func ExampleClusterClient_ClientPauseWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.AllPrimaries}
+	result, err := client.ClientPauseWithOptions(context.Background(), 10*time.Millisecond, options.PauseAll, opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	result1, err := client.ClientUnpauseWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	fmt.Println(result1)
+
+	// Output:
+	// OK
+	// OK
+}
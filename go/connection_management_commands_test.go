@@ -5,6 +5,7 @@ package glide
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -80,3 +81,21 @@ func ExampleClient_ClientGetName() {
 
 	// Output: true
 }
+
+func ExampleClient_ClientPause() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.ClientPause(context.Background(), 10*time.Millisecond, options.PauseWrite)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	result1, err := client.ClientUnpause(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	fmt.Println(result1)
+
+	// Output:
+	// OK
+	// OK
+}
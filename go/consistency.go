@@ -0,0 +1,120 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// WaitForReplication blocks until all the previous write commands of the client are acknowledged by at least
+// minReplicas replicas, or until the timeout is reached, by sending WAIT. Unlike [baseClient.Wait], it fails if too few
+// replicas acknowledged the writes, so that a write can be made durable before it is read from a replica. In cluster
+// mode, WAIT is sent to every primary, and the lowest number of acknowledging replicas is compared to minReplicas.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	minReplicas - The number of replicas that must acknowledge the writes.
+//	timeout - The timeout value. A value of `0` will block indefinitely, or until the deadline of ctx.
+//
+// Return value:
+//
+//	A [ReplicationError] if fewer than minReplicas replicas acknowledged the writes before the timeout.
+//
+// [valkey.io]: https://valkey.io/commands/wait/
+func (client *baseClient) WaitForReplication(ctx context.Context, minReplicas int64, timeout time.Duration) error {
+	acknowledged, err := client.Wait(ctx, minReplicas, timeout)
+	if err != nil {
+		return err
+	}
+	if acknowledged < minReplicas {
+		return NewReplicationError(minReplicas, acknowledged)
+	}
+	return nil
+}
+
+// ReplicationError is a client error that occurs when fewer replicas than requested acknowledged the writes of the
+// client before the timeout, see WaitForReplication.
+type ReplicationError struct {
+	msg          string
+	requested    int64
+	acknowledged int64
+}
+
+func NewReplicationError(requested int64, acknowledged int64) *ReplicationError {
+	return &ReplicationError{
+		msg:          fmt.Sprintf("writes were acknowledged by %d replicas, expected at least %d", acknowledged, requested),
+		requested:    requested,
+		acknowledged: acknowledged,
+	}
+}
+
+func (e *ReplicationError) Error() string { return e.msg }
+
+// Requested returns the number of replicas that had to acknowledge the writes.
+func (e *ReplicationError) Requested() int64 { return e.requested }
+
+// Acknowledged returns the number of replicas that acknowledged the writes.
+func (e *ReplicationError) Acknowledged() int64 { return e.acknowledged }
+
+// readYourWrites remembers the keys written recently, so that reads of those keys are sent to their primary, see
+// [config.AdvancedClusterClientConfiguration.WithReadYourWrites]. It is shared by pointer between the copies of a
+// client.
+type readYourWrites struct {
+	window time.Duration
+
+	mu sync.Mutex
+	// written holds the time each key was last written.
+	written map[string]time.Time
+	// pruned is the time the expired keys were last removed from written.
+	pruned time.Time
+}
+
+func newReadYourWrites(window time.Duration) *readYourWrites {
+	return &readYourWrites{window: window, written: make(map[string]time.Time)}
+}
+
+// recordWrite remembers the keys of a command that may modify them. Only the commands whose keys are known to the
+// client, such as SET, HSET or DEL, are tracked.
+func (ryw *readYourWrites) recordWrite(requestType C.RequestType, args []string) {
+	keys := writeKeys(requestType, args)
+	if len(keys) == 0 {
+		return
+	}
+	now := time.Now()
+	ryw.mu.Lock()
+	defer ryw.mu.Unlock()
+	for _, key := range keys {
+		ryw.written[key] = now
+	}
+	if now.Sub(ryw.pruned) >= ryw.window {
+		for key, written := range ryw.written {
+			if now.Sub(written) >= ryw.window {
+				delete(ryw.written, key)
+			}
+		}
+		ryw.pruned = now
+	}
+}
+
+// readRoute returns the route to the primary of the key's shard if the key was written within the window, or nil
+// otherwise.
+func (ryw *readYourWrites) readRoute(key string) config.Route {
+	ryw.mu.Lock()
+	written, ok := ryw.written[key]
+	ryw.mu.Unlock()
+	if !ok || time.Since(written) >= ryw.window {
+		return nil
+	}
+	return config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+}
@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestReadYourWrites(t *testing.T) {
+	ryw := newReadYourWrites(time.Hour)
+	ryw.recordWrite(requestTypesByName()["GET"], []string{"read"})
+	ryw.recordWrite(requestTypesByName()["SET"], []string{"written", "value"})
+	ryw.recordWrite(requestTypesByName()["MSET"], []string{"a", "1", "b", "2"})
+	assert.Nil(t, ryw.readRoute("read"))
+	assert.Nil(t, ryw.readRoute("value"))
+	assert.Nil(t, ryw.readRoute("1"))
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "written"), ryw.readRoute("written"))
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "b"), ryw.readRoute("b"))
+
+	ryw.written["written"] = time.Now().Add(-time.Hour)
+	assert.Nil(t, ryw.readRoute("written"))
+	ryw.pruned = time.Time{}
+	ryw.recordWrite(requestTypesByName()["DEL"], []string{"c"})
+	assert.NotContains(t, ryw.written, "written")
+	assert.Contains(t, ryw.written, "c")
+}
+
+func TestBaseClient_ReadRouteAfterWrite(t *testing.T) {
+	get := requestTypesByName()["GET"]
+	replica := config.ByAddressRoute{Host: "10.0.0.3", Port: 6380}
+	client := &baseClient{readYourWrites: newReadYourWrites(time.Hour), replicaLag: newReplicaLagMonitor(0, time.Second)}
+	client.replicaLag.shards = []clusterShard{{start: 0, end: maxHashSlot, replicas: []config.ByAddressRoute{replica}}}
+	assert.Equal(t, &replica, client.readRoute(get, []string{"key"}))
+
+	client.readYourWrites.recordWrite(requestTypesByName()["INCR"], []string{"key"})
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "key"), client.readRoute(get, []string{"key"}))
+}
+
+func TestBaseClient_UnsentWritesNotRecorded(t *testing.T) {
+	client := &baseClient{
+		pending:        make(map[unsafe.Pointer]struct{}),
+		mu:             &sync.Mutex{},
+		stats:          &clientStats{},
+		commandFilter:  newCommandFilter(nil, []string{"SET"}),
+		readYourWrites: newReadYourWrites(time.Hour),
+	}
+	_, err := client.Set(context.Background(), "filtered", "value")
+	var filterErr *ForbiddenCommandError
+	assert.ErrorAs(t, err, &filterErr)
+	_, err = client.Incr(context.Background(), "closed")
+	assert.IsType(t, &ClosingError{}, err)
+	assert.Empty(t, client.readYourWrites.written)
+}
+
+func TestReplicationError(t *testing.T) {
+	err := NewReplicationError(2, 1)
+	assert.Equal(t, "writes were acknowledged by 1 replicas, expected at least 2", err.Error())
+	assert.Equal(t, int64(2), err.Requested())
+	assert.Equal(t, int64(1), err.Acknowledged())
+}
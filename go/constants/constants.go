@@ -44,6 +44,10 @@ const (
 	StreamsKeyword      string = "STREAMS"
 	WithCodeKeyword     string = "WITHCODE"
 	LibraryNameKeyword  string = "LIBRARYNAME"
+	/// Valkey API keywords for the FAILOVER command
+	ToKeyword      string = "TO"
+	AbortKeyword   string = "ABORT"
+	TimeoutKeyword string = "TIMEOUT"
 )
 
 type InfBoundary string
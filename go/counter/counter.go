@@ -0,0 +1,291 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package counter provides counters built on a Valkey GLIDE client, for analytics and quota tracking. A [Counter]
+// keeps one string key per time bucket of each of its windows, e.g. one key per minute and one per hour, which
+// expire once they are older than the retention of their window.
+package counter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the counters.
+type Client interface {
+	IncrBy(ctx context.Context, key string, amount int64) (int64, error)
+	Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error)
+	MGet(ctx context.Context, keys []string) ([]models.Result[string], error)
+}
+
+type standaloneExecutor interface {
+	Exec(ctx context.Context, batch pipeline.StandaloneBatch, raiseOnError bool) ([]any, error)
+}
+
+type clusterExecutor interface {
+	Exec(ctx context.Context, batch pipeline.ClusterBatch, raiseOnError bool) ([]any, error)
+}
+
+// Window is a rollup window of a [Counter].
+type Window struct {
+	// Name is used as the suffix of the keys of the window, and must be unique within a counter.
+	Name string
+	// Size is the duration of each bucket of the window.
+	Size time.Duration
+	// Retention is how long a bucket is kept after it started.
+	Retention time.Duration
+}
+
+var (
+	// Minute counts per minute, keeping the last 2 hours.
+	Minute = Window{Name: "m", Size: time.Minute, Retention: 2 * time.Hour}
+	// Hour counts per hour, keeping the last 2 days.
+	Hour = Window{Name: "h", Size: time.Hour, Retention: 48 * time.Hour}
+	// Day counts per day, keeping the last 90 days.
+	Day = Window{Name: "d", Size: 24 * time.Hour, Retention: 90 * 24 * time.Hour}
+)
+
+// Bucket is the count of a window bucket.
+type Bucket struct {
+	// Start is the start time of the bucket.
+	Start time.Time
+	Count int64
+}
+
+// Counter counts events in several rollup windows at once.
+type Counter struct {
+	client  Client
+	name    string
+	windows []Window
+	clock   clock.Clock
+}
+
+// New returns a [Counter] counting in the given windows, e.g. [Minute] and [Hour]. The keys of the counter are
+// "{name}:<window>:<bucket>", where the bucket is the start of the bucket in Unix seconds divided by the window size.
+// The hash tag keeps all keys of the counter in the same hash slot in cluster mode, so that they can be incremented
+// atomically.
+//
+// Parameters:
+//
+//	client - The client used to store the counter, e.g. a glide.Client or glide.ClusterClient.
+//	name - The name of the counter.
+//	windows - The rollup windows of the counter.
+func New(client Client, name string, windows ...Window) *Counter {
+	return &Counter{client: client, name: name, windows: windows, clock: clock.System()}
+}
+
+// WithClock sets the clock selecting the current bucket of the windows, e.g. a clock.Fake in unit tests. If not
+// explicitly set, the system clock is used.
+func (counter *Counter) WithClock(c clock.Clock) *Counter {
+	counter.clock = c
+	return counter
+}
+
+func (counter *Counter) key(window Window, bucket int64) string {
+	return fmt.Sprintf("{%s}:%s:%d", counter.name, window.Name, bucket)
+}
+
+func bucketOf(window Window, at time.Time) int64 {
+	return at.Unix() / int64(window.Size/time.Second)
+}
+
+func bucketStart(window Window, bucket int64) time.Time {
+	return time.Unix(bucket*int64(window.Size/time.Second), 0)
+}
+
+// Incr adds amount to the current bucket of every window of the counter. If the client is a glide.Client or
+// glide.ClusterClient, the buckets are incremented and their expiry set in a single transaction. Otherwise the
+// commands are sent one by one.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	amount - The amount to add, which may be negative.
+//
+// Return value:
+//
+//	The new count of the current bucket of each window, in the order of the windows of the counter.
+func (counter *Counter) Incr(ctx context.Context, amount int64) ([]int64, error) {
+	now := counter.clock.Now()
+	keys := make([]string, len(counter.windows))
+	ttls := make([]time.Duration, len(counter.windows))
+	for i, window := range counter.windows {
+		bucket := bucketOf(window, now)
+		keys[i] = counter.key(window, bucket)
+		// The bucket expires once its retention elapsed since it started, rather than since its last increment.
+		ttls[i] = max(time.Second, window.Retention-now.Sub(bucketStart(window, bucket)))
+	}
+
+	var results []any
+	var err error
+	switch executor := counter.client.(type) {
+	case standaloneExecutor:
+		batch := pipeline.NewStandaloneBatch(true)
+		addIncr(&batch.BaseBatch, keys, ttls, amount)
+		results, err = executor.Exec(ctx, *batch, true)
+	case clusterExecutor:
+		batch := pipeline.NewClusterBatch(true)
+		addIncr(&batch.BaseBatch, keys, ttls, amount)
+		results, err = executor.Exec(ctx, *batch, true)
+	default:
+		return counter.incrOneByOne(ctx, keys, ttls, amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]int64, len(keys))
+	for i := range keys {
+		count, ok := results[2*i].(int64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response to INCRBY: %v", results[2*i])
+		}
+		counts[i] = count
+	}
+	return counts, nil
+}
+
+func addIncr[T pipeline.StandaloneBatch | pipeline.ClusterBatch](
+	batch *pipeline.BaseBatch[T],
+	keys []string,
+	ttls []time.Duration,
+	amount int64,
+) {
+	for i, key := range keys {
+		batch.IncrBy(key, amount)
+		batch.Expire(key, ttls[i])
+	}
+}
+
+func (counter *Counter) incrOneByOne(
+	ctx context.Context,
+	keys []string,
+	ttls []time.Duration,
+	amount int64,
+) ([]int64, error) {
+	counts := make([]int64, len(keys))
+	for i, key := range keys {
+		count, err := counter.client.IncrBy(ctx, key, amount)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := counter.client.Expire(ctx, key, ttls[i]); err != nil {
+			return nil, err
+		}
+		counts[i] = count
+	}
+	return counts, nil
+}
+
+// Count returns the count of the current bucket of a window.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	window - The window to read, which should be one of the windows of the counter.
+//
+// Return value:
+//
+//	The count of the current bucket, which is 0 if nothing was counted yet.
+func (counter *Counter) Count(ctx context.Context, window Window) (int64, error) {
+	now := counter.clock.Now()
+	buckets, err := counter.Range(ctx, window, now, now)
+	if err != nil {
+		return 0, err
+	}
+	return buckets[0].Count, nil
+}
+
+// Range returns the buckets of a window from the bucket containing from up to the bucket containing to, both
+// inclusive. Buckets that were never counted or that expired have a count of 0.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	window - The window to read, which should be one of the windows of the counter.
+//	from - A time within the first bucket.
+//	to - A time within the last bucket.
+//
+// Return value:
+//
+//	The buckets ordered by start time, or an empty slice if to is before from.
+func (counter *Counter) Range(ctx context.Context, window Window, from time.Time, to time.Time) ([]Bucket, error) {
+	first, last := bucketOf(window, from), bucketOf(window, to)
+	if last < first {
+		return []Bucket{}, nil
+	}
+	keys := make([]string, 0, last-first+1)
+	for bucket := first; bucket <= last; bucket++ {
+		keys = append(keys, counter.key(window, bucket))
+	}
+	values, err := counter.client.MGet(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	buckets := make([]Bucket, len(values))
+	for i, value := range values {
+		buckets[i].Start = bucketStart(window, first+int64(i))
+		if value.IsNil() {
+			continue
+		}
+		if buckets[i].Count, err = strconv.ParseInt(value.Value(), 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return buckets, nil
+}
+
+// Sum returns the total count of a window from the bucket containing from up to the bucket containing to.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	window - The window to read, which should be one of the windows of the counter.
+//	from - A time within the first bucket.
+//	to - A time within the last bucket.
+//
+// Return value:
+//
+//	The sum of the counts of the buckets.
+func (counter *Counter) Sum(ctx context.Context, window Window, from time.Time, to time.Time) (int64, error) {
+	buckets, err := counter.Range(ctx, window, from, to)
+	if err != nil {
+		return 0, err
+	}
+	var sum int64
+	for _, bucket := range buckets {
+		sum += bucket.Count
+	}
+	return sum, nil
+}
+
+// IncrWithTTL adds amount to the counter at key, and sets its time to live when the increment creates it. The counter
+// therefore resets ttl after its first increment, which suits fixed-window quotas.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	client - The client used to store the counter.
+//	key - The key of the counter.
+//	amount - The amount to add.
+//	ttl - The time to live of the counter.
+//
+// Return value:
+//
+//	The new value of the counter.
+func IncrWithTTL(ctx context.Context, client Client, key string, amount int64, ttl time.Duration) (int64, error) {
+	count, err := client.IncrBy(ctx, key, amount)
+	if err != nil {
+		return 0, err
+	}
+	if count == amount {
+		if _, err := client.Expire(ctx, key, ttl); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
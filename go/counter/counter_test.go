@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package counter
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// fakeClient keeps counters in memory, and does not support batches.
+type fakeClient struct {
+	values map[string]int64
+	ttls   map[string]time.Duration
+}
+
+func (c *fakeClient) IncrBy(_ context.Context, key string, amount int64) (int64, error) {
+	c.values[key] += amount
+	return c.values[key], nil
+}
+
+func (c *fakeClient) Expire(_ context.Context, key string, expireTime time.Duration) (bool, error) {
+	c.ttls[key] = expireTime
+	return true, nil
+}
+
+func (c *fakeClient) MGet(_ context.Context, keys []string) ([]models.Result[string], error) {
+	results := make([]models.Result[string], len(keys))
+	for i, key := range keys {
+		if value, ok := c.values[key]; ok {
+			results[i] = models.CreateStringResult(strconv.FormatInt(value, 10))
+		} else {
+			results[i] = models.CreateNilStringResult()
+		}
+	}
+	return results, nil
+}
+
+func TestCounterWindows(t *testing.T) {
+	client := &fakeClient{values: make(map[string]int64), ttls: make(map[string]time.Duration)}
+	fakeClock := clock.NewFake(time.Unix(7230, 0)) // 2h 30s
+	counter := New(client, "visits", Minute, Hour).WithClock(fakeClock)
+	ctx := context.Background()
+
+	counts, err := counter.Incr(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 2}, counts)
+	assert.Equal(t, 2*time.Hour-30*time.Second, client.ttls["{visits}:m:120"])
+	assert.Equal(t, 48*time.Hour-30*time.Second, client.ttls["{visits}:h:2"])
+
+	fakeClock.Advance(time.Minute)
+	now := fakeClock.Now()
+	counts, err = counter.Incr(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, counts)
+
+	count, err := counter.Count(ctx, Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	buckets, err := counter.Range(ctx, Minute, now.Add(-2*time.Minute), now)
+	assert.NoError(t, err)
+	assert.Equal(t, []Bucket{
+		{Start: time.Unix(7140, 0), Count: 0},
+		{Start: time.Unix(7200, 0), Count: 2},
+		{Start: time.Unix(7260, 0), Count: 1},
+	}, buckets)
+	sum, err := counter.Sum(ctx, Minute, now.Add(-time.Hour), now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), sum)
+}
+
+func TestIncrWithTTL(t *testing.T) {
+	client := &fakeClient{values: make(map[string]int64), ttls: make(map[string]time.Duration)}
+	count, err := IncrWithTTL(context.Background(), client, "quota", 1, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, time.Minute, client.ttls["quota"])
+
+	delete(client.ttls, "quota")
+	count, err = IncrWithTTL(context.Background(), client, "quota", 1, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.NotContains(t, client.ttls, "quota")
+}
@@ -0,0 +1,91 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// debugNodeKey marks the context of the commands sent by WithDebugNode, which are forced to a node.
+type debugNodeKey struct{}
+
+// debugNode is the node the commands of a WithDebugNode call are forced to, and whether any of them succeeded on it.
+type debugNode struct {
+	node      config.ByAddressRoute
+	mu        sync.Mutex
+	succeeded bool
+}
+
+func debugNodeFrom(ctx context.Context) *debugNode {
+	debug, _ := ctx.Value(debugNodeKey{}).(*debugNode)
+	return debug
+}
+
+// record records whether a command forced to the node succeeded.
+func (debug *debugNode) record(err error) {
+	if err != nil {
+		return
+	}
+	debug.mu.Lock()
+	defer debug.mu.Unlock()
+	debug.succeeded = true
+}
+
+// forcedNode returns the address of the node as "host:port" if a command succeeded on it, or "" otherwise.
+func (debug *debugNode) forcedNode() string {
+	debug.mu.Lock()
+	defer debug.mu.Unlock()
+	if !debug.succeeded {
+		return ""
+	}
+	return net.JoinHostPort(debug.node.Host, strconv.Itoa(int(debug.node.Port)))
+}
+
+// DebugResponse is the response of the commands sent by [WithDebugNode], with the node they were forced to.
+type DebugResponse[T any] struct {
+	// Value is the value returned by the call.
+	Value T
+	// ForcedNode is the address given to WithDebugNode, as "host:port", if a command of the call forced to it
+	// succeeded, or "" if none did, e.g. for batches and scripts, which follow their usual routing. The core does not
+	// report the node serving a command, but the commands forced to a node are not redirected, so a command which
+	// succeeded was served by the node at that address.
+	ForcedNode string
+}
+
+// WithDebugNode runs call with a context forcing the commands it sends with a cluster client to a node, and returns its
+// value with the node they were forced to, to investigate anomalies specific to a node, e.g. a replica returning stale
+// data. The commands forced to a node are not redirected, so a command the node cannot serve fails with the error it
+// replies, e.g. MOVED. Reads forced to a node are neither hedged nor coalesced with the reads sent without it.
+//
+// Only the commands sent through ctx are forced, so that the other commands of the application keep their routing.
+// Batches, scripts and the commands of standalone clients are not affected by the context, and standalone clients
+// reject it.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	node - The node to send the commands to.
+//	call - The function sending the commands, with the context it must use.
+//
+// Return value:
+//
+//	The value returned by call, and the node its commands were forced to.
+//
+// Example:
+//
+//	response, err := glide.WithDebugNode(ctx, config.ByAddressRoute{Host: "10.0.0.5", Port: 6379},
+//		func(ctx context.Context) (models.Result[string], error) { return client.Get(ctx, "user:42") })
+func WithDebugNode[T any](
+	ctx context.Context,
+	node config.ByAddressRoute,
+	call func(ctx context.Context) (T, error),
+) (DebugResponse[T], error) {
+	debug := &debugNode{node: node}
+	value, err := call(context.WithValue(ctx, debugNodeKey{}, debug))
+	return DebugResponse[T]{Value: value, ForcedNode: debug.forcedNode()}, err
+}
@@ -0,0 +1,33 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestWithDebugNode_RecordsForcedNode(t *testing.T) {
+	node := config.ByAddressRoute{Host: "10.0.0.5", Port: 6379}
+	response, err := WithDebugNode(context.Background(), node, func(ctx context.Context) (string, error) {
+		debug := debugNodeFrom(ctx)
+		assert.Equal(t, node, debug.node)
+		debug.record(nil)
+		return "value", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, DebugResponse[string]{Value: "value", ForcedNode: "10.0.0.5:6379"}, response)
+
+	response, err = WithDebugNode(context.Background(), node, func(ctx context.Context) (string, error) {
+		debugNodeFrom(ctx).record(errors.New("MOVED"))
+		return "", errors.New("MOVED")
+	})
+	assert.EqualError(t, err, "MOVED")
+	assert.Empty(t, response.ForcedNode)
+	assert.Nil(t, debugNodeFrom(context.Background()))
+}
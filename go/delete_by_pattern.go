@@ -0,0 +1,168 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// keyDeleter unlinks the keys found by a scan in batches, following the rate limit, the maximum number of keys and the
+// dry-run mode of the options.
+type keyDeleter struct {
+	client  *baseClient
+	opts    options.DeleteByPatternOptions
+	started time.Time
+	scanned int64
+	deleted int64
+}
+
+func newKeyDeleter(client *baseClient, opts options.DeleteByPatternOptions) *keyDeleter {
+	return &keyDeleter{client: client, opts: opts, started: time.Now()}
+}
+
+// done reports whether the maximum number of keys was reached.
+func (deleter *keyDeleter) done() bool {
+	return deleter.opts.MaxKeys > 0 && deleter.deleted >= deleter.opts.MaxKeys
+}
+
+// process deletes the keys of a scan iteration, in batches of at most BatchSize keys.
+func (deleter *keyDeleter) process(ctx context.Context, keys []string) error {
+	deleter.scanned += int64(len(keys))
+	for len(keys) > 0 && !deleter.done() {
+		size := min(int64(len(keys)), deleter.opts.BatchSize)
+		if deleter.opts.MaxKeys > 0 {
+			size = min(size, deleter.opts.MaxKeys-deleter.deleted)
+		}
+		if err := deleter.delete(ctx, keys[:size]); err != nil {
+			return err
+		}
+		keys = keys[size:]
+	}
+	return nil
+}
+
+func (deleter *keyDeleter) delete(ctx context.Context, batch []string) error {
+	if err := deleter.throttle(ctx); err != nil {
+		return err
+	}
+	if deleter.opts.DryRun {
+		deleter.deleted += int64(len(pageKeys(batch)))
+	} else {
+		deleted, err := deleter.client.Unlink(ctx, batch)
+		if err != nil {
+			return err
+		}
+		deleter.deleted += deleted
+	}
+	if deleter.opts.Progress != nil {
+		deleter.opts.Progress(deleter.scanned, deleter.deleted)
+	}
+	return nil
+}
+
+// throttle waits until the keys deleted so far are within the rate limit.
+func (deleter *keyDeleter) throttle(ctx context.Context) error {
+	if deleter.opts.MaxKeysPerSecond <= 0 || deleter.opts.DryRun {
+		return nil
+	}
+	due := deleter.started.Add(time.Duration(deleter.deleted) * time.Second / time.Duration(deleter.opts.MaxKeysPerSecond))
+	wait := time.Until(due)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// DeleteByPattern deletes the keys of the database matching pattern, by scanning them with SCAN and unlinking them in
+// batches, rather than with KEYS and DEL, which block the server. The deletion can be paced, capped, and rehearsed in
+// dry-run mode, see [options.DeleteByPatternOptions].
+//
+// Like SCAN, the deletion is not a snapshot: keys added while deleting may or may not be deleted. In dry-run mode, a key
+// returned more than once by the scan may be counted more than once.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	pattern - The glob-style pattern of the keys to delete. The pattern "*" must be explicitly allowed.
+//	opts - The batch size, rate limit, maximum number of keys, dry-run mode and progress callback.
+//
+// Return value:
+//
+//	The number of keys deleted, or matched in dry-run mode, which is the number of keys deleted before the error in
+//	case of an error.
+func (client *Client) DeleteByPattern(
+	ctx context.Context,
+	pattern string,
+	opts options.DeleteByPatternOptions,
+) (int64, error) {
+	if err := opts.Validate(pattern); err != nil {
+		return 0, err
+	}
+	deleter := newKeyDeleter(&client.baseClient, opts)
+	scanOptions := options.NewScanOptions().SetMatch(pattern).SetCount(opts.BatchSize)
+	cursor := models.NewCursor()
+	for !cursor.IsFinished() && !deleter.done() {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return deleter.deleted, err
+		}
+		if err := deleter.process(ctx, result.Data); err != nil {
+			return deleter.deleted, err
+		}
+		cursor = result.Cursor
+	}
+	return deleter.deleted, nil
+}
+
+// DeleteByPattern deletes the keys of the cluster matching pattern, by scanning them with a cluster scan, see
+// [ClusterClient.Scan], and unlinking them in batches, rather than with KEYS and DEL, which block the nodes. UNLINK is
+// split by hash slot, so the keys of a batch may belong to any node. The deletion can be paced, capped, and rehearsed
+// in dry-run mode, see [options.DeleteByPatternOptions].
+//
+// Like SCAN, the deletion is not a snapshot: keys added while deleting may or may not be deleted. In dry-run mode, a key
+// returned more than once by the scan may be counted more than once.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	pattern - The glob-style pattern of the keys to delete. The pattern "*" must be explicitly allowed.
+//	opts - The batch size, rate limit, maximum number of keys, dry-run mode and progress callback.
+//
+// Return value:
+//
+//	The number of keys deleted, or matched in dry-run mode, which is the number of keys deleted before the error in
+//	case of an error.
+func (client *ClusterClient) DeleteByPattern(
+	ctx context.Context,
+	pattern string,
+	opts options.DeleteByPatternOptions,
+) (int64, error) {
+	if err := opts.Validate(pattern); err != nil {
+		return 0, err
+	}
+	deleter := newKeyDeleter(&client.baseClient, opts)
+	scanOptions := options.NewClusterScanOptions().SetMatch(pattern).SetCount(opts.BatchSize)
+	cursor := models.NewClusterScanCursor()
+	for !cursor.IsFinished() && !deleter.done() {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return deleter.deleted, err
+		}
+		if err := deleter.process(ctx, result.Keys); err != nil {
+			return deleter.deleted, err
+		}
+		cursor = result.Cursor
+	}
+	return deleter.deleted, nil
+}
@@ -0,0 +1,29 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func TestKeyDeleter_DryRun(t *testing.T) {
+	var progress [][2]int64
+	opts := options.NewDeleteByPatternOptions().
+		SetBatchSize(2).
+		SetMaxKeys(3).
+		SetDryRun(true).
+		SetProgress(func(scanned int64, deleted int64) { progress = append(progress, [2]int64{scanned, deleted}) })
+	deleter := newKeyDeleter(&baseClient{}, *opts)
+
+	assert.NoError(t, deleter.process(context.Background(), []string{"k1", "k1", "k2"}))
+	assert.False(t, deleter.done())
+	assert.NoError(t, deleter.process(context.Background(), []string{"k3", "k4"}))
+	assert.True(t, deleter.done())
+	assert.Equal(t, int64(3), deleter.deleted)
+	assert.Equal(t, [][2]int64{{3, 1}, {3, 2}, {5, 3}}, progress)
+}
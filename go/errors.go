@@ -78,6 +78,129 @@ func NewConfigurationError(message string) *ConfigurationError {
 
 func (e *ConfigurationError) Error() string { return e.msg }
 
+// PendingLimitError is a client error that occurs when a command is rejected because the client already has the
+// configured maximum number of commands awaiting a response.
+type PendingLimitError struct {
+	msg string
+}
+
+func NewPendingLimitError(message string) *PendingLimitError {
+	return &PendingLimitError{msg: message}
+}
+
+func (e *PendingLimitError) Error() string { return e.msg }
+
+// CircuitOpenError is a client error that occurs when a command is rejected without being sent, because the circuit
+// breaker of the client or of the target node is open after too many commands failed.
+type CircuitOpenError struct {
+	msg string
+}
+
+func NewCircuitOpenError(message string) *CircuitOpenError {
+	return &CircuitOpenError{msg: message}
+}
+
+func (e *CircuitOpenError) Error() string { return e.msg }
+
+// RateLimitError is a client error that occurs when a command of a tenant client is rejected without being sent,
+// because the tenant exceeded its rate limit, see Client.TenantClientWithOptions.
+type RateLimitError struct {
+	msg string
+}
+
+func NewRateLimitError(message string) *RateLimitError {
+	return &RateLimitError{msg: message}
+}
+
+func (e *RateLimitError) Error() string { return e.msg }
+
+// QuotaExceededError is a client error that occurs when a request is rejected without being sent, because the quota
+// hook of the client configuration returned an error, which it wraps.
+type QuotaExceededError struct {
+	msg   string
+	cause error
+}
+
+func NewQuotaExceededError(message string, cause error) *QuotaExceededError {
+	return &QuotaExceededError{msg: message, cause: cause}
+}
+
+func (e *QuotaExceededError) Error() string { return e.msg }
+
+// Unwrap returns the error returned by the quota hook.
+func (e *QuotaExceededError) Unwrap() error { return e.cause }
+
+// RequestError is a client error that occurs when a command is rejected without being sent, because its arguments
+// are invalid. It is only returned in strict validation mode, by tenant clients for the commands they may not send, for
+// routes given to standalone clients, or for invalid counts of the commands popping several elements.
+type RequestError struct {
+	msg string
+}
+
+func NewRequestError(message string) *RequestError {
+	return &RequestError{msg: message}
+}
+
+func (e *RequestError) Error() string { return e.msg }
+
+// ForbiddenCommandError is a client error that occurs when a command is rejected without being sent, because it is not
+// allowed, or it is denied, by the client configuration.
+type ForbiddenCommandError struct {
+	msg string
+}
+
+func NewForbiddenCommandError(message string) *ForbiddenCommandError {
+	return &ForbiddenCommandError{msg: message}
+}
+
+func (e *ForbiddenCommandError) Error() string { return e.msg }
+
+// RequestTooLargeError is a client error that occurs when a request is rejected without being sent, because the size of
+// its arguments exceeds the maximum request size of the client configuration.
+type RequestTooLargeError struct {
+	msg string
+}
+
+func NewRequestTooLargeError(message string) *RequestTooLargeError {
+	return &RequestTooLargeError{msg: message}
+}
+
+func (e *RequestTooLargeError) Error() string { return e.msg }
+
+// ConversionError is a client error that occurs when the response of a command does not have the shape expected by the
+// client, e.g. when a proxy or a module replies with another type. It holds the raw response for debugging.
+type ConversionError struct {
+	msg      string
+	command  string
+	expected string
+	actual   string
+	value    any
+}
+
+func NewConversionError(command string, expected string, actual string, value any) *ConversionError {
+	msg := fmt.Sprintf("unexpected return type from Valkey: got %s, expected %s", actual, expected)
+	if command != "" {
+		msg = fmt.Sprintf("unexpected return type from Valkey for %s: got %s, expected %s", command, actual, expected)
+	}
+	return &ConversionError{msg: msg, command: command, expected: expected, actual: actual, value: value}
+}
+
+func (e *ConversionError) Error() string { return e.msg }
+
+// Command returns the name of the client method whose response could not be converted, such as Get or XPending, or
+// an empty string if it is not known.
+func (e *ConversionError) Command() string { return e.command }
+
+// Expected returns the type the response, or the part of it that could not be converted, was expected to have.
+func (e *ConversionError) Expected() string { return e.expected }
+
+// Actual returns the type of the response, or of the part of it that could not be converted.
+func (e *ConversionError) Actual() string { return e.actual }
+
+// Value returns the raw value that could not be converted, or the array or map holding it, as parsed from the response:
+// nil, a string, an int64, a float64, a bool, an []any, a map[string]any, or a map[string]struct{}.
+func (e *ConversionError) Value() any { return e.value }
+
 type BatchError struct {
 	errors []error
 }
@@ -0,0 +1,242 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// exportHeader starts the output of ExportKeys, identifying the format and its version.
+const exportHeader = "GLIDEKEYS\x01"
+
+// importBatchSize is the number of keys restored by each batch sent by ImportKeys.
+const importBatchSize = 100
+
+// maxExportFieldLength bounds the length of the keys and values read by ImportKeys, which matches the maximum length
+// of a string on the server.
+const maxExportFieldLength = 512 * 1024 * 1024
+
+// ExportKeys writes the keys matching pattern to w, in a portable format which can be read by [Client.ImportKeys] or
+// [ClusterClient.ImportKeys], e.g. to back up a database or to migrate it to another server. Every key is written with
+// its value serialized by DUMP and its remaining time to live, so values of all types are exported as is. The format
+// does not depend on the server version, but RESTORE fails on a server older than the one the keys were dumped from.
+//
+// The keys are iterated with SCAN, so keys changed while exporting may or may not be written, and a key may be written
+// more than once. Keys which expire or are deleted between being scanned and being dumped are skipped.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	pattern - The glob-style pattern of the keys to export, e.g. "*" for all keys.
+//	w - The writer the keys are written to.
+//
+// Return value:
+//
+//	The number of keys written.
+func (client *Client) ExportKeys(ctx context.Context, pattern string, w io.Writer) (int, error) {
+	exporter, err := newKeyExporter(w)
+	if err != nil {
+		return 0, err
+	}
+	cursor := models.NewCursor()
+	scanOptions := options.NewScanOptions().SetMatch(pattern)
+	for !cursor.IsFinished() {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return exporter.count, err
+		}
+		if err := client.exportKeys(ctx, exporter, result.Data); err != nil {
+			return exporter.count, err
+		}
+		cursor = result.Cursor
+	}
+	return exporter.count, exporter.writer.Flush()
+}
+
+// ExportKeys writes the keys matching pattern to w, in a portable format which can be read by [Client.ImportKeys] or
+// [ClusterClient.ImportKeys], e.g. to back up a cluster or to migrate it to another server. Every key is written with
+// its value serialized by DUMP and its remaining time to live, so values of all types are exported as is. The format
+// does not depend on the server version, but RESTORE fails on a server older than the one the keys were dumped from.
+//
+// The keys of all the primaries are iterated with a cluster scan, so keys changed while exporting may or may not be
+// written, and a key may be written more than once. Keys which expire or are deleted between being scanned and being
+// dumped are skipped.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	pattern - The glob-style pattern of the keys to export, e.g. "*" for all keys.
+//	w - The writer the keys are written to.
+//
+// Return value:
+//
+//	The number of keys written.
+func (client *ClusterClient) ExportKeys(ctx context.Context, pattern string, w io.Writer) (int, error) {
+	exporter, err := newKeyExporter(w)
+	if err != nil {
+		return 0, err
+	}
+	cursor := models.NewClusterScanCursor()
+	scanOptions := options.NewClusterScanOptions().SetMatch(pattern)
+	for !cursor.IsFinished() {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return exporter.count, err
+		}
+		if err := client.exportKeys(ctx, exporter, result.Keys); err != nil {
+			return exporter.count, err
+		}
+		cursor = result.Cursor
+	}
+	return exporter.count, exporter.writer.Flush()
+}
+
+// ImportKeys restores the keys written by [Client.ExportKeys] or [ClusterClient.ExportKeys] from r, replacing the
+// existing keys of the same names. The keys are restored with the time to live they had when they were exported, and
+// are sent in non-atomic batches, which are split by hash slot in cluster mode.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	r - The reader the keys are read from.
+//
+// Return value:
+//
+//	The number of keys restored. If the input is malformed, the keys read before are restored and an error is returned.
+func (client *baseClient) ImportKeys(ctx context.Context, r io.Reader) (int, error) {
+	reader := bufio.NewReader(r)
+	header := make([]byte, len(exportHeader))
+	if _, err := io.ReadFull(reader, header); err != nil || string(header) != exportHeader {
+		return 0, errors.New("invalid export: missing header")
+	}
+
+	count := 0
+	restoreOptions := options.NewRestoreOptions().SetReplace()
+	batch := pipeline.NewClusterBatch(false)
+	for {
+		key, value, ttl, err := readExportedKey(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_, flushErr := executeBulk[string](ctx, client, batch)
+			if flushErr == nil {
+				count += len(batch.Commands)
+			}
+			return count, err
+		}
+		batch.RestoreWithOptions(key, ttl, value, *restoreOptions)
+		if len(batch.Commands) == importBatchSize {
+			if _, err := executeBulk[string](ctx, client, batch); err != nil {
+				return count, err
+			}
+			count += importBatchSize
+			batch = pipeline.NewClusterBatch(false)
+		}
+	}
+	if _, err := executeBulk[string](ctx, client, batch); err != nil {
+		return count, err
+	}
+	return count + len(batch.Commands), nil
+}
+
+// keyExporter writes the exported keys, and counts them.
+type keyExporter struct {
+	writer *bufio.Writer
+	count  int
+}
+
+func newKeyExporter(w io.Writer) (*keyExporter, error) {
+	writer := bufio.NewWriter(w)
+	if _, err := writer.WriteString(exportHeader); err != nil {
+		return nil, err
+	}
+	return &keyExporter{writer: writer}, nil
+}
+
+// exportKeys dumps the given keys along with their remaining time to live in a single non-atomic batch, and writes
+// them.
+func (client *baseClient) exportKeys(ctx context.Context, exporter *keyExporter, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	batch := pipeline.NewClusterBatch(false)
+	for _, key := range keys {
+		batch.PTTL(key).Dump(key)
+	}
+	results, err := client.executeBatch(ctx, batch.Batch, true, nil)
+	if err != nil {
+		return err
+	}
+	for idx, key := range keys {
+		ttl, _ := results[2*idx].(int64)
+		value, ok := results[2*idx+1].(string)
+		if !ok || ttl == -2 {
+			// The key was deleted or expired after being scanned.
+			continue
+		}
+		// A TTL of -1 means the key has no expiry. A key about to expire is kept with the shortest TTL instead.
+		if ttl == 0 {
+			ttl = 1
+		}
+		if _, err := exporter.writer.Write(appendExportedKey(nil, key, value, ttl)); err != nil {
+			return err
+		}
+		exporter.count++
+	}
+	return nil
+}
+
+// appendExportedKey appends the exported form of a key to buffer: the length and bytes of the key, followed by the
+// length and bytes of its serialized value, and by its time to live in milliseconds, or 0 if it has no expiry. The
+// lengths and the time to live are unsigned varints.
+func appendExportedKey(buffer []byte, key string, value string, ttlMillis int64) []byte {
+	buffer = binary.AppendUvarint(buffer, uint64(len(key)))
+	buffer = append(buffer, key...)
+	buffer = binary.AppendUvarint(buffer, uint64(len(value)))
+	buffer = append(buffer, value...)
+	return binary.AppendUvarint(buffer, uint64(max(ttlMillis, 0)))
+}
+
+// readExportedKey reads a key written by appendExportedKey. It returns io.EOF if the input ends before the key.
+func readExportedKey(reader *bufio.Reader) (key string, value string, ttl time.Duration, err error) {
+	if _, err := reader.Peek(1); err == io.EOF {
+		return "", "", 0, io.EOF
+	}
+	if key, err = readExportedField(reader); err != nil {
+		return "", "", 0, err
+	}
+	if value, err = readExportedField(reader); err != nil {
+		return "", "", 0, err
+	}
+	millis, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid export: truncated key %q", key)
+	}
+	return key, value, time.Duration(millis) * time.Millisecond, nil
+}
+
+func readExportedField(reader *bufio.Reader) (string, error) {
+	length, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return "", errors.New("invalid export: truncated key")
+	}
+	if length > maxExportFieldLength {
+		return "", fmt.Errorf("invalid export: field of %d bytes exceeds the maximum length", length)
+	}
+	field := make([]byte, length)
+	if _, err := io.ReadFull(reader, field); err != nil {
+		return "", errors.New("invalid export: truncated key")
+	}
+	return string(field), nil
+}
@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportedKey_RoundTrip(t *testing.T) {
+	var buffer []byte
+	buffer = appendExportedKey(buffer, "key", "\x00\x01binary\xff", -1)
+	buffer = appendExportedKey(buffer, "", "", 1500)
+	reader := bufio.NewReader(bytes.NewReader(buffer))
+
+	key, value, ttl, err := readExportedKey(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "key", key)
+	assert.Equal(t, "\x00\x01binary\xff", value)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	key, value, ttl, err = readExportedKey(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "", key)
+	assert.Equal(t, "", value)
+	assert.Equal(t, 1500*time.Millisecond, ttl)
+
+	_, _, _, err = readExportedKey(reader)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestExportedKey_Truncated(t *testing.T) {
+	buffer := appendExportedKey(nil, "key", "value", 10)
+	for length := 1; length < len(buffer); length++ {
+		_, _, _, err := readExportedKey(bufio.NewReader(bytes.NewReader(buffer[:length])))
+		assert.ErrorContains(t, err, "invalid export", "length %d", length)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// Future is the result of a command sent by an async method, such as GetAsync, which returns as soon as the command is
+// sent. The response is delivered to the future by the core, without a goroutine per command, so that one goroutine can
+// overlap many commands by sending them all before waiting for any of them.
+//
+// The context given to the async method still bounds the command, including the time spent before Get is called. The
+// resources held for the command are released once Get returns or the context is done, so a future which is never
+// waited for holds them until its context is done.
+type Future[T any] struct {
+	once    sync.Once
+	pending *pendingCommand
+	convert func(response *C.struct_CommandResponse) (T, error)
+	value   T
+	err     error
+}
+
+// submitFuture returns a future converting the response of a submitted command, or failing with err if the command
+// could not be sent.
+func submitFuture[T any](
+	pending *pendingCommand,
+	err error,
+	convert func(response *C.struct_CommandResponse) (T, error),
+) *Future[T] {
+	future := &Future[T]{pending: pending, convert: convert}
+	if err != nil {
+		future.once.Do(func() { future.err = err })
+		return future
+	}
+	// Completing the future once its context is done releases the pending slot, the pinned channel, the timers and the
+	// response of the command, even if Get is never called. No goroutine is started until then.
+	context.AfterFunc(pending.ctx, future.complete)
+	return future
+}
+
+// Get waits for the response of the command and returns it, like the blocking method would. It may be called any number
+// of times, from any goroutine, and returns the same result every time.
+func (future *Future[T]) Get() (T, error) {
+	future.complete()
+	return future.value, future.err
+}
+
+// complete waits for the response of the command and converts it, the first time it is called.
+func (future *Future[T]) complete() {
+	future.once.Do(func() {
+		response, err := future.pending.await()
+		if err != nil {
+			future.err = err
+			return
+		}
+		future.value, future.err = future.convert(response)
+	})
+}
+
+// GetAsync sends a GET command like Get, and returns without waiting for its response. Unlike Get, it neither coalesces
+// nor hedges the read, since both wait for responses.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to be retrieved from the database.
+//
+// Return value:
+//
+//	A future of the value of key, or [models.CreateNilStringResult] if key does not exist.
+func (client *baseClient) GetAsync(ctx context.Context, key string) *Future[models.Result[string]] {
+	pending, err := client.submitCommand(ctx, C.Get, []string{key})
+	return submitFuture(pending, err, func(response *C.struct_CommandResponse) (models.Result[string], error) {
+		return client.decodeResult(handleStringOrNilResponse(response))
+	})
+}
+
+// SetAsync sends a SET command like Set, and returns without waiting for its response.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to store.
+//	value - The value to store with the given key.
+//
+// Return value:
+//
+//	A future of `"OK"`.
+func (client *baseClient) SetAsync(ctx context.Context, key string, value string) *Future[string] {
+	pending, err := client.submitCommand(ctx, C.Set, []string{key, value})
+	return submitFuture(pending, err, handleOkResponse)
+}
+
+// DelAsync sends a DEL command like Del, and returns without waiting for its response.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - One or more keys to delete.
+//
+// Return value:
+//
+//	A future of the number of keys that were removed.
+func (client *baseClient) DelAsync(ctx context.Context, keys []string) *Future[int64] {
+	pending, err := client.submitCommand(ctx, C.Del, keys)
+	return submitFuture(pending, err, handleIntResponse)
+}
@@ -0,0 +1,85 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuture_ClosedClient(t *testing.T) {
+	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}, stats: &clientStats{}}
+	before := pinnedObjects.Load()
+
+	future := client.GetAsync(context.Background(), "key")
+	_, err := future.Get()
+	assert.IsType(t, &ClosingError{}, err)
+	_, again := future.Get()
+	assert.Equal(t, err, again)
+	assert.Equal(t, before, pinnedObjects.Load())
+}
+
+func TestFuture_CanceledContext(t *testing.T) {
+	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}, stats: &clientStats{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.SetAsync(ctx, "key", "value").Get()
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestFuture_SharedResult(t *testing.T) {
+	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}, stats: &clientStats{}}
+	pending := &pendingCommand{
+		client:        client,
+		ctx:           context.Background(),
+		parentCtx:     context.Background(),
+		resultChannel: make(chan payload, 1),
+	}
+	failure := errors.New("failed")
+	cleanups := 0
+	pending.onFinish(func(err error) {
+		assert.Equal(t, failure, err)
+		cleanups++
+	})
+	future := submitFuture(pending, nil, handleStringOrNilResponse)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := future.Get()
+			assert.Equal(t, failure, err)
+		}()
+	}
+	pending.resultChannel <- payload{error: failure}
+	wg.Wait()
+	assert.Equal(t, 1, cleanups)
+}
+
+func TestFuture_ReleasedWhenContextDone(t *testing.T) {
+	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}, stats: &clientStats{}}
+	ctx, cancel := context.WithCancel(context.Background())
+	pending := &pendingCommand{client: client, ctx: ctx, parentCtx: ctx, resultChannel: make(chan payload, 1)}
+	pending.resultChannelPtr = unsafe.Pointer(&pending.resultChannel)
+	client.pending[pending.resultChannelPtr] = struct{}{}
+	released := make(chan error, 1)
+	pending.onFinish(func(err error) { released <- err })
+	future := submitFuture(pending, nil, handleStringOrNilResponse)
+
+	// The future is never waited for, but its resources are released once its context is done.
+	cancel()
+	assert.ErrorIs(t, <-released, context.Canceled)
+	pending.resultChannel <- payload{}
+	client.mu.Lock()
+	assert.Empty(t, client.pending)
+	client.mu.Unlock()
+	_, err := future.Get()
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -449,6 +449,25 @@ func ExampleClient_TTL() {
 	// -1
 }
 
+func ExampleClient_TTLs() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	client.SetWithOptions(
+		context.Background(),
+		"key2",
+		"someValue",
+		*options.NewSetOptions().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+	)
+	result, err := client.TTLs(context.Background(), []string{"key1", "key2", "missingKey"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output:
+	// [-1 100 -2]
+}
+
 func ExampleClusterClient_TTL() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 	result, err := client.Set(context.Background(), "key", "someValue")
@@ -611,6 +630,32 @@ func ExampleClusterClient_Type() {
 	// string
 }
 
+func ExampleClient_KeyInfo() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	info, err := client.KeyInfo(context.Background(), "key1")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(info.Exists, info.Type, info.TTL)
+
+	// Output:
+	// true string -1
+}
+
+func ExampleClusterClient_KeyInfo() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	info, err := client.KeyInfo(context.Background(), "key1")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(info.Exists, info.Type, info.TTL)
+
+	// Output:
+	// true string -1
+}
+
 func ExampleClient_Rename() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.Set(context.Background(), "key1", "someValue")
@@ -641,6 +686,24 @@ func ExampleClusterClient_Rename() {
 	// OK
 }
 
+func ExampleClusterClient_RenameAcrossSlots() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.Set(context.Background(), "{slot1}key", "someValue")
+	result1, err := client.RenameAcrossSlots(context.Background(), "{slot1}key", "{slot2}key")
+	result2, err := client.Get(context.Background(), "{slot2}key")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	fmt.Println(result1)
+	fmt.Println(result2.Value())
+
+	// Output:
+	// OK
+	// OK
+	// someValue
+}
+
 func ExampleClient_RenameNX() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.Set(context.Background(), "key1", "someValue")
@@ -689,6 +752,25 @@ func ExampleClient_Persist() {
 	// true
 }
 
+func ExampleClient_PersistAll() {
+	var client *Client = getExampleClient() // example helper function
+	client.Set(context.Background(), "key1", "someValue")
+	client.SetWithOptions(
+		context.Background(),
+		"key2",
+		"someValue",
+		*options.NewSetOptions().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+	)
+	result, err := client.PersistAll(context.Background(), []string{"key1", "key2", "missingKey"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output:
+	// [false true false]
+}
+
 func ExampleClusterClient_Persist() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 	result, err := client.Set(context.Background(), "key1", "someValue")
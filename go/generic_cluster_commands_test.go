@@ -3,6 +3,7 @@
 package glide
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -247,3 +248,48 @@ func ExampleClusterClient_RandomKeyWithRoute() {
 
 	// Output: true
 }
+
+func ExampleClusterClient_ExportKeys() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	prefix := uuid.NewString()
+	client.Set(context.Background(), prefix+":a", "1")
+	client.Set(context.Background(), prefix+":b", "2")
+	var backup bytes.Buffer
+	exported, err := client.ExportKeys(context.Background(), prefix+":*", &backup)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	client.Del(context.Background(), []string{prefix + ":a", prefix + ":b"})
+	imported, err := client.ImportKeys(context.Background(), &backup)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	value, _ := client.Get(context.Background(), prefix+":b")
+	fmt.Println(exported, imported, value.Value())
+
+	// Output: 2 2 2
+}
+
+func ExampleClusterClient_ClusterGetKeysInSlot() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	tag := "{" + uuid.NewString() + "}"
+	client.MSet(context.Background(), map[string]string{tag + "a": "1", tag + "b": "2"})
+	keySlot, err := client.CustomCommand(context.Background(), []string{"CLUSTER", "KEYSLOT", tag})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	slot := keySlot.SingleValue().(int64)
+	count, err := client.ClusterCountKeysInSlot(context.Background(), slot)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	keys, err := client.ClusterGetKeysInSlot(context.Background(), slot, 10)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(count, len(keys))
+
+	// Output: 2 2
+}
@@ -3,6 +3,7 @@
 package glide
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -24,6 +25,23 @@ func ExampleClient_CustomCommand() {
 	// Output: PONG
 }
 
+func ExampleClient_Prepare() {
+	var client *Client = getExampleClient() // example helper function
+	increment, err := client.Prepare("increment", "INCRBY", []string{"$1", "$2"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	key := uuid.New().String()
+	increment.Execute(context.Background(), key, "5")
+	result, err := client.ExecutePrepared(context.Background(), "increment", key, "10")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 15
+}
+
 func ExampleClient_Move() {
 	var client *Client = getExampleClient() // example helper function
 	key := uuid.New().String()
@@ -85,3 +103,25 @@ func ExampleClient_RandomKey() {
 
 	// Output: true
 }
+
+func ExampleClient_ExportKeys() {
+	var client *Client = getExampleClient() // example helper function
+
+	prefix := uuid.NewString()
+	client.Set(context.Background(), prefix+":a", "1")
+	client.Set(context.Background(), prefix+":b", "2")
+	var backup bytes.Buffer
+	exported, err := client.ExportKeys(context.Background(), prefix+":*", &backup)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	client.Del(context.Background(), []string{prefix + ":a", prefix + ":b"})
+	imported, err := client.ImportKeys(context.Background(), &backup)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	value, _ := client.Get(context.Background(), prefix+":b")
+	fmt.Println(exported, imported, value.Value())
+
+	// Output: 2 2 2
+}
@@ -0,0 +1,252 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package geofence provides circular geofences built on a Valkey GLIDE client. Fences are stored as members of a
+// geospatial index, and position updates of tracked objects are checked against them with GEOSEARCH. Whenever an
+// object enters or exits a fence, an [Event] is published to the channel of the fence.
+package geofence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the geofences. Events are
+// published through the Publish command of glide.Client or glide.ClusterClient.
+type Client interface {
+	GeoAdd(ctx context.Context, key string, membersToGeospatialData map[string]options.GeospatialData) (int64, error)
+	GeoSearchWithInfoOptions(
+		ctx context.Context,
+		key string,
+		searchFrom options.GeoSearchOrigin,
+		searchByShape options.GeoSearchShape,
+		infoOptions options.GeoSearchInfoOptions,
+	) ([]options.Location, error)
+	ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error)
+	ZRem(ctx context.Context, key string, members []string) (int64, error)
+	ZMScore(ctx context.Context, key string, members []string) ([]models.Result[float64], error)
+	ZRangeWithScores(
+		ctx context.Context,
+		key string,
+		rangeQuery options.ZRangeQueryWithScores,
+	) ([]models.MemberAndScore, error)
+	SAdd(ctx context.Context, key string, members []string) (int64, error)
+	SRem(ctx context.Context, key string, members []string) (int64, error)
+	SMembers(ctx context.Context, key string) (map[string]struct{}, error)
+}
+
+type standalonePublisher interface {
+	Publish(ctx context.Context, channel string, message string) (int64, error)
+}
+
+type clusterPublisher interface {
+	Publish(ctx context.Context, channel string, message string, sharded bool) (int64, error)
+}
+
+// EventType is the kind of a geofence [Event].
+type EventType string
+
+const (
+	// Enter is published when an object moves inside a fence.
+	Enter EventType = "enter"
+	// Exit is published when an object moves outside a fence, or the fence is removed while the object is inside.
+	Exit EventType = "exit"
+)
+
+// Event is published as JSON to the channel of a fence when an object enters or exits it.
+type Event struct {
+	Type   EventType `json:"type"`
+	Fence  string    `json:"fence"`
+	Object string    `json:"object"`
+	// Position is the position of the object that triggered the event.
+	Position options.GeospatialData `json:"position"`
+}
+
+// Fences is a set of circular geofences.
+type Fences struct {
+	client        Client
+	name          string
+	channelPrefix string
+}
+
+// New returns the set of [Fences] with the given name. The keys of the set are "{name}:fences", "{name}:radii" and
+// "{name}:inside:<object>", which share a hash slot in cluster mode. Events are published to the channel
+// "geofence:<name>:<fence>", unless another prefix is set with [Fences.WithChannelPrefix].
+//
+// Parameters:
+//
+//	client - The client used to store the fences, e.g. a glide.Client or glide.ClusterClient.
+//	name - The name of the set of fences.
+func New(client Client, name string) *Fences {
+	return &Fences{client: client, name: name, channelPrefix: fmt.Sprintf("geofence:%s:", name)}
+}
+
+// WithChannelPrefix sets the prefix of the channels events are published to. The channel of a fence is the prefix
+// followed by the name of the fence.
+func (fences *Fences) WithChannelPrefix(prefix string) *Fences {
+	fences.channelPrefix = prefix
+	return fences
+}
+
+// Channel returns the channel the events of a fence are published to.
+func (fences *Fences) Channel(fence string) string {
+	return fences.channelPrefix + fence
+}
+
+func (fences *Fences) fencesKey() string { return fmt.Sprintf("{%s}:fences", fences.name) }
+
+func (fences *Fences) radiiKey() string { return fmt.Sprintf("{%s}:radii", fences.name) }
+
+func (fences *Fences) insideKey(object string) string {
+	return fmt.Sprintf("{%s}:inside:%s", fences.name, object)
+}
+
+// Add registers a circular fence, or moves it if a fence with the same name exists.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	fence - The name of the fence.
+//	center - The center of the fence.
+//	radius - The radius of the fence, in meters.
+func (fences *Fences) Add(ctx context.Context, fence string, center options.GeospatialData, radius float64) error {
+	if radius <= 0 {
+		return errors.New("the radius of a fence must be positive")
+	}
+	if _, err := fences.client.GeoAdd(ctx, fences.fencesKey(), map[string]options.GeospatialData{fence: center}); err != nil {
+		return err
+	}
+	_, err := fences.client.ZAdd(ctx, fences.radiiKey(), map[string]float64{fence: radius})
+	return err
+}
+
+// Remove unregisters a fence. Objects inside the fence get an [Exit] event on their next update.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	fence - The name of the fence.
+func (fences *Fences) Remove(ctx context.Context, fence string) error {
+	if _, err := fences.client.ZRem(ctx, fences.fencesKey(), []string{fence}); err != nil {
+		return err
+	}
+	_, err := fences.client.ZRem(ctx, fences.radiiKey(), []string{fence})
+	return err
+}
+
+// Inside returns the fences an object was inside of at its last update.
+func (fences *Fences) Inside(ctx context.Context, object string) (map[string]struct{}, error) {
+	return fences.client.SMembers(ctx, fences.insideKey(object))
+}
+
+// Update checks the new position of an object against the fences, and publishes an event for every fence the object
+// entered or exited since its previous update.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	object - The name of the tracked object.
+//	position - The new position of the object.
+//
+// Return value:
+//
+//	The published events, with the exits first.
+func (fences *Fences) Update(ctx context.Context, object string, position options.GeospatialData) ([]Event, error) {
+	inside, err := fences.containing(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := fences.client.SMembers(ctx, fences.insideKey(object))
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	var exited, entered []string
+	for fence := range previous {
+		if _, ok := inside[fence]; !ok {
+			exited = append(exited, fence)
+			events = append(events, Event{Type: Exit, Fence: fence, Object: object, Position: position})
+		}
+	}
+	for fence := range inside {
+		if _, ok := previous[fence]; !ok {
+			entered = append(entered, fence)
+			events = append(events, Event{Type: Enter, Fence: fence, Object: object, Position: position})
+		}
+	}
+	if len(exited) > 0 {
+		if _, err := fences.client.SRem(ctx, fences.insideKey(object), exited); err != nil {
+			return nil, err
+		}
+	}
+	if len(entered) > 0 {
+		if _, err := fences.client.SAdd(ctx, fences.insideKey(object), entered); err != nil {
+			return nil, err
+		}
+	}
+	for _, event := range events {
+		if err := fences.publish(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// containing returns the fences containing the given position. Candidates are searched within the largest radius,
+// and then filtered by their own radius.
+func (fences *Fences) containing(ctx context.Context, position options.GeospatialData) (map[string]struct{}, error) {
+	largest, err := fences.client.ZRangeWithScores(ctx, fences.radiiKey(), options.NewRangeByIndexQuery(0, 0).SetReverse())
+	if err != nil {
+		return nil, err
+	}
+	inside := make(map[string]struct{})
+	if len(largest) == 0 {
+		return inside, nil
+	}
+	candidates, err := fences.client.GeoSearchWithInfoOptions(
+		ctx,
+		fences.fencesKey(),
+		&options.GeoCoordOrigin{GeospatialData: position},
+		*options.NewCircleSearchShape(largest[0].Score, constants.GeoUnitMeters),
+		*options.NewGeoSearchInfoOptions().SetWithDist(true),
+	)
+	if err != nil || len(candidates) == 0 {
+		return inside, err
+	}
+	names := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		names[i] = candidate.Name
+	}
+	radii, err := fences.client.ZMScore(ctx, fences.radiiKey(), names)
+	if err != nil {
+		return nil, err
+	}
+	for i, candidate := range candidates {
+		if !radii[i].IsNil() && candidate.Dist <= radii[i].Value() {
+			inside[candidate.Name] = struct{}{}
+		}
+	}
+	return inside, nil
+}
+
+func (fences *Fences) publish(ctx context.Context, event Event) error {
+	message, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	switch publisher := fences.client.(type) {
+	case standalonePublisher:
+		_, err = publisher.Publish(ctx, fences.Channel(event.Fence), string(message))
+	case clusterPublisher:
+		_, err = publisher.Publish(ctx, fences.Channel(event.Fence), string(message), false)
+	default:
+		err = errors.New("the client does not support publishing geofence events")
+	}
+	return err
+}
@@ -33,6 +33,25 @@ func ExampleClient_GeoAdd() {
 	// 2
 }
 
+func ExampleClient_GeoAddMembers() {
+	client := getExampleClient()
+	key := uuid.New().String()
+
+	// A point from a geometry library, e.g. orb.Point, is laid out as [longitude, latitude].
+	catania := [2]float64{15.087269, 37.502669}
+	result, err := client.GeoAddMembers(context.Background(), key, []options.GeoMember{
+		{Name: "Palermo", Lon: 13.361389, Lat: 38.115556},
+		options.NewGeoMemberFromPoint("Catania", catania),
+	})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output:
+	// 2
+}
+
 func ExampleClusterClient_GeoAdd() {
 	client := getExampleClusterClient()
 
@@ -312,7 +331,37 @@ func ExampleClient_GeoSearchWithFullOptions() {
 	fmt.Println(result)
 
 	// Output:
-	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698}]
+	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698 km}]
+}
+
+func ExampleClient_GeoSearchWithFullOptions_distanceUnits() {
+	client := getExampleClient()
+
+	key := uuid.New().String()
+
+	AddInitialGeoData(client, key)
+	client.GeoAdd(context.Background(), key, map[string]options.GeospatialData{
+		"Catania": {Longitude: 15.087269, Latitude: 37.502669},
+	})
+
+	result, err := client.GeoSearchWithFullOptions(context.Background(),
+		key,
+		&options.GeoMemberOrigin{Member: "Palermo"},
+		*options.NewCircleSearchShape(200, constants.GeoUnitKilometers),
+		*options.NewGeoSearchResultOptions().SetSortOrder(options.ASC),
+		*options.NewGeoSearchInfoOptions().SetWithDist(true),
+	)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+
+	for _, location := range result {
+		fmt.Printf("%s: %.4f %s, %.4f mi\n", location.Name, location.Dist, location.Unit, location.Miles())
+	}
+
+	// Output:
+	// Palermo: 0.0000 km, 0.0000 mi
+	// Catania: 166.2742 km, 103.3182 mi
 }
 
 func ExampleClusterClient_GeoSearchWithFullOptions() {
@@ -336,7 +385,7 @@ func ExampleClusterClient_GeoSearchWithFullOptions() {
 	fmt.Println(result)
 
 	// Output:
-	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698}]
+	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698 km}]
 }
 
 func ExampleClient_GeoSearchWithInfoOptions() {
@@ -359,7 +408,7 @@ func ExampleClient_GeoSearchWithInfoOptions() {
 	fmt.Println(result)
 
 	// Output:
-	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698}]
+	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698 km}]
 }
 
 func ExampleClusterClient_GeoSearchWithInfoOptions() {
@@ -382,7 +431,7 @@ func ExampleClusterClient_GeoSearchWithInfoOptions() {
 	fmt.Println(result)
 
 	// Output:
-	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698}]
+	// [{Palermo {38.1155563954963 13.361389338970184} 0 3479099956230698 km}]
 }
 
 func ExampleClient_GeoSearchStore() {
@@ -7,6 +7,8 @@ import "C"
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 
@@ -61,10 +63,48 @@ func NewClient(config *config.ClientConfiguration) (*Client, error) {
 	}
 	if config.HasSubscription() {
 		subConfig := config.GetSubscription()
-		client.setMessageHandler(NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()))
+		client.setMessageHandler(
+			NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()).
+				withReplayBuffer(subConfig.GetReplayBufferSize()),
+		)
 	}
 
-	return &Client{*client}, nil
+	glideClient := &Client{*client}
+	if glideClient.heartbeat != nil {
+		glideClient.heartbeat.start(&glideClient.baseClient)
+	}
+	if glideClient.seedResolver != nil {
+		glideClient.seedResolver.start(&glideClient.baseClient)
+	}
+	return glideClient, nil
+}
+
+// WithSubscriptions creates a lightweight [Client] that shares the underlying connection of this client, but has
+// its own Pub/Sub subscriptions and message handler. This allows a single connection to serve multiple independent
+// consumers of channels. Messages on the channels and patterns of the derived client are delivered to its callback
+// or queue, and also to the message handler of this client if it subscribed to them in its configuration. The
+// subscriptions of the derived client are restored when the connection is lost.
+//
+// Closing the derived client unsubscribes the channels and patterns no other client relies on, and leaves the
+// shared connection open. Closing this client also closes all derived clients.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	subscriptionConfig - The channels and patterns to subscribe to, and the optional callback to receive messages.
+//
+// Return value:
+//
+//	A [Client] receiving the messages of the given subscriptions.
+func (client *Client) WithSubscriptions(
+	ctx context.Context,
+	subscriptionConfig *config.StandaloneSubscriptionConfig,
+) (*Client, error) {
+	derived := &Client{client.newDerivedClient(subscriptionConfig.BaseSubscriptionConfig)}
+	if err := derived.subscribe(ctx, subscriptionConfig.GetSubscriptions()); err != nil {
+		return nil, err
+	}
+	return derived, nil
 }
 
 // Executes a batch by processing the queued commands.
@@ -601,6 +641,326 @@ func (client *Client) ClientSetName(ctx context.Context, connectionName string)
 	return handleOkResponse(result)
 }
 
+// Starts a coordinated failover from the primary to one of its replicas. The failover runs asynchronously, its
+// progress can be observed through the replication section of [Client.InfoWithOptions].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The [options.FailoverOptions] type. Pass nil to fail over to any replica without a timeout.
+//
+// Return value:
+//
+//	OK to confirm that the failover was started, or aborted if [options.FailoverOptions.SetAbort] is used.
+//
+// [valkey.io]: https://valkey.io/commands/failover/
+func (client *Client) Failover(ctx context.Context, opts *options.FailoverOptions) (string, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	result, err := client.executeCommand(ctx, C.CustomCommand, append([]string{"FAILOVER"}, optionArgs...))
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Suspends all the clients of the server for the given duration, or only when they attempt to execute a write
+// command, depending on mode.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	duration - How long the clients are suspended for. The duration is rounded down to milliseconds.
+//	mode - Which commands are suspended, see [options.ClientPauseMode].
+//
+// Return value:
+//
+//	OK to confirm that the clients were paused.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *Client) ClientPause(
+	ctx context.Context,
+	duration time.Duration,
+	mode options.ClientPauseMode,
+) (string, error) {
+	if duration < 0 {
+		return models.DefaultStringResponse, errors.New("pause duration cannot be negative")
+	}
+	result, err := client.executeCommand(
+		ctx,
+		C.ClientPause,
+		[]string{utils.IntToString(duration.Milliseconds()), string(mode)},
+	)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Resumes the clients that were paused by [Client.ClientPause].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	OK to confirm that the clients were unpaused.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *Client) ClientUnpause(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnpause, []string{})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Synchronously saves the dataset to disk, blocking all the clients of the server until the save completes.
+// Prefer [Client.BgSave] on production servers.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	OK to confirm that the dataset was saved.
+//
+// [valkey.io]: https://valkey.io/commands/save/
+func (client *Client) Save(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"SAVE"})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Saves the dataset to disk in the background. The time of the last successful save can be checked with
+// [Client.LastSave].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	[models.BackgroundTaskStarted] once the save was started.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *Client) BgSave(ctx context.Context) (models.BackgroundTaskStatus, error) {
+	return client.BgSaveWithOptions(ctx, *options.NewBgSaveOptions())
+}
+
+// Saves the dataset to disk in the background. The time of the last successful save can be checked with
+// [Client.LastSave].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The [options.BgSaveOptions] type.
+//
+// Return value:
+//
+//	[models.BackgroundTaskStarted] once the save was started, or [models.BackgroundTaskScheduled] if the save
+//	was scheduled to run after an append only file rewrite in progress.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *Client) BgSaveWithOptions(
+	ctx context.Context,
+	opts options.BgSaveOptions,
+) (models.BackgroundTaskStatus, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.BackgroundTaskStarted, err
+	}
+	result, err := client.executeCommand(ctx, C.CustomCommand, append([]string{"BGSAVE"}, optionArgs...))
+	if err != nil {
+		return models.BackgroundTaskStarted, err
+	}
+	return handleBackgroundTaskResponse(result)
+}
+
+// Rewrites the append only file in the background.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	[models.BackgroundTaskStarted] once the rewrite was started, or [models.BackgroundTaskScheduled] if the
+//	rewrite was scheduled to run after a save in progress.
+//
+// [valkey.io]: https://valkey.io/commands/bgrewriteaof/
+func (client *Client) BgRewriteAof(ctx context.Context) (models.BackgroundTaskStatus, error) {
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"BGREWRITEAOF"})
+	if err != nil {
+		return models.BackgroundTaskStarted, err
+	}
+	return handleBackgroundTaskResponse(result)
+}
+
+// Shuts down the server. The connection closing as the server exits is reported as success.
+//
+// The client keeps trying to reconnect to the server afterwards, so it should be closed unless the server is expected
+// to be restarted.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The [options.ShutdownOptions] type. Pass nil to use the default behavior of the server.
+//
+// Return value:
+//
+//	OK once the server shut down, or once an ongoing shutdown was aborted if [options.ShutdownOptions.SetAbort]
+//	is used.
+//
+// [valkey.io]: https://valkey.io/commands/shutdown/
+func (client *Client) Shutdown(ctx context.Context, opts *options.ShutdownOptions) (string, error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	result, err := client.executeCommand(ctx, C.CustomCommand, append([]string{"SHUTDOWN"}, optionArgs...))
+	return handleShutdownResponse(result, err, opts != nil && opts.Abort)
+}
+
+// Makes the server a replica of the primary at the given address. If the server is already a replica, it stops
+// replicating its current primary and discards its dataset in favour of the new primary's.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	host - The host of the primary to replicate.
+//	port - The port of the primary to replicate.
+//
+// Return value:
+//
+//	OK to confirm that replication was set up.
+//
+// [valkey.io]: https://valkey.io/commands/replicaof/
+func (client *Client) ReplicaOf(ctx context.Context, host string, port int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"REPLICAOF", host, utils.IntToString(port)})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Stops replication and promotes the server to a primary, keeping its dataset.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	OK to confirm that the server was promoted.
+//
+// [valkey.io]: https://valkey.io/commands/replicaof/
+func (client *Client) ReplicaOfNoOne(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"REPLICAOF", "NO", "ONE"})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Makes the server a replica of the primary at the given address. SLAVEOF is the deprecated alias of REPLICAOF, kept for
+// code migrating from other clients.
+//
+// Deprecated: Use [Client.ReplicaOf] instead.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	host - The host of the primary to replicate.
+//	port - The port of the primary to replicate.
+//
+// Return value:
+//
+//	OK to confirm that replication was set up.
+//
+// [valkey.io]: https://valkey.io/commands/slaveof/
+func (client *Client) SlaveOf(ctx context.Context, host string, port int64) (string, error) {
+	// The core has no command mapping for SLAVEOF, so it is sent as a custom command.
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"SLAVEOF", host, utils.IntToString(port)})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Stops replication and promotes the server to a primary, keeping its dataset. SLAVEOF is the deprecated alias of
+// REPLICAOF, kept for code migrating from other clients.
+//
+// Deprecated: Use [Client.ReplicaOfNoOne] instead.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	OK to confirm that the server was promoted.
+//
+// [valkey.io]: https://valkey.io/commands/slaveof/
+func (client *Client) SlaveOfNoOne(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.CustomCommand, []string{"SLAVEOF", "NO", "ONE"})
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Gets the replication state of the server, parsed from the replication section of INFO.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [models.ReplicationInfo] describing the role, offsets, and replicas or primary of the server.
+//
+// [valkey.io]: https://valkey.io/commands/info/
+func (client *Client) ReplicationInfo(ctx context.Context) (models.ReplicationInfo, error) {
+	info, err := client.InfoWithOptions(ctx, options.InfoOptions{Sections: []constants.Section{constants.Replication}})
+	if err != nil {
+		return models.ReplicationInfo{}, err
+	}
+	return parseReplicationInfo(info)
+}
+
 // Move key from the currently selected database to the database specified by `dbIndex`.
 //
 // See [valkey.io] for details.
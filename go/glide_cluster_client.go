@@ -8,6 +8,8 @@ import "C"
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
@@ -64,12 +66,69 @@ func NewClusterClient(config *config.ClusterClientConfiguration) (*ClusterClient
 	if err != nil {
 		return nil, err
 	}
+	client.hedgeDelay = config.GetHedgeDelay()
+	client.routingOverrides = newRoutingOverrides()
+	if maxLag, interval := config.GetReplicaLagThreshold(); interval > 0 {
+		client.replicaLag = newReplicaLagMonitor(maxLag, interval)
+	}
+	if window := config.GetReadYourWritesWindow(); window > 0 {
+		client.readYourWrites = newReadYourWrites(window)
+	}
+	if interval := config.GetNodeStatsInterval(); interval > 0 {
+		client.nodeStats = newNodeStatsMonitor(interval)
+	}
 	if config.HasSubscription() {
 		subConfig := config.GetSubscription()
-		client.setMessageHandler(NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()))
+		client.setMessageHandler(
+			NewMessageHandler(subConfig.GetCallback(), subConfig.GetContext()).
+				withReplayBuffer(subConfig.GetReplayBufferSize()),
+		)
+	}
+
+	glideClient := &ClusterClient{*client}
+	if glideClient.heartbeat != nil {
+		glideClient.heartbeat.start(&glideClient.baseClient)
 	}
+	if glideClient.seedResolver != nil {
+		glideClient.seedResolver.start(&glideClient.baseClient)
+	}
+	if glideClient.replicaLag != nil {
+		glideClient.replicaLag.start(&glideClient.baseClient)
+	}
+	if glideClient.nodeStats != nil {
+		glideClient.nodeStats.start(&glideClient.baseClient)
+	}
+	return glideClient, nil
+}
 
-	return &ClusterClient{*client}, nil
+// WithSubscriptions creates a lightweight [ClusterClient] that shares the underlying connection of this client, but
+// has its own Pub/Sub subscriptions and message handler. This allows a single connection to serve multiple independent
+// consumers of channels. Messages on the channels and patterns of the derived client are delivered to its callback
+// or queue, and also to the message handler of this client if it subscribed to them in its configuration. The
+// subscriptions of the derived client are restored when a connection is lost, or when a sharded channel moves to
+// another node.
+//
+// Closing the derived client unsubscribes the channels and patterns no other client relies on, and leaves the
+// shared connection open. Closing this client also closes all derived clients.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	subscriptionConfig - The channels, patterns and sharded channels to subscribe to, and the optional callback to
+//	  receive messages. Sharded channels are available since Valkey version 7.0.
+//
+// Return value:
+//
+//	A [ClusterClient] receiving the messages of the given subscriptions.
+func (client *ClusterClient) WithSubscriptions(
+	ctx context.Context,
+	subscriptionConfig *config.ClusterSubscriptionConfig,
+) (*ClusterClient, error) {
+	derived := &ClusterClient{client.newDerivedClient(subscriptionConfig.BaseSubscriptionConfig)}
+	if err := derived.subscribe(ctx, subscriptionConfig.GetSubscriptions()); err != nil {
+		return nil, err
+	}
+	return derived, nil
 }
 
 // Executes a batch by processing the queued commands.
@@ -335,6 +394,74 @@ func (client *ClusterClient) CustomCommandWithRoute(ctx context.Context,
 	return models.CreateClusterValue[any](data), nil
 }
 
+// Gets the replication state of every node in the cluster, parsed from the replication section of INFO.
+//
+// The command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map where each address is the key and its corresponding [models.ReplicationInfo] is the value.
+//
+// [valkey.io]: https://valkey.io/commands/info/
+func (client *ClusterClient) ReplicationInfo(ctx context.Context) (models.ClusterValue[models.ReplicationInfo], error) {
+	return client.ReplicationInfoWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+}
+
+// Gets the replication state of the nodes defined by the route, parsed from the replication section of INFO.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route. If no route is provided, the command will be routed to all nodes.
+//
+// Return value:
+//
+//	When specifying a route other than a single node, it returns a map where each address is the key and its
+//	corresponding [models.ReplicationInfo] is the value. For a single node route it returns the
+//	[models.ReplicationInfo] of that node.
+//
+// [valkey.io]: https://valkey.io/commands/info/
+func (client *ClusterClient) ReplicationInfoWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.ReplicationInfo], error) {
+	route := opts.Route
+	if route == nil {
+		route = config.AllNodes
+	}
+	infoOpts := options.ClusterInfoOptions{
+		InfoOptions: &options.InfoOptions{Sections: []constants.Section{constants.Replication}},
+		RouteOption: &options.RouteOption{Route: route},
+	}
+	info, err := client.InfoWithOptions(ctx, infoOpts)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.ReplicationInfo](), err
+	}
+	if info.IsSingleValue() {
+		data, err := parseReplicationInfo(info.SingleValue())
+		if err != nil {
+			return models.CreateEmptyClusterValue[models.ReplicationInfo](), err
+		}
+		return models.CreateClusterSingleValue[models.ReplicationInfo](data), nil
+	}
+	data := make(map[string]models.ReplicationInfo, len(info.MultiValue()))
+	for node, nodeInfo := range info.MultiValue() {
+		if data[node], err = parseReplicationInfo(nodeInfo); err != nil {
+			return models.CreateEmptyClusterValue[models.ReplicationInfo](), err
+		}
+	}
+	return models.CreateClusterMultiValue[models.ReplicationInfo](data), nil
+}
+
 // Pings the server.
 // The command will be routed to all primary nodes.
 //
@@ -626,6 +753,19 @@ func (client *ClusterClient) clusterScan(
 	default:
 		// Continue with execution
 	}
+	if client.tenant != nil {
+		return nil, client.tenant.unsupported("Scan")
+	}
+	if client.commandFilter != nil {
+		if err := client.commandFilter.checkName("SCAN"); err != nil {
+			return nil, err
+		}
+	}
+	if client.quotaHook != nil {
+		if err := client.checkQuota(ctx, "SCAN", commandFamily(uint32(C.Scan)), 1, 0); err != nil {
+			return nil, err
+		}
+	}
 
 	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
 	resultChannel := make(chan payload, 1)
@@ -640,10 +780,13 @@ func (client *ClusterClient) clusterScan(
 		client.mu.Unlock()
 		return nil, NewClosingError("Cluster Scan failed. The client is closed.")
 	}
-	client.pending[resultChannelPtr] = struct{}{}
+	if err := client.registerPending(resultChannelPtr); err != nil {
+		client.mu.Unlock()
+		return nil, err
+	}
 
-	c_cursor := C.CString(cursor.GetCursor())
-	defer C.free(unsafe.Pointer(c_cursor))
+	c_cursor := cString(cursor.GetCursor())
+	defer freeCBuffer(unsafe.Pointer(c_cursor))
 
 	args, err := opts.ToArgs()
 	if err != nil {
@@ -681,7 +824,7 @@ func (client *ClusterClient) clusterScan(
 		go func() {
 			// Wait for payload on separate channel
 			if payload := <-resultChannel; payload.value != nil {
-				C.free_command_response(payload.value)
+				freeCommandResponse(payload.value)
 			}
 		}()
 		return nil, ctx.Err()
@@ -786,6 +929,74 @@ func (client *ClusterClient) ScanWithOptions(
 	return models.ClusterScanResult{Cursor: models.NewClusterScanCursorWithId(res.Cursor.String()), Keys: res.Data}, err
 }
 
+// Returns the number of keys in the given hash slot. The command is sent to the primary owning the slot, so the count
+// only covers the keys of the slot held by that node, e.g. the keys not yet migrated while the slot is migrating.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot, between 0 and 16383.
+//
+// Return value:
+//
+//	The number of keys in the slot.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-countkeysinslot/
+func (client *ClusterClient) ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error) {
+	if err := validateSlot(slot); err != nil {
+		return models.DefaultIntResponse, err
+	}
+	// The core has no dedicated request type for this subcommand, so it is sent as a custom command.
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.CustomCommand,
+		[]string{"CLUSTER", "COUNTKEYSINSLOT", utils.IntToString(slot)},
+		config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot)),
+	)
+	if err != nil {
+		return models.DefaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Returns up to count keys of the given hash slot, e.g. to relocate the keys of a slot. The command is sent to the
+// primary owning the slot. Calling it again returns the same keys unless they were moved or deleted in the meantime.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot, between 0 and 16383.
+//	count - The maximum number of keys to return. Must be positive.
+//
+// Return value:
+//
+//	The keys of the slot.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-getkeysinslot/
+func (client *ClusterClient) ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error) {
+	if err := validateSlot(slot); err != nil {
+		return nil, err
+	}
+	if count <= 0 {
+		return nil, fmt.Errorf("count must be positive, got %d", count)
+	}
+	// The core has no dedicated request type for this subcommand, so it is sent as a custom command.
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.CustomCommand,
+		[]string{"CLUSTER", "GETKEYSINSLOT", utils.IntToString(slot), utils.IntToString(count)},
+		config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
 // Displays a piece of generative computer art of the specific Valkey version and it's optional arguments.
 //
 // See [valkey.io] for details.
@@ -984,6 +1195,204 @@ func (client *ClusterClient) LastSaveWithOptions(
 	return models.CreateClusterSingleValue[int64](data), nil
 }
 
+// Synchronously saves the dataset of every primary to disk, blocking all the clients of each primary until its save
+// completes. Prefer [ClusterClient.BgSave] on production clusters.
+//
+// The command will be routed to all primary nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	OK to confirm that the dataset of every primary was saved.
+//
+// [valkey.io]: https://valkey.io/commands/save/
+func (client *ClusterClient) Save(ctx context.Context) (string, error) {
+	return client.SaveWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Synchronously saves the dataset of the nodes defined by the route to disk.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	OK to confirm that the dataset of all the routed nodes was saved.
+//
+// [valkey.io]: https://valkey.io/commands/save/
+func (client *ClusterClient) SaveWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.CustomCommand, []string{"SAVE"}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkOrMultiNodeOkResponse(response)
+}
+
+// Saves the dataset of every primary to disk in the background.
+//
+// The command will be routed to all primary nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map where each address is the key and its [models.BackgroundTaskStatus] is the value.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *ClusterClient) BgSave(ctx context.Context) (models.ClusterValue[models.BackgroundTaskStatus], error) {
+	return client.BgSaveWithOptions(ctx, options.ClusterBgSaveOptions{
+		RouteOption: &options.RouteOption{Route: config.AllPrimaries},
+	})
+}
+
+// Saves the dataset of the nodes defined by the route to disk in the background.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The [options.ClusterBgSaveOptions] type. If no route is provided, the command will be routed to all
+//	       primary nodes.
+//
+// Return value:
+//
+//	When specifying a route other than a single node, it returns a map where each address is the key and its
+//	[models.BackgroundTaskStatus] is the value. For a single node route it returns the status of that node.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+func (client *ClusterClient) BgSaveWithOptions(
+	ctx context.Context,
+	opts options.ClusterBgSaveOptions,
+) (models.ClusterValue[models.BackgroundTaskStatus], error) {
+	optionArgs, err := opts.BgSaveOptions.ToArgs()
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.BackgroundTaskStatus](), err
+	}
+	var route config.Route = config.AllPrimaries
+	if opts.RouteOption != nil && opts.RouteOption.Route != nil {
+		route = opts.RouteOption.Route
+	}
+	return client.executeBackgroundTask(ctx, append([]string{"BGSAVE"}, optionArgs...), route)
+}
+
+// Rewrites the append only file of every primary in the background.
+//
+// The command will be routed to all primary nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A map where each address is the key and its [models.BackgroundTaskStatus] is the value.
+//
+// [valkey.io]: https://valkey.io/commands/bgrewriteaof/
+func (client *ClusterClient) BgRewriteAof(ctx context.Context) (models.ClusterValue[models.BackgroundTaskStatus], error) {
+	return client.BgRewriteAofWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+}
+
+// Rewrites the append only file of the nodes defined by the route in the background.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route. If no route is provided, the command will be routed to all
+//	       primary nodes.
+//
+// Return value:
+//
+//	When specifying a route other than a single node, it returns a map where each address is the key and its
+//	[models.BackgroundTaskStatus] is the value. For a single node route it returns the status of that node.
+//
+// [valkey.io]: https://valkey.io/commands/bgrewriteaof/
+func (client *ClusterClient) BgRewriteAofWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (models.ClusterValue[models.BackgroundTaskStatus], error) {
+	var route config.Route = config.AllPrimaries
+	if opts.Route != nil {
+		route = opts.Route
+	}
+	return client.executeBackgroundTask(ctx, []string{"BGREWRITEAOF"}, route)
+}
+
+func (client *ClusterClient) executeBackgroundTask(
+	ctx context.Context,
+	args []string,
+	route config.Route,
+) (models.ClusterValue[models.BackgroundTaskStatus], error) {
+	response, err := client.executeCommandWithRoute(ctx, C.CustomCommand, args, route)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.BackgroundTaskStatus](), err
+	}
+	if route.IsMultiNode() {
+		data, err := handleBackgroundTaskMapResponse(response)
+		if err != nil {
+			return models.CreateEmptyClusterValue[models.BackgroundTaskStatus](), err
+		}
+		return models.CreateClusterMultiValue[models.BackgroundTaskStatus](data), nil
+	}
+	data, err := handleBackgroundTaskResponse(response)
+	if err != nil {
+		return models.CreateEmptyClusterValue[models.BackgroundTaskStatus](), err
+	}
+	return models.CreateClusterSingleValue[models.BackgroundTaskStatus](data), nil
+}
+
+// Shuts down the node defined by the route. The connection closing as the node exits is reported as success.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The [options.ClusterShutdownOptions] type. A route to a single node must be provided, so that a node is
+//	       never shut down by accident.
+//
+// Return value:
+//
+//	OK once the node shut down, or once an ongoing shutdown was aborted if [options.ShutdownOptions.SetAbort]
+//	is used.
+//
+// [valkey.io]: https://valkey.io/commands/shutdown/
+func (client *ClusterClient) ShutdownWithOptions(ctx context.Context, opts options.ClusterShutdownOptions) (string, error) {
+	if opts.RouteOption == nil || opts.RouteOption.Route == nil || opts.RouteOption.Route.IsMultiNode() {
+		return models.DefaultStringResponse, errors.New("SHUTDOWN requires a route to a single node")
+	}
+	optionArgs, err := opts.ShutdownOptions.ToArgs()
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	response, err := client.executeCommandWithRoute(
+		ctx,
+		C.CustomCommand,
+		append([]string{"SHUTDOWN"}, optionArgs...),
+		opts.RouteOption.Route,
+	)
+	return handleShutdownResponse(response, err, opts.ShutdownOptions != nil && opts.ShutdownOptions.Abort)
+}
+
 // Resets the statistics reported by the server using the INFO and LATENCY HISTOGRAM.
 //
 // See [valkey.io] for details.
@@ -1266,6 +1675,114 @@ func (client *ClusterClient) ClientGetNameWithOptions(
 	return models.CreateClusterSingleValue[models.Result[string]](data), nil
 }
 
+// Suspends all the clients of every node in the cluster for the given duration, or only when they attempt to execute
+// a write command, depending on mode. This allows a controlled failover, as no writes are accepted while the replicas
+// catch up with their primaries.
+//
+// The command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	duration - How long the clients are suspended for. The duration is rounded down to milliseconds.
+//	mode - Which commands are suspended, see [options.ClientPauseMode].
+//
+// Return value:
+//
+//	OK to confirm that the clients were paused on every node.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *ClusterClient) ClientPause(
+	ctx context.Context,
+	duration time.Duration,
+	mode options.ClientPauseMode,
+) (string, error) {
+	return client.ClientPauseWithOptions(ctx, duration, mode, options.RouteOption{Route: config.AllNodes})
+}
+
+// Suspends the clients of the nodes defined by the route for the given duration, or only when they attempt to
+// execute a write command, depending on mode.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	duration - How long the clients are suspended for. The duration is rounded down to milliseconds.
+//	mode - Which commands are suspended, see [options.ClientPauseMode].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	OK to confirm that the clients were paused on all the routed nodes.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *ClusterClient) ClientPauseWithOptions(
+	ctx context.Context,
+	duration time.Duration,
+	mode options.ClientPauseMode,
+	opts options.RouteOption,
+) (string, error) {
+	if duration < 0 {
+		return models.DefaultStringResponse, errors.New("pause duration cannot be negative")
+	}
+	response, err := client.executeCommandWithRoute(
+		ctx,
+		C.ClientPause,
+		[]string{utils.IntToString(duration.Milliseconds()), string(mode)},
+		opts.Route,
+	)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkOrMultiNodeOkResponse(response)
+}
+
+// Resumes the clients of every node in the cluster that were paused by [ClusterClient.ClientPause].
+//
+// The command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	OK to confirm that the clients were unpaused on every node.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *ClusterClient) ClientUnpause(ctx context.Context) (string, error) {
+	return client.ClientUnpauseWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+}
+
+// Resumes the clients of the nodes defined by the route.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	       command to the nodes defined by route.
+//
+// Return value:
+//
+//	OK to confirm that the clients were unpaused on all the routed nodes.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *ClusterClient) ClientUnpauseWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	response, err := client.executeCommandWithRoute(ctx, C.ClientUnpause, []string{}, opts.Route)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	return handleOkOrMultiNodeOkResponse(response)
+}
+
 // Rewrites the configuration file with the current configuration.
 // The command will be routed a random node.
 //
@@ -0,0 +1,336 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package glidepool manages named Valkey GLIDE clients shared by a whole process, so that large applications register
+// the configuration of every client once, at startup, and look the clients up by name where they are needed instead of
+// passing them through every constructor. Clients are created on first use, and closed together with [Pool.Close].
+//
+// The package-level functions operate on a default pool, see [Default].
+package glidepool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+var (
+	// ErrClosed is returned when a client is requested from a closed pool.
+	ErrClosed = errors.New("the pool is closed")
+	// ErrNotRegistered is returned when a client is requested under a name with no registered configuration.
+	ErrNotRegistered = errors.New("no client is registered under this name")
+)
+
+// client is the subset of the methods of glide.Client and glide.ClusterClient used by the pool.
+type client interface {
+	Ping(ctx context.Context) (string, error)
+	Statistics() glide.ClientStatistics
+	Close()
+}
+
+// entry is a registered client configuration, along with the client once it is created.
+type entry struct {
+	mu     sync.Mutex
+	create func() (client, error)
+	client client
+}
+
+// Pool holds named clients, created from their registered configuration on first use. It is safe for concurrent use.
+type Pool struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+	closed  bool
+}
+
+// New returns an empty [Pool].
+func New() *Pool {
+	return &Pool{entries: make(map[string]*entry)}
+}
+
+// Register registers the configuration of a standalone client under name. The client is created by the first call to
+// [Pool.Client] with this name.
+//
+// Parameters:
+//
+//	name - The name of the client.
+//	cfg - The configuration of the client.
+//
+// Return value:
+//
+//	An error if a client is already registered under name, or if the pool is closed.
+func (pool *Pool) Register(name string, cfg *config.ClientConfiguration) error {
+	return pool.register(name, func() (client, error) { return glide.NewClient(cfg) })
+}
+
+// RegisterCluster registers the configuration of a cluster client under name. The client is created by the first call
+// to [Pool.ClusterClient] with this name.
+//
+// Parameters:
+//
+//	name - The name of the client.
+//	cfg - The configuration of the client.
+//
+// Return value:
+//
+//	An error if a client is already registered under name, or if the pool is closed.
+func (pool *Pool) RegisterCluster(name string, cfg *config.ClusterClientConfiguration) error {
+	return pool.register(name, func() (client, error) { return glide.NewClusterClient(cfg) })
+}
+
+func (pool *Pool) register(name string, create func() (client, error)) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.closed {
+		return ErrClosed
+	}
+	if _, ok := pool.entries[name]; ok {
+		return fmt.Errorf("a client is already registered under %q", name)
+	}
+	pool.entries[name] = &entry{create: create}
+	return nil
+}
+
+// Client returns the standalone client registered under name, creating it on first use. If creating the client
+// fails, the error is returned and the next call tries again.
+//
+// Parameters:
+//
+//	name - The name of the client.
+//
+// Return value:
+//
+//	The client, or an error if no standalone client is registered under name, if the pool is closed, or if creating the
+//	client failed.
+func (pool *Pool) Client(name string) (*glide.Client, error) {
+	c, err := pool.get(name)
+	if err != nil {
+		return nil, err
+	}
+	standalone, ok := c.(*glide.Client)
+	if !ok {
+		return nil, fmt.Errorf("the client registered under %q is a cluster client", name)
+	}
+	return standalone, nil
+}
+
+// ClusterClient returns the cluster client registered under name, creating it on first use. If creating the client
+// fails, the error is returned and the next call tries again.
+//
+// Parameters:
+//
+//	name - The name of the client.
+//
+// Return value:
+//
+//	The client, or an error if no cluster client is registered under name, if the pool is closed, or if creating the
+//	client failed.
+func (pool *Pool) ClusterClient(name string) (*glide.ClusterClient, error) {
+	c, err := pool.get(name)
+	if err != nil {
+		return nil, err
+	}
+	cluster, ok := c.(*glide.ClusterClient)
+	if !ok {
+		return nil, fmt.Errorf("the client registered under %q is a standalone client", name)
+	}
+	return cluster, nil
+}
+
+func (pool *Pool) get(name string) (client, error) {
+	pool.mu.Lock()
+	entry, ok := pool.entries[name]
+	closed := pool.closed
+	pool.mu.Unlock()
+	if closed {
+		return nil, ErrClosed
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrNotRegistered, name)
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.client != nil {
+		return entry.client, nil
+	}
+	created, err := entry.create()
+	if err != nil {
+		return nil, err
+	}
+	// The pool may have been closed while the client was created, in which case the client would never be closed.
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if pool.closed {
+		created.Close()
+		return nil, ErrClosed
+	}
+	entry.client = created
+	return created, nil
+}
+
+// Names returns the sorted names of the registered clients.
+func (pool *Pool) Names() []string {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	names := make([]string, 0, len(pool.entries))
+	for name := range pool.entries {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// created returns the clients created so far, by name.
+func (pool *Pool) created() map[string]client {
+	pool.mu.Lock()
+	entries := maps.Clone(pool.entries)
+	pool.mu.Unlock()
+	clients := make(map[string]client, len(entries))
+	for name, entry := range entries {
+		entry.mu.Lock()
+		if entry.client != nil {
+			clients[name] = entry.client
+		}
+		entry.mu.Unlock()
+	}
+	return clients
+}
+
+// Health pings every client created so far, and reports the ones which are unhealthy: the ones whose ping failed, or
+// which are reported as unhealthy by their heartbeats, see config.AdvancedClientConfiguration.WithHeartbeat. Clients
+// which were not created yet are not reported.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the pings.
+//
+// Return value:
+//
+//	The error of every unhealthy client, by name. The map is empty if all clients are healthy.
+func (pool *Pool) Health(ctx context.Context) map[string]error {
+	unhealthy := make(map[string]error)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, c := range pool.created() {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Ping(ctx)
+			if err == nil && !c.Statistics().Healthy {
+				err = errors.New("heartbeats are failing")
+			}
+			if err != nil {
+				mu.Lock()
+				unhealthy[name] = err
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return unhealthy
+}
+
+// Statistics aggregates the statistics of the clients of a [Pool].
+type Statistics struct {
+	// Clients holds the statistics of every client created so far, by name.
+	Clients map[string]glide.ClientStatistics
+	// PendingCommands is the number of commands currently awaiting a response from any of the clients.
+	PendingCommands int
+	// BytesByFamily holds the traffic of all the clients per command family.
+	BytesByFamily map[string]glide.CommandBytes
+	// HedgedReads is the number of hedged reads of all the clients.
+	HedgedReads int64
+	// HedgeWins is the number of hedged reads of all the clients answered first by the duplicate.
+	HedgeWins int64
+	// CoalescedReads is the number of coalesced reads of all the clients.
+	CoalescedReads int64
+	// Unhealthy is the sorted names of the clients reported as unhealthy by their heartbeats.
+	Unhealthy []string
+}
+
+// Statistics returns the statistics of every client created so far, along with their totals.
+func (pool *Pool) Statistics() Statistics {
+	stats := Statistics{
+		Clients:       make(map[string]glide.ClientStatistics),
+		BytesByFamily: make(map[string]glide.CommandBytes),
+	}
+	for name, c := range pool.created() {
+		clientStats := c.Statistics()
+		stats.Clients[name] = clientStats
+		stats.PendingCommands += clientStats.PendingCommands
+		stats.HedgedReads += clientStats.HedgedReads
+		stats.HedgeWins += clientStats.HedgeWins
+		stats.CoalescedReads += clientStats.CoalescedReads
+		for family, bytes := range clientStats.BytesByFamily {
+			total := stats.BytesByFamily[family]
+			total.Commands += bytes.Commands
+			total.Sent += bytes.Sent
+			total.Received += bytes.Received
+			stats.BytesByFamily[family] = total
+		}
+		if !clientStats.Healthy {
+			stats.Unhealthy = append(stats.Unhealthy, name)
+		}
+	}
+	slices.Sort(stats.Unhealthy)
+	return stats
+}
+
+// Close closes every client created so far. Afterwards, no client can be registered or requested from the pool.
+// Closing a closed pool has no effect.
+func (pool *Pool) Close() {
+	pool.mu.Lock()
+	if pool.closed {
+		pool.mu.Unlock()
+		return
+	}
+	pool.closed = true
+	entries := pool.entries
+	pool.mu.Unlock()
+	for _, entry := range entries {
+		entry.mu.Lock()
+		if entry.client != nil {
+			entry.client.Close()
+			entry.client = nil
+		}
+		entry.mu.Unlock()
+	}
+}
+
+var defaultPool = New()
+
+// Default returns the default pool of the process, on which the package-level functions operate.
+func Default() *Pool {
+	return defaultPool
+}
+
+// Register registers the configuration of a standalone client under name in the default pool, see [Pool.Register].
+func Register(name string, cfg *config.ClientConfiguration) error {
+	return defaultPool.Register(name, cfg)
+}
+
+// RegisterCluster registers the configuration of a cluster client under name in the default pool, see
+// [Pool.RegisterCluster].
+func RegisterCluster(name string, cfg *config.ClusterClientConfiguration) error {
+	return defaultPool.RegisterCluster(name, cfg)
+}
+
+// Client returns the standalone client registered under name in the default pool, see [Pool.Client].
+func Client(name string) (*glide.Client, error) {
+	return defaultPool.Client(name)
+}
+
+// ClusterClient returns the cluster client registered under name in the default pool, see [Pool.ClusterClient].
+func ClusterClient(name string) (*glide.ClusterClient, error) {
+	return defaultPool.ClusterClient(name)
+}
+
+// Close closes the clients of the default pool, see [Pool.Close].
+func Close() {
+	defaultPool.Close()
+}
@@ -0,0 +1,107 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glidepool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+type fakeClient struct {
+	pingErr error
+	stats   glide.ClientStatistics
+	closed  bool
+}
+
+func (c *fakeClient) Ping(_ context.Context) (string, error) {
+	return "PONG", c.pingErr
+}
+
+func (c *fakeClient) Statistics() glide.ClientStatistics {
+	return c.stats
+}
+
+func (c *fakeClient) Close() {
+	c.closed = true
+}
+
+func TestPool_Registry(t *testing.T) {
+	pool := New()
+	assert.NoError(t, pool.Register("cache", config.NewClientConfiguration()))
+	assert.NoError(t, pool.RegisterCluster("sessions", config.NewClusterClientConfiguration()))
+	assert.EqualError(t, pool.Register("cache", config.NewClientConfiguration()),
+		`a client is already registered under "cache"`)
+	assert.Equal(t, []string{"cache", "sessions"}, pool.Names())
+
+	_, err := pool.Client("unknown")
+	assert.ErrorIs(t, err, ErrNotRegistered)
+
+	pool.Close()
+	_, err = pool.Client("cache")
+	assert.ErrorIs(t, err, ErrClosed)
+	assert.ErrorIs(t, pool.Register("other", config.NewClientConfiguration()), ErrClosed)
+}
+
+func TestPool_LazyCreation(t *testing.T) {
+	pool := New()
+	created := 0
+	fake := &fakeClient{}
+	createErr := errors.New("connection refused")
+	require.NoError(t, pool.register("cache", func() (client, error) {
+		created++
+		if created == 1 {
+			return nil, createErr
+		}
+		return fake, nil
+	}))
+	assert.Empty(t, pool.created())
+
+	_, err := pool.get("cache")
+	assert.ErrorIs(t, err, createErr)
+	c, err := pool.get("cache")
+	assert.NoError(t, err)
+	assert.Same(t, fake, c)
+	_, err = pool.get("cache")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, created)
+
+	_, err = pool.Client("cache")
+	assert.EqualError(t, err, `the client registered under "cache" is a cluster client`)
+
+	pool.Close()
+	assert.True(t, fake.closed)
+}
+
+func TestPool_HealthAndStatistics(t *testing.T) {
+	pool := New()
+	healthy := &fakeClient{stats: glide.ClientStatistics{
+		Healthy:         true,
+		PendingCommands: 2,
+		BytesByFamily:   map[string]glide.CommandBytes{"String": {Commands: 1, Sent: 10, Received: 5}},
+	}}
+	failing := &fakeClient{pingErr: errors.New("timeout"), stats: glide.ClientStatistics{
+		PendingCommands: 3,
+		BytesByFamily:   map[string]glide.CommandBytes{"String": {Commands: 2, Sent: 20, Received: 10}},
+	}}
+	require.NoError(t, pool.register("healthy", func() (client, error) { return healthy, nil }))
+	require.NoError(t, pool.register("failing", func() (client, error) { return failing, nil }))
+	require.NoError(t, pool.register("unused", func() (client, error) { return nil, errors.New("unused") }))
+	_, _ = pool.get("healthy")
+	_, _ = pool.get("failing")
+
+	unhealthy := pool.Health(context.Background())
+	assert.Len(t, unhealthy, 1)
+	assert.EqualError(t, unhealthy["failing"], "timeout")
+
+	stats := pool.Statistics()
+	assert.Len(t, stats.Clients, 2)
+	assert.Equal(t, 5, stats.PendingCommands)
+	assert.Equal(t, glide.CommandBytes{Commands: 3, Sent: 30, Received: 15}, stats.BytesByFamily["String"])
+	assert.Equal(t, []string{"failing"}, stats.Unhealthy)
+}
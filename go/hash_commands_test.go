@@ -725,6 +725,50 @@ func ExampleClusterClient_HRandFieldWithCountWithValues() {
 	// Output: true
 }
 
+func ExampleClient_HRandFieldWithRandomCount() {
+	var client *Client = getExampleClient() // example helper function
+
+	fields := map[string]string{
+		"field1": "someValue",
+		"field2": "someOtherValue",
+	}
+
+	client.HSet(context.Background(), "my_hash", fields)
+	unique, err := client.HRandFieldWithRandomCount(context.Background(), "my_hash", options.Unique(5))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	repeated, err := client.HRandFieldWithRandomCount(context.Background(), "my_hash", options.AllowRepeats(5))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(unique), len(repeated))
+
+	// Output: 2 5
+}
+
+func ExampleClusterClient_HRandFieldWithRandomCount() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	fields := map[string]string{
+		"field1": "someValue",
+		"field2": "someOtherValue",
+	}
+
+	client.HSet(context.Background(), "my_hash", fields)
+	unique, err := client.HRandFieldWithRandomCount(context.Background(), "my_hash", options.Unique(5))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	repeated, err := client.HRandFieldWithRandomCount(context.Background(), "my_hash", options.AllowRepeats(5))
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(len(unique), len(repeated))
+
+	// Output: 2 5
+}
+
 func ExampleClient_HScanWithOptions() {
 	var client *Client = getExampleClient() // example helper function
 
@@ -0,0 +1,156 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// heartbeat periodically sends a PING to every node the client is connected to, so that half-open connections are
+// detected early, and replaces the core client once the failures reach the threshold. The core only reconnects
+// connections which are closed, not the ones which stop responding. It is shared by pointer between the copies of a
+// client.
+type heartbeat struct {
+	interval         time.Duration
+	failureThreshold int
+	clusterMode      bool
+	client           *baseClient
+	// connect creates a new core client from the connection request of the client.
+	connect func() (unsafe.Pointer, error)
+
+	mu                  sync.Mutex
+	lastSuccess         time.Time
+	consecutiveFailures int
+	reconnections       int64
+	stop                chan struct{}
+	stopOnce            sync.Once
+}
+
+func newHeartbeat(
+	interval time.Duration,
+	failureThreshold int,
+	clusterMode bool,
+	connect func() (unsafe.Pointer, error),
+) *heartbeat {
+	return &heartbeat{
+		interval:         interval,
+		failureThreshold: failureThreshold,
+		clusterMode:      clusterMode,
+		connect:          connect,
+		stop:             make(chan struct{}),
+	}
+}
+
+// start begins sending heartbeats through the given client. It must be the client returned to the user, so that
+// heartbeats stop reaching the core once that client is closed.
+func (hb *heartbeat) start(client *baseClient) {
+	hb.client = client
+	go hb.run()
+}
+
+// ping sends a PING to every node of the client, failing if any node does not respond. The PING is internal, so it is
+// not seen by the hooks of the client and not counted in its statistics.
+func (hb *heartbeat) ping(ctx context.Context) error {
+	var route config.Route
+	if hb.clusterMode {
+		route = config.AllNodes
+	}
+	result, err := hb.client.executeInternalCommand(ctx, C.Ping, []string{}, route)
+	if err != nil {
+		return err
+	}
+	freeCommandResponse(result)
+	return nil
+}
+
+func (hb *heartbeat) run() {
+	ticker := time.NewTicker(hb.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-hb.stop:
+			return
+		case <-ticker.C:
+			hb.beat()
+		}
+	}
+}
+
+func (hb *heartbeat) beat() {
+	ctx, cancel := context.WithTimeout(context.Background(), hb.interval)
+	defer cancel()
+	if !hb.record(hb.ping(ctx)) {
+		return
+	}
+	// Every failed heartbeat past the threshold reconnects again, until a heartbeat succeeds.
+	if err := hb.client.reconnect(ctx, hb.connect); err == nil {
+		hb.mu.Lock()
+		hb.reconnections++
+		hb.mu.Unlock()
+	}
+}
+
+// record records the outcome of a heartbeat, and reports whether the failures reached the threshold.
+func (hb *heartbeat) record(err error) bool {
+	hb.mu.Lock()
+	defer hb.mu.Unlock()
+	if err != nil {
+		hb.consecutiveFailures++
+		return hb.consecutiveFailures >= hb.failureThreshold
+	}
+	hb.consecutiveFailures = 0
+	hb.lastSuccess = time.Now()
+	return false
+}
+
+func (hb *heartbeat) close() {
+	hb.stopOnce.Do(func() { close(hb.stop) })
+}
+
+// snapshot returns the time of the last successful heartbeat, the number of consecutive failures, whether the
+// failures have not yet reached the configured threshold, and the number of reconnections they triggered.
+func (hb *heartbeat) snapshot() (time.Time, int, bool, int64) {
+	hb.mu.Lock()
+	defer hb.mu.Unlock()
+	return hb.lastSuccess, hb.consecutiveFailures, hb.consecutiveFailures < hb.failureThreshold, hb.reconnections
+}
+
+// reconnect replaces the core client with a new one created by connect. Commands in flight complete on the previous
+// core client, which is closed once they are done, and the subscriptions of the derived clients are restored on the
+// new one.
+func (client *baseClient) reconnect(ctx context.Context, connect func() (unsafe.Pointer, error)) error {
+	client.mu.Lock()
+	closed := client.coreClient == nil
+	client.mu.Unlock()
+	if closed {
+		return NewClosingError("reconnect failed: the client is closed")
+	}
+
+	coreClient, err := connect()
+	if err != nil {
+		return err
+	}
+	client.mu.Lock()
+	previous := client.coreClient
+	if previous == nil {
+		client.mu.Unlock()
+		C.close_client(coreClient)
+		return NewClosingError("reconnect failed: the client is closed")
+	}
+	registerClient(client, uintptr(coreClient))
+	unregisterClient(uintptr(previous))
+	client.coreClient = coreClient
+	client.subscribers.reattachAll(coreClient)
+	client.mu.Unlock()
+	C.close_client(previous)
+
+	return client.resubscribe(ctx)
+}
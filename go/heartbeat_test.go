@@ -0,0 +1,37 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeartbeatFailureThreshold(t *testing.T) {
+	// A closed client fails every heartbeat without reaching the core.
+	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), mu: &sync.Mutex{}, stats: &clientStats{}}
+	hb := newHeartbeat(time.Second, 2, false, nil)
+	hb.client = client
+
+	hb.beat()
+	lastSuccess, failures, healthy, _ := hb.snapshot()
+	assert.True(t, lastSuccess.IsZero())
+	assert.Equal(t, 1, failures)
+	assert.True(t, healthy)
+
+	hb.beat()
+	// Reaching the threshold reconnects, which fails on a closed client.
+	_, failures, healthy, reconnections := hb.snapshot()
+	assert.Equal(t, 2, failures)
+	assert.False(t, healthy)
+	assert.Zero(t, reconnections)
+
+	assert.False(t, hb.record(nil))
+	_, failures, healthy, _ = hb.snapshot()
+	assert.Zero(t, failures)
+	assert.True(t, healthy)
+}
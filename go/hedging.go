@@ -0,0 +1,179 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// hedgeableRequestTypes are the read-only commands taking a single key as their first argument, which can be sent to
+// any replica of the key's shard.
+var hedgeableRequestTypes = map[C.RequestType]struct{}{
+	C.BitCount:       {},
+	C.BitPos:         {},
+	C.Dump:           {},
+	C.ExpireTime:     {},
+	C.GeoDist:        {},
+	C.GeoHash:        {},
+	C.GeoPos:         {},
+	C.GeoSearch:      {},
+	C.Get:            {},
+	C.GetBit:         {},
+	C.GetRange:       {},
+	C.HExists:        {},
+	C.HGet:           {},
+	C.HGetAll:        {},
+	C.HKeys:          {},
+	C.HLen:           {},
+	C.HMGet:          {},
+	C.HRandField:     {},
+	C.HStrlen:        {},
+	C.HVals:          {},
+	C.LIndex:         {},
+	C.LLen:           {},
+	C.LPos:           {},
+	C.LRange:         {},
+	C.ObjectEncoding: {},
+	C.PExpireTime:    {},
+	C.PTTL:           {},
+	C.SCard:          {},
+	C.SIsMember:      {},
+	C.SMIsMember:     {},
+	C.SMembers:       {},
+	C.SRandMember:    {},
+	C.Strlen:         {},
+	C.TTL:            {},
+	C.Type:           {},
+	C.XLen:           {},
+	C.XRange:         {},
+	C.XRevRange:      {},
+	C.ZCard:          {},
+	C.ZCount:         {},
+	C.ZLexCount:      {},
+	C.ZMScore:        {},
+	C.ZRandMember:    {},
+	C.ZRange:         {},
+	C.ZRank:          {},
+	C.ZRevRank:       {},
+	C.ZScore:         {},
+}
+
+// hedgeKey returns the key used to route the hedge of a read, and whether the read can be hedged at all.
+func (client *baseClient) hedgeKey(requestType C.RequestType, args []string) (string, bool) {
+	if client.hedgeDelay <= 0 {
+		return "", false
+	}
+	return readKey(requestType, args)
+}
+
+// readKey returns the key of a single-key read, and whether the command is such a read. Custom commands are reads if
+// they were registered by [RegisterReadOnlyCommand].
+func readKey(requestType C.RequestType, args []string) (string, bool) {
+	if requestType == C.CustomCommand {
+		if len(args) < 2 || !isRegisteredReadOnlyCommand(strings.ToUpper(args[0])) {
+			return "", false
+		}
+		return args[1], true
+	}
+	if _, ok := hedgeableRequestTypes[requestType]; !ok || len(args) == 0 {
+		return "", false
+	}
+	return args[0], true
+}
+
+type hedgeResult struct {
+	response *C.struct_CommandResponse
+	err      error
+	hedge    bool
+}
+
+// executeHedged sends a read with the default routing, and a duplicate of it to a replica of the key's shard if no
+// response arrived within the hedge delay. The first successful response is returned, and the other is released.
+//
+// Only the original read goes through the hooks, limits and statistics of the client, so that a hedged read is
+// accounted for once. The hedge is sent as an internal command, with the keys of the tenant if any.
+func (client *baseClient) executeHedged(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	key string,
+) (*C.struct_CommandResponse, error) {
+	hedgeArgs := args
+	if client.tenant != nil {
+		scoped, err := client.tenant.scope(requestType, args)
+		if err != nil {
+			// The read is rejected the same way, without being hedged.
+			return client.executeCommandWithRoute(ctx, requestType, args, nil)
+		}
+		hedgeArgs = scoped
+		key, _ = readKey(requestType, scoped)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan hedgeResult, 2)
+	go func() {
+		response, err := client.executeCommandWithRoute(ctx, requestType, args, nil)
+		results <- hedgeResult{response: response, err: err}
+	}()
+	sendHedge := func(route config.Route) {
+		hedgeCtx, cancelTimeout, _ := client.runtime.withRequestTimeout(ctx, requestType)
+		defer cancelTimeout()
+		response, err := client.executeInternalCommand(hedgeCtx, requestType, hedgeArgs, route)
+		results <- hedgeResult{response: response, err: err, hedge: true}
+	}
+
+	timer := time.NewTimer(client.hedgeDelay)
+	defer timer.Stop()
+	inFlight, hedged := 1, false
+	var firstErr error
+	for {
+		select {
+		case <-timer.C:
+			if hedged {
+				continue
+			}
+			hedged = true
+			inFlight++
+			go sendHedge(config.NewSlotKeyRoute(config.SlotTypeReplica, key))
+			client.mu.Lock()
+			client.stats.hedgedReads++
+			client.mu.Unlock()
+		case result := <-results:
+			inFlight--
+			if result.err == nil {
+				if inFlight > 0 {
+					go releaseHedgeResults(results, inFlight)
+				}
+				if result.hedge {
+					client.mu.Lock()
+					client.stats.hedgeWins++
+					client.mu.Unlock()
+				}
+				return result.response, nil
+			}
+			// Prefer the error of the original read, since the hedge may have been cancelled by it.
+			if firstErr == nil || !result.hedge {
+				firstErr = result.err
+			}
+			if inFlight == 0 {
+				return nil, firstErr
+			}
+		}
+	}
+}
+
+// releaseHedgeResults frees the responses of the reads that lost the race.
+func releaseHedgeResults(results <-chan hedgeResult, count int) {
+	for i := 0; i < count; i++ {
+		if result := <-results; result.response != nil {
+			freeCommandResponse(result.response)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestExecuteHedged_AccountedOnce(t *testing.T) {
+	var quotaCalls, auditRecords atomic.Int32
+	client := &baseClient{
+		pending:    make(map[unsafe.Pointer]struct{}),
+		mu:         &sync.Mutex{},
+		stats:      &clientStats{},
+		hedgeDelay: time.Millisecond,
+		quotaHook: func(ctx context.Context, request config.QuotaRequest) error {
+			quotaCalls.Add(1)
+			// Delay the original read past the hedge delay, so that the read is hedged.
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		},
+		auditHook:      func(record config.AuditRecord) { auditRecords.Add(1) },
+		auditRedaction: config.NewRedaction(),
+		tenant:         &tenantScope{id: "t1", prefix: "t1:", limiter: newTokenBucket(0.001, 2, time.Now())},
+	}
+
+	_, err := client.Get(context.Background(), "key")
+	assert.IsType(t, &ClosingError{}, err)
+	assert.Equal(t, int64(1), client.Statistics().HedgedReads)
+	assert.Equal(t, int32(1), quotaCalls.Load())
+	assert.Equal(t, int32(1), auditRecords.Load())
+	// The read took a single token of the tenant, out of two.
+	assert.NoError(t, client.tenant.allow(1))
+	assert.Error(t, client.tenant.allow(1))
+}
@@ -0,0 +1,136 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// hyperLogLogCopyTTL is the expiry of the copies of HyperLogLogs made by PfCountAcrossSlots and PfMergeAcrossSlots, so
+// that they are removed even if the client fails to delete them.
+const hyperLogLogCopyTTL = time.Minute
+
+// PfCountAcrossSlots estimates the combined cardinality of the HyperLogLogs stored at keys, like [ClusterClient.PfCount],
+// but the keys may map to different hash slots. The HyperLogLogs whose keys do not map to the slot of the first key are
+// copied with DUMP and RESTORE to temporary keys of that slot, which are deleted once counted. Keys that do not exist
+// count as empty HyperLogLogs.
+//
+// Since the HyperLogLogs are copied one at a time, the estimation is not atomic.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys of the HyperLogLog data structures to be analyzed.
+//
+// Return value:
+//
+//	The approximated cardinality of the union of the given HyperLogLog data structures.
+//
+// [valkey.io]: https://valkey.io/commands/pfcount/
+func (client *ClusterClient) PfCountAcrossSlots(ctx context.Context, keys []string) (int64, error) {
+	if len(keys) == 0 {
+		return client.PfCount(ctx, keys)
+	}
+	colocated, cleanup, err := client.colocateHyperLogLogs(ctx, keys[0], keys)
+	defer cleanup()
+	if err != nil {
+		return 0, err
+	}
+	return client.PfCount(ctx, colocated)
+}
+
+// PfMergeAcrossSlots merges multiple HyperLogLogs into destination, like [ClusterClient.PfMerge], but the source keys
+// may map to other hash slots than destination. The HyperLogLogs whose keys do not map to the slot of destination are
+// copied with DUMP and RESTORE to temporary keys of that slot, which are deleted once merged. Source keys that do not
+// exist are ignored.
+//
+// Since the HyperLogLogs are copied one at a time, the merge is not atomic.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	destination - The key of the destination HyperLogLog where the merged data sets will be stored.
+//	sourceKeys - The keys of the HyperLogLog structures to be merged.
+//
+// Return value:
+//
+//	"OK" if the HyperLogLogs were merged.
+//
+// [valkey.io]: https://valkey.io/commands/pfmerge/
+func (client *ClusterClient) PfMergeAcrossSlots(ctx context.Context, destination string, sourceKeys []string) (string, error) {
+	colocated, cleanup, err := client.colocateHyperLogLogs(ctx, destination, sourceKeys)
+	defer cleanup()
+	if err != nil {
+		return "", err
+	}
+	return client.PfMerge(ctx, destination, colocated)
+}
+
+// colocateHyperLogLogs returns keys holding the HyperLogLogs stored at keys, which all map to the slot of anchor: the
+// keys of that slot, and temporary copies of the others. The returned function deletes the copies.
+func (client *ClusterClient) colocateHyperLogLogs(
+	ctx context.Context,
+	anchor string,
+	keys []string,
+) ([]string, func(), error) {
+	slot := keySlot(anchor)
+	tag := sameSlotTag(anchor)
+	colocated := make([]string, 0, len(keys))
+	var copies []string
+	cleanup := func() {
+		if len(copies) > 0 {
+			_, _ = client.Del(context.WithoutCancel(ctx), copies)
+		}
+	}
+	for _, key := range keys {
+		if keySlot(key) == slot {
+			colocated = append(colocated, key)
+			continue
+		}
+		dump, err := client.Dump(ctx, key)
+		if err != nil {
+			return nil, cleanup, err
+		}
+		if dump.IsNil() {
+			continue
+		}
+		suffix := make([]byte, 8)
+		if _, err := rand.Read(suffix); err != nil {
+			return nil, cleanup, err
+		}
+		copyKey := tag + ":hll-copy:" + hex.EncodeToString(suffix)
+		if _, err := client.Restore(ctx, copyKey, hyperLogLogCopyTTL, dump.Value()); err != nil {
+			return nil, cleanup, err
+		}
+		copies = append(copies, copyKey)
+		colocated = append(colocated, copyKey)
+	}
+	return colocated, cleanup, nil
+}
+
+// sameSlotTag returns a hash tag, including its braces, such that the keys starting with it map to the slot of key.
+func sameSlotTag(key string) string {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			return key[start : start+end+2]
+		}
+	}
+	if key != "" && !strings.ContainsAny(key, "{}") {
+		return "{" + key + "}"
+	}
+	slot := keySlot(key)
+	for idx := 0; ; idx++ {
+		if tag := strconv.Itoa(idx); keySlot(tag) == slot {
+			return "{" + tag + "}"
+		}
+	}
+}
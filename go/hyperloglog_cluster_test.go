@@ -0,0 +1,19 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameSlotTag(t *testing.T) {
+	for _, key := range []string{"foo", "{user1}:a", "a{b}c{d}", "{}x", "x{", "}", ""} {
+		tag := sameSlotTag(key)
+		assert.Equal(t, keySlot(key), keySlot(tag+":copy"), key)
+	}
+	assert.Equal(t, "{foo}", sameSlotTag("foo"))
+	assert.Equal(t, "{user1}", sameSlotTag("{user1}:a"))
+	assert.Equal(t, "{b}", sameSlotTag("a{b}c{d}"))
+}
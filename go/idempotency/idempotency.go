@@ -0,0 +1,163 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package idempotency runs side effects at most once per idempotency key, built on a Valkey GLIDE client, e.g. for
+// webhook and event handlers receiving the same delivery several times. The first call with a key claims it and runs
+// the side effect, and the calls that follow replay its recorded outcome instead of running it again.
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+const (
+	// DefaultPrefix is the prefix of the idempotency keys if none is set with [Store.WithPrefix].
+	DefaultPrefix = "idempotency:"
+
+	pendingPrefix = "pending:"
+	donePrefix    = "done:"
+	ownerBytes    = 16
+	claimAttempts = 3
+)
+
+var (
+	// ErrInProgress is returned by [Store.Idempotent] when the key is claimed by another call that has not completed.
+	ErrInProgress = errors.New("idempotency key is claimed by a call in progress")
+	// ErrClaimLost is returned by [Store.Idempotent] along with the outcome of the side effect, when the claim of the
+	// key expired before the side effect completed, so that its outcome could not be recorded.
+	ErrClaimLost = errors.New("idempotency key claim expired before the outcome was recorded")
+)
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the store.
+type Client interface {
+	SetWithOptions(ctx context.Context, key string, value string, options options.SetOptions) (models.Result[string], error)
+	Get(ctx context.Context, key string) (models.Result[string], error)
+	CompareAndSet(ctx context.Context, key string, expected string, newValue string) (bool, error)
+	CompareAndDelete(ctx context.Context, key string, expected string) (bool, error)
+}
+
+// Outcome is the result of a side effect run by [Store.Idempotent].
+type Outcome struct {
+	// Value is the value returned by the side effect.
+	Value string
+	// Replayed is true if the value was recorded by a previous call, rather than returned by running the side effect.
+	Replayed bool
+}
+
+// Store records the outcomes of side effects under idempotency keys. Each key is a string set with NX, holding a
+// random value identifying the call that claimed it while its side effect runs, and then the value returned by the side
+// effect. Failed side effects are not recorded: their claim is released, so that they can be retried.
+type Store struct {
+	client Client
+	prefix string
+}
+
+// NewStore returns a [Store] keeping the outcomes in client.
+//
+// Parameters:
+//
+//	client - The client used to store the outcomes, e.g. a glide.Client or glide.ClusterClient.
+func NewStore(client Client) *Store {
+	return &Store{client: client, prefix: DefaultPrefix}
+}
+
+// WithPrefix sets the prefix of the idempotency keys. If not explicitly set, [DefaultPrefix] is used.
+func (store *Store) WithPrefix(prefix string) *Store {
+	store.prefix = prefix
+	return store
+}
+
+func newOwner() (string, error) {
+	buf := make([]byte, ownerBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Idempotent runs fn once for key, and returns its recorded outcome to the calls that follow, until ttl elapsed since
+// the key was claimed. The claim and the outcome share the same time to live, so ttl must exceed the duration of fn,
+// otherwise the claim expires and another call may run fn again.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution, passed to fn.
+//	key - The idempotency key, e.g. the ID of a webhook delivery.
+//	ttl - The time the key stays claimed and its outcome recorded. It must be at least one millisecond.
+//	fn - The side effect, returning the value to record.
+//
+// Return value:
+//
+//	The [Outcome] of fn, the error of fn if it failed, [ErrInProgress] if another call is running fn for key, or
+//	[ErrClaimLost] along with the outcome if the claim expired before fn completed.
+func (store *Store) Idempotent(
+	ctx context.Context,
+	key string,
+	ttl time.Duration,
+	fn func(ctx context.Context) (string, error),
+) (Outcome, error) {
+	if ttl < time.Millisecond {
+		return Outcome{}, errors.New("idempotency ttl must be at least one millisecond")
+	}
+	owner, err := newOwner()
+	if err != nil {
+		return Outcome{}, err
+	}
+	fullKey := store.prefix + key
+	claim := pendingPrefix + owner
+	setOptions := options.NewSetOptions().
+		SetOnlyIfDoesNotExist().
+		SetExpiry(options.NewExpiryIn(ttl.Truncate(time.Millisecond)))
+	// The key may expire or be released between a failed claim and its read, in which case it is claimed again.
+	for range claimAttempts {
+		claimed, err := store.client.SetWithOptions(ctx, fullKey, claim, *setOptions)
+		if err != nil {
+			return Outcome{}, err
+		}
+		if !claimed.IsNil() {
+			return store.run(ctx, fullKey, claim, fn)
+		}
+		current, err := store.client.Get(ctx, fullKey)
+		if err != nil {
+			return Outcome{}, err
+		}
+		if current.IsNil() {
+			continue
+		}
+		if value, ok := strings.CutPrefix(current.Value(), donePrefix); ok {
+			return Outcome{Value: value, Replayed: true}, nil
+		}
+		return Outcome{}, ErrInProgress
+	}
+	return Outcome{}, ErrInProgress
+}
+
+// run runs fn under the claim of key, and records its outcome, or releases the claim if it failed.
+func (store *Store) run(
+	ctx context.Context,
+	key string,
+	claim string,
+	fn func(ctx context.Context) (string, error),
+) (Outcome, error) {
+	value, err := fn(ctx)
+	if err != nil {
+		// The claim is released even if ctx is done, so that the side effect can be retried.
+		_, _ = store.client.CompareAndDelete(context.WithoutCancel(ctx), key, claim)
+		return Outcome{}, err
+	}
+	recorded, err := store.client.CompareAndSet(context.WithoutCancel(ctx), key, claim, donePrefix+value)
+	if err != nil {
+		return Outcome{Value: value}, err
+	}
+	if !recorded {
+		return Outcome{Value: value}, ErrClaimLost
+	}
+	return Outcome{Value: value}, nil
+}
@@ -0,0 +1,133 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// fakeClient keeps strings in memory, ignoring their expiry.
+type fakeClient struct {
+	mu      sync.Mutex
+	strings map[string]string
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{strings: make(map[string]string)}
+}
+
+func (c *fakeClient) SetWithOptions(
+	_ context.Context,
+	key string,
+	value string,
+	setOptions options.SetOptions,
+) (models.Result[string], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.strings[key]; ok && setOptions.ConditionalSet == constants.OnlyIfDoesNotExist {
+		return models.CreateNilStringResult(), nil
+	}
+	c.strings[key] = value
+	return models.CreateStringResult("OK"), nil
+}
+
+func (c *fakeClient) Get(_ context.Context, key string) (models.Result[string], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.strings[key]
+	if !ok {
+		return models.CreateNilStringResult(), nil
+	}
+	return models.CreateStringResult(value), nil
+}
+
+func (c *fakeClient) CompareAndSet(_ context.Context, key string, expected string, newValue string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if current, ok := c.strings[key]; !ok || current != expected {
+		return false, nil
+	}
+	c.strings[key] = newValue
+	return true, nil
+}
+
+func (c *fakeClient) CompareAndDelete(_ context.Context, key string, expected string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if current, ok := c.strings[key]; !ok || current != expected {
+		return false, nil
+	}
+	delete(c.strings, key)
+	return true, nil
+}
+
+func TestIdempotent(t *testing.T) {
+	client := newFakeClient()
+	store := NewStore(client).WithPrefix("webhooks:")
+	ctx := context.Background()
+	runs := 0
+	charge := func(context.Context) (string, error) {
+		runs++
+		return "charge-1", nil
+	}
+
+	outcome, err := store.Idempotent(ctx, "delivery-1", time.Hour, charge)
+	assert.NoError(t, err)
+	assert.Equal(t, Outcome{Value: "charge-1"}, outcome)
+	assert.Equal(t, "done:charge-1", client.strings["webhooks:delivery-1"])
+
+	outcome, err = store.Idempotent(ctx, "delivery-1", time.Hour, charge)
+	assert.NoError(t, err)
+	assert.Equal(t, Outcome{Value: "charge-1", Replayed: true}, outcome)
+	assert.Equal(t, 1, runs)
+}
+
+func TestIdempotent_FailureReleasesClaim(t *testing.T) {
+	client := newFakeClient()
+	store := NewStore(client)
+	ctx := context.Background()
+
+	_, err := store.Idempotent(ctx, "delivery-1", time.Hour, func(context.Context) (string, error) {
+		return "", errors.New("payment gateway unavailable")
+	})
+	assert.EqualError(t, err, "payment gateway unavailable")
+	assert.Empty(t, client.strings)
+
+	outcome, err := store.Idempotent(ctx, "delivery-1", time.Hour, func(context.Context) (string, error) {
+		return "charge-2", nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "charge-2", outcome.Value)
+}
+
+func TestIdempotent_InProgressAndClaimLost(t *testing.T) {
+	client := newFakeClient()
+	store := NewStore(client)
+	ctx := context.Background()
+
+	outcome, err := store.Idempotent(ctx, "delivery-1", time.Hour, func(ctx context.Context) (string, error) {
+		_, err := store.Idempotent(ctx, "delivery-1", time.Hour, func(context.Context) (string, error) {
+			t.Fatal("the side effect ran twice")
+			return "", nil
+		})
+		assert.ErrorIs(t, err, ErrInProgress)
+		// The claim expires while the side effect runs.
+		delete(client.strings, DefaultPrefix+"delivery-1")
+		return "charge-1", nil
+	})
+	assert.ErrorIs(t, err, ErrClaimLost)
+	assert.Equal(t, "charge-1", outcome.Value)
+
+	_, err = store.Idempotent(ctx, "delivery-1", 0, nil)
+	assert.EqualError(t, err, "idempotency ttl must be at least one millisecond")
+}
@@ -1840,6 +1840,30 @@ func CreateSortedSetTests(batch *pipeline.ClusterBatch, isAtomic bool, serverVer
 	testData = append(testData, CommandTestData{ExpectedResponse: int64(1), TestName: "ZAdd(prefixKey, {member1:1.0})"})
 	batch.ZRangeStore(dest, prefixKey, options.NewRangeByIndexQuery(0, -1))
 	testData = append(testData, CommandTestData{ExpectedResponse: int64(1), TestName: "ZRangeStore(dest, prefixKey, 0, -1)"})
+	batch.ZRangeStoreByScore(
+		dest,
+		prefixKey,
+		options.NewInfiniteScoreBoundary(constants.NegativeInfinity),
+		options.NewScoreBoundary(1, true),
+		true,
+		nil,
+	)
+	testData = append(
+		testData,
+		CommandTestData{ExpectedResponse: int64(1), TestName: "ZRangeStoreByScore(dest, prefixKey, -inf, 1, rev)"},
+	)
+	batch.ZRangeStoreByLex(
+		dest,
+		prefixKey,
+		options.NewLexBoundary("member2", true),
+		options.NewLexBoundary("z", true),
+		false,
+		nil,
+	)
+	testData = append(
+		testData,
+		CommandTestData{ExpectedResponse: int64(0), TestName: "ZRangeStoreByLex(dest, prefixKey, member2, z)"},
+	)
 
 	batch.ZRank(key, "member1")
 	testData = append(testData, CommandTestData{ExpectedResponse: int64(0), TestName: "ZRank(key, member1)"})
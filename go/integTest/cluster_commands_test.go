@@ -6,7 +6,10 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
@@ -179,6 +182,98 @@ func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_InvalidRoute() {
 	assert.True(suite.T(), result.IsEmpty())
 }
 
+func (suite *GlideTestSuite) TestClusterShutdown_RequiresSingleNodeRoute() {
+	client := suite.defaultClusterClient()
+	opts := options.ClusterShutdownOptions{ShutdownOptions: options.NewShutdownOptions().SetAbort()}
+	_, err := client.ShutdownWithOptions(context.Background(), opts)
+	assert.ErrorContains(suite.T(), err, "requires a route to a single node")
+
+	opts.RouteOption = &options.RouteOption{Route: config.AllNodes}
+	_, err = client.ShutdownWithOptions(context.Background(), opts)
+	assert.ErrorContains(suite.T(), err, "requires a route to a single node")
+
+	opts.RouteOption = &options.RouteOption{Route: config.RandomRoute}
+	_, err = client.ShutdownWithOptions(context.Background(), opts)
+	assert.ErrorContains(suite.T(), err, "No shutdown in progress")
+}
+
+func (suite *GlideTestSuite) TestClusterSave() {
+	client := suite.defaultClusterClient()
+	suite.verifyOK(client.Save(context.Background()))
+
+	route := options.RouteOption{Route: config.RandomRoute}
+	suite.verifyOK(client.SaveWithOptions(context.Background(), route))
+}
+
+func (suite *GlideTestSuite) TestClusterBgRewriteAof() {
+	client := suite.defaultClusterClient()
+	result, err := client.BgRewriteAof(context.Background())
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsMultiValue())
+	expected := []models.BackgroundTaskStatus{models.BackgroundTaskStarted, models.BackgroundTaskScheduled}
+	for _, status := range result.MultiValue() {
+		assert.Contains(suite.T(), expected, status)
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterReplicationInfo() {
+	client := suite.defaultClusterClient()
+	result, err := client.ReplicationInfo(context.Background())
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsMultiValue())
+
+	primaries := 0
+	for _, info := range result.MultiValue() {
+		if info.IsPrimary() {
+			primaries++
+			assert.Len(suite.T(), info.Replicas, int(info.ConnectedReplicas))
+		} else {
+			assert.NotEmpty(suite.T(), info.PrimaryHost)
+			assert.NotEmpty(suite.T(), info.PrimaryLinkStatus)
+		}
+	}
+	assert.Greater(suite.T(), primaries, 0)
+}
+
+func (suite *GlideTestSuite) TestClusterAdmin_AddSlotsBusySlot() {
+	client := suite.defaultClusterClient()
+	node := *config.NewByAddressRoute(suite.clusterHosts[0].Host, int32(suite.clusterHosts[0].Port))
+
+	// every slot is already assigned in the test cluster
+	_, err := client.Admin().AddSlots(context.Background(), node, []int64{0})
+	var adminErr *glide.ClusterAdminError
+	require.ErrorAs(suite.T(), err, &adminErr)
+	assert.Equal(suite.T(), "ADDSLOTS", adminErr.Command)
+	assert.Equal(suite.T(), fmt.Sprintf("%s:%d", node.Host, node.Port), adminErr.Node)
+}
+
+func (suite *GlideTestSuite) TestClusterAdmin_InvalidArguments() {
+	client := suite.defaultClusterClient()
+	node := *config.NewByAddressRoute(suite.clusterHosts[0].Host, int32(suite.clusterHosts[0].Port))
+	var adminErr *glide.ClusterAdminError
+
+	_, err := client.Admin().AddSlots(context.Background(), node, []int64{16384})
+	assert.ErrorAs(suite.T(), err, &adminErr)
+	assert.ErrorContains(suite.T(), err, "out of range")
+
+	_, err = client.Admin().AddSlotsRange(context.Background(), node, 10, 5)
+	assert.ErrorAs(suite.T(), err, &adminErr)
+	assert.ErrorContains(suite.T(), err, "greater than end")
+
+	_, err = client.Admin().SetSlot(context.Background(), node, 0, options.SlotStable, "someNodeId")
+	assert.ErrorAs(suite.T(), err, &adminErr)
+	assert.Equal(suite.T(), "SETSLOT", adminErr.Command)
+
+	_, err = client.Admin().SetSlot(context.Background(), node, 0, options.SlotNode, "")
+	assert.ErrorAs(suite.T(), err, &adminErr)
+}
+
+func (suite *GlideTestSuite) TestClusterAdmin_SetSlotStable() {
+	client := suite.defaultClusterClient()
+	node := *config.NewByAddressRoute(suite.clusterHosts[0].Host, int32(suite.clusterHosts[0].Port))
+	suite.verifyOK(client.Admin().SetSlot(context.Background(), node, 0, options.SlotStable, ""))
+}
+
 func (suite *GlideTestSuite) TestClusterCustomCommandWithRoute_AllNodes() {
 	client := suite.defaultClusterClient()
 	route := config.SimpleNodeRoute(config.AllNodes)
@@ -2661,3 +2756,395 @@ func (suite *GlideTestSuite) TestBatchWithSingleNodeRoute() {
 		assert.Contains(suite.T(), res[0], "# Replication", "isAtomic = %v", isAtomic)
 	}
 }
+
+func (suite *GlideTestSuite) TestClusterHedgedReads() {
+	clientConfig := suite.defaultClusterClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClusterClientConfiguration().WithHedgedReads(time.Microsecond))
+	client, err := suite.clusterClient(clientConfig)
+	require.NoError(suite.T(), err)
+	key := uuid.New().String()
+
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	for i := 0; i < 10; i++ {
+		_, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+	}
+	stats := client.Statistics()
+	assert.Positive(suite.T(), stats.HedgedReads)
+	assert.LessOrEqual(suite.T(), stats.HedgeWins, stats.HedgedReads)
+}
+
+func (suite *GlideTestSuite) TestClusterWatchValue() {
+	client := suite.defaultClusterClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	key := uuid.NewString()
+
+	values, stop := client.WatchValue(ctx, key, 10*time.Millisecond)
+	defer stop()
+	assert.True(suite.T(), (<-values).IsNil())
+	suite.verifyOK(client.Set(context.Background(), key, initialValue))
+	assert.Equal(suite.T(), initialValue, (<-values).Value())
+
+	cancel()
+	for range values {
+		// Drain the value read before cancelling, if any.
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterReconfigureReadFrom() {
+	ctx := context.Background()
+	primaryOnly := suite.defaultClusterClient()
+	err := primaryOnly.Reconfigure(ctx, config.NewReconfiguration().WithReadFrom(config.PreferReplica))
+	var configErr *glide.ConfigurationError
+	assert.ErrorAs(suite.T(), err, &configErr)
+
+	client, err := suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(config.PreferReplica))
+	require.NoError(suite.T(), err)
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	_, err = client.CustomCommandWithRoute(
+		ctx, []string{"WAIT", "1", "1000"}, config.NewSlotKeyRoute(config.SlotTypePrimary, key),
+	)
+	require.NoError(suite.T(), err)
+
+	// OBJECT IDLETIME does not access the key, so its idle time is only reset on the node serving a read.
+	idleTime := func(slotType config.SlotType) int64 {
+		result, err := client.CustomCommandWithRoute(
+			ctx, []string{"OBJECT", "IDLETIME", key}, config.NewSlotKeyRoute(slotType, key),
+		)
+		require.NoError(suite.T(), err)
+		return result.SingleValue().(int64)
+	}
+	read := func() {
+		time.Sleep(2 * time.Second)
+		result, err := client.Get(ctx, key)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", result.Value())
+	}
+
+	require.NoError(suite.T(), client.Reconfigure(ctx, config.NewReconfiguration().WithReadFrom(config.Primary)))
+	read()
+	assert.Less(suite.T(), idleTime(config.SlotTypePrimary), int64(1))
+	assert.GreaterOrEqual(suite.T(), idleTime(config.SlotTypeReplica), int64(1))
+
+	require.NoError(suite.T(), client.Reconfigure(ctx, config.NewReconfiguration().WithReadFrom(config.PreferReplica)))
+	read()
+	assert.Less(suite.T(), idleTime(config.SlotTypeReplica), int64(1))
+	assert.GreaterOrEqual(suite.T(), idleTime(config.SlotTypePrimary), int64(1))
+}
+
+func (suite *GlideTestSuite) TestClusterKeysInSlot() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	tag := "{" + uuid.NewString() + "}"
+	keys := []string{tag + "a", tag + "b", tag + "c"}
+	for _, key := range keys {
+		suite.verifyOK(client.Set(ctx, key, "value"))
+	}
+	keySlot, err := client.CustomCommand(ctx, []string{"CLUSTER", "KEYSLOT", tag})
+	require.NoError(suite.T(), err)
+	slot := keySlot.SingleValue().(int64)
+
+	count, err := client.ClusterCountKeysInSlot(ctx, slot)
+	assert.NoError(suite.T(), err)
+	assert.GreaterOrEqual(suite.T(), count, int64(3))
+
+	slotKeys, err := client.ClusterGetKeysInSlot(ctx, slot, 1000)
+	assert.NoError(suite.T(), err)
+	assert.Subset(suite.T(), slotKeys, keys)
+	slotKeys, err = client.ClusterGetKeysInSlot(ctx, slot, 2)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), slotKeys, 2)
+
+	_, err = client.ClusterCountKeysInSlot(ctx, 16384)
+	assert.Error(suite.T(), err)
+	_, err = client.ClusterGetKeysInSlot(ctx, slot, 0)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestClusterConcurrentRoutedCommandsReleaseNativeMemory() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	routes := []config.Route{
+		config.RandomRoute,
+		config.AllPrimaries,
+		config.AllNodes,
+		config.NewSlotIdRoute(config.SlotTypePrimary, 42),
+		config.NewSlotKeyRoute(config.SlotTypePrimary, key),
+		config.NewSlotKeyRoute(config.SlotTypeReplica, key),
+	}
+	before := glide.GetNativeMemoryStats()
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 50; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				message := fmt.Sprintf("%d-%d", worker, i)
+				route := routes[(worker+i)%len(routes)]
+				_, err := client.CustomCommandWithRoute(ctx, []string{"ECHO", message}, route)
+				assert.NoError(suite.T(), err)
+				value, err := client.CustomCommandWithRoute(ctx, []string{"GET", key}, routes[4])
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), "value", value.SingleValue())
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	after := glide.GetNativeMemoryStats()
+	assert.Equal(suite.T(), before.LiveBuffers(), after.LiveBuffers())
+	assert.Equal(suite.T(), before.LiveResponses(), after.LiveResponses())
+	assert.Greater(suite.T(), after.ResponsesReceived, before.ResponsesReceived)
+}
+
+func (suite *GlideTestSuite) TestClusterReplicaLagThreshold() {
+	clientConfig := suite.defaultClusterClientConfig().
+		WithReadFrom(config.PreferReplica).
+		WithAdvancedConfiguration(config.NewAdvancedClusterClientConfiguration().WithReplicaLagThreshold(0, 10*time.Millisecond))
+	client, err := suite.clusterClient(clientConfig)
+	require.NoError(suite.T(), err)
+	key := uuid.New().String()
+
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	assert.Eventually(suite.T(), func() bool {
+		value, err := client.Get(context.Background(), key)
+		return err == nil && value.Value() == "value"
+	}, time.Second, 10*time.Millisecond)
+	for range 10 {
+		value, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", value.Value())
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterReadYourWrites() {
+	clientConfig := suite.defaultClusterClientConfig().
+		WithReadFrom(config.PreferReplica).
+		WithAdvancedConfiguration(config.NewAdvancedClusterClientConfiguration().WithReadYourWrites(time.Minute))
+	client, err := suite.clusterClient(clientConfig)
+	require.NoError(suite.T(), err)
+	key := uuid.New().String()
+
+	// Each read follows a write of the key, so it is sent to the primary and never misses the write.
+	for idx := range 20 {
+		value := strconv.Itoa(idx)
+		suite.verifyOK(client.Set(context.Background(), key, value))
+		result, err := client.Get(context.Background(), key)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), value, result.Value())
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterPfCountAndPfMergeAcrossSlots() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key1, key2, key3 := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	missing, destination := uuid.NewString(), uuid.NewString()
+
+	_, err := client.PfAdd(ctx, key1, []string{"a", "b", "c"})
+	require.NoError(suite.T(), err)
+	_, err = client.PfAdd(ctx, key2, []string{"c", "d"})
+	require.NoError(suite.T(), err)
+	_, err = client.PfAdd(ctx, key3, []string{"e"})
+	require.NoError(suite.T(), err)
+
+	count, err := client.PfCountAcrossSlots(ctx, []string{key1, key2, key3, missing})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), count)
+
+	suite.verifyOK(client.PfMergeAcrossSlots(ctx, destination, []string{key1, key2, key3, missing}))
+	count, err = client.PfCount(ctx, []string{destination})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(5), count)
+
+	// The copies made in the slot of destination are deleted.
+	keys, err := client.CustomCommand(ctx, []string{"KEYS", "*hll-copy*"})
+	assert.NoError(suite.T(), err)
+	for _, nodeKeys := range keys.MultiValue() {
+		assert.Empty(suite.T(), nodeKeys)
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterNodeStats() {
+	clientConfig := suite.defaultClusterClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClusterClientConfiguration().WithNodeStats(50 * time.Millisecond))
+	client, err := suite.clusterClient(clientConfig)
+	require.NoError(suite.T(), err)
+	assert.Nil(suite.T(), suite.defaultClusterClient().NodeStats())
+
+	// Wait for a probe to measure the round-trip time of every node.
+	assert.Eventually(suite.T(), func() bool {
+		stats := client.NodeStats()
+		for _, node := range stats {
+			if node.RTT == 0 {
+				return false
+			}
+		}
+		return len(stats) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	var requests int64
+	primaries := 0
+	for _, node := range client.NodeStats() {
+		assert.Zero(suite.T(), node.InFlight)
+		requests += node.Requests
+		if node.Primary {
+			primaries++
+		}
+	}
+	assert.Positive(suite.T(), requests)
+	assert.Positive(suite.T(), primaries)
+}
+
+func (suite *GlideTestSuite) TestClusterPinSlotRangeAndKey() {
+	client := suite.defaultClusterClient()
+	defer client.ClearPins()
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	keySlot, err := client.CustomCommand(ctx, []string{"CLUSTER", "KEYSLOT", key})
+	require.NoError(suite.T(), err)
+	slot := keySlot.SingleValue().(int64)
+	slots, err := client.CustomCommand(ctx, []string{"CLUSTER", "SLOTS"})
+	require.NoError(suite.T(), err)
+	var owner config.ByAddressRoute
+	for _, entry := range slots.SingleValue().([]any) {
+		shard := entry.([]any)
+		if shard[0].(int64) <= slot && slot <= shard[1].(int64) {
+			primary := shard[2].([]any)
+			owner = config.ByAddressRoute{Host: primary[0].(string), Port: int32(primary[1].(int64))}
+		}
+	}
+	require.NotEmpty(suite.T(), owner.Host)
+
+	require.NoError(suite.T(), client.PinSlotRange(slot, slot, owner))
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	result, err := client.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+
+	client.PinKey(key, owner)
+	require.NoError(suite.T(), client.UnpinSlotRange(slot, slot))
+	result, err = client.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+
+	assert.Error(suite.T(), client.PinSlotRange(0, 16384, owner))
+}
+
+func (suite *GlideTestSuite) TestClusterAdmin_Asking() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	slots, err := client.CustomCommand(ctx, []string{"CLUSTER", "SLOTS"})
+	require.NoError(suite.T(), err)
+	keySlot, err := client.CustomCommand(ctx, []string{"CLUSTER", "KEYSLOT", key})
+	require.NoError(suite.T(), err)
+	slot := keySlot.SingleValue().(int64)
+	var owner config.ByAddressRoute
+	for _, entry := range slots.SingleValue().([]any) {
+		shard := entry.([]any)
+		if shard[0].(int64) <= slot && slot <= shard[1].(int64) {
+			primary := shard[2].([]any)
+			owner = config.ByAddressRoute{Host: primary[0].(string), Port: int32(primary[1].(int64))}
+		}
+	}
+	require.NotEmpty(suite.T(), owner.Host)
+
+	result, err := client.Admin().Asking(ctx, owner, []string{"GET", key})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result)
+
+	var adminErr *glide.ClusterAdminError
+	_, err = client.Admin().Asking(ctx, owner, nil)
+	assert.ErrorAs(suite.T(), err, &adminErr)
+}
+
+func (suite *GlideTestSuite) TestClusterBrowseKeys() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	prefix := "browse:" + uuid.NewString() + ":"
+	for idx := range 25 {
+		suite.verifyOK(client.Set(ctx, prefix+strconv.Itoa(idx), "value"))
+	}
+
+	keys := make(map[string]struct{})
+	cursor := models.NewClusterScanCursor()
+	for !cursor.IsFinished() {
+		page, err := client.BrowseKeys(ctx, cursor, prefix+"*", 10)
+		require.NoError(suite.T(), err)
+		for _, info := range page.Keys {
+			assert.Equal(suite.T(), "string", info.Type)
+			keys[info.Key] = struct{}{}
+		}
+		cursor = page.Cursor
+	}
+	assert.Len(suite.T(), keys, 25)
+}
+
+func (suite *GlideTestSuite) TestClusterWithDebugNode() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	keySlot, err := client.CustomCommand(ctx, []string{"CLUSTER", "KEYSLOT", key})
+	require.NoError(suite.T(), err)
+	slot := keySlot.SingleValue().(int64)
+	slots, err := client.CustomCommand(ctx, []string{"CLUSTER", "SLOTS"})
+	require.NoError(suite.T(), err)
+	var owner, other config.ByAddressRoute
+	for _, entry := range slots.SingleValue().([]any) {
+		shard := entry.([]any)
+		primary := shard[2].([]any)
+		node := config.ByAddressRoute{Host: primary[0].(string), Port: int32(primary[1].(int64))}
+		if shard[0].(int64) <= slot && slot <= shard[1].(int64) {
+			owner = node
+		} else {
+			other = node
+		}
+	}
+	require.NotEmpty(suite.T(), owner.Host)
+	require.NotEmpty(suite.T(), other.Host)
+
+	get := func(ctx context.Context) (models.Result[string], error) { return client.Get(ctx, key) }
+	response, err := glide.WithDebugNode(ctx, owner, get)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", response.Value.Value())
+	assert.Equal(suite.T(), net.JoinHostPort(owner.Host, strconv.Itoa(int(owner.Port))), response.ForcedNode)
+
+	response, err = glide.WithDebugNode(ctx, other, get)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), response.ForcedNode)
+
+	_, err = glide.WithDebugNode(ctx, owner, func(ctx context.Context) (models.Result[string], error) {
+		return suite.defaultClient().Get(ctx, key)
+	})
+	assert.IsType(suite.T(), &glide.RequestError{}, err)
+}
+
+func (suite *GlideTestSuite) TestClusterDeleteByPattern() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	prefix := uuid.NewString() + ":"
+	for idx := range 25 {
+		suite.verifyOK(client.Set(ctx, prefix+strconv.Itoa(idx), "value"))
+	}
+	suite.verifyOK(client.Set(ctx, prefix+"keep", "value"))
+
+	deleted, err := client.DeleteByPattern(ctx, prefix+"[0-9]*", *options.NewDeleteByPatternOptions().SetBatchSize(7))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(25), deleted)
+
+	exists, err := client.Exists(ctx, []string{prefix + "keep", prefix + "0"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), exists)
+}
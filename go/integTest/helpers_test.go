@@ -0,0 +1,428 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/activity"
+	"github.com/valkey-io/valkey-glide/go/v2/counter"
+	"github.com/valkey-io/valkey-glide/go/v2/geofence"
+	"github.com/valkey-io/valkey-glide/go/v2/glidepool"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/leaderboard"
+	"github.com/valkey-io/valkey-glide/go/v2/lock"
+	"github.com/valkey-io/valkey-glide/go/v2/mirror"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/queue"
+	"github.com/valkey-io/valkey-glide/go/v2/retention"
+	"github.com/valkey-io/valkey-glide/go/v2/sessions"
+)
+
+func (suite *GlideTestSuite) TestSessionStore() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		store := sessions.NewStore(client, time.Minute).WithPrefix("{sessions}:")
+		id, err := store.Create(ctx, map[string]string{"user": "alice"})
+		require.NoError(suite.T(), err)
+
+		ttl, err := client.TTL(ctx, "{sessions}:"+id)
+		assert.NoError(suite.T(), err)
+		assert.Positive(suite.T(), ttl)
+
+		var data map[string]string
+		session, err := store.Get(ctx, id, &data)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), map[string]string{"user": "alice"}, data)
+		assert.Equal(suite.T(), id, session.ID)
+
+		assert.NoError(suite.T(), store.Refresh(ctx, id))
+		assert.NoError(suite.T(), store.Destroy(ctx, id))
+		_, err = store.Get(ctx, id, &data)
+		assert.ErrorIs(suite.T(), err, sessions.ErrNotFound)
+	})
+}
+
+func (suite *GlideTestSuite) TestLeaderboard() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		board := leaderboard.New(client, uuid.New().String())
+		for i := 1; i <= 5; i++ {
+			require.NoError(suite.T(), board.SetScore(ctx, fmt.Sprintf("player%d", i), float64(i*10)))
+		}
+		score, err := board.AddScore(ctx, "player1", 100)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), 110.0, score)
+
+		top, err := board.Top(ctx, 2)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []leaderboard.Entry{
+			{Member: "player1", Score: 110, Rank: 0},
+			{Member: "player5", Score: 50, Rank: 1},
+		}, top)
+
+		around, err := board.Around(ctx, "player3", 1)
+		assert.NoError(suite.T(), err)
+		assert.Len(suite.T(), around, 3)
+		assert.Equal(suite.T(), "player3", around[1].Member)
+		assert.Equal(suite.T(), int64(3), around[1].Rank)
+
+		pages := board.Pages(2)
+		var count int
+		for !pages.Done() {
+			page, err := pages.Next(ctx)
+			require.NoError(suite.T(), err)
+			count += len(page)
+		}
+		assert.Equal(suite.T(), 5, count)
+	})
+}
+
+func (suite *GlideTestSuite) TestListQueue() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		var q queue.Queue = queue.NewListQueue(client, uuid.New().String())
+		_, err := q.Enqueue(ctx, "first")
+		require.NoError(suite.T(), err)
+
+		message, err := q.Dequeue(ctx, time.Second)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "first", message.Payload)
+		assert.NoError(suite.T(), q.Ack(ctx, message))
+
+		_, err = q.Dequeue(ctx, 100*time.Millisecond)
+		assert.ErrorIs(suite.T(), err, queue.ErrEmpty)
+	})
+}
+
+func (suite *GlideTestSuite) TestStreamQueue() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := "{queue}" + uuid.New().String()
+		deadLetterKey := "{queue}" + uuid.New().String()
+		q := queue.NewStreamQueue(client, key, "workers", "worker1").
+			WithVisibilityTimeout(50*time.Millisecond).
+			WithDeadLetter(deadLetterKey, 2)
+		id, err := q.Enqueue(ctx, "job")
+		require.NoError(suite.T(), err)
+
+		message, err := q.Dequeue(ctx, time.Second)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), queue.Message{ID: id, Payload: "job", Deliveries: 1}, message)
+
+		// the message is delivered again once its visibility timeout expires
+		time.Sleep(100 * time.Millisecond)
+		message, err = q.Dequeue(ctx, 100*time.Millisecond)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(2), message.Deliveries)
+
+		// and moved to the dead-letter stream once delivered too many times
+		time.Sleep(100 * time.Millisecond)
+		_, err = q.Dequeue(ctx, 100*time.Millisecond)
+		assert.ErrorIs(suite.T(), err, queue.ErrEmpty)
+		length, err := client.XLen(ctx, deadLetterKey)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), length)
+
+		id, err = q.Enqueue(ctx, "another job")
+		require.NoError(suite.T(), err)
+		message, err = q.Dequeue(ctx, time.Second)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), id, message.ID)
+		assert.NoError(suite.T(), q.Ack(ctx, message))
+		time.Sleep(100 * time.Millisecond)
+		_, err = q.Dequeue(ctx, 100*time.Millisecond)
+		assert.ErrorIs(suite.T(), err, queue.ErrEmpty)
+	})
+}
+
+func (suite *GlideTestSuite) TestPriorityQueue() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		q := queue.NewPriorityQueue(client, uuid.New().String())
+		for member, priority := range map[string]float64{"low": 1, "mid": 5, "high": 10, "urgent": 20} {
+			require.NoError(suite.T(), q.Push(ctx, member, priority))
+		}
+
+		item, err := q.PopMax(ctx)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), queue.Item{Member: "urgent", Priority: 20}, item)
+		item, err = q.BlockingPopMin(ctx, time.Second)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), queue.Item{Member: "low", Priority: 1}, item)
+
+		items, err := q.PopMaxN(ctx, 5)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []queue.Item{{Member: "high", Priority: 10}, {Member: "mid", Priority: 5}}, items)
+
+		_, err = q.PopMin(ctx)
+		assert.ErrorIs(suite.T(), err, queue.ErrEmpty)
+		_, err = q.BlockingPopMax(ctx, 100*time.Millisecond)
+		assert.ErrorIs(suite.T(), err, queue.ErrEmpty)
+	})
+}
+
+func (suite *GlideTestSuite) TestScheduler() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		tag := "{" + uuid.New().String() + "}"
+		scheduler := queue.NewScheduler(client, tag+":scheduled", tag+":ready")
+		ready := queue.NewListQueue(client, tag+":ready")
+
+		require.NoError(suite.T(), scheduler.Schedule(ctx, "due", time.Now().Add(-time.Second)))
+		require.NoError(suite.T(), scheduler.ScheduleAfter(ctx, "later", time.Hour))
+		require.NoError(suite.T(), scheduler.ScheduleAfter(ctx, "cancelled", time.Hour))
+		cancelled, err := scheduler.Cancel(ctx, "cancelled")
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), cancelled)
+
+		promoted, err := scheduler.Promote(ctx)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), promoted)
+		message, err := ready.Dequeue(ctx, time.Second)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "due", message.Payload)
+
+		streamScheduler := queue.NewScheduler(client, tag+":scheduled", tag+":stream").WithReadyStream()
+		require.NoError(suite.T(), streamScheduler.Schedule(ctx, "later", time.Now()))
+		runCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(suite.T(), streamScheduler.Run(runCtx, 50*time.Millisecond), context.DeadlineExceeded)
+		length, err := client.XLen(ctx, tag+":stream")
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), length)
+	})
+}
+
+func (suite *GlideTestSuite) TestCounter() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		visits := counter.New(client, uuid.New().String(), counter.Minute, counter.Hour)
+		counts, err := visits.Incr(ctx, 2)
+		require.NoError(suite.T(), err)
+		assert.Len(suite.T(), counts, 2)
+		counts, err = visits.Incr(ctx, 1)
+		require.NoError(suite.T(), err)
+
+		count, err := visits.Count(ctx, counter.Hour)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), counts[1], count)
+		sum, err := visits.Sum(ctx, counter.Minute, time.Now().Add(-time.Minute), time.Now())
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(3), sum)
+
+		key := uuid.New().String()
+		value, err := counter.IncrWithTTL(ctx, client, key, 1, time.Minute)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), value)
+		ttl, err := client.TTL(ctx, key)
+		assert.NoError(suite.T(), err)
+		assert.Positive(suite.T(), ttl)
+	})
+}
+
+func (suite *GlideTestSuite) TestGeofence() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		fences := geofence.New(client, uuid.New().String())
+		palermo := options.GeospatialData{Longitude: 13.361389, Latitude: 38.115556}
+		catania := options.GeospatialData{Longitude: 15.087269, Latitude: 37.502669}
+		require.NoError(suite.T(), fences.Add(ctx, "palermo", palermo, 1000))
+		require.NoError(suite.T(), fences.Add(ctx, "catania", catania, 5000))
+
+		events, err := fences.Update(ctx, "truck", palermo)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []geofence.Event{
+			{Type: geofence.Enter, Fence: "palermo", Object: "truck", Position: palermo},
+		}, events)
+
+		events, err = fences.Update(ctx, "truck", palermo)
+		assert.NoError(suite.T(), err)
+		assert.Empty(suite.T(), events)
+
+		events, err = fences.Update(ctx, "truck", catania)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []geofence.Event{
+			{Type: geofence.Exit, Fence: "palermo", Object: "truck", Position: catania},
+			{Type: geofence.Enter, Fence: "catania", Object: "truck", Position: catania},
+		}, events)
+		inside, err := fences.Inside(ctx, "truck")
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), map[string]struct{}{"catania": {}}, inside)
+
+		require.NoError(suite.T(), fences.Remove(ctx, "catania"))
+		events, err = fences.Update(ctx, "truck", catania)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []geofence.Event{
+			{Type: geofence.Exit, Fence: "catania", Object: "truck", Position: catania},
+		}, events)
+	})
+}
+
+func (suite *GlideTestSuite) TestActivityTracker() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		tracker := activity.New(client, uuid.New().String()).WithPartitionSize(1024)
+		monday := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		tuesday := monday.Add(24 * time.Hour)
+		// user IDs beyond 2^32 land in their own partition
+		for _, userID := range []int64{1, 2, 5000, 1 << 40} {
+			require.NoError(suite.T(), tracker.MarkActive(ctx, userID, monday))
+		}
+		for _, userID := range []int64{2, 1 << 40, 7} {
+			require.NoError(suite.T(), tracker.MarkActive(ctx, userID, tuesday))
+		}
+
+		active, err := tracker.IsActive(ctx, 1<<40, monday)
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), active)
+		count, err := tracker.CountActive(ctx, monday)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(4), count)
+		count, err = tracker.ActiveInAll(ctx, monday, tuesday)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(2), count)
+
+		assert.Error(suite.T(), tracker.MarkActive(ctx, -1, monday))
+	})
+}
+
+func (suite *GlideTestSuite) TestKeyedMutex() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		mutex := lock.NewKeyedMutex(client, time.Minute).WithPrefix(uuid.New().String() + ":")
+		lease, err := mutex.TryLock(ctx, "resource")
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), lease.Token)
+
+		_, err = mutex.TryLock(ctx, "resource")
+		assert.ErrorIs(suite.T(), err, lock.ErrNotAcquired)
+		assert.NoError(suite.T(), mutex.Unlock(ctx, lease))
+		assert.ErrorIs(suite.T(), mutex.Unlock(ctx, lease), lock.ErrNotHeld)
+
+		next, err := mutex.Lock(ctx, "resource")
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(2), next.Token)
+		assert.NoError(suite.T(), mutex.Unlock(ctx, next))
+
+		// An expired lease does not release the lock acquired after it.
+		shortMutex := lock.NewKeyedMutex(client, 100*time.Millisecond).WithPrefix(uuid.New().String() + ":")
+		expired, err := shortMutex.TryLock(ctx, "resource")
+		require.NoError(suite.T(), err)
+		time.Sleep(200 * time.Millisecond)
+		current, err := shortMutex.TryLock(ctx, "resource")
+		require.NoError(suite.T(), err)
+		assert.Greater(suite.T(), current.Token, expired.Token)
+		assert.ErrorIs(suite.T(), shortMutex.Unlock(ctx, expired), lock.ErrNotHeld)
+		assert.NoError(suite.T(), shortMutex.Unlock(ctx, current))
+	})
+}
+
+func (suite *GlideTestSuite) TestStreamRetention() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		byLength := uuid.New().String()
+		byAge := uuid.New().String()
+		for i := 0; i < 500; i++ {
+			_, err := client.XAdd(ctx, byLength, []models.FieldValue{{Field: "n", Value: fmt.Sprint(i)}})
+			require.NoError(suite.T(), err)
+		}
+		_, err := client.XAddWithOptions(
+			ctx,
+			byAge,
+			[]models.FieldValue{{Field: "n", Value: "old"}},
+			*options.NewXAddOptions().SetId("1-0"),
+		)
+		require.NoError(suite.T(), err)
+		_, err = client.XAdd(ctx, byAge, []models.FieldValue{{Field: "n", Value: "new"}})
+		require.NoError(suite.T(), err)
+
+		manager := retention.NewManager(client)
+		require.NoError(suite.T(), manager.SetPolicy(byLength, retention.Policy{MaxLen: 10}))
+		require.NoError(suite.T(), manager.SetPolicy(byAge, retention.Policy{MaxAge: time.Hour}))
+		trimmed, err := manager.Trim(ctx)
+		assert.NoError(suite.T(), err)
+		assert.Positive(suite.T(), trimmed)
+
+		// Approximate trimming keeps whole nodes of the stream.
+		length, err := client.XLen(ctx, byLength)
+		assert.NoError(suite.T(), err)
+		assert.Less(suite.T(), length, int64(500))
+		assert.GreaterOrEqual(suite.T(), length, int64(10))
+	})
+}
+
+func (suite *GlideTestSuite) TestMirror() {
+	ctx := context.Background()
+	primary := suite.defaultClient()
+	secondary, err := suite.client(suite.defaultClientConfig().WithDatabaseId(1))
+	require.NoError(suite.T(), err)
+	mirrored := mirror.New(primary, secondary)
+	prefix := uuid.NewString()
+
+	_, err = mirrored.Set(ctx, prefix+":string", "value")
+	require.NoError(suite.T(), err)
+	_, err = mirrored.HSet(ctx, prefix+":hash", map[string]string{"field": "value"})
+	require.NoError(suite.T(), err)
+	_, err = mirrored.PExpire(ctx, prefix+":hash", time.Minute)
+	require.NoError(suite.T(), err)
+
+	mismatches, err := mirrored.VerifyScan(ctx, mirror.ScanStandalone(primary, prefix+":*"))
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), mismatches)
+
+	suite.verifyOK(primary.Set(ctx, prefix+":unmirrored", "value"))
+	_, err = secondary.HSet(ctx, prefix+":hash", map[string]string{"field": "changed"})
+	require.NoError(suite.T(), err)
+	mismatches, err = mirrored.Verify(ctx, []string{prefix + ":string", prefix + ":hash", prefix + ":unmirrored"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []mirror.Mismatch{
+		{Key: prefix + ":hash", Kind: mirror.ValueMismatch},
+		{Key: prefix + ":unmirrored", Kind: mirror.MissingOnSecondary},
+	}, mismatches)
+
+	assert.NoError(suite.T(), mirrored.Repair(ctx, prefix+":hash"))
+	assert.NoError(suite.T(), mirrored.Repair(ctx, prefix+":unmirrored"))
+	mismatches, err = mirrored.VerifyScan(ctx, mirror.ScanStandalone(primary, prefix+":*"))
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), mismatches)
+}
+
+func (suite *GlideTestSuite) TestClientPool() {
+	ctx := context.Background()
+	pool := glidepool.New()
+	defer pool.Close()
+	require.NoError(suite.T(), pool.Register("standalone", suite.defaultClientConfig()))
+	require.NoError(suite.T(), pool.RegisterCluster("cluster", suite.defaultClusterClientConfig()))
+
+	standalone, err := pool.Client("standalone")
+	require.NoError(suite.T(), err)
+	again, err := pool.Client("standalone")
+	require.NoError(suite.T(), err)
+	assert.Same(suite.T(), standalone, again)
+	suite.verifyOK(standalone.Set(ctx, uuid.NewString(), "value"))
+
+	_, err = pool.ClusterClient("standalone")
+	assert.Error(suite.T(), err)
+	cluster, err := pool.ClusterClient("cluster")
+	require.NoError(suite.T(), err)
+	_, err = cluster.Ping(ctx)
+	assert.NoError(suite.T(), err)
+
+	assert.Empty(suite.T(), pool.Health(ctx))
+	stats := pool.Statistics()
+	assert.Len(suite.T(), stats.Clients, 2)
+	assert.Positive(suite.T(), stats.BytesByFamily["String"].Commands)
+
+	pool.Close()
+	_, err = standalone.Get(ctx, "key")
+	assert.Error(suite.T(), err)
+	_, err = pool.Client("standalone")
+	assert.ErrorIs(suite.T(), err, glidepool.ErrClosed)
+}
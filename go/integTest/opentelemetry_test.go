@@ -72,6 +72,17 @@ func WrongOpenTelemetryConfig(suite *GlideTestSuite) {
 	err = glide.GetOtelInstance().Init(cfg)
 	assert.Error(suite.T(), err)
 	assert.Contains(suite.T(), err.Error(), "sample percentage must be between 0 and 100")
+
+	// Test negative minimum span duration
+	cfg = glide.OpenTelemetryConfig{
+		Traces: &glide.OpenTelemetryTracesConfig{
+			Endpoint:    validFileEndpointTraces,
+			MinDuration: -time.Millisecond,
+		},
+	}
+	err = glide.GetOtelInstance().Init(cfg)
+	assert.Error(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "minimum duration of trace spans cannot be negative")
 	// Test wrong file path format
 	cfg = glide.OpenTelemetryConfig{
 		Traces: &glide.OpenTelemetryTracesConfig{
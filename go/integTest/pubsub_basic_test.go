@@ -7,9 +7,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 )
 
 // TestPubSub_Patterns tests all combinations of client types and message reading methods
@@ -1218,3 +1222,83 @@ func (suite *GlideTestSuite) TestPubSub_Basic_CombinedExactPatternMultipleSubscr
 		})
 	}
 }
+
+func (suite *GlideTestSuite) TestPubSub_Basic_ReplayBuffer() {
+	channel := "replay-" + uuid.NewString()
+	subConfig := config.NewStandaloneSubscriptionConfig().
+		WithSubscription(config.ExactChannelMode, channel).
+		WithReplayBuffer(2)
+	receiver := suite.createStandaloneClientWithSubscriptions(subConfig)
+	defer receiver.Close()
+	publisher := suite.defaultClient()
+
+	for _, message := range []string{"m1", "m2", "m3"} {
+		_, err := publisher.Publish(context.Background(), channel, message)
+		require.NoError(suite.T(), err)
+	}
+
+	queue, err := receiver.GetQueue()
+	require.NoError(suite.T(), err)
+	for range 3 {
+		select {
+		case <-queue.WaitForMessage():
+		case <-time.After(MESSAGE_TIMEOUT * time.Second):
+			suite.T().Fatal("timed out waiting for a message")
+		}
+	}
+
+	messages, err := receiver.ReplayMessages(channel)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), messages, 2)
+	assert.Equal(suite.T(), "m2", messages[0].Message)
+	assert.Equal(suite.T(), "m3", messages[1].Message)
+}
+
+func (suite *GlideTestSuite) TestPubSub_Basic_MessageRouter() {
+	prefix := "router-" + uuid.NewString()
+	orders := make(chan string, 1)
+	others := make(chan string, 1)
+	router := glide.NewMessageRouter().
+		Handle(prefix+".orders.*", func(message *models.PubSubMessage, ctx any) { orders <- message.Channel }).
+		HandleDefault(func(message *models.PubSubMessage, ctx any) { others <- message.Channel })
+	subConfig := config.NewStandaloneSubscriptionConfig().
+		WithSubscription(config.PatternChannelMode, prefix+".*").
+		WithCallback(router.Callback(), nil)
+	receiver := suite.createStandaloneClientWithSubscriptions(subConfig)
+	defer receiver.Close()
+	publisher := suite.defaultClient()
+
+	_, err := publisher.Publish(context.Background(), prefix+".orders.eu", "order")
+	require.NoError(suite.T(), err)
+	_, err = publisher.Publish(context.Background(), prefix+".payments", "payment")
+	require.NoError(suite.T(), err)
+
+	for _, expected := range []struct {
+		received <-chan string
+		channel  string
+	}{{orders, prefix + ".orders.eu"}, {others, prefix + ".payments"}} {
+		select {
+		case channel := <-expected.received:
+			assert.Equal(suite.T(), expected.channel, channel)
+		case <-time.After(MESSAGE_TIMEOUT * time.Second):
+			suite.T().Fatal("timed out waiting for a message")
+		}
+	}
+}
+
+func (suite *GlideTestSuite) TestPubSub_Basic_CloseWithDrain() {
+	channel := "drain-" + uuid.NewString()
+	subConfig := config.NewStandaloneSubscriptionConfig().WithSubscription(config.ExactChannelMode, channel)
+	receiver := suite.createStandaloneClientWithSubscriptions(subConfig)
+	publisher := suite.defaultClient()
+
+	_, err := publisher.Publish(context.Background(), channel, "message")
+	require.NoError(suite.T(), err)
+	queue, err := receiver.GetQueue()
+	require.NoError(suite.T(), err)
+	go func() {
+		<-queue.WaitForMessage()
+	}()
+
+	assert.NoError(suite.T(), receiver.CloseWithDrain(MESSAGE_TIMEOUT*time.Second))
+}
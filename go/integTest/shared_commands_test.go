@@ -3,6 +3,7 @@
 package integTest
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math"
@@ -18,6 +19,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/interfaces"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -183,6 +185,20 @@ func (suite *GlideTestSuite) TestSetWithOptions_UpdateExistingExpiry() {
 	})
 }
 
+func (suite *GlideTestSuite) TestSetWithOptions_InvalidConditionalSet() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+
+		opts := options.SetOptions{ConditionalSet: constants.OnlyIfExists, ComparisonValue: initialValue}
+		_, err := client.SetWithOptions(context.Background(), key, anotherValue, opts)
+		suite.Error(err)
+
+		opts = options.SetOptions{ConditionalSet: "IFGT"}
+		_, err = client.SetWithOptions(context.Background(), key, anotherValue, opts)
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestSetWithOptions_OnlyIfEquals() {
 	suite.SkipIfServerVersionLowerThan("8.1.0", suite.T())
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
@@ -270,6 +286,35 @@ func (suite *GlideTestSuite) TestGetExWithOptions_UpdateExpiry() {
 	})
 }
 
+func (suite *GlideTestSuite) TestGetExWithOptions_ExpireAtTimeAndPersist() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+
+		opts := options.NewGetExOptions().ExpireAtTime(time.Now().Add(100 * time.Second).Truncate(time.Second))
+		result, err := client.GetExWithOptions(context.Background(), key, *opts)
+		suite.NoError(err)
+		assert.Equal(suite.T(), initialValue, result.Value())
+
+		ttl, err := client.TTL(context.Background(), key)
+		suite.NoError(err)
+		assert.Greater(suite.T(), ttl, int64(0))
+
+		opts = options.NewGetExOptions().Persist()
+		result, err = client.GetExWithOptions(context.Background(), key, *opts)
+		suite.NoError(err)
+		assert.Equal(suite.T(), initialValue, result.Value())
+
+		ttl, err = client.TTL(context.Background(), key)
+		suite.NoError(err)
+		assert.Equal(suite.T(), int64(-1), ttl)
+
+		opts = options.NewGetExOptions().Persist().ExpireAtTime(time.Now().Add(time.Minute))
+		_, err = client.GetExWithOptions(context.Background(), key, *opts)
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestSetWithOptions_ReturnOldValue_nonExistentKey() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -757,6 +802,113 @@ func (suite *GlideTestSuite) TestGetDel_EmptyKey() {
 	})
 }
 
+func (suite *GlideTestSuite) TestCompareAndSet() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+
+		swapped, err := client.CompareAndSet(context.Background(), key, initialValue, anotherValue)
+		suite.NoError(err)
+		suite.False(swapped)
+
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+		_, err = client.Expire(context.Background(), key, 100*time.Second)
+		suite.NoError(err)
+
+		swapped, err = client.CompareAndSet(context.Background(), key, anotherValue, "other")
+		suite.NoError(err)
+		suite.False(swapped)
+
+		swapped, err = client.CompareAndSet(context.Background(), key, initialValue, anotherValue)
+		suite.NoError(err)
+		suite.True(swapped)
+
+		result, err := client.Get(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal(anotherValue, result.Value())
+
+		ttl, err := client.TTL(context.Background(), key)
+		suite.NoError(err)
+		suite.Greater(ttl, int64(0))
+	})
+}
+
+func (suite *GlideTestSuite) TestSetPreservingTTL() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+
+		replaced, err := client.ReplaceValueKeepTTL(context.Background(), key, initialValue)
+		suite.NoError(err)
+		suite.False(replaced)
+
+		suite.verifyOK(client.SetPreservingTTL(context.Background(), key, initialValue))
+		ttl, err := client.TTL(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal(int64(-1), ttl)
+
+		_, err = client.Expire(context.Background(), key, 100*time.Second)
+		suite.NoError(err)
+		suite.verifyOK(client.SetPreservingTTL(context.Background(), key, anotherValue))
+		replaced, err = client.ReplaceValueKeepTTL(context.Background(), key, "other")
+		suite.NoError(err)
+		suite.True(replaced)
+
+		result, err := client.Get(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal("other", result.Value())
+		ttl, err = client.TTL(context.Background(), key)
+		suite.NoError(err)
+		suite.Greater(ttl, int64(0))
+	})
+}
+
+func (suite *GlideTestSuite) TestCompareAndDelete() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+
+		deleted, err := client.CompareAndDelete(context.Background(), key, anotherValue)
+		suite.NoError(err)
+		suite.False(deleted)
+
+		deleted, err = client.CompareAndDelete(context.Background(), key, initialValue)
+		suite.NoError(err)
+		suite.True(deleted)
+
+		exists, err := client.Exists(context.Background(), []string{key})
+		suite.NoError(err)
+		suite.Equal(int64(0), exists)
+	})
+}
+
+func (suite *GlideTestSuite) TestMGetDel() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key1 := "{key}" + uuid.NewString()
+		key2 := "{key}" + uuid.NewString()
+		missingKey := "{key}" + uuid.NewString()
+		listKey := "{key}" + uuid.NewString()
+		suite.verifyOK(client.MSet(context.Background(), map[string]string{key1: initialValue, key2: anotherValue}))
+		_, err := client.LPush(context.Background(), listKey, []string{"element"})
+		suite.NoError(err)
+
+		result, err := client.MGetDel(context.Background(), []string{key1, missingKey, key2, listKey})
+		suite.NoError(err)
+		suite.Equal([]models.Result[string]{
+			models.CreateStringResult(initialValue),
+			models.CreateNilStringResult(),
+			models.CreateStringResult(anotherValue),
+			models.CreateNilStringResult(),
+		}, result)
+
+		exists, err := client.Exists(context.Background(), []string{key1, key2, listKey})
+		suite.NoError(err)
+		suite.Equal(int64(1), exists)
+
+		result, err = client.MGetDel(context.Background(), []string{})
+		suite.NoError(err)
+		suite.Empty(result)
+	})
+}
+
 func (suite *GlideTestSuite) TestHSet_WithExistingKey() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		fields := map[string]string{"field1": "value1", "field2": "value2"}
@@ -2286,6 +2438,49 @@ func (suite *GlideTestSuite) TestSRandMemberCount() {
 	})
 }
 
+func (suite *GlideTestSuite) TestRandomCountOptions() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		hashKey := "{key}" + uuid.NewString()
+		setKey := "{key}" + uuid.NewString()
+		zsetKey := "{key}" + uuid.NewString()
+		ctx := context.Background()
+
+		_, err := client.HSet(ctx, hashKey, map[string]string{"f1": "v1", "f2": "v2"})
+		suite.NoError(err)
+		_, err = client.SAdd(ctx, setKey, []string{"one", "two"})
+		suite.NoError(err)
+		_, err = client.ZAdd(ctx, zsetKey, map[string]float64{"one": 1, "two": 2})
+		suite.NoError(err)
+
+		fields, err := client.HRandFieldWithRandomCount(ctx, hashKey, options.Unique(5))
+		suite.NoError(err)
+		assert.ElementsMatch(suite.T(), []string{"f1", "f2"}, fields)
+		fields, err = client.HRandFieldWithRandomCount(ctx, hashKey, options.AllowRepeats(5))
+		suite.NoError(err)
+		assert.Len(suite.T(), fields, 5)
+		fieldsWithValues, err := client.HRandFieldWithRandomCountWithValues(ctx, hashKey, options.AllowRepeats(3))
+		suite.NoError(err)
+		assert.Len(suite.T(), fieldsWithValues, 3)
+
+		members, err := client.SRandMemberWithRandomCount(ctx, setKey, options.Unique(5))
+		suite.NoError(err)
+		assert.ElementsMatch(suite.T(), []string{"one", "two"}, members)
+		members, err = client.SRandMemberWithRandomCount(ctx, setKey, options.AllowRepeats(5))
+		suite.NoError(err)
+		assert.Len(suite.T(), members, 5)
+
+		members, err = client.ZRandMemberWithRandomCount(ctx, zsetKey, options.Unique(5))
+		suite.NoError(err)
+		assert.ElementsMatch(suite.T(), []string{"one", "two"}, members)
+		withScores, err := client.ZRandMemberWithRandomCountWithScores(ctx, zsetKey, options.AllowRepeats(4))
+		suite.NoError(err)
+		assert.Len(suite.T(), withScores, 4)
+
+		_, err = client.ZRandMemberWithRandomCount(ctx, zsetKey, options.Unique(-1))
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestSPop() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.NewString()
@@ -2877,6 +3072,34 @@ func (suite *GlideTestSuite) TestLRem() {
 	})
 }
 
+func (suite *GlideTestSuite) TestLPopAllAndRPopAll() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		_, err := client.RPush(context.Background(), key, []string{"value1", "value2", "value3"})
+		suite.NoError(err)
+
+		res, err := client.LPopAll(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal([]string{"value1", "value2", "value3"}, res)
+
+		res, err = client.LPopAll(context.Background(), key)
+		suite.NoError(err)
+		suite.Empty(res)
+
+		_, err = client.RPush(context.Background(), key, []string{"value1", "value2"})
+		suite.NoError(err)
+		res, err = client.RPopAll(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal([]string{"value2", "value1"}, res)
+
+		var requestErr *glide.RequestError
+		_, err = client.LPopCount(context.Background(), key, 0)
+		suite.ErrorAs(err, &requestErr)
+		_, err = client.RPopCount(context.Background(), key, -1)
+		suite.ErrorAs(err, &requestErr)
+	})
+}
+
 func (suite *GlideTestSuite) TestRPopAndRPopCount() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		list := []string{"value1", "value2", "value3", "value4"}
@@ -4416,6 +4639,38 @@ func (suite *GlideTestSuite) TestSortReadyOnlyWithOptions_DescendingOrder() {
 	})
 }
 
+func (suite *GlideTestSuite) TestRPopLPushAndBRPopLPush() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key1 := "{key}-1" + uuid.NewString()
+		key2 := "{key}-2" + uuid.NewString()
+		nonListKey := "{key}-3" + uuid.NewString()
+
+		res, err := client.RPopLPush(context.Background(), key1, key2)
+		suite.NoError(err)
+		suite.True(res.IsNil())
+		res, err = client.BRPopLPush(context.Background(), key1, key2, 100*time.Millisecond)
+		suite.NoError(err)
+		suite.True(res.IsNil())
+
+		_, err = client.RPush(context.Background(), key1, []string{"one", "two", "three"})
+		suite.NoError(err)
+		res, err = client.RPopLPush(context.Background(), key1, key2)
+		suite.NoError(err)
+		suite.Equal("three", res.Value())
+		res, err = client.BRPopLPush(context.Background(), key1, key2, 100*time.Millisecond)
+		suite.NoError(err)
+		suite.Equal("two", res.Value())
+
+		list, err := client.LRange(context.Background(), key2, 0, -1)
+		suite.NoError(err)
+		suite.Equal([]string{"two", "three"}, list)
+
+		suite.verifyOK(client.Set(context.Background(), nonListKey, "value"))
+		_, err = client.RPopLPush(context.Background(), nonListKey, key2)
+		suite.Error(err)
+	})
+}
+
 func (suite *GlideTestSuite) TestBLMove() {
 	if suite.serverVersion < "6.2.0" {
 		suite.T().Skip("This feature is added in version 6.2.0")
@@ -4553,6 +4808,31 @@ func (suite *GlideTestSuite) TestType() {
 	})
 }
 
+func (suite *GlideTestSuite) TestKeyInfo() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		info, err := client.KeyInfo(context.Background(), key)
+		suite.NoError(err)
+		suite.False(info.Exists)
+		suite.Equal("none", info.Type)
+
+		suite.verifyOK(client.Set(context.Background(), key, initialValue))
+		info, err = client.KeyInfo(context.Background(), key)
+		suite.NoError(err)
+		suite.True(info.Exists)
+		suite.Equal("string", info.Type)
+		suite.Equal(int64(-1), info.TTL)
+		suite.NotEmpty(info.Encoding)
+		suite.Greater(info.Size, int64(0))
+
+		_, err = client.Expire(context.Background(), key, 100*time.Second)
+		suite.NoError(err)
+		info, err = client.KeyInfo(context.Background(), key)
+		suite.NoError(err)
+		suite.Greater(info.TTL, int64(0))
+	})
+}
+
 func (suite *GlideTestSuite) TestTouch() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		// Test 1: Check if an touch valid key
@@ -4606,6 +4886,37 @@ func (suite *GlideTestSuite) TestRename() {
 	})
 }
 
+func (suite *GlideTestSuite) TestRenameAcrossSlots() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		// The keys map to different hash slots in cluster mode.
+		key := "{slot1}" + uuid.NewString()
+		newKey := "{slot2}" + uuid.NewString()
+		_, err := client.SetWithOptions(
+			ctx,
+			key,
+			"value",
+			*options.NewSetOptions().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+		)
+		require.NoError(suite.T(), err)
+		suite.verifyOK(client.Set(ctx, newKey, "overwritten"))
+
+		suite.verifyOK(client.RenameAcrossSlots(ctx, key, newKey))
+		value, err := client.Get(ctx, newKey)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", value.Value())
+		ttl, err := client.TTL(ctx, newKey)
+		assert.NoError(suite.T(), err)
+		assert.Positive(suite.T(), ttl)
+		exists, err := client.Exists(ctx, []string{key})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(0), exists)
+
+		_, err = client.RenameAcrossSlots(ctx, key, newKey)
+		assert.Error(suite.T(), err)
+	})
+}
+
 func (suite *GlideTestSuite) TestRenameNX() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		// Test 1 Check if the RenameNX command return true if key was renamed to newKey
@@ -5046,6 +5357,53 @@ func (suite *GlideTestSuite) TestXReadGroup() {
 	})
 }
 
+func (suite *GlideTestSuite) TestXReadGroupNoAckAndBlockDeadline() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := "{xreadgroup}" + uuid.NewString()
+		group := uuid.NewString()
+		consumer := uuid.NewString()
+		suite.verifyOK(
+			client.XGroupCreateWithOptions(ctx, key, group, "0", *options.NewXGroupCreateOptions().SetMakeStream()),
+		)
+		_, err := client.XAdd(ctx, key, []models.FieldValue{{Field: "f", Value: "v"}})
+		require.NoError(suite.T(), err)
+
+		read, err := client.XReadGroupWithOptions(
+			ctx,
+			group,
+			consumer,
+			map[string]string{key: ">"},
+			*options.NewXReadGroupOptions().SetNoAck(),
+		)
+		assert.NoError(suite.T(), err)
+		assert.Len(suite.T(), read[key].Entries, 1)
+		pending, err := client.XPending(ctx, key, group)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(0), pending.NumOfMessages)
+
+		// A read blocking indefinitely only blocks the server until the deadline of its context.
+		deadlineCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err = client.XReadGroupWithOptions(
+			deadlineCtx,
+			group,
+			consumer,
+			map[string]string{key: ">"},
+			*options.NewXReadGroupOptions().SetBlock(0),
+		)
+		if err != nil {
+			assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+		}
+		pingCtx, cancelPing := context.WithTimeout(ctx, 2*time.Second)
+		defer cancelPing()
+		_, err = client.XLen(pingCtx, key)
+		assert.NoError(suite.T(), err)
+		assert.Less(suite.T(), time.Since(start), 2*time.Second)
+	})
+}
+
 func (suite *GlideTestSuite) TestXRead() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key1 := "{xread}" + uuid.NewString()
@@ -5901,6 +6259,48 @@ func (suite *GlideTestSuite) TestZRangeStore() {
 	})
 }
 
+func (suite *GlideTestSuite) TestZRangeStoreByScoreAndLex() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		t := suite.T()
+		ctx := context.Background()
+		key := "{key}" + uuid.New().String()
+		dest := "{key}" + uuid.New().String()
+		_, err := client.ZAdd(ctx, key, map[string]float64{"a": 1.0, "b": 2.0, "c": 3.0})
+		require.NoError(t, err)
+		stored := func() []string {
+			members, err := client.ZRange(ctx, dest, options.NewRangeByIndexQuery(0, -1))
+			require.NoError(t, err)
+			return members
+		}
+
+		// score (1:+inf]
+		res, err := client.ZRangeStoreByScore(ctx, dest, key,
+			options.NewScoreBoundary(1, false), options.NewInfiniteScoreBoundary(constants.PositiveInfinity), false, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), res)
+		assert.Equal(t, []string{"b", "c"}, stored())
+		// score [1:3] reverse limit 0 1, with the boundaries in the same order
+		res, err = client.ZRangeStoreByScore(ctx, dest, key,
+			options.NewScoreBoundary(1, true), options.NewScoreBoundary(3, true), true, &options.Limit{Offset: 0, Count: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), res)
+		assert.Equal(t, []string{"c"}, stored())
+		// lex [-:c)
+		res, err = client.ZRangeStoreByLex(ctx, dest, key,
+			options.NewInfiniteLexBoundary(constants.NegativeInfinity), options.NewLexBoundary("c", false), false, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), res)
+		assert.Equal(t, []string{"a", "b"}, stored())
+		// lex [a:+] reverse limit 0 2
+		res, err = client.ZRangeStoreByLex(ctx, dest, key,
+			options.NewLexBoundary("a", true), options.NewInfiniteLexBoundary(constants.PositiveInfinity), true,
+			&options.Limit{Offset: 0, Count: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), res)
+		assert.Equal(t, []string{"b", "c"}, stored())
+	})
+}
+
 func (suite *GlideTestSuite) TestPersist() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		// Test 1: Check if persist command removes the expiration time of a key.
@@ -5929,6 +6329,43 @@ func (suite *GlideTestSuite) TestPersist() {
 	})
 }
 
+func (suite *GlideTestSuite) TestTTLsAndPersistAll() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		// The keys map to different hash slots in cluster mode.
+		volatileKey := uuid.NewString()
+		persistentKey := uuid.NewString()
+		missingKey := uuid.NewString()
+		suite.verifyOK(client.Set(ctx, persistentKey, "value"))
+		_, err := client.SetWithOptions(
+			ctx,
+			volatileKey,
+			"value",
+			*options.NewSetOptions().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+		)
+		require.NoError(suite.T(), err)
+		keys := []string{volatileKey, persistentKey, missingKey}
+
+		ttls, err := client.TTLs(ctx, keys)
+		assert.NoError(suite.T(), err)
+		require.Len(suite.T(), ttls, 3)
+		assert.Positive(suite.T(), ttls[0])
+		assert.Equal(suite.T(), []int64{-1, -2}, ttls[1:])
+
+		persisted, err := client.PersistAll(ctx, keys)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []bool{true, false, false}, persisted)
+
+		ttls, err = client.TTLs(ctx, keys)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []int64{-1, -1, -2}, ttls)
+
+		ttls, err = client.TTLs(ctx, []string{})
+		assert.NoError(suite.T(), err)
+		assert.Empty(suite.T(), ttls)
+	})
+}
+
 func (suite *GlideTestSuite) TestZRank() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -8170,6 +8607,66 @@ func (suite *GlideTestSuite) TestXInfoGroups() {
 	})
 }
 
+func (suite *GlideTestSuite) TestStreamLag() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := uuid.NewString()
+		group := uuid.NewString()
+		consumer := uuid.NewString()
+
+		suite.verifyOK(
+			client.XGroupCreateWithOptions(ctx, key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream()),
+		)
+		for i := 1; i <= 3; i++ {
+			_, err := client.XAddWithOptions(
+				ctx,
+				key,
+				[]models.FieldValue{{Field: "f", Value: "v"}},
+				*options.NewXAddOptions().SetId(fmt.Sprintf("0-%d", i)),
+			)
+			require.NoError(suite.T(), err)
+		}
+		_, err := client.XReadGroupWithOptions(
+			ctx,
+			group,
+			consumer,
+			map[string]string{key: ">"},
+			*options.NewXReadGroupOptions().SetCount(2),
+		)
+		require.NoError(suite.T(), err)
+
+		lag, err := client.StreamLag(ctx, key, group)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), group, lag.Group)
+		assert.Equal(suite.T(), int64(1), lag.Consumers)
+		assert.Equal(suite.T(), int64(2), lag.Pending)
+		assert.Equal(suite.T(), models.CreateStringResult("0-1"), lag.OldestPendingId)
+		assert.Equal(suite.T(), models.CreateStringResult("0-2"), lag.NewestPendingId)
+		assert.Equal(
+			suite.T(),
+			[]models.ConsumerPendingMessage{{ConsumerName: consumer, MessageCount: 2}},
+			lag.ConsumerPending,
+		)
+		assert.Equal(suite.T(), "0-2", lag.LastDeliveredId)
+		assert.Equal(suite.T(), "0-3", lag.LastEntryId)
+		assert.Equal(suite.T(), int64(3), lag.StreamLength)
+		assert.False(suite.T(), lag.CaughtUp)
+		if suite.serverVersion >= "7.0.0" {
+			assert.Equal(suite.T(), models.CreateInt64Result(1), lag.Lag)
+		}
+
+		_, err = client.XReadGroup(ctx, group, consumer, map[string]string{key: ">"})
+		require.NoError(suite.T(), err)
+		lag, err = client.StreamLag(ctx, key, group)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(3), lag.Pending)
+		assert.True(suite.T(), lag.CaughtUp)
+
+		_, err = client.StreamLag(ctx, key, uuid.NewString())
+		assert.Error(suite.T(), err)
+	})
+}
+
 func (suite *GlideTestSuite) TestSetBit_SetSingleBit() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -8238,6 +8735,21 @@ func (suite *GlideTestSuite) TestWait() {
 	})
 }
 
+func (suite *GlideTestSuite) TestWaitForReplication() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.New().String()
+		suite.verifyOK(client.Set(context.Background(), key, "test"))
+		assert.NoError(suite.T(), client.WaitForReplication(context.Background(), 1, 2000*time.Millisecond))
+
+		// No deployment has that many replicas, so WAIT returns once the timeout is reached.
+		err := client.WaitForReplication(context.Background(), 100, 100*time.Millisecond)
+		var replicationErr *glide.ReplicationError
+		require.ErrorAs(suite.T(), err, &replicationErr)
+		assert.Equal(suite.T(), int64(100), replicationErr.Requested())
+		assert.Less(suite.T(), replicationErr.Acknowledged(), int64(100))
+	})
+}
+
 func (suite *GlideTestSuite) TestGetBit_ExistingKey_ValidOffset() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := uuid.New().String()
@@ -10240,6 +10752,26 @@ func (suite *GlideTestSuite) TestZLexCount() {
 			),
 		)
 		suite.Error(err)
+
+		// boundaries built with the typed helpers
+		result, err = client.ZLexCount(context.Background(),
+			key1,
+			*options.NewRangeByLexQuery(options.LexBoundary("a", options.Exclusive), options.LexPosInf()),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), result)
+
+		// invalid boundary, rejected before being sent
+		_, err = client.ZLexCount(context.Background(),
+			key1,
+			*options.NewRangeByLexQuery(options.LexBound("a"), options.LexPosInf()),
+		)
+		assert.EqualError(t, err, `invalid lex boundary: "a"`)
+		_, err = client.ZCount(context.Background(),
+			key1,
+			*options.NewZCountRange(options.ScoreBoundary(math.NaN(), options.Inclusive), options.PosInf()),
+		)
+		assert.EqualError(t, err, `invalid score boundary: "NaN"`)
 	})
 }
 
@@ -10349,6 +10881,41 @@ func (suite *GlideTestSuite) TestGeoDist() {
 	})
 }
 
+func (suite *GlideTestSuite) TestGeoAddMembers() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		key := uuid.New().String()
+		members := []options.GeoMember{
+			{Name: "Palermo", Lon: 13.361389, Lat: 38.115556},
+			options.NewGeoMemberFromPoint("Catania", [2]float64{15.087269, 37.502669}),
+			options.NewGeoMemberFromGeospatialData("edge", options.GeospatialData{Longitude: 12.758489, Latitude: 38.788135}),
+		}
+		result, err := client.GeoAddMembers(ctx, key, members)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(3), result)
+
+		positions, err := client.GeoPos(ctx, key, []string{"Palermo", "Catania", "edge"})
+		assert.NoError(suite.T(), err)
+		for i, member := range members {
+			assert.InDelta(suite.T(), member.Point()[0], positions[i][0], 1e-5)
+			assert.InDelta(suite.T(), member.Point()[1], positions[i][1], 1e-5)
+		}
+
+		// Only the existing members are updated, and the number of changed members is returned.
+		result, err = client.GeoAddMembersWithOptions(
+			ctx,
+			key,
+			[]options.GeoMember{{Name: "Palermo", Lon: 13.5, Lat: 38.1}, {Name: "Messina", Lon: 15.5, Lat: 38.2}},
+			*options.NewGeoAddOptions().SetConditionalChange(constants.OnlyIfExists).SetChanged(true),
+		)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), result)
+		exists, err := client.ZScore(ctx, key, "Messina")
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), exists.IsNil())
+	})
+}
+
 func (suite *GlideTestSuite) TestGeoAdd_InvalidArgs() {
 	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
 		key := "{testKey}:3-" + uuid.New().String()
@@ -10597,14 +11164,17 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 			{
 				Name: "edge2",
 				Dist: 236529.1799,
+				Unit: constants.GeoUnitMeters,
 			},
 			{
 				Name: "Palermo",
 				Dist: 166274.1516,
+				Unit: constants.GeoUnitMeters,
 			},
 			{
 				Name: "Catania",
 				Dist: 0.0,
+				Unit: constants.GeoUnitMeters,
 			},
 		}
 		memberResults, err := client.GeoSearchWithFullOptions(context.Background(),
@@ -10628,8 +11198,8 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 			*options.NewGeoSearchInfoOptions().SetWithHash(true),
 		)
 		expectedResults3 := []options.Location{
-			{Name: "Palermo", Hash: int64(3479099956230698)},
-			{Name: "edge1", Hash: int64(3479273021651468)},
+			{Name: "Palermo", Hash: int64(3479099956230698), Unit: constants.GeoUnitFeet},
+			{Name: "edge1", Hash: int64(3479273021651468), Unit: constants.GeoUnitFeet},
 		}
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), 2, len(feetResult))
@@ -10719,6 +11289,7 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 					Longitude: 13.361389338970184,
 					Latitude:  38.1155563954963,
 				},
+				Unit: constants.GeoUnitKilometers,
 			},
 		}
 		anyResult, err := client.GeoSearchWithFullOptions(context.Background(),
@@ -10732,6 +11303,12 @@ func (suite *GlideTestSuite) TestGeoSearch() {
 		)
 		assert.NoError(suite.T(), err)
 		assert.Equal(suite.T(), expectedAnyResults, anyResult)
+		// The distances can be read in any unit.
+		assert.InDelta(suite.T(), 190442.4, anyResult[0].Meters(), 1e-6)
+		assert.InDelta(suite.T(), 190.4424, anyResult[0].Kilometers(), 1e-9)
+		assert.InDelta(suite.T(), 190442.4/1609.34, anyResult[0].Miles(), 1e-9)
+		assert.InDelta(suite.T(), 190442.4/0.3048, anyResult[0].Feet(), 1e-6)
+		assert.Equal(suite.T(), float64(3479099956230698), anyResult[0].Score())
 
 		// Test empty results - small area
 		smallShape := options.NewBoxSearchShape(50, 50, constants.GeoUnitMeters)
@@ -11258,3 +11835,297 @@ func (suite *GlideTestSuite) TestRegisterClientNameAndVersion() {
 		assert.Regexp(suite.T(), "lib-ver=unknown|lib-ver=v", infoStr, "lib-ver not found or incorrect")
 	})
 }
+
+func (suite *GlideTestSuite) TestBulkLoadAndBulkGet() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		prefix := uuid.NewString()
+		values := make(map[string]string)
+		keys := make([]string, 0, 250)
+		for i := 0; i < 250; i++ {
+			key := fmt.Sprintf("%s:%d", prefix, i)
+			values[key] = strconv.Itoa(i)
+			keys = append(keys, key)
+		}
+		var progress []int
+		opts := options.NewBulkOptions().SetChunkSize(40).SetParallelism(3).
+			SetProgress(func(done int, total int) { progress = append(progress, done) })
+
+		loaded, err := client.BulkLoad(ctx, values, *opts)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), 250, loaded)
+		assert.Len(suite.T(), progress, 7)
+		assert.Equal(suite.T(), 250, progress[len(progress)-1])
+
+		missingKey := uuid.NewString()
+		result, err := client.BulkGet(ctx, append(keys, missingKey), *options.NewBulkOptions().SetChunkSize(40))
+		assert.NoError(suite.T(), err)
+		require.Len(suite.T(), result, 251)
+		for i, key := range keys {
+			assert.Equal(suite.T(), values[key], result[i].Value())
+		}
+		assert.True(suite.T(), result[250].IsNil())
+
+		loaded, err = client.BulkLoad(ctx, map[string]string{}, *opts)
+		assert.NoError(suite.T(), err)
+		assert.Zero(suite.T(), loaded)
+	})
+}
+
+func (suite *GlideTestSuite) TestExecAll() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		prefix := uuid.NewString()
+		keys := make([]string, 20)
+		for i := range keys {
+			keys[i] = fmt.Sprintf("%s:%d", prefix, i)
+			suite.verifyOK(client.Set(ctx, keys[i], strconv.Itoa(i)))
+		}
+
+		values := make([]string, len(keys))
+		fns := make([]func(client interfaces.BaseClientCommands) error, len(keys))
+		for i, key := range keys {
+			fns[i] = func(client interfaces.BaseClientCommands) error {
+				result, err := client.Get(ctx, key)
+				values[i] = result.Value()
+				return err
+			}
+		}
+		assert.NoError(suite.T(), client.ExecAllWithParallelism(ctx, 4, fns...))
+		for i, value := range values {
+			assert.Equal(suite.T(), strconv.Itoa(i), value)
+		}
+
+		err := client.ExecAll(ctx,
+			func(client interfaces.BaseClientCommands) error {
+				_, err := client.Get(ctx, keys[0])
+				return err
+			},
+			func(client interfaces.BaseClientCommands) error {
+				_, err := client.LPush(ctx, keys[1], []string{"value"})
+				return err
+			},
+		)
+		assert.Error(suite.T(), err)
+		assert.Contains(suite.T(), err.Error(), "WRONGTYPE")
+	})
+}
+
+func (suite *GlideTestSuite) TestExportAndImportKeys() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		ctx := context.Background()
+		prefix := uuid.NewString()
+		stringKey := prefix + ":string"
+		hashKey := prefix + ":hash"
+		volatileKey := prefix + ":volatile"
+		suite.verifyOK(client.Set(ctx, stringKey, "\x00binary\xff"))
+		_, err := client.HSet(ctx, hashKey, map[string]string{"field": "value"})
+		require.NoError(suite.T(), err)
+		_, err = client.SetWithOptions(
+			ctx,
+			volatileKey,
+			"value",
+			*options.NewSetOptions().SetExpiry(options.NewExpiryIn(100 * time.Second)),
+		)
+		require.NoError(suite.T(), err)
+
+		var buffer bytes.Buffer
+		var exported int
+		switch c := client.(type) {
+		case interfaces.GlideClientCommands:
+			exported, err = c.ExportKeys(ctx, prefix+":*", &buffer)
+		case interfaces.GlideClusterClientCommands:
+			exported, err = c.ExportKeys(ctx, prefix+":*", &buffer)
+		}
+		require.NoError(suite.T(), err)
+		assert.GreaterOrEqual(suite.T(), exported, 3)
+
+		_, err = client.Del(ctx, []string{stringKey, hashKey, volatileKey})
+		require.NoError(suite.T(), err)
+
+		imported, err := client.ImportKeys(ctx, &buffer)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), exported, imported)
+		value, err := client.Get(ctx, stringKey)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "\x00binary\xff", value.Value())
+		hash, err := client.HGetAll(ctx, hashKey)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), map[string]string{"field": "value"}, hash)
+		ttl, err := client.TTL(ctx, volatileKey)
+		assert.NoError(suite.T(), err)
+		assert.Positive(suite.T(), ttl)
+
+		_, err = client.ImportKeys(ctx, strings.NewReader("not an export"))
+		assert.ErrorContains(suite.T(), err, "invalid export")
+	})
+}
+
+func (suite *GlideTestSuite) TestBlockingCommandTimeoutClampedToDeadline() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := "{key}-" + uuid.NewString()
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		// The server-side timeout of 10s is clamped to the deadline, so that BLPOP does not keep blocking the
+		// connection after the caller gave up.
+		started := time.Now()
+		result, err := client.BLPop(ctx, []string{key}, 10*time.Second)
+		if err != nil {
+			assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+		} else {
+			assert.Nil(suite.T(), result)
+		}
+		_, err = client.Get(context.Background(), key)
+		require.NoError(suite.T(), err)
+		assert.Less(suite.T(), time.Since(started), 5*time.Second)
+	})
+}
+
+func (suite *GlideTestSuite) TestRotateListAndCircularBuffer() {
+	if suite.serverVersion < "6.2.0" {
+		suite.T().Skip("This feature is added in version 6.2.0")
+	}
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		res, err := client.RotateList(context.Background(), key, constants.Left)
+		suite.NoError(err)
+		suite.True(res.IsNil())
+
+		_, err = client.RPush(context.Background(), key, []string{"one", "two", "three"})
+		suite.NoError(err)
+		res, err = client.RotateList(context.Background(), key, constants.Left)
+		suite.NoError(err)
+		suite.Equal("one", res.Value())
+		res, err = client.RotateList(context.Background(), key, constants.Right)
+		suite.NoError(err)
+		suite.Equal("one", res.Value())
+		list, err := client.LRange(context.Background(), key, 0, -1)
+		suite.NoError(err)
+		suite.Equal([]string{"one", "two", "three"}, list)
+	})
+
+	for _, client := range []interface {
+		CircularBuffer(key string, capacity int64) *glide.CircularBuffer
+	}{suite.defaultClient(), suite.defaultClusterClient()} {
+		buffer := client.CircularBuffer(uuid.NewString(), 3)
+		length, err := buffer.Push(context.Background(), []string{"e1", "e2"})
+		suite.NoError(err)
+		suite.Equal(int64(2), length)
+		length, err = buffer.Push(context.Background(), []string{"e3", "e4"})
+		suite.NoError(err)
+		suite.Equal(int64(3), length)
+
+		items, err := buffer.Items(context.Background())
+		suite.NoError(err)
+		suite.Equal([]string{"e4", "e3", "e2"}, items)
+		length, err = buffer.Len(context.Background())
+		suite.NoError(err)
+		suite.Equal(int64(3), length)
+	}
+}
+
+func (suite *GlideTestSuite) TestBlob() {
+	for _, client := range []interface {
+		Blob(key string) *glide.Blob
+	}{suite.defaultClient(), suite.defaultClusterClient()} {
+		blob := client.Blob(uuid.NewString()).WithChunkSize(4).WithParallelism(2)
+		_, err := blob.Size(context.Background())
+		suite.ErrorIs(err, glide.ErrBlobNotFound)
+
+		size, err := blob.Upload(context.Background(), strings.NewReader("hello, chunked world"))
+		suite.NoError(err)
+		suite.Equal(int64(20), size)
+
+		data, err := blob.ReadRange(context.Background(), 3, 8)
+		suite.NoError(err)
+		suite.Equal("lo, chun", string(data))
+		data, err = blob.ReadRange(context.Background(), 18, 10)
+		suite.NoError(err)
+		suite.Equal("ld", string(data))
+
+		size, err = blob.Append(context.Background(), []byte("!!!"))
+		suite.NoError(err)
+		suite.Equal(int64(23), size)
+
+		var out bytes.Buffer
+		written, err := blob.Download(context.Background(), &out)
+		suite.NoError(err)
+		suite.Equal(int64(23), written)
+		suite.Equal("hello, chunked world!!!", out.String())
+
+		size, err = blob.Upload(context.Background(), strings.NewReader("short"))
+		suite.NoError(err)
+		suite.Equal(int64(5), size)
+		data, err = blob.ReadRange(context.Background(), 0, 100)
+		suite.NoError(err)
+		suite.Equal("short", string(data))
+
+		suite.NoError(blob.Delete(context.Background()))
+		_, err = blob.Download(context.Background(), &out)
+		suite.ErrorIs(err, glide.ErrBlobNotFound)
+	}
+}
+
+func (suite *GlideTestSuite) TestSubstr() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		_, err := client.Set(context.Background(), key, "Welcome to Valkey Glide!")
+		suite.NoError(err)
+
+		//lint:ignore SA1019 SUBSTR is tested for parity with GETRANGE
+		res, err := client.Substr(context.Background(), key, 0, 6)
+		suite.NoError(err)
+		suite.Equal("Welcome", res)
+	})
+}
+
+func (suite *GlideTestSuite) TestGetWithTTL() {
+	suite.runWithDefaultClients(func(client interfaces.BaseClientCommands) {
+		key := uuid.NewString()
+		result, ttl, err := client.GetWithTTL(context.Background(), key)
+		suite.NoError(err)
+		suite.True(result.IsNil())
+		suite.Equal(time.Duration(0), ttl)
+
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+		result, ttl, err = client.GetWithTTL(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal("value", result.Value())
+		suite.Equal(time.Duration(-1), ttl)
+
+		_, err = client.Expire(context.Background(), key, 100*time.Second)
+		suite.NoError(err)
+		result, ttl, err = client.GetWithTTL(context.Background(), key)
+		suite.NoError(err)
+		suite.Equal("value", result.Value())
+		suite.Greater(ttl, 90*time.Second)
+		suite.LessOrEqual(ttl, 100*time.Second)
+	})
+}
+
+func (suite *GlideTestSuite) TestScriptSet() {
+	for _, client := range []interface {
+		ScriptSet() *glide.ScriptSet
+		ScriptFlush(ctx context.Context) (string, error)
+	}{suite.defaultClient(), suite.defaultClusterClient()} {
+		key := uuid.NewString()
+		scripts := client.ScriptSet().
+			Register("set", "return redis.call('SET', KEYS[1], ARGV[1])").
+			Register("get", "return redis.call('GET', KEYS[1])")
+		suite.NoError(scripts.Load(context.Background()))
+
+		result, err := scripts.Invoke(context.Background(), "set", []string{key}, []string{"v1"})
+		suite.NoError(err)
+		suite.Equal("OK", result)
+
+		_, err = client.ScriptFlush(context.Background())
+		suite.NoError(err)
+		result, err = scripts.Invoke(context.Background(), "get", []string{key}, nil)
+		suite.NoError(err)
+		suite.Equal("v1", result)
+
+		_, err = scripts.Invoke(context.Background(), "missing", nil, nil)
+		suite.Error(err)
+	}
+}
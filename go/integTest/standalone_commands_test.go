@@ -3,11 +3,18 @@
 package integTest
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	cryptorand "crypto/rand"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
@@ -17,6 +24,7 @@ import (
 	glide "github.com/valkey-io/valkey-glide/go/v2"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -817,6 +825,69 @@ func (suite *GlideTestSuite) TestLastSave() {
 	assert.Greater(t, result, int64(0))
 }
 
+func (suite *GlideTestSuite) TestFailover_InvalidOptions() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	_, err := client.Failover(context.Background(), options.NewFailoverOptions().SetAbort().SetTimeout(time.Second))
+	assert.ErrorContains(t, err, "ABORT cannot be combined")
+
+	_, err = client.Failover(context.Background(), options.NewFailoverOptions().SetForce().SetTimeout(time.Second))
+	assert.ErrorContains(t, err, "FORCE requires both a target replica and a timeout")
+
+	_, err = client.Failover(context.Background(), options.NewFailoverOptions().SetTimeout(-time.Second))
+	assert.ErrorContains(t, err, "cannot be negative")
+}
+
+func (suite *GlideTestSuite) TestFailover_AbortWithoutFailover() {
+	client := suite.defaultClient()
+	_, err := client.Failover(context.Background(), options.NewFailoverOptions().SetAbort())
+	assert.ErrorContains(suite.T(), err, "No failover in progress")
+}
+
+func (suite *GlideTestSuite) TestCommandGetKeys() {
+	client := suite.defaultClient()
+	t := suite.T()
+
+	keys, err := client.CommandGetKeys(context.Background(), []string{"EVAL", "return 1", "2", "key1", "key2", "arg"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key1", "key2"}, keys)
+
+	_, err = client.CommandGetKeys(context.Background(), []string{"PING"})
+	assert.Error(t, err)
+
+	_, err = client.CommandGetKeys(context.Background(), []string{})
+	assert.ErrorContains(t, err, "requires the command name")
+}
+
+func (suite *GlideTestSuite) TestShutdown_AbortWithoutShutdown() {
+	client := suite.defaultClient()
+	_, err := client.Shutdown(context.Background(), options.NewShutdownOptions().SetAbort())
+	assert.ErrorContains(suite.T(), err, "No shutdown in progress")
+
+	_, err = client.Shutdown(context.Background(), options.NewShutdownOptions().SetAbort().SetNow())
+	assert.ErrorContains(suite.T(), err, "ABORT cannot be combined")
+}
+
+func (suite *GlideTestSuite) TestSaveAndLastSave() {
+	client := suite.defaultClient()
+	t := suite.T()
+	before, err := client.LastSave(context.Background())
+	require.NoError(t, err)
+	suite.verifyOK(client.Save(context.Background()))
+	after, err := client.LastSave(context.Background())
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, after, before)
+}
+
+func (suite *GlideTestSuite) TestBgRewriteAof() {
+	client := suite.defaultClient()
+	status, err := client.BgRewriteAof(context.Background())
+	require.NoError(suite.T(), err)
+	expected := []models.BackgroundTaskStatus{models.BackgroundTaskStarted, models.BackgroundTaskScheduled}
+	assert.Contains(suite.T(), expected, status)
+}
+
 func (suite *GlideTestSuite) TestConfigResetStat() {
 	client := suite.defaultClient()
 	suite.verifyOK(client.ConfigResetStat(context.Background()))
@@ -902,6 +973,15 @@ func (suite *GlideTestSuite) TestConfigRewrite() {
 	}
 }
 
+func (suite *GlideTestSuite) TestSlaveOfNoOne() {
+	client := suite.defaultClient()
+	// The server is a primary already, so promoting it leaves it unchanged.
+	suite.verifyOK(client.SlaveOfNoOne(context.Background()))
+	result, err := client.ReplicationInfo(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsPrimary())
+}
+
 func (suite *GlideTestSuite) TestRandomKey() {
 	client := suite.defaultClient()
 	// Test 1: Check if the command return random key
@@ -1320,3 +1400,618 @@ func (suite *GlideTestSuite) TestScriptKill() {
 	assert.Error(suite.T(), err)
 	assert.True(suite.T(), strings.Contains(strings.ToLower(err.Error()), "notbusy"))
 }
+
+func (suite *GlideTestSuite) TestStrictValidation() {
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithStrictValidation(true))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	var requestErr *glide.RequestError
+
+	_, err = client.GetDel(ctx, "")
+	assert.ErrorAs(suite.T(), err, &requestErr)
+	assert.ErrorContains(suite.T(), err, "GETDEL")
+
+	_, err = client.Del(ctx, []string{})
+	assert.ErrorAs(suite.T(), err, &requestErr)
+
+	_, err = client.SAdd(ctx, uuid.NewString(), nil)
+	assert.ErrorAs(suite.T(), err, &requestErr)
+
+	_, err = client.LPopCount(ctx, uuid.NewString(), -1)
+	assert.ErrorAs(suite.T(), err, &requestErr)
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	result, err := client.GetDel(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+}
+
+func (suite *GlideTestSuite) TestAuditHook() {
+	var mu sync.Mutex
+	var records []config.AuditRecord
+	hook := func(record config.AuditRecord) {
+		mu.Lock()
+		defer mu.Unlock()
+		records = append(records, record)
+	}
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithAuditHook(hook, nil))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(context.Background(), key, "secret"))
+	_, err = client.CustomCommand(context.Background(), []string{"get", key})
+	assert.NoError(suite.T(), err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(suite.T(), records, 2)
+	assert.Equal(suite.T(), "SET", records[0].Command)
+	assert.Equal(suite.T(), []string{key, config.DefaultRedactionMask}, records[0].Args)
+	assert.Equal(suite.T(), "GET", records[1].Command)
+	assert.Equal(suite.T(), []string{key}, records[1].Args)
+}
+
+func (suite *GlideTestSuite) TestPreparedCommands() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	stream := uuid.NewString()
+
+	addEvent, err := client.Prepare("add_event", "xadd", []string{stream, "*", "type", "$1", "payload", "$2"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "add_event", addEvent.Name())
+	_, err = addEvent.Execute(ctx, "click", "{}")
+	assert.NoError(suite.T(), err)
+	_, err = client.ExecutePrepared(ctx, "add_event", "view", "{}")
+	assert.NoError(suite.T(), err)
+	length, err := client.XLen(ctx, stream)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), length)
+
+	var requestErr *glide.RequestError
+	_, err = addEvent.Execute(ctx, "click")
+	assert.ErrorAs(suite.T(), err, &requestErr)
+	_, err = client.ExecutePrepared(ctx, "unknown")
+	assert.ErrorAs(suite.T(), err, &requestErr)
+
+	// Commands without a request type of their own are sent as custom commands.
+	memoryUsage, err := client.Prepare("memory_usage", "MEMORY USAGE", []string{"$1"})
+	require.NoError(suite.T(), err)
+	result, err := memoryUsage.Execute(ctx, stream)
+	assert.NoError(suite.T(), err)
+	assert.Positive(suite.T(), result)
+
+	_, err = client.Prepare("invalid", "SET", []string{"$2"})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestWatchValue() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+
+	for _, flags := range []string{"", "KA"} {
+		previous, err := client.ConfigGet(ctx, []string{"notify-keyspace-events"})
+		require.NoError(suite.T(), err)
+		suite.verifyOK(client.ConfigSet(ctx, map[string]string{"notify-keyspace-events": flags}))
+
+		key := uuid.NewString()
+		values, stop := client.WatchValue(ctx, key, 10*time.Millisecond)
+		assert.True(suite.T(), (<-values).IsNil())
+
+		suite.verifyOK(client.Set(ctx, key, initialValue))
+		assert.Equal(suite.T(), initialValue, (<-values).Value())
+		suite.verifyOK(client.Set(ctx, key, anotherValue))
+		assert.Equal(suite.T(), anotherValue, (<-values).Value())
+		_, err = client.Del(ctx, []string{key})
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), (<-values).IsNil())
+
+		stop()
+		for range values {
+			// Drain the value read before stopping, if any.
+		}
+		suite.verifyOK(client.ConfigSet(ctx, previous))
+	}
+}
+
+func (suite *GlideTestSuite) TestReconfigure() {
+	client, err := suite.client(suite.defaultClientConfig())
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	var configErr *glide.ConfigurationError
+
+	err = client.Reconfigure(ctx, config.NewReconfiguration().WithReadFrom(config.PreferReplica))
+	assert.ErrorAs(suite.T(), err, &configErr)
+	err = client.Reconfigure(ctx, config.NewReconfiguration().WithCredentials(config.NewServerCredentials("other", "")))
+	assert.ErrorAs(suite.T(), err, &configErr)
+
+	require.NoError(suite.T(), client.Reconfigure(ctx, config.NewReconfiguration().WithRequestTimeout(time.Nanosecond)))
+	_, err = client.Get(ctx, uuid.NewString())
+	var timeoutErr *glide.TimeoutError
+	assert.ErrorAs(suite.T(), err, &timeoutErr)
+
+	require.NoError(suite.T(), client.Reconfigure(ctx, config.NewReconfiguration().WithRequestTimeout(0)))
+	_, err = client.Get(ctx, uuid.NewString())
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestCompression() {
+	compression := config.NewCompression(config.NewGzipCompressor(gzip.BestSpeed), 64)
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithCompression(compression))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	plainClient := suite.defaultClient()
+	ctx := context.Background()
+	large := strings.Repeat(`{"field":"value"}`, 100)
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(ctx, key, large))
+	raw, err := plainClient.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Less(suite.T(), len(raw.Value()), len(large))
+	result, err := client.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), large, result.Value())
+
+	// Values written without compression are read as is.
+	plainKey := uuid.NewString()
+	suite.verifyOK(plainClient.Set(ctx, plainKey, large))
+	values, err := client.MGet(ctx, []string{key, plainKey})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), large, values[0].Value())
+	assert.Equal(suite.T(), large, values[1].Value())
+
+	hashKey := uuid.NewString()
+	_, err = client.HSet(ctx, hashKey, map[string]string{"large": large, "small": "small"})
+	assert.NoError(suite.T(), err)
+	hash, err := client.HGetAll(ctx, hashKey)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{"large": large, "small": "small"}, hash)
+	field, err := client.HGet(ctx, hashKey, "large")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), large, field.Value())
+}
+
+// envelopeTransformer encrypts values with AES-GCM, as a stand-in for envelope encryption with keys managed by a KMS.
+type envelopeTransformer struct {
+	aead cipher.AEAD
+}
+
+const envelopePrefix = "enc:v1:"
+
+func newEnvelopeTransformer(key []byte) *envelopeTransformer {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return &envelopeTransformer{aead: aead}
+}
+
+func (transformer *envelopeTransformer) Encode(value string) (string, error) {
+	nonce := make([]byte, transformer.aead.NonceSize())
+	if _, err := cryptorand.Read(nonce); err != nil {
+		return "", err
+	}
+	return envelopePrefix + string(transformer.aead.Seal(nonce, nonce, []byte(value), nil)), nil
+}
+
+func (transformer *envelopeTransformer) Decode(value string) (string, error) {
+	if !strings.HasPrefix(value, envelopePrefix) {
+		return value, nil
+	}
+	sealed := []byte(strings.TrimPrefix(value, envelopePrefix))
+	nonceSize := transformer.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("encrypted value is too short")
+	}
+	plain, err := transformer.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	return string(plain), err
+}
+
+func (suite *GlideTestSuite) TestTransformers() {
+	transformer := newEnvelopeTransformer([]byte("0123456789abcdef0123456789abcdef"))
+	compression := config.NewCompression(config.NewGzipCompressor(gzip.BestSpeed), 64)
+	clientConfig := suite.defaultClientConfig().WithAdvancedConfiguration(
+		config.NewAdvancedClientConfiguration().WithCompression(compression).WithTransformers(transformer),
+	)
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	plainClient := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	large := strings.Repeat("secret ", 100)
+
+	suite.verifyOK(client.Set(ctx, key, "secret"))
+	raw, err := plainClient.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), strings.HasPrefix(raw.Value(), envelopePrefix))
+	assert.NotContains(suite.T(), raw.Value(), "secret")
+	result, err := client.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "secret", result.Value())
+
+	// Large values are compressed before being encrypted.
+	suite.verifyOK(client.Set(ctx, key, large))
+	raw, err = plainClient.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Less(suite.T(), len(raw.Value()), len(large))
+	result, err = client.GetDel(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), large, result.Value())
+}
+
+func (suite *GlideTestSuite) TestReadCoalescing() {
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithReadCoalescing(true))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+	hashKey := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	_, err = client.HSet(ctx, hashKey, map[string]string{"field": "value"})
+	require.NoError(suite.T(), err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			result, err := client.Get(ctx, key)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), "value", result.Value())
+		}()
+		go func() {
+			defer wg.Done()
+			result, err := client.HGetAll(ctx, hashKey)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), map[string]string{"field": "value"}, result)
+			result["other"] = "mutated"
+		}()
+	}
+	wg.Wait()
+	assert.GreaterOrEqual(suite.T(), client.Statistics().CoalescedReads, int64(0))
+}
+
+func (suite *GlideTestSuite) TestMaxRequestSize() {
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithMaxRequestSize(1024))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	var tooLargeErr *glide.RequestTooLargeError
+	_, err = client.Set(ctx, key, strings.Repeat("x", 2048))
+	assert.ErrorAs(suite.T(), err, &tooLargeErr)
+	_, err = client.MSet(ctx, map[string]string{key: strings.Repeat("x", 512), uuid.NewString(): strings.Repeat("x", 512)})
+	assert.ErrorAs(suite.T(), err, &tooLargeErr)
+
+	batch := pipeline.NewStandaloneBatch(false).Set(key, strings.Repeat("x", 600)).Set(key, strings.Repeat("x", 600))
+	_, err = client.Exec(ctx, *batch, false)
+	assert.ErrorAs(suite.T(), err, &tooLargeErr)
+
+	result, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+}
+
+func (suite *GlideTestSuite) TestTenantClient() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	tenantID := uuid.NewString()
+	tenant, err := client.TenantClient(tenantID)
+	require.NoError(suite.T(), err)
+	defer tenant.Close()
+
+	key := uuid.NewString()
+	suite.verifyOK(tenant.Set(ctx, key, "value"))
+	result, err := client.Get(ctx, tenantID+":"+key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+	exists, err := client.Exists(ctx, []string{key})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(0), exists)
+
+	suite.verifyOK(tenant.MSet(ctx, map[string]string{"k1": "v1", "k2": "v2"}))
+	values, err := client.MGet(ctx, []string{tenantID + ":k1", tenantID + ":k2"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "v1", values[0].Value())
+	assert.Equal(suite.T(), "v2", values[1].Value())
+
+	batch := pipeline.NewStandaloneBatch(false).Get(key).Del([]string{key})
+	batchResult, err := tenant.Exec(ctx, *batch, true)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []any{"value", int64(1)}, batchResult)
+
+	var requestErr *glide.RequestError
+	_, err = tenant.CustomCommand(ctx, []string{"GET", key})
+	assert.ErrorAs(suite.T(), err, &requestErr)
+	_, err = tenant.Scan(ctx, models.NewCursor())
+	assert.ErrorAs(suite.T(), err, &requestErr)
+
+	limited, err := client.TenantClientWithOptions(tenantID, *options.NewTenantOptions().SetRateLimit(0.001, 1))
+	require.NoError(suite.T(), err)
+	defer limited.Close()
+	_, err = limited.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	var rateErr *glide.RateLimitError
+	_, err = limited.Get(ctx, key)
+	assert.ErrorAs(suite.T(), err, &rateErr)
+
+	// closing a tenant client leaves the shared connection open
+	tenant.Close()
+	_, err = client.Ping(ctx)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestTenantClientReadCoalescing() {
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithReadCoalescing(true))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	tenantA, err := client.TenantClient(uuid.NewString())
+	require.NoError(suite.T(), err)
+	defer tenantA.Close()
+	tenantB, err := client.TenantClient(uuid.NewString())
+	require.NoError(suite.T(), err)
+	defer tenantB.Close()
+
+	key := uuid.NewString()
+	suite.verifyOK(tenantA.Set(ctx, key, "a"))
+	suite.verifyOK(tenantB.Set(ctx, key, "b"))
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(2)
+		for _, reader := range []struct {
+			client   *glide.Client
+			expected string
+		}{{tenantA, "a"}, {tenantB, "b"}} {
+			go func() {
+				defer wg.Done()
+				result, err := reader.client.Get(ctx, key)
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), reader.expected, result.Value())
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func (suite *GlideTestSuite) TestDeniedCommands() {
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithDeniedCommands("FLUSHALL", "config set"))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	var forbiddenErr *glide.ForbiddenCommandError
+	_, err = client.FlushAll(ctx)
+	assert.ErrorAs(suite.T(), err, &forbiddenErr)
+	_, err = client.CustomCommand(ctx, []string{"flushall"})
+	assert.ErrorAs(suite.T(), err, &forbiddenErr)
+	_, err = client.ConfigSet(ctx, map[string]string{"timeout": "1000"})
+	assert.ErrorAs(suite.T(), err, &forbiddenErr)
+	_, err = client.Exec(ctx, *pipeline.NewStandaloneBatch(false).Get(key).FlushAll(), false)
+	assert.ErrorAs(suite.T(), err, &forbiddenErr)
+
+	result, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+}
+
+func (suite *GlideTestSuite) TestQuotaHook() {
+	var sent atomic.Int64
+	quota := func(ctx context.Context, request config.QuotaRequest) error {
+		if sent.Load()+request.PayloadSize > 1024 {
+			return errors.New("bandwidth quota exceeded")
+		}
+		sent.Add(request.PayloadSize)
+		return nil
+	}
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithQuotaHook(quota))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	var quotaErr *glide.QuotaExceededError
+	_, err = client.Set(ctx, key, strings.Repeat("x", 2048))
+	assert.ErrorAs(suite.T(), err, &quotaErr)
+	result, err := client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+}
+
+func (suite *GlideTestSuite) TestExecuteRaw() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	_, err := client.HSet(ctx, key, map[string]string{"field": "value"})
+	require.NoError(suite.T(), err)
+
+	result, err := client.ExecuteRaw(ctx, glide.RequestTypeCustomCommand, []string{"HGETALL", key}, nil)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), models.RawMap, result.Type)
+	require.Len(suite.T(), result.Entries, 1)
+	assert.Equal(suite.T(), []byte("field"), result.Entries[0].Key.Data)
+	assert.Equal(suite.T(), []byte("value"), result.Entries[0].Value.Data)
+
+	result, err = client.ExecuteRaw(ctx, glide.RequestTypeCustomCommand, []string{"GET", uuid.NewString()}, nil)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsNull())
+
+	result, err = client.ExecuteRaw(ctx, glide.RequestTypeHGet, []string{key, "field"}, nil)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []byte("value"), result.Data)
+
+	var requestErr *glide.RequestError
+	_, err = client.ExecuteRaw(ctx, glide.RequestTypeCustomCommand, []string{"PING"}, config.AllNodes)
+	assert.ErrorAs(suite.T(), err, &requestErr)
+}
+
+func (suite *GlideTestSuite) TestAsyncCommands() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	prefix := uuid.NewString()
+	keys := make([]string, 100)
+	sets := make([]*glide.Future[string], len(keys))
+	for i := range keys {
+		keys[i] = fmt.Sprintf("%s:%d", prefix, i)
+		sets[i] = client.SetAsync(ctx, keys[i], strconv.Itoa(i))
+	}
+	for _, set := range sets {
+		result, err := set.Get()
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "OK", result)
+	}
+
+	gets := make([]*glide.Future[models.Result[string]], len(keys))
+	for i, key := range keys {
+		gets[i] = client.GetAsync(ctx, key)
+	}
+	missing := client.GetAsync(ctx, prefix+":missing")
+	for i, get := range gets {
+		result, err := get.Get()
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), strconv.Itoa(i), result.Value())
+	}
+	result, err := missing.Get()
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsNil())
+
+	deleted, err := client.DelAsync(ctx, keys).Get()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(len(keys)), deleted)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.GetAsync(canceled, keys[0]).Get()
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+}
+
+func (suite *GlideTestSuite) TestCommandLatenciesWithProfilerLabels() {
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithProfilerLabels(true))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+	before := glide.GetCommandLatencies()
+
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	_, err = client.Get(ctx, key)
+	require.NoError(suite.T(), err)
+	_, err = client.CustomCommand(ctx, []string{"get", key})
+	require.NoError(suite.T(), err)
+
+	after := glide.GetCommandLatencies()
+	// Other clients of the process may count commands concurrently.
+	assert.GreaterOrEqual(suite.T(), after["SET"].Count, before["SET"].Count+1)
+	assert.GreaterOrEqual(suite.T(), after["GET"].Count, before["GET"].Count+2)
+	assert.Greater(suite.T(), after["GET"].Sum, before["GET"].Sum)
+}
+
+func (suite *GlideTestSuite) TestSlowCommandLog() {
+	clientConfig := suite.defaultClientConfig().
+		WithAdvancedConfiguration(config.NewAdvancedClientConfiguration().WithSlowCommandLog(50*time.Millisecond, 2))
+	client, err := suite.client(clientConfig)
+	require.NoError(suite.T(), err)
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	assert.Empty(suite.T(), client.SlowCommands())
+
+	// BLPOP on an empty list blocks until its timeout, which exceeds the threshold.
+	for range 3 {
+		_, err = client.BLPop(ctx, []string{key + "-list"}, 100*time.Millisecond)
+		require.NoError(suite.T(), err)
+	}
+	slow := client.SlowCommands()
+	require.Len(suite.T(), slow, 2)
+	for _, command := range slow {
+		assert.Equal(suite.T(), "BLPOP", command.Command)
+		assert.Equal(suite.T(), []string{key + "-list", config.DefaultRedactionMask}, command.Args)
+		assert.GreaterOrEqual(suite.T(), command.Duration, 50*time.Millisecond)
+		assert.Empty(suite.T(), command.Route)
+		assert.Empty(suite.T(), command.Node)
+	}
+	assert.True(suite.T(), slow[0].Time.Before(slow[1].Time))
+}
+
+func (suite *GlideTestSuite) TestBrowseKeys() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	prefix := "browse:" + uuid.NewString() + ":"
+	for idx := range 25 {
+		suite.verifyOK(client.Set(ctx, prefix+strconv.Itoa(idx), "value"))
+	}
+
+	var keys []string
+	cursor := models.NewCursor()
+	for !cursor.IsFinished() {
+		page, err := client.BrowseKeys(ctx, cursor, prefix+"*", 10)
+		suite.NoError(err)
+		for _, info := range page.Keys {
+			suite.True(info.Exists)
+			suite.Equal("string", info.Type)
+			keys = append(keys, info.Key)
+		}
+		cursor = page.Cursor
+	}
+	suite.Len(keys, 25)
+
+	_, err := client.BrowseKeys(ctx, models.NewCursor(), prefix+"*", 0)
+	suite.Error(err)
+}
+
+func (suite *GlideTestSuite) TestDeleteByPattern() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	prefix := uuid.NewString() + ":"
+	for idx := range 25 {
+		suite.verifyOK(client.Set(ctx, prefix+strconv.Itoa(idx), "value"))
+	}
+	suite.verifyOK(client.Set(ctx, prefix+"keep", "value"))
+
+	matched, err := client.DeleteByPattern(ctx, prefix+"[0-9]*", *options.NewDeleteByPatternOptions().SetDryRun(true))
+	suite.NoError(err)
+	suite.GreaterOrEqual(matched, int64(25))
+
+	deleted, err := client.DeleteByPattern(ctx, prefix+"[0-9]*", *options.NewDeleteByPatternOptions().SetMaxKeys(10))
+	suite.NoError(err)
+	suite.Equal(int64(10), deleted)
+
+	var reported int64
+	deleted, err = client.DeleteByPattern(ctx, prefix+"[0-9]*", *options.NewDeleteByPatternOptions().
+		SetBatchSize(5).
+		SetMaxKeysPerSecond(1000).
+		SetProgress(func(_ int64, deleted int64) { reported = deleted }))
+	suite.NoError(err)
+	suite.Equal(int64(15), deleted)
+	suite.Equal(int64(15), reported)
+
+	exists, err := client.Exists(ctx, []string{prefix + "keep", prefix + "0"})
+	suite.NoError(err)
+	suite.Equal(int64(1), exists)
+
+	_, err = client.DeleteByPattern(ctx, "*", *options.NewDeleteByPatternOptions())
+	suite.Error(err)
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
@@ -344,6 +345,21 @@ func Convert2DArrayOfFloat(data any) (any, error) {
 	// actually returns a [][]float64
 }
 
+// GeoSearchWithFullOptions, with the distances in the given unit
+func LocationArrayConverter(unit constants.GeoUnit) func(any) (any, error) {
+	return func(data any) (any, error) {
+		converted, err := ConvertLocationArrayResponse(data)
+		if err != nil {
+			return nil, err
+		}
+		locations := converted.([]options.Location)
+		for idx := range locations {
+			locations[idx].Unit = unit
+		}
+		return locations, nil
+	}
+}
+
 // GeoSearchWithFullOptions
 func ConvertLocationArrayResponse(data any) (any, error) {
 	converted, err := arrayConverter[[]any]{
@@ -26,6 +26,10 @@ type BaseClientCommands interface {
 	Watch(ctx context.Context, keys []string) (string, error)
 	Unwatch(ctx context.Context) (string, error)
 
+	ExecAll(ctx context.Context, fns ...func(client BaseClientCommands) error) error
+
+	ExecAllWithParallelism(ctx context.Context, parallelism int, fns ...func(client BaseClientCommands) error) error
+
 	// Close terminates the client by closing all associated resources.
 	Close()
 }
@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -41,4 +42,17 @@ type ConnectionManagementClusterCommands interface {
 		ctx context.Context,
 		routeOptions options.RouteOption,
 	) (models.ClusterValue[models.Result[string]], error)
+
+	ClientPause(ctx context.Context, duration time.Duration, mode options.ClientPauseMode) (string, error)
+
+	ClientPauseWithOptions(
+		ctx context.Context,
+		duration time.Duration,
+		mode options.ClientPauseMode,
+		routeOptions options.RouteOption,
+	) (string, error)
+
+	ClientUnpause(ctx context.Context) (string, error)
+
+	ClientUnpauseWithOptions(ctx context.Context, routeOptions options.RouteOption) (string, error)
 }
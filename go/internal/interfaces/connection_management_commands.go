@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -26,4 +27,8 @@ type ConnectionManagementCommands interface {
 	ClientGetName(ctx context.Context) (models.Result[string], error)
 
 	ClientSetName(ctx context.Context, connectionName string) (string, error)
+
+	ClientPause(ctx context.Context, duration time.Duration, mode options.ClientPauseMode) (string, error)
+
+	ClientUnpause(ctx context.Context) (string, error)
 }
@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
@@ -63,6 +64,8 @@ type GenericBaseCommands interface {
 
 	TTL(ctx context.Context, key string) (int64, error)
 
+	TTLs(ctx context.Context, keys []string) ([]int64, error)
+
 	PTTL(ctx context.Context, key string) (int64, error)
 
 	Unlink(ctx context.Context, keys []string) (int64, error)
@@ -71,12 +74,20 @@ type GenericBaseCommands interface {
 
 	Type(ctx context.Context, key string) (string, error)
 
+	KeyInfo(ctx context.Context, key string) (models.KeyInfo, error)
+
 	Rename(ctx context.Context, key string, newKey string) (string, error)
 
+	RenameAcrossSlots(ctx context.Context, key string, newKey string) (string, error)
+
 	RenameNX(ctx context.Context, key string, newKey string) (bool, error)
 
 	Persist(ctx context.Context, key string) (bool, error)
 
+	PersistAll(ctx context.Context, keys []string) ([]bool, error)
+
+	ImportKeys(ctx context.Context, r io.Reader) (int, error)
+
 	Restore(ctx context.Context, key string, ttl time.Duration, value string) (string, error)
 
 	RestoreWithOptions(
@@ -111,6 +122,8 @@ type GenericBaseCommands interface {
 
 	Wait(ctx context.Context, numberOfReplicas int64, timeout time.Duration) (int64, error)
 
+	WaitForReplication(ctx context.Context, minReplicas int64, timeout time.Duration) error
+
 	Copy(ctx context.Context, source string, destination string) (bool, error)
 
 	CopyWithOptions(ctx context.Context, source string, destination string, option options.CopyOptions) (bool, error)
@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
@@ -28,7 +29,22 @@ type GenericClusterCommands interface {
 		opts options.ClusterScanOptions,
 	) (models.ClusterScanResult, error)
 
+	BrowseKeys(
+		ctx context.Context,
+		cursor models.ClusterScanCursor,
+		pattern string,
+		pageSize int64,
+	) (models.ClusterKeyPage, error)
+
+	DeleteByPattern(ctx context.Context, pattern string, opts options.DeleteByPatternOptions) (int64, error)
+
+	ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error)
+
+	ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error)
+
 	RandomKey(ctx context.Context) (models.Result[string], error)
 
 	RandomKeyWithRoute(ctx context.Context, opts options.RouteOption) (models.Result[string], error)
+
+	ExportKeys(ctx context.Context, pattern string, w io.Writer) (int, error)
 }
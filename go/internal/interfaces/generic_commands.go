@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -23,5 +24,11 @@ type GenericCommands interface {
 
 	ScanWithOptions(ctx context.Context, cursor models.Cursor, scanOptions options.ScanOptions) (models.ScanResult, error)
 
+	BrowseKeys(ctx context.Context, cursor models.Cursor, pattern string, pageSize int64) (models.KeyPage, error)
+
+	DeleteByPattern(ctx context.Context, pattern string, opts options.DeleteByPatternOptions) (int64, error)
+
 	RandomKey(ctx context.Context) (models.Result[string], error)
+
+	ExportKeys(ctx context.Context, pattern string, w io.Writer) (int, error)
 }
@@ -26,6 +26,15 @@ type GeoSpatialCommands interface {
 		options options.GeoAddOptions,
 	) (int64, error)
 
+	GeoAddMembers(ctx context.Context, key string, members []options.GeoMember) (int64, error)
+
+	GeoAddMembersWithOptions(
+		ctx context.Context,
+		key string,
+		members []options.GeoMember,
+		options options.GeoAddOptions,
+	) (int64, error)
+
 	GeoHash(ctx context.Context, key string, members []string) ([]models.Result[string], error)
 
 	GeoPos(ctx context.Context, key string, members []string) ([][]float64, error)
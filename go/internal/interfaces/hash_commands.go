@@ -55,4 +55,8 @@ type HashCommands interface {
 	HRandFieldWithCount(ctx context.Context, key string, count int64) ([]string, error)
 
 	HRandFieldWithCountWithValues(ctx context.Context, key string, count int64) ([][]string, error)
+
+	HRandFieldWithRandomCount(ctx context.Context, key string, count options.RandomCount) ([]string, error)
+
+	HRandFieldWithRandomCountWithValues(ctx context.Context, key string, count options.RandomCount) ([][]string, error)
 }
@@ -23,6 +23,8 @@ type ListCommands interface {
 
 	LPopCount(ctx context.Context, key string, count int64) ([]string, error)
 
+	LPopAll(ctx context.Context, key string) ([]string, error)
+
 	LPos(ctx context.Context, key string, element string) (models.Result[int64], error)
 
 	LPosWithOptions(ctx context.Context, key string, element string, options options.LPosOptions) (models.Result[int64], error)
@@ -53,6 +55,8 @@ type ListCommands interface {
 
 	RPopCount(ctx context.Context, key string, count int64) ([]string, error)
 
+	RPopAll(ctx context.Context, key string) ([]string, error)
+
 	LInsert(
 		ctx context.Context,
 		key string,
@@ -111,4 +115,10 @@ type ListCommands interface {
 		whereTo constants.ListDirection,
 		timeout time.Duration,
 	) (models.Result[string], error)
+
+	RPopLPush(ctx context.Context, source string, destination string) (models.Result[string], error)
+
+	BRPopLPush(ctx context.Context, source string, destination string, timeout time.Duration) (models.Result[string], error)
+
+	RotateList(ctx context.Context, key string, direction constants.ListDirection) (models.Result[string], error)
 }
@@ -39,6 +39,28 @@ type ServerManagementClusterCommands interface {
 
 	LastSaveWithOptions(ctx context.Context, routeOption options.RouteOption) (models.ClusterValue[int64], error)
 
+	Save(ctx context.Context) (string, error)
+
+	SaveWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	BgSave(ctx context.Context) (models.ClusterValue[models.BackgroundTaskStatus], error)
+
+	BgSaveWithOptions(
+		ctx context.Context,
+		opts options.ClusterBgSaveOptions,
+	) (models.ClusterValue[models.BackgroundTaskStatus], error)
+
+	BgRewriteAof(ctx context.Context) (models.ClusterValue[models.BackgroundTaskStatus], error)
+
+	BgRewriteAofWithOptions(
+		ctx context.Context,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[models.BackgroundTaskStatus], error)
+
+	ShutdownWithOptions(ctx context.Context, opts options.ClusterShutdownOptions) (string, error)
+
+	CommandGetKeys(ctx context.Context, args []string) ([]string, error)
+
 	ConfigResetStat(ctx context.Context) (string, error)
 
 	ConfigResetStatWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
@@ -58,4 +80,11 @@ type ServerManagementClusterCommands interface {
 	ConfigRewrite(ctx context.Context) (string, error)
 
 	ConfigRewriteWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	ReplicationInfo(ctx context.Context) (models.ClusterValue[models.ReplicationInfo], error)
+
+	ReplicationInfoWithOptions(
+		ctx context.Context,
+		routeOption options.RouteOption,
+	) (models.ClusterValue[models.ReplicationInfo], error)
 }
@@ -5,6 +5,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
 )
 
@@ -42,7 +43,31 @@ type ServerManagementCommands interface {
 
 	LastSave(ctx context.Context) (int64, error)
 
+	Save(ctx context.Context) (string, error)
+
+	BgSave(ctx context.Context) (models.BackgroundTaskStatus, error)
+
+	BgSaveWithOptions(ctx context.Context, opts options.BgSaveOptions) (models.BackgroundTaskStatus, error)
+
+	BgRewriteAof(ctx context.Context) (models.BackgroundTaskStatus, error)
+
+	Shutdown(ctx context.Context, opts *options.ShutdownOptions) (string, error)
+
+	CommandGetKeys(ctx context.Context, args []string) ([]string, error)
+
 	ConfigResetStat(ctx context.Context) (string, error)
 
 	ConfigRewrite(ctx context.Context) (string, error)
+
+	Failover(ctx context.Context, opts *options.FailoverOptions) (string, error)
+
+	ReplicaOf(ctx context.Context, host string, port int64) (string, error)
+
+	ReplicaOfNoOne(ctx context.Context) (string, error)
+
+	SlaveOf(ctx context.Context, host string, port int64) (string, error)
+
+	SlaveOfNoOne(ctx context.Context) (string, error)
+
+	ReplicationInfo(ctx context.Context) (models.ReplicationInfo, error)
 }
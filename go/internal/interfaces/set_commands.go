@@ -41,6 +41,8 @@ type SetCommands interface {
 
 	SRandMemberCount(ctx context.Context, key string, count int64) ([]string, error)
 
+	SRandMemberWithRandomCount(ctx context.Context, key string, count options.RandomCount) ([]string, error)
+
 	SPop(ctx context.Context, key string) (models.Result[string], error)
 
 	SPopCount(ctx context.Context, key string, count int64) (map[string]struct{}, error)
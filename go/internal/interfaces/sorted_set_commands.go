@@ -92,6 +92,26 @@ type SortedSetCommands interface {
 
 	ZRangeStore(ctx context.Context, destination string, key string, rangeQuery options.ZRangeQuery) (int64, error)
 
+	ZRangeStoreByScore(
+		ctx context.Context,
+		destination string,
+		key string,
+		min options.ScoreBound,
+		max options.ScoreBound,
+		reverse bool,
+		limit *options.Limit,
+	) (int64, error)
+
+	ZRangeStoreByLex(
+		ctx context.Context,
+		destination string,
+		key string,
+		min options.LexBound,
+		max options.LexBound,
+		reverse bool,
+		limit *options.Limit,
+	) (int64, error)
+
 	ZRank(ctx context.Context, key string, member string) (models.Result[int64], error)
 
 	ZRankWithScore(ctx context.Context, key string, member string) (models.Result[models.RankAndScore], error)
@@ -129,6 +149,14 @@ type SortedSetCommands interface {
 
 	ZRandMemberWithCountWithScores(ctx context.Context, key string, count int64) ([]models.MemberAndScore, error)
 
+	ZRandMemberWithRandomCount(ctx context.Context, key string, count options.RandomCount) ([]string, error)
+
+	ZRandMemberWithRandomCountWithScores(
+		ctx context.Context,
+		key string,
+		count options.RandomCount,
+	) ([]models.MemberAndScore, error)
+
 	ZMScore(ctx context.Context, key string, members []string) ([]models.Result[float64], error)
 
 	ZDiffStore(ctx context.Context, destination string, keys []string) (int64, error)
@@ -178,6 +178,8 @@ type StreamCommands interface {
 
 	XInfoGroups(ctx context.Context, key string) ([]models.XInfoGroupInfo, error)
 
+	StreamLag(ctx context.Context, key string, group string) (models.StreamLag, error)
+
 	XRange(
 		ctx context.Context,
 		key string,
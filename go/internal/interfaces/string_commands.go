@@ -4,6 +4,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -23,6 +24,8 @@ type StringCommands interface {
 
 	GetEx(ctx context.Context, key string) (models.Result[string], error)
 
+	GetWithTTL(ctx context.Context, key string) (models.Result[string], time.Duration, error)
+
 	GetExWithOptions(ctx context.Context, key string, options options.GetExOptions) (models.Result[string], error)
 
 	MSet(ctx context.Context, keyValueMap map[string]string) (string, error)
@@ -47,6 +50,8 @@ type StringCommands interface {
 
 	GetRange(ctx context.Context, key string, start int, end int) (string, error)
 
+	Substr(ctx context.Context, key string, start int, end int) (string, error)
+
 	Append(ctx context.Context, key string, value string) (int64, error)
 
 	LCS(ctx context.Context, key1 string, key2 string) (*models.LCSMatch, error)
@@ -56,4 +61,18 @@ type StringCommands interface {
 	LCSWithOptions(ctx context.Context, key1, key2 string, opts options.LCSIdxOptions) (*models.LCSMatch, error)
 
 	GetDel(ctx context.Context, key string) (models.Result[string], error)
+
+	CompareAndSet(ctx context.Context, key string, expected string, newValue string) (bool, error)
+
+	CompareAndDelete(ctx context.Context, key string, expected string) (bool, error)
+
+	MGetDel(ctx context.Context, keys []string) ([]models.Result[string], error)
+
+	SetPreservingTTL(ctx context.Context, key string, value string) (string, error)
+
+	ReplaceValueKeepTTL(ctx context.Context, key string, value string) (bool, error)
+
+	BulkLoad(ctx context.Context, keyValueMap map[string]string, opts options.BulkOptions) (int, error)
+
+	BulkGet(ctx context.Context, keys []string, opts options.BulkOptions) ([]models.Result[string], error)
 }
@@ -0,0 +1,10 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package nativelink links the native library into test binaries. The options and pipeline packages call into the
+// native library, whose linker flags are declared by the glide package, so the tests of packages using them without
+// the glide package fail to link unless they import this package:
+//
+//	import _ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+package nativelink
+
+import _ "github.com/valkey-io/valkey-glide/go/v2"
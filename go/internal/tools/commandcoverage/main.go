@@ -0,0 +1,245 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Command commandcoverage lists the server commands that have no method on the clients, and generates stubs sending
+// them as custom commands, to be reviewed and moved to base_client.go. The server commands are read from stdin, one
+// per line, with the subcommands written as "container|subcommand", e.g. as listed by:
+//
+//	valkey-cli --json COMMAND | jq -r '.[] | .[0], (.[9][]?[0])'
+//
+// Containers whose subcommands are listed, e.g. CLIENT, are only checked through their subcommands. Module commands,
+// e.g. FT.SEARCH, are implemented by separate packages and ignored.
+//
+// Usage:
+//
+//	go run ./internal/tools/commandcoverage [-dir .] [-stubs file] [-ignore MONITOR,SYNC]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// clientTypes are the receivers of the methods implementing commands.
+var clientTypes = map[string]bool{"baseClient": true, "Client": true, "ClusterClient": true}
+
+// methodSuffixes are stripped from the names of the methods before they are matched to commands, since they name
+// variants of the same command.
+var methodSuffixes = []string{"WithOptions", "WithRoute"}
+
+func main() {
+	dir := flag.String("dir", ".", "the directory of the glide package")
+	stubs := flag.String("stubs", "", "the file to write the stubs of the missing commands to")
+	ignore := flag.String("ignore", "", "comma-separated commands to ignore, e.g. commands the clients cannot support")
+	flag.Parse()
+
+	commands, err := readCommands(os.Stdin)
+	if err != nil {
+		fail(err)
+	}
+	methods, err := implementedMethods(*dir)
+	if err != nil {
+		fail(err)
+	}
+	ignored := map[string]bool{}
+	for _, command := range strings.Split(*ignore, ",") {
+		ignored[commandKey(command)] = true
+	}
+
+	var missing []string
+	for _, command := range commands {
+		if !methods[commandKey(command)] && !ignored[commandKey(command)] {
+			missing = append(missing, command)
+		}
+	}
+	for _, command := range missing {
+		fmt.Println(command)
+	}
+	fmt.Fprintf(os.Stderr, "%d of %d commands have no method\n", len(missing), len(commands))
+
+	if *stubs != "" && len(missing) > 0 {
+		source, err := generateStubs(missing)
+		if err != nil {
+			fail(err)
+		}
+		if err := os.WriteFile(*stubs, source, 0o644); err != nil {
+			fail(err)
+		}
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "commandcoverage:", err)
+	os.Exit(1)
+}
+
+// readCommands reads the server commands, returning them uppercased and sorted, with their subcommands separated by a
+// space. Containers with listed subcommands and module commands are dropped.
+func readCommands(r io.Reader) ([]string, error) {
+	commands := map[string]bool{}
+	containers := map[string]bool{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.ToUpper(strings.Trim(strings.TrimSpace(scanner.Text()), `"`))
+		if line == "" || strings.Contains(line, ".") {
+			continue
+		}
+		if container, _, ok := strings.Cut(line, "|"); ok {
+			containers[container] = true
+		}
+		commands[strings.ReplaceAll(line, "|", " ")] = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sorted := make([]string, 0, len(commands))
+	for command := range commands {
+		if !containers[command] {
+			sorted = append(sorted, command)
+		}
+	}
+	sort.Strings(sorted)
+	return sorted, nil
+}
+
+// implementedMethods returns the keys of the exported methods of the clients found in dir, see commandKey.
+func implementedMethods(dir string) (map[string]bool, error) {
+	packages, err := parser.ParseDir(token.NewFileSet(), dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	methods := map[string]bool{}
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.IsExported() && isClientMethod(fn) {
+					methods[methodKey(fn.Name.Name)] = true
+				}
+			}
+		}
+	}
+	return methods, nil
+}
+
+func isClientMethod(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return false
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	ident, ok := recv.(*ast.Ident)
+	return ok && clientTypes[ident.Name]
+}
+
+// methodKey returns the key of a method, matching the key of the command it implements, e.g. "BITFIELDRO" for
+// BitFieldReadOnly.
+func methodKey(name string) string {
+	for _, suffix := range methodSuffixes {
+		name = strings.TrimSuffix(name, suffix)
+	}
+	if strings.HasSuffix(name, "ReadOnly") {
+		name = strings.TrimSuffix(name, "ReadOnly") + "RO"
+	}
+	return strings.ToUpper(name)
+}
+
+// commandKey returns the key of a command, its letters and digits uppercased, e.g. "CLIENTNOEVICT" for
+// "CLIENT NO-EVICT".
+func commandKey(command string) string {
+	return strings.ToUpper(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, command))
+}
+
+// methodName returns the name of the stub of a command, e.g. "ClientNoEvict" for "CLIENT NO-EVICT".
+func methodName(command string) string {
+	words := strings.FieldsFunc(command, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	for idx, word := range words {
+		words[idx] = word[:1] + strings.ToLower(word[1:])
+	}
+	return strings.Join(words, "")
+}
+
+type stub struct {
+	Command string
+	Method  string
+	Words   string
+	Page    string
+}
+
+var stubsTemplate = template.Must(template.New("stubs").Parse(stubsSource))
+
+const stubsSource = `// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Code generated by commandcoverage. DO NOT EDIT.
+// Review each stub, give it typed parameters and results, and move it to base_client.go.
+
+//go:build commandcoverage
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import "context"
+{{range .}}
+// {{.Method}} sends the {{.Command}} command.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/{{.Page}}/
+func (client *baseClient) {{.Method}}(ctx context.Context, args []string) (any, error) {
+	result, err := client.executeCommand(ctx, C.CustomCommand, append([]string{ {{- .Words -}} }, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	return handleInterfaceResponse(result)
+}
+{{end}}`
+
+// quotedWords returns the words of a command as Go string literals separated by commas, e.g. `"CLIENT", "NO-EVICT"`.
+func quotedWords(command string) string {
+	words := strings.Fields(command)
+	for idx, word := range words {
+		words[idx] = strconv.Quote(word)
+	}
+	return strings.Join(words, ", ")
+}
+
+// generateStubs returns the formatted source of the stubs of commands.
+func generateStubs(commands []string) ([]byte, error) {
+	stubs := make([]stub, len(commands))
+	for idx, command := range commands {
+		stubs[idx] = stub{
+			Command: command,
+			Method:  methodName(command),
+			Words:   quotedWords(command),
+			Page:    strings.ToLower(strings.ReplaceAll(command, " ", "-")),
+		}
+	}
+	var source bytes.Buffer
+	if err := stubsTemplate.Execute(&source, stubs); err != nil {
+		return nil, err
+	}
+	return format.Source(source.Bytes())
+}
@@ -0,0 +1,40 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCommands(t *testing.T) {
+	commands, err := readCommands(strings.NewReader("get\nclient\nclient|no-evict\n\nFT.SEARCH\nsubstr\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CLIENT NO-EVICT", "GET", "SUBSTR"}, commands)
+}
+
+func TestKeys(t *testing.T) {
+	assert.Equal(t, commandKey("CLIENT NO-EVICT"), methodKey("ClientNoEvict"))
+	assert.Equal(t, commandKey("BITFIELD_RO"), methodKey("BitFieldReadOnly"))
+	assert.Equal(t, commandKey("ZADD"), methodKey("ZAddWithOptions"))
+	assert.Equal(t, "ClientNoEvict", methodName("CLIENT NO-EVICT"))
+}
+
+func TestImplementedMethods(t *testing.T) {
+	methods, err := implementedMethods("../../..")
+	require.NoError(t, err)
+	assert.True(t, methods[commandKey("GETRANGE")])
+	assert.True(t, methods[commandKey("SUBSTR")])
+	assert.False(t, methods[commandKey("MONITOR")])
+}
+
+func TestGenerateStubs(t *testing.T) {
+	source, err := generateStubs([]string{"CLIENT NO-EVICT"})
+	require.NoError(t, err)
+	assert.Contains(t, string(source), "func (client *baseClient) ClientNoEvict(ctx context.Context, args []string)")
+	assert.Contains(t, string(source), `append([]string{"CLIENT", "NO-EVICT"}, args...)`)
+	assert.Contains(t, string(source), "https://valkey.io/commands/client-no-evict/")
+}
@@ -22,8 +22,39 @@ func IntToString(value int64) string {
 	return strconv.FormatInt(value, 10 /*base*/)
 }
 
+// FloatFormat controls how floats are formatted into command arguments.
+type FloatFormat struct {
+	// Precision is the number of digits after the decimal point, or -1 for the fewest digits that round-trip exactly.
+	Precision int
+	// Scientific allows the scientific notation for very large and very small values.
+	Scientific bool
+}
+
+// DefaultFloatFormat is the format used by FloatToString: the shortest exact representation, without the scientific
+// notation, since the server parses large exponents with long double precision, which can change the stored value.
+var DefaultFloatFormat = FloatFormat{Precision: -1}
+
+// FormatFloat formats value according to format.
+func FormatFloat(value float64, format FloatFormat) string {
+	if format.Scientific {
+		return strconv.FormatFloat(value, 'g', format.Precision, 64 /*bit*/)
+	}
+	return strconv.FormatFloat(value, 'f', format.Precision, 64 /*bit*/)
+}
+
 func FloatToString(value float64) string {
-	return strconv.FormatFloat(value, 'g', -1 /*precision*/, 64 /*bit*/)
+	return FormatFloat(value, DefaultFloatFormat)
+}
+
+// ValidateFloat rejects NaN, which the server cannot parse, and infinities unless allowInf is set.
+func ValidateFloat(value float64, allowInf bool) error {
+	if math.IsNaN(value) {
+		return errors.New("the value cannot be NaN")
+	}
+	if !allowInf && math.IsInf(value, 0) {
+		return fmt.Errorf("the value cannot be %v", value)
+	}
+	return nil
 }
 
 // ConvertMapToKeyValueStringArray converts a map of string keys and values to a slice of the initial key followed by the
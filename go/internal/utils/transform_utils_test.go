@@ -3,6 +3,7 @@
 package utils
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -99,3 +100,19 @@ func TestConcat(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatFloat(t *testing.T) {
+	assert.Equal(t, "0.1", FloatToString(0.1))
+	assert.Equal(t, "100000000000000000000", FloatToString(1e20))
+	assert.Equal(t, "0.0000001", FloatToString(1e-7))
+	assert.Equal(t, "+Inf", FloatToString(math.Inf(1)))
+	assert.Equal(t, "3.14", FormatFloat(3.14159, FloatFormat{Precision: 2}))
+	assert.Equal(t, "1e+20", FormatFloat(1e20, FloatFormat{Precision: -1, Scientific: true}))
+}
+
+func TestValidateFloat(t *testing.T) {
+	assert.NoError(t, ValidateFloat(1.5, false))
+	assert.NoError(t, ValidateFloat(math.Inf(-1), true))
+	assert.EqualError(t, ValidateFloat(math.Inf(1), false), "the value cannot be +Inf")
+	assert.EqualError(t, ValidateFloat(math.NaN(), true), "the value cannot be NaN")
+}
@@ -0,0 +1,181 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// keyInfoCommands is the number of commands sent to describe a key, see addKeyInfoCommands.
+const keyInfoCommands = 4
+
+// addKeyInfoCommands adds to batch the commands that describe key, whose results are parsed by parseKeyInfo.
+func addKeyInfoCommands(batch *pipeline.ClusterBatch, key string) {
+	batch.Type(key).PTTL(key).ObjectEncoding(key).CustomCommand([]string{"MEMORY", "USAGE", key})
+}
+
+// parseKeyInfo parses the results of the commands added by addKeyInfoCommands. The errors of OBJECT ENCODING and MEMORY
+// USAGE are ignored, since they only mean that the metadata is not available.
+func parseKeyInfo(key string, results []any) (models.KeyInfo, error) {
+	for _, result := range results[:2] {
+		if err, ok := result.(error); ok {
+			return models.KeyInfo{}, err
+		}
+	}
+	keyType, _ := results[0].(string)
+	if keyType == "none" {
+		return models.KeyInfo{Key: key, Type: keyType}, nil
+	}
+	ttl, _ := results[1].(int64)
+	encoding, _ := results[2].(string)
+	size, _ := results[3].(int64)
+	return models.KeyInfo{Key: key, Exists: true, Type: keyType, TTL: ttl, Encoding: encoding, Size: size}, nil
+}
+
+// keyInfos describes the given keys in a single non-atomic batch, which is split by hash slot in cluster mode. The
+// keys that no longer exist are left out.
+func (client *baseClient) keyInfos(ctx context.Context, keys []string) ([]models.KeyInfo, error) {
+	if len(keys) == 0 {
+		return []models.KeyInfo{}, nil
+	}
+	batch := pipeline.NewClusterBatch(false)
+	for _, key := range keys {
+		addKeyInfoCommands(batch, key)
+	}
+	results, err := client.executeBatch(ctx, batch.Batch, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != keyInfoCommands*len(keys) {
+		return nil, fmt.Errorf("unexpected number of responses of the key metadata: %d", len(results))
+	}
+	infos := make([]models.KeyInfo, 0, len(keys))
+	for idx, key := range keys {
+		info, err := parseKeyInfo(key, results[keyInfoCommands*idx:keyInfoCommands*(idx+1)])
+		if err != nil {
+			return nil, err
+		}
+		if info.Exists {
+			infos = append(infos, info)
+		}
+	}
+	return infos, nil
+}
+
+// pageKeys removes the duplicates of keys, since SCAN may return a key more than once, and sorts them.
+func pageKeys(keys []string) []string {
+	seen := make(map[string]struct{}, len(keys))
+	unique := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			unique = append(unique, key)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
+func validatePageSize(pageSize int64) error {
+	if pageSize <= 0 {
+		return errors.New("the page size must be positive")
+	}
+	return nil
+}
+
+// BrowseKeys returns a page of the keys of the database matching pattern, along with their metadata as returned by
+// [baseClient.KeyInfo], for building key browsers such as admin UIs. The keys are scanned with SCAN until at least
+// pageSize keys are found or the scan is over, so that pages are only short at the end of the browsing. A page may hold
+// a few more keys than pageSize, since the keys returned by a SCAN iteration cannot be split across pages.
+//
+// Like SCAN, the browsing is not a snapshot: keys added or deleted while browsing may or may not be returned. The
+// keys deleted before their metadata are fetched are left out of the page.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	cursor - The cursor of the page, [models.NewCursor] for the first page, or the cursor of the previous page.
+//	pattern - The glob-style pattern of the keys to return, or an empty string for all keys.
+//	pageSize - The number of keys of a page.
+//
+// Return value:
+//
+//	The page of keys, sorted by name, and the cursor of the next page.
+func (client *Client) BrowseKeys(
+	ctx context.Context,
+	cursor models.Cursor,
+	pattern string,
+	pageSize int64,
+) (models.KeyPage, error) {
+	if err := validatePageSize(pageSize); err != nil {
+		return models.KeyPage{}, err
+	}
+	scanOptions := options.NewScanOptions().SetMatch(pattern).SetCount(pageSize)
+	var keys []string
+	for int64(len(keys)) < pageSize && !cursor.IsFinished() {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return models.KeyPage{}, err
+		}
+		cursor = result.Cursor
+		keys = append(keys, result.Data...)
+	}
+	infos, err := client.keyInfos(ctx, pageKeys(keys))
+	if err != nil {
+		return models.KeyPage{}, err
+	}
+	return models.KeyPage{Cursor: cursor, Keys: infos}, nil
+}
+
+// BrowseKeys returns a page of the keys of the cluster matching pattern, along with their metadata as returned by
+// [baseClient.KeyInfo], for building key browsers such as admin UIs. The keys are scanned with a cluster scan, see
+// [ClusterClient.Scan], until at least pageSize keys are found or the scan is over, so that pages are only short at the
+// end of the browsing. A page may hold a few more keys than pageSize, since the keys returned by a scan iteration
+// cannot be split across pages.
+//
+// Like SCAN, the browsing is not a snapshot: keys added or deleted while browsing may or may not be returned. The
+// keys deleted before their metadata are fetched are left out of the page. The cursor refers to the state of the scan
+// held by the client, so it can only be used with the client that returned it.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	cursor - The cursor of the page, [models.NewClusterScanCursor] for the first page, or the cursor of the previous page.
+//	pattern - The glob-style pattern of the keys to return, or an empty string for all keys.
+//	pageSize - The number of keys of a page.
+//
+// Return value:
+//
+//	The page of keys, sorted by name, and the cursor of the next page.
+func (client *ClusterClient) BrowseKeys(
+	ctx context.Context,
+	cursor models.ClusterScanCursor,
+	pattern string,
+	pageSize int64,
+) (models.ClusterKeyPage, error) {
+	if err := validatePageSize(pageSize); err != nil {
+		return models.ClusterKeyPage{}, err
+	}
+	scanOptions := options.NewClusterScanOptions().SetMatch(pattern).SetCount(pageSize)
+	var keys []string
+	for int64(len(keys)) < pageSize && !cursor.IsFinished() {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return models.ClusterKeyPage{}, err
+		}
+		cursor = result.Cursor
+		keys = append(keys, result.Keys...)
+	}
+	infos, err := client.keyInfos(ctx, pageKeys(keys))
+	if err != nil {
+		return models.ClusterKeyPage{}, err
+	}
+	return models.ClusterKeyPage{Cursor: cursor, Keys: infos}, nil
+}
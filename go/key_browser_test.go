@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestParseKeyInfo(t *testing.T) {
+	info, err := parseKeyInfo("key", []any{"hash", int64(-1), "listpack", int64(72)})
+	assert.NoError(t, err)
+	assert.Equal(t, models.KeyInfo{Key: "key", Exists: true, Type: "hash", TTL: -1, Encoding: "listpack", Size: 72}, info)
+
+	info, err = parseKeyInfo("key", []any{"string", int64(500), errors.New("NOPERM"), errors.New("NOPERM")})
+	assert.NoError(t, err)
+	assert.Equal(t, models.KeyInfo{Key: "key", Exists: true, Type: "string", TTL: 500}, info)
+
+	info, err = parseKeyInfo("key", []any{"none", int64(-2), nil, nil})
+	assert.NoError(t, err)
+	assert.False(t, info.Exists)
+
+	_, err = parseKeyInfo("key", []any{errors.New("NOPERM"), int64(-2), nil, nil})
+	assert.Error(t, err)
+}
+
+func TestPageKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, pageKeys([]string{"c", "a", "b", "a"}))
+	assert.Empty(t, pageKeys(nil))
+}
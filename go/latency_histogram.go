@@ -0,0 +1,171 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"expvar"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// latencyBounds are the upper bounds of the buckets of the command latency histograms.
+var latencyBounds = []time.Duration{
+	100 * time.Microsecond,
+	250 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	2500 * time.Microsecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// profilerLabelCommand is the pprof label holding the name of the command a goroutine waits for, see
+// [config.AdvancedClientConfiguration.WithProfilerLabels].
+const profilerLabelCommand = "valkey.command"
+
+// latencyHistogram counts the latencies of a command, across all clients.
+type latencyHistogram struct {
+	// counts holds the number of latencies of each bucket, followed by the number of latencies above every bound.
+	counts []atomic.Int64
+	sum    atomic.Int64
+}
+
+// commandLatencies holds the latency histograms of the commands executed so far, keyed by command name.
+var commandLatencies = struct {
+	sync.RWMutex
+	byCommand map[string]*latencyHistogram
+}{byCommand: make(map[string]*latencyHistogram)}
+
+// LatencyHistogram is a snapshot of the latency histogram of a command, across all clients.
+type LatencyHistogram struct {
+	// Bounds are the upper bounds of the buckets, in increasing order.
+	Bounds []time.Duration
+	// Counts holds the number of latencies of each bucket, which are greater than the bound of the previous bucket and
+	// not greater than the bound of the bucket. The last element counts the latencies greater than every bound.
+	Counts []int64
+	// Count is the number of latencies.
+	Count int64
+	// Sum is the sum of the latencies.
+	Sum time.Duration
+}
+
+// Mean returns the mean latency, or 0 if no latency was counted.
+func (histogram LatencyHistogram) Mean() time.Duration {
+	if histogram.Count == 0 {
+		return 0
+	}
+	return histogram.Sum / time.Duration(histogram.Count)
+}
+
+// GetCommandLatencies returns the latency histograms of the commands executed by all the clients of the process, keyed
+// by command name, such as GET, EVALSHA or BATCH for batches. Custom commands unknown to the client are counted
+// under CUSTOM.
+func GetCommandLatencies() map[string]LatencyHistogram {
+	commandLatencies.RLock()
+	defer commandLatencies.RUnlock()
+	snapshot := make(map[string]LatencyHistogram, len(commandLatencies.byCommand))
+	for command, histogram := range commandLatencies.byCommand {
+		counts := make([]int64, len(histogram.counts))
+		var count int64
+		for idx := range histogram.counts {
+			counts[idx] = histogram.counts[idx].Load()
+			count += counts[idx]
+		}
+		snapshot[command] = LatencyHistogram{
+			Bounds: latencyBounds,
+			Counts: counts,
+			Count:  count,
+			Sum:    time.Duration(histogram.sum.Load()),
+		}
+	}
+	return snapshot
+}
+
+// PublishCommandLatencies publishes the latency histograms returned by GetCommandLatencies as an expvar variable with
+// the given name, which is served by the /debug/vars handler of the expvar package. Each command maps to its count,
+// the sum of its latencies in seconds, and its buckets keyed by their bound in seconds, like the "le" label of a
+// Prometheus histogram: each bucket holds the cumulative number of latencies up to its bound, and the "+Inf" bucket
+// holds the count. Like expvar.Publish, it panics if the name is already in use.
+func PublishCommandLatencies(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		published := make(map[string]any)
+		for command, histogram := range GetCommandLatencies() {
+			buckets := make(map[string]int64, len(histogram.Bounds)+1)
+			var cumulative int64
+			for idx, bound := range histogram.Bounds {
+				cumulative += histogram.Counts[idx]
+				buckets[strconv.FormatFloat(bound.Seconds(), 'g', -1, 64)] = cumulative
+			}
+			buckets["+Inf"] = histogram.Count
+			published[command] = map[string]any{
+				"count":   histogram.Count,
+				"sum":     histogram.Sum.Seconds(),
+				"buckets": buckets,
+			}
+		}
+		return published
+	}))
+}
+
+// recordLatency counts the latency of a command in its histogram.
+func recordLatency(command string, latency time.Duration) {
+	commandLatencies.RLock()
+	histogram, ok := commandLatencies.byCommand[command]
+	commandLatencies.RUnlock()
+	if !ok {
+		commandLatencies.Lock()
+		if histogram, ok = commandLatencies.byCommand[command]; !ok {
+			histogram = &latencyHistogram{counts: make([]atomic.Int64, len(latencyBounds)+1)}
+			commandLatencies.byCommand[command] = histogram
+		}
+		commandLatencies.Unlock()
+	}
+	bucket := len(latencyBounds)
+	for idx, bound := range latencyBounds {
+		if latency <= bound {
+			bucket = idx
+			break
+		}
+	}
+	histogram.counts[bucket].Add(1)
+	histogram.sum.Add(int64(latency))
+}
+
+// latencyCommandName returns the name the latency of a command is counted under. Custom commands unknown to the client
+// are counted together, so that arbitrary arguments don't create histograms.
+func latencyCommandName(requestType C.RequestType, args []string) string {
+	name, _ := commandName(requestType, args)
+	if requestType == C.CustomCommand {
+		if _, known := requestTypesByName()[name]; !known {
+			return "CUSTOM"
+		}
+	}
+	if name == "" {
+		return strings.ToUpper(commandFamily(uint32(requestType)))
+	}
+	return name
+}
+
+// withProfilerLabels labels the current goroutine with the name of a command until the returned function is called,
+// so that the time spent waiting for the command is attributed to it by pprof.
+func withProfilerLabels(ctx context.Context, command string) (context.Context, func()) {
+	labeled := pprof.WithLabels(ctx, pprof.Labels(profilerLabelCommand, command))
+	pprof.SetGoroutineLabels(labeled)
+	return labeled, func() { pprof.SetGoroutineLabels(ctx) }
+}
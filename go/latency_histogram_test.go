@@ -0,0 +1,65 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandLatencies(t *testing.T) {
+	command := "TEST.LATENCY"
+	recordLatency(command, 50*time.Microsecond)
+	recordLatency(command, time.Millisecond)
+	recordLatency(command, 3*time.Millisecond)
+	recordLatency(command, time.Minute)
+
+	histogram := GetCommandLatencies()[command]
+	require.Len(t, histogram.Counts, len(latencyBounds)+1)
+	assert.Equal(t, int64(4), histogram.Count)
+	assert.Equal(t, int64(1), histogram.Counts[0])
+	assert.Equal(t, int64(1), histogram.Counts[3])
+	assert.Equal(t, int64(1), histogram.Counts[5])
+	assert.Equal(t, int64(1), histogram.Counts[len(latencyBounds)])
+	assert.Equal(t, time.Minute+4050*time.Microsecond, histogram.Sum)
+	assert.Equal(t, histogram.Sum/4, histogram.Mean())
+	assert.Zero(t, LatencyHistogram{}.Mean())
+
+	PublishCommandLatencies("glide_command_latencies_test")
+	var published map[string]struct {
+		Count   int64            `json:"count"`
+		Sum     float64          `json:"sum"`
+		Buckets map[string]int64 `json:"buckets"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get("glide_command_latencies_test").String()), &published))
+	assert.Equal(t, int64(4), published[command].Count)
+	assert.InDelta(t, 60.00405, published[command].Sum, 1e-9)
+	assert.Equal(t, int64(1), published[command].Buckets["0.0001"])
+	assert.Equal(t, int64(2), published[command].Buckets["0.001"])
+	assert.Equal(t, int64(3), published[command].Buckets["5"])
+	assert.Equal(t, int64(4), published[command].Buckets["+Inf"])
+}
+
+func TestLatencyCommandName(t *testing.T) {
+	customCommand := requestTypesByName()["GET"]
+	customCommand = 1 // C.CustomCommand, which tests cannot refer to
+	assert.Equal(t, "GET", latencyCommandName(requestTypesByName()["GET"], []string{"key"}))
+	assert.Equal(t, "GET", latencyCommandName(customCommand, []string{"get", "key"}))
+	assert.Equal(t, "CUSTOM", latencyCommandName(customCommand, []string{"MODULE.CMD", "key"}))
+}
+
+func TestWithProfilerLabels(t *testing.T) {
+	ctx, restore := withProfilerLabels(context.Background(), "BLPOP")
+	defer restore()
+
+	command, ok := pprof.Label(ctx, profilerLabelCommand)
+	assert.True(t, ok)
+	assert.Equal(t, "BLPOP", command)
+}
@@ -0,0 +1,252 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package leaderboard provides a leaderboard built on a Valkey GLIDE client. A leaderboard is stored in a single
+// sorted set, with the members ranked by score, highest first unless the leaderboard is ascending.
+package leaderboard
+
+import (
+	"context"
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// ErrNotFound is returned when a member is not on the leaderboard.
+var ErrNotFound = errors.New("member not found")
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the leaderboard.
+type Client interface {
+	ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error)
+	ZIncrBy(ctx context.Context, key string, increment float64, member string) (float64, error)
+	ZRem(ctx context.Context, key string, members []string) (int64, error)
+	ZCard(ctx context.Context, key string) (int64, error)
+	ZRankWithScore(ctx context.Context, key string, member string) (models.Result[models.RankAndScore], error)
+	ZRevRankWithScore(ctx context.Context, key string, member string) (models.Result[models.RankAndScore], error)
+	ZRangeWithScores(
+		ctx context.Context,
+		key string,
+		rangeQuery options.ZRangeQueryWithScores,
+	) ([]models.MemberAndScore, error)
+}
+
+// Entry is a member of the leaderboard with its score and rank.
+type Entry struct {
+	Member string
+	Score  float64
+	// Rank is the 0-based position of the member on the leaderboard.
+	Rank int64
+}
+
+// Leaderboard ranks members by score.
+type Leaderboard struct {
+	client    Client
+	key       string
+	ascending bool
+}
+
+// New returns a [Leaderboard] stored in the sorted set at key, ranking the highest score first.
+//
+// Parameters:
+//
+//	client - The client used to store the leaderboard, e.g. a glide.Client or glide.ClusterClient.
+//	key - The key of the sorted set holding the leaderboard.
+func New(client Client, key string) *Leaderboard {
+	return &Leaderboard{client: client, key: key}
+}
+
+// WithAscending ranks the lowest score first, e.g. for lap times. If not explicitly set, the highest score is ranked
+// first.
+func (board *Leaderboard) WithAscending(ascending bool) *Leaderboard {
+	board.ascending = ascending
+	return board
+}
+
+// SetScore sets the score of a member, adding the member if it is not on the leaderboard.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to set the score of.
+//	score - The new score of the member.
+func (board *Leaderboard) SetScore(ctx context.Context, member string, score float64) error {
+	_, err := board.client.ZAdd(ctx, board.key, map[string]float64{member: score})
+	return err
+}
+
+// AddScore adds delta to the score of a member, adding the member with a score of delta if it is not on the
+// leaderboard.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to add the score to.
+//	delta - The score to add, which may be negative.
+//
+// Return value:
+//
+//	The new score of the member.
+func (board *Leaderboard) AddScore(ctx context.Context, member string, delta float64) (float64, error) {
+	return board.client.ZIncrBy(ctx, board.key, delta, member)
+}
+
+// Remove removes a member from the leaderboard.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to remove.
+//
+// Return value:
+//
+//	nil, or [ErrNotFound] if the member is not on the leaderboard.
+func (board *Leaderboard) Remove(ctx context.Context, member string) error {
+	removed, err := board.client.ZRem(ctx, board.key, []string{member})
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Size returns the number of members on the leaderboard.
+func (board *Leaderboard) Size(ctx context.Context) (int64, error) {
+	return board.client.ZCard(ctx, board.key)
+}
+
+// Rank returns the entry of a member.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to look up.
+//
+// Return value:
+//
+//	The [Entry] of the member, or [ErrNotFound] if the member is not on the leaderboard.
+func (board *Leaderboard) Rank(ctx context.Context, member string) (Entry, error) {
+	var result models.Result[models.RankAndScore]
+	var err error
+	if board.ascending {
+		result, err = board.client.ZRankWithScore(ctx, board.key, member)
+	} else {
+		result, err = board.client.ZRevRankWithScore(ctx, board.key, member)
+	}
+	if err != nil {
+		return Entry{}, err
+	}
+	if result.IsNil() {
+		return Entry{}, ErrNotFound
+	}
+	return Entry{Member: member, Score: result.Value().Score, Rank: result.Value().Rank}, nil
+}
+
+// Range returns the entries ranked from start to stop, both inclusive and 0-based.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	start - The rank of the first entry.
+//	stop - The rank of the last entry.
+//
+// Return value:
+//
+//	The entries, ordered by rank. The result is empty if start is beyond the last rank.
+func (board *Leaderboard) Range(ctx context.Context, start int64, stop int64) ([]Entry, error) {
+	if start < 0 || stop < start {
+		return []Entry{}, nil
+	}
+	query := options.NewRangeByIndexQuery(start, stop)
+	if !board.ascending {
+		query.SetReverse()
+	}
+	members, err := board.client.ZRangeWithScores(ctx, board.key, query)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, len(members))
+	for i, member := range members {
+		entries[i] = Entry{Member: member.Member, Score: member.Score, Rank: start + int64(i)}
+	}
+	return entries, nil
+}
+
+// Top returns the n best ranked entries.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	n - The number of entries to return.
+//
+// Return value:
+//
+//	Up to n entries, ordered by rank.
+func (board *Leaderboard) Top(ctx context.Context, n int64) ([]Entry, error) {
+	return board.Range(ctx, 0, n-1)
+}
+
+// Around returns the entry of a member together with up to n entries ranked directly above and below it.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to look up.
+//	n - The number of entries to return on each side of the member.
+//
+// Return value:
+//
+//	Up to 2n+1 entries ordered by rank, or [ErrNotFound] if the member is not on the leaderboard.
+func (board *Leaderboard) Around(ctx context.Context, member string, n int64) ([]Entry, error) {
+	entry, err := board.Rank(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+	return board.Range(ctx, max(0, entry.Rank-n), entry.Rank+n)
+}
+
+// Pages returns an iterator over the leaderboard, pageSize entries at a time.
+func (board *Leaderboard) Pages(pageSize int64) *PageIterator {
+	return &PageIterator{board: board, pageSize: pageSize}
+}
+
+// PageIterator iterates over the pages of a [Leaderboard], from the best ranked entry onwards. Members added or
+// removed during the iteration may shift the entries across pages.
+type PageIterator struct {
+	board    *Leaderboard
+	pageSize int64
+	next     int64
+	done     bool
+}
+
+// Next returns the next page of entries. The last page may hold fewer than pageSize entries, after which [PageIterator.Done]
+// returns true and Next returns no entries.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The entries of the next page, ordered by rank.
+func (it *PageIterator) Next(ctx context.Context) ([]Entry, error) {
+	if it.done || it.pageSize <= 0 {
+		it.done = true
+		return []Entry{}, nil
+	}
+	entries, err := it.board.Range(ctx, it.next, it.next+it.pageSize-1)
+	if err != nil {
+		return nil, err
+	}
+	it.next += int64(len(entries))
+	if int64(len(entries)) < it.pageSize {
+		it.done = true
+	}
+	return entries, nil
+}
+
+// Done returns whether all pages were returned.
+func (it *PageIterator) Done() bool {
+	return it.done
+}
@@ -0,0 +1,148 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package leaderboard
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// fakeClient keeps a single sorted set in memory.
+type fakeClient struct {
+	scores map[string]float64
+}
+
+func (c *fakeClient) ZAdd(_ context.Context, _ string, members map[string]float64) (int64, error) {
+	for member, score := range members {
+		c.scores[member] = score
+	}
+	return int64(len(members)), nil
+}
+
+func (c *fakeClient) ZIncrBy(_ context.Context, _ string, increment float64, member string) (float64, error) {
+	c.scores[member] += increment
+	return c.scores[member], nil
+}
+
+func (c *fakeClient) ZRem(_ context.Context, _ string, members []string) (int64, error) {
+	var removed int64
+	for _, member := range members {
+		if _, ok := c.scores[member]; ok {
+			delete(c.scores, member)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+func (c *fakeClient) ZCard(_ context.Context, _ string) (int64, error) {
+	return int64(len(c.scores)), nil
+}
+
+func (c *fakeClient) sorted(reverse bool) []models.MemberAndScore {
+	members := make([]models.MemberAndScore, 0, len(c.scores))
+	for member, score := range c.scores {
+		members = append(members, models.MemberAndScore{Member: member, Score: score})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].Score == members[j].Score {
+			return members[i].Member < members[j].Member != reverse
+		}
+		return members[i].Score < members[j].Score != reverse
+	})
+	return members
+}
+
+func (c *fakeClient) rankWithScore(member string, reverse bool) models.Result[models.RankAndScore] {
+	for rank, entry := range c.sorted(reverse) {
+		if entry.Member == member {
+			return models.CreateRankAndScoreResult(int64(rank), entry.Score)
+		}
+	}
+	return models.CreateNilRankAndScoreResult()
+}
+
+func (c *fakeClient) ZRankWithScore(_ context.Context, _ string, member string) (models.Result[models.RankAndScore], error) {
+	return c.rankWithScore(member, false), nil
+}
+
+func (c *fakeClient) ZRevRankWithScore(
+	_ context.Context,
+	_ string,
+	member string,
+) (models.Result[models.RankAndScore], error) {
+	return c.rankWithScore(member, true), nil
+}
+
+func (c *fakeClient) ZRangeWithScores(
+	_ context.Context,
+	_ string,
+	query options.ZRangeQueryWithScores,
+) ([]models.MemberAndScore, error) {
+	byIndex := query.(*options.RangeByIndex)
+	members := c.sorted(byIndex.Reverse)
+	start, end := min(int(byIndex.Start), len(members)), min(int(byIndex.End)+1, len(members))
+	return members[start:end], nil
+}
+
+func newTestLeaderboard() *Leaderboard {
+	client := &fakeClient{scores: map[string]float64{"a": 10, "b": 20, "c": 30, "d": 40, "e": 50}}
+	return New(client, "board")
+}
+
+func TestLeaderboardRank(t *testing.T) {
+	board := newTestLeaderboard()
+	ctx := context.Background()
+
+	entry, err := board.Rank(ctx, "d")
+	assert.NoError(t, err)
+	assert.Equal(t, Entry{Member: "d", Score: 40, Rank: 1}, entry)
+
+	score, err := board.AddScore(ctx, "a", 45)
+	assert.NoError(t, err)
+	assert.Equal(t, 55.0, score)
+	top, err := board.Top(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []Entry{{Member: "a", Score: 55, Rank: 0}, {Member: "e", Score: 50, Rank: 1}}, top)
+
+	around, err := board.Around(ctx, "c", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"d", "c", "b"}, members(around))
+
+	assert.NoError(t, board.Remove(ctx, "a"))
+	_, err = board.Rank(ctx, "a")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, board.Remove(ctx, "a"), ErrNotFound)
+
+	board.WithAscending(true)
+	entry, err = board.Rank(ctx, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), entry.Rank)
+}
+
+func TestLeaderboardPages(t *testing.T) {
+	board := newTestLeaderboard()
+	ctx := context.Background()
+	pages := board.Pages(2)
+	var ranked [][]string
+	for !pages.Done() {
+		page, err := pages.Next(ctx)
+		assert.NoError(t, err)
+		ranked = append(ranked, members(page))
+	}
+	assert.Equal(t, [][]string{{"e", "d"}, {"c", "b"}, {"a"}}, ranked)
+}
+
+func members(entries []Entry) []string {
+	result := make([]string, len(entries))
+	for i, entry := range entries {
+		result[i] = entry.Member
+	}
+	return result
+}
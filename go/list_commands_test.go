@@ -895,6 +895,64 @@ func ExampleClusterClient_LMove() {
 	// [one three four]
 }
 
+func ExampleClient_RPopLPush() {
+	var client *Client = getExampleClient() // example helper function
+	client.RPush(context.Background(), "my_list1", []string{"one", "two"})
+	result, err := client.RPopLPush(context.Background(), "my_list1", "my_list2")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	list, _ := client.LRange(context.Background(), "my_list2", 0, -1)
+	fmt.Println(result.Value())
+	fmt.Println(list)
+
+	// Output:
+	// two
+	// [two]
+}
+
+func ExampleClient_BRPopLPush() {
+	var client *Client = getExampleClient() // example helper function
+	client.RPush(context.Background(), "my_list1", []string{"one", "two"})
+	result, err := client.BRPopLPush(context.Background(), "my_list1", "my_list2", 100*time.Millisecond)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// Output:
+	// two
+}
+
+func ExampleClusterClient_RPopLPush() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.RPush(context.Background(), "{list}-1", []string{"one", "two"})
+	result, err := client.RPopLPush(context.Background(), "{list}-1", "{list}-2")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	list, _ := client.LRange(context.Background(), "{list}-2", 0, -1)
+	fmt.Println(result.Value())
+	fmt.Println(list)
+
+	// Output:
+	// two
+	// [two]
+}
+
+func ExampleClusterClient_BRPopLPush() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	client.RPush(context.Background(), "{list}-1", []string{"one", "two"})
+	result, err := client.BRPopLPush(context.Background(), "{list}-1", "{list}-2", 100*time.Millisecond)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+
+	// Output:
+	// two
+}
+
 func ExampleClient_BLMove() {
 	var client *Client = getExampleClient() // example helper function
 	result, err := client.LPush(context.Background(), "my_list1", []string{"two", "one"})
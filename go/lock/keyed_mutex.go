@@ -0,0 +1,208 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package lock provides distributed locks built on a Valkey GLIDE client. A [KeyedMutex] maps each key to a lock with
+// a lease, and hands out a fencing token with every acquisition, so that the resources guarded by the lock can reject
+// the writes of a holder whose lease expired.
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+const (
+	// DefaultPrefix is the prefix of the lock keys if none is set with [KeyedMutex.WithPrefix].
+	DefaultPrefix = "mutex:"
+	// DefaultRetryInterval is the interval between attempts of [KeyedMutex.Lock] if none is set with
+	// [KeyedMutex.WithRetryInterval].
+	DefaultRetryInterval = 50 * time.Millisecond
+
+	ownerBytes = 16
+)
+
+var (
+	// ErrNotAcquired is returned by [KeyedMutex.TryLock] when the lock is held by another owner.
+	ErrNotAcquired = errors.New("lock is held by another owner")
+	// ErrNotHeld is returned by [KeyedMutex.Unlock] when the lease expired, and the lock was released or acquired by
+	// another owner in the meantime.
+	ErrNotHeld = errors.New("lock is no longer held")
+)
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the mutex.
+type Client interface {
+	SetWithOptions(ctx context.Context, key string, value string, options options.SetOptions) (models.Result[string], error)
+	Incr(ctx context.Context, key string) (int64, error)
+	CompareAndDelete(ctx context.Context, key string, expected string) (bool, error)
+}
+
+// Lease is a lock held on a key of a [KeyedMutex].
+type Lease struct {
+	// Key is the key the lock is held on.
+	Key string
+	// Token is the fencing token of the acquisition. Tokens of the same key strictly increase with every acquisition,
+	// so a resource guarded by the lock should remember the highest token it saw and reject requests carrying a lower
+	// one.
+	Token int64
+	// Expires is the time after which the lock may be acquired by another owner, measured on the local clock.
+	Expires time.Time
+	owner   string
+}
+
+// KeyedMutex holds distributed locks on arbitrary keys. Each lock is a string key set with NX and a lease, holding a
+// random value identifying its owner, so that only the owner releases it. Once acquired, a counter key is incremented
+// to produce the fencing token of the acquisition. The counter keys never expire, so that tokens are never reused.
+type KeyedMutex struct {
+	client        Client
+	lease         time.Duration
+	prefix        string
+	retryInterval time.Duration
+	clock         clock.Clock
+}
+
+// NewKeyedMutex returns a [KeyedMutex] whose locks expire after lease, unless released earlier.
+//
+// Parameters:
+//
+//	client - The client used to store the locks, e.g. a glide.Client or glide.ClusterClient.
+//	lease - The duration after which a lock expires. It must be at least one millisecond.
+func NewKeyedMutex(client Client, lease time.Duration) *KeyedMutex {
+	return &KeyedMutex{
+		client:        client,
+		lease:         lease,
+		prefix:        DefaultPrefix,
+		retryInterval: DefaultRetryInterval,
+		clock:         clock.System(),
+	}
+}
+
+// WithPrefix sets the prefix of the lock keys. If not explicitly set, [DefaultPrefix] is used.
+func (mutex *KeyedMutex) WithPrefix(prefix string) *KeyedMutex {
+	mutex.prefix = prefix
+	return mutex
+}
+
+// WithRetryInterval sets the interval between attempts of [KeyedMutex.Lock]. If not explicitly set,
+// [DefaultRetryInterval] is used.
+func (mutex *KeyedMutex) WithRetryInterval(interval time.Duration) *KeyedMutex {
+	mutex.retryInterval = interval
+	return mutex
+}
+
+// WithClock sets the clock measuring the expiry of the leases and the retry interval, e.g. a clock.Fake in unit tests.
+// If not explicitly set, the system clock is used.
+func (mutex *KeyedMutex) WithClock(c clock.Clock) *KeyedMutex {
+	mutex.clock = c
+	return mutex
+}
+
+// lockKey returns the key holding the lock on key. The hash tag keeps the lock and its fencing counter in the same hash
+// slot in cluster mode.
+func (mutex *KeyedMutex) lockKey(key string) string {
+	return mutex.prefix + "{" + key + "}"
+}
+
+func (mutex *KeyedMutex) fenceKey(key string) string {
+	return mutex.lockKey(key) + ":fence"
+}
+
+func newOwner() (string, error) {
+	buf := make([]byte, ownerBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// TryLock acquires the lock on key if it is not held.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to lock.
+//
+// Return value:
+//
+//	The [Lease] of the lock, or [ErrNotAcquired] if the lock is held by another owner.
+func (mutex *KeyedMutex) TryLock(ctx context.Context, key string) (*Lease, error) {
+	if mutex.lease < time.Millisecond {
+		return nil, errors.New("lock lease must be at least one millisecond")
+	}
+	owner, err := newOwner()
+	if err != nil {
+		return nil, err
+	}
+	start := mutex.clock.Now()
+	setOptions := options.NewSetOptions().
+		SetOnlyIfDoesNotExist().
+		SetExpiry(options.NewExpiryIn(mutex.lease.Truncate(time.Millisecond)))
+	result, err := mutex.client.SetWithOptions(ctx, mutex.lockKey(key), owner, *setOptions)
+	if err != nil {
+		return nil, err
+	}
+	if result.IsNil() {
+		return nil, ErrNotAcquired
+	}
+	// The token is produced once the lock is held, so that tokens increase in the order the lock is acquired.
+	token, err := mutex.client.Incr(ctx, mutex.fenceKey(key))
+	if err != nil {
+		// Do not keep a lock without a token until its lease expires.
+		_, _ = mutex.client.CompareAndDelete(context.WithoutCancel(ctx), mutex.lockKey(key), owner)
+		return nil, fmt.Errorf("failed to produce a fencing token: %w", err)
+	}
+	return &Lease{Key: key, Token: token, Expires: start.Add(mutex.lease), owner: owner}, nil
+}
+
+// Lock acquires the lock on key, retrying every retry interval while it is held by another owner.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution, and how long to wait for the lock.
+//	key - The key to lock.
+//
+// Return value:
+//
+//	The [Lease] of the lock, or the error of ctx if it is done before the lock is acquired.
+func (mutex *KeyedMutex) Lock(ctx context.Context, key string) (*Lease, error) {
+	for {
+		lease, err := mutex.TryLock(ctx, key)
+		if !errors.Is(err, ErrNotAcquired) {
+			return lease, err
+		}
+		timer := mutex.clock.NewTimer(mutex.retryInterval)
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Unlock releases the lock held by lease.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	lease - The lease returned when the lock was acquired.
+//
+// Return value:
+//
+//	nil, or [ErrNotHeld] if the lease expired and the lock is no longer held by its owner.
+func (mutex *KeyedMutex) Unlock(ctx context.Context, lease *Lease) error {
+	released, err := mutex.client.CompareAndDelete(ctx, mutex.lockKey(lease.Key), lease.owner)
+	if err != nil {
+		return err
+	}
+	if !released {
+		return ErrNotHeld
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package lock
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// fakeClient keeps strings in memory, ignoring their expiry.
+type fakeClient struct {
+	mu      sync.Mutex
+	strings map[string]string
+	expiry  map[string]*options.Expiry
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{strings: make(map[string]string), expiry: make(map[string]*options.Expiry)}
+}
+
+func (c *fakeClient) SetWithOptions(
+	_ context.Context,
+	key string,
+	value string,
+	setOptions options.SetOptions,
+) (models.Result[string], error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.strings[key]; ok && setOptions.ConditionalSet == constants.OnlyIfDoesNotExist {
+		return models.CreateNilStringResult(), nil
+	}
+	c.strings[key] = value
+	c.expiry[key] = setOptions.Expiry
+	return models.CreateStringResult("OK"), nil
+}
+
+func (c *fakeClient) Incr(_ context.Context, key string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, _ := strconv.ParseInt(c.strings[key], 10, 64)
+	value++
+	c.strings[key] = strconv.FormatInt(value, 10)
+	return value, nil
+}
+
+func (c *fakeClient) CompareAndDelete(_ context.Context, key string, expected string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if current, ok := c.strings[key]; !ok || current != expected {
+		return false, nil
+	}
+	delete(c.strings, key)
+	return true, nil
+}
+
+func TestKeyedMutex(t *testing.T) {
+	client := newFakeClient()
+	fakeClock := clock.NewFake(time.Unix(1000, 0))
+	mutex := NewKeyedMutex(client, 5*time.Second).WithPrefix("app:lock:").WithClock(fakeClock)
+	ctx := context.Background()
+
+	lease, err := mutex.TryLock(ctx, "order:1")
+	assert.NoError(t, err)
+	assert.Equal(t, "order:1", lease.Key)
+	assert.Equal(t, int64(1), lease.Token)
+	assert.Equal(t, time.Unix(1005, 0), lease.Expires)
+	assert.Equal(t, options.NewExpiryIn(5*time.Second), client.expiry["app:lock:{order:1}"])
+
+	_, err = mutex.TryLock(ctx, "order:1")
+	assert.ErrorIs(t, err, ErrNotAcquired)
+	other, err := mutex.TryLock(ctx, "order:2")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), other.Token)
+
+	assert.NoError(t, mutex.Unlock(ctx, lease))
+	assert.ErrorIs(t, mutex.Unlock(ctx, lease), ErrNotHeld)
+
+	next, err := mutex.TryLock(ctx, "order:1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), next.Token)
+	// A stale lease does not release the lock acquired after it.
+	assert.ErrorIs(t, mutex.Unlock(ctx, lease), ErrNotHeld)
+	assert.NoError(t, mutex.Unlock(ctx, next))
+}
+
+func TestKeyedMutexLockWaits(t *testing.T) {
+	fakeClock := clock.NewFake(time.Unix(1000, 0))
+	mutex := NewKeyedMutex(newFakeClient(), time.Second).WithRetryInterval(time.Second).WithClock(fakeClock)
+	ctx := context.Background()
+	lease, err := mutex.Lock(ctx, "key")
+	assert.NoError(t, err)
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		fakeClock.BlockUntil(1)
+		cancel()
+	}()
+	_, err = mutex.Lock(cancelledCtx, "key")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	go func() {
+		fakeClock.BlockUntil(1)
+		assert.NoError(t, mutex.Unlock(ctx, lease))
+		fakeClock.Advance(time.Second)
+	}()
+	next, err := mutex.Lock(ctx, "key")
+	assert.NoError(t, err)
+	assert.Greater(t, next.Token, lease.Token)
+	assert.Equal(t, time.Unix(1002, 0), next.Expires)
+}
+
+func TestKeyedMutexRejectsShortLease(t *testing.T) {
+	_, err := NewKeyedMutex(newFakeClient(), time.Microsecond).TryLock(context.Background(), "key")
+	assert.ErrorContains(t, err, "at least one millisecond")
+}
@@ -0,0 +1,68 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// applyMapKeyPolicy enforces the map key policy of the client on the maps of a response, before it is converted. The
+// response handlers keep the value of the last occurrence of a key, so for FirstKeyWins the value of the first
+// occurrence of every duplicated key is swapped with the value of its last occurrence, while DuplicateKeyError fails
+// with a ConversionError.
+func applyMapKeyPolicy(response *C.struct_CommandResponse, policy config.MapKeyPolicy) error {
+	if response == nil || policy == config.LastKeyWins {
+		return nil
+	}
+	switch response.response_type {
+	case C.Array:
+		if response.array_value == nil {
+			return nil
+		}
+		elements := unsafe.Slice(response.array_value, response.array_value_len)
+		for idx := range elements {
+			if err := applyMapKeyPolicy(&elements[idx], policy); err != nil {
+				return err
+			}
+		}
+	case C.Map:
+		if response.array_value == nil {
+			return nil
+		}
+		entries := unsafe.Slice(response.array_value, response.array_value_len)
+		first := make(map[string]int, len(entries))
+		last := make(map[string]int)
+		for idx := range entries {
+			if err := applyMapKeyPolicy(entries[idx].map_value, policy); err != nil {
+				return err
+			}
+			if entries[idx].map_key == nil {
+				continue
+			}
+			parsedKey, err := parseString(entries[idx].map_key)
+			if err != nil {
+				return err
+			}
+			key, _ := parsedKey.(string)
+			if _, seen := first[key]; !seen {
+				first[key] = idx
+				continue
+			}
+			if policy == config.DuplicateKeyError {
+				value, _ := parseMap(response)
+				return NewConversionError(callerCommand(), "map with unique keys", fmt.Sprintf("duplicate key %q", key), value)
+			}
+			last[key] = idx
+		}
+		for key, idx := range last {
+			entries[first[key]].map_value, entries[idx].map_value = entries[idx].map_value, entries[first[key]].map_value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package mirror assists zero-downtime migrations between Valkey deployments built on two Valkey GLIDE clients. A
+// [Mirror] double-writes mutations to a primary and a secondary deployment, and verifies that the keys of both hold
+// the same values and expiries, so that reads can be switched over to the secondary once it caught up.
+package mirror
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// DefaultTTLTolerance is the largest difference between the expiries of a key on both deployments that is not
+// reported by [Mirror.Verify], unless set otherwise with [Mirror.WithTTLTolerance].
+const DefaultTTLTolerance = time.Second
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the mirror.
+type Client interface {
+	Get(ctx context.Context, key string) (models.Result[string], error)
+	SetWithOptions(ctx context.Context, key string, value string, options options.SetOptions) (models.Result[string], error)
+	Del(ctx context.Context, keys []string) (int64, error)
+	PExpire(ctx context.Context, key string, expireTime time.Duration) (bool, error)
+	HSet(ctx context.Context, key string, values map[string]string) (int64, error)
+	HDel(ctx context.Context, key string, fields []string) (int64, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	PTTL(ctx context.Context, key string) (int64, error)
+	Type(ctx context.Context, key string) (string, error)
+	Dump(ctx context.Context, key string) (models.Result[string], error)
+	RestoreWithOptions(
+		ctx context.Context,
+		key string,
+		ttl time.Duration,
+		value string,
+		options options.RestoreOptions,
+	) (string, error)
+}
+
+// SecondaryError is returned by the writes of a [Mirror] which succeeded on the primary but failed on the secondary,
+// unless the error is handled by the function set with [Mirror.WithSecondaryErrorHandler]. The result of the primary
+// is returned along with it, so callers may ignore the error and repair the key later, e.g. with [Mirror.Repair].
+type SecondaryError struct {
+	// Keys are the keys of the failed write.
+	Keys []string
+	// Err is the error returned by the secondary.
+	Err error
+}
+
+func (e *SecondaryError) Error() string {
+	return fmt.Sprintf("mirrored write of %v failed on the secondary: %v", e.Keys, e.Err)
+}
+
+func (e *SecondaryError) Unwrap() error { return e.Err }
+
+// Mirror writes to a primary and a secondary client. Every write is sent to the primary first, and only sent to the
+// secondary if it succeeded on the primary, so the primary remains the source of truth. Reads should be served by the
+// primary until [Mirror.Verify] reports no mismatches.
+type Mirror struct {
+	primary        Client
+	secondary      Client
+	ttlTolerance   time.Duration
+	onSecondaryErr func(err *SecondaryError)
+}
+
+// New returns a [Mirror] double-writing to primary and secondary.
+//
+// Parameters:
+//
+//	primary - The client of the deployment being migrated from, e.g. a glide.Client or glide.ClusterClient.
+//	secondary - The client of the deployment being migrated to.
+func New(primary Client, secondary Client) *Mirror {
+	return &Mirror{primary: primary, secondary: secondary, ttlTolerance: DefaultTTLTolerance}
+}
+
+// WithTTLTolerance sets the largest difference between the expiries of a key on both deployments that is not reported
+// as a mismatch. Since writes reach the secondary after the primary, the expiries of keys written with a relative TTL
+// differ slightly. If not explicitly set, [DefaultTTLTolerance] is used.
+func (mirror *Mirror) WithTTLTolerance(tolerance time.Duration) *Mirror {
+	mirror.ttlTolerance = tolerance
+	return mirror
+}
+
+// WithSecondaryErrorHandler sets a function handling the errors of the secondary, e.g. to log them or to queue the
+// keys for repair. Once set, the writes only return the errors of the primary. The function is called synchronously by
+// the write, so it should return quickly.
+func (mirror *Mirror) WithSecondaryErrorHandler(handler func(err *SecondaryError)) *Mirror {
+	mirror.onSecondaryErr = handler
+	return mirror
+}
+
+// Primary returns the client of the primary deployment, e.g. to serve reads.
+func (mirror *Mirror) Primary() Client {
+	return mirror.primary
+}
+
+// Secondary returns the client of the secondary deployment.
+func (mirror *Mirror) Secondary() Client {
+	return mirror.secondary
+}
+
+// mirrored sends write to the secondary, and returns the error to report for it.
+func (mirror *Mirror) mirrored(keys []string, write func(client Client) error) error {
+	err := write(mirror.secondary)
+	if err == nil {
+		return nil
+	}
+	secondaryErr := &SecondaryError{Keys: keys, Err: err}
+	if mirror.onSecondaryErr != nil {
+		mirror.onSecondaryErr(secondaryErr)
+		return nil
+	}
+	return secondaryErr
+}
+
+// Set sets key to hold value on both deployments.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to store.
+//	value - The value to store with the given key.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+func (mirror *Mirror) Set(ctx context.Context, key string, value string) (string, error) {
+	result, err := mirror.SetWithOptions(ctx, key, value, *options.NewSetOptions())
+	return result.Value(), err
+}
+
+// SetWithOptions sets key to hold value with the given options on both deployments. The value returned by GET is the
+// one of the primary. Conditional sets are only mirrored when the primary applied them, and are then sent to the
+// secondary without their condition, so that the secondary holds the value of the primary even if the deployments
+// diverged.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to store.
+//	value - The value to store with the given key.
+//	options - The [options.SetOptions].
+//
+// Return value:
+//
+//	The result of the primary.
+func (mirror *Mirror) SetWithOptions(
+	ctx context.Context,
+	key string,
+	value string,
+	options options.SetOptions,
+) (models.Result[string], error) {
+	result, err := mirror.primary.SetWithOptions(ctx, key, value, options)
+	if err != nil || !setApplied(options, result) {
+		return result, err
+	}
+	mirroredOptions := options
+	mirroredOptions.ConditionalSet = ""
+	mirroredOptions.ComparisonValue = ""
+	mirroredOptions.ReturnOldValue = false
+	return result, mirror.mirrored([]string{key}, func(client Client) error {
+		_, err := client.SetWithOptions(ctx, key, value, mirroredOptions)
+		return err
+	})
+}
+
+// setApplied reports whether a SET sent with options stored the value, given its result. Without GET, a conditional SET
+// returns nil when its condition is not met. With GET, it returns the previous value, from which the outcome of the
+// condition follows.
+func setApplied(options options.SetOptions, result models.Result[string]) bool {
+	if !options.ReturnOldValue {
+		return options.ConditionalSet == "" || !result.IsNil()
+	}
+	switch options.ConditionalSet {
+	case constants.OnlyIfDoesNotExist:
+		return result.IsNil()
+	case constants.OnlyIfExists:
+		return !result.IsNil()
+	case constants.OnlyIfEquals:
+		return !result.IsNil() && result.Value() == options.ComparisonValue
+	default:
+		return true
+	}
+}
+
+// Del removes the given keys from both deployments.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys to delete.
+//
+// Return value:
+//
+//	The number of keys removed from the primary.
+func (mirror *Mirror) Del(ctx context.Context, keys []string) (int64, error) {
+	result, err := mirror.primary.Del(ctx, keys)
+	if err != nil {
+		return result, err
+	}
+	return result, mirror.mirrored(keys, func(client Client) error {
+		_, err := client.Del(ctx, keys)
+		return err
+	})
+}
+
+// PExpire sets a timeout on key on both deployments.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to expire.
+//	expireTime - The duration for the key to expire.
+//
+// Return value:
+//
+//	`true` if the timeout was set on the primary, `false` if the key does not exist there.
+func (mirror *Mirror) PExpire(ctx context.Context, key string, expireTime time.Duration) (bool, error) {
+	result, err := mirror.primary.PExpire(ctx, key, expireTime)
+	if err != nil {
+		return result, err
+	}
+	return result, mirror.mirrored([]string{key}, func(client Client) error {
+		_, err := client.PExpire(ctx, key, expireTime)
+		return err
+	})
+}
+
+// HSet sets the given fields of the hash stored at key on both deployments.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	values - A map of field-value pairs to set in the hash.
+//
+// Return value:
+//
+//	The number of fields added to the hash on the primary.
+func (mirror *Mirror) HSet(ctx context.Context, key string, values map[string]string) (int64, error) {
+	result, err := mirror.primary.HSet(ctx, key, values)
+	if err != nil {
+		return result, err
+	}
+	return result, mirror.mirrored([]string{key}, func(client Client) error {
+		_, err := client.HSet(ctx, key, values)
+		return err
+	})
+}
+
+// HDel removes the given fields from the hash stored at key on both deployments.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key of the hash.
+//	fields - The fields to remove from the hash.
+//
+// Return value:
+//
+//	The number of fields removed from the hash on the primary.
+func (mirror *Mirror) HDel(ctx context.Context, key string, fields []string) (int64, error) {
+	result, err := mirror.primary.HDel(ctx, key, fields)
+	if err != nil {
+		return result, err
+	}
+	return result, mirror.mirrored([]string{key}, func(client Client) error {
+		_, err := client.HDel(ctx, key, fields)
+		return err
+	})
+}
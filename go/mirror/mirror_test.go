@@ -0,0 +1,298 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// fakeClient keeps strings and hashes in memory, with their TTL in milliseconds, honouring only the conditions and GET
+// option of SET. DUMP serializes strings as is.
+type fakeClient struct {
+	strings map[string]string
+	hashes  map[string]map[string]string
+	ttls    map[string]int64
+	err     error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		strings: make(map[string]string),
+		hashes:  make(map[string]map[string]string),
+		ttls:    make(map[string]int64),
+	}
+}
+
+func (c *fakeClient) Get(_ context.Context, key string) (models.Result[string], error) {
+	if value, ok := c.strings[key]; ok {
+		return models.CreateStringResult(value), nil
+	}
+	return models.CreateNilStringResult(), nil
+}
+
+func (c *fakeClient) SetWithOptions(
+	_ context.Context,
+	key string,
+	value string,
+	setOptions options.SetOptions,
+) (models.Result[string], error) {
+	if c.err != nil {
+		return models.CreateNilStringResult(), c.err
+	}
+	old, exists := c.strings[key]
+	result := models.CreateStringResult("OK")
+	if setOptions.ReturnOldValue {
+		result = models.CreateNilStringResult()
+		if exists {
+			result = models.CreateStringResult(old)
+		}
+	}
+	switch setOptions.ConditionalSet {
+	case constants.OnlyIfDoesNotExist:
+		exists = !exists
+	case constants.OnlyIfEquals:
+		exists = exists && old == setOptions.ComparisonValue
+	case "":
+		exists = true
+	}
+	if !exists {
+		if setOptions.ReturnOldValue {
+			return result, nil
+		}
+		return models.CreateNilStringResult(), nil
+	}
+	c.strings[key] = value
+	delete(c.ttls, key)
+	return result, nil
+}
+
+func (c *fakeClient) Del(_ context.Context, keys []string) (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	var removed int64
+	for _, key := range keys {
+		_, isString := c.strings[key]
+		_, isHash := c.hashes[key]
+		if isString || isHash {
+			removed++
+		}
+		delete(c.strings, key)
+		delete(c.hashes, key)
+		delete(c.ttls, key)
+	}
+	return removed, nil
+}
+
+func (c *fakeClient) PExpire(_ context.Context, key string, expireTime time.Duration) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+	c.ttls[key] = expireTime.Milliseconds()
+	return true, nil
+}
+
+func (c *fakeClient) HSet(_ context.Context, key string, values map[string]string) (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	if c.hashes[key] == nil {
+		c.hashes[key] = make(map[string]string)
+	}
+	maps.Copy(c.hashes[key], values)
+	return int64(len(values)), nil
+}
+
+func (c *fakeClient) HDel(_ context.Context, key string, fields []string) (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	for _, field := range fields {
+		delete(c.hashes[key], field)
+	}
+	return int64(len(fields)), nil
+}
+
+func (c *fakeClient) HGetAll(_ context.Context, key string) (map[string]string, error) {
+	return maps.Clone(c.hashes[key]), nil
+}
+
+func (c *fakeClient) PTTL(_ context.Context, key string) (int64, error) {
+	if keyType, _ := c.Type(context.Background(), key); keyType == "none" {
+		return -2, nil
+	}
+	if ttl, ok := c.ttls[key]; ok {
+		return ttl, nil
+	}
+	return -1, nil
+}
+
+func (c *fakeClient) Type(_ context.Context, key string) (string, error) {
+	if _, ok := c.strings[key]; ok {
+		return "string", nil
+	}
+	if _, ok := c.hashes[key]; ok {
+		return "hash", nil
+	}
+	return "none", nil
+}
+
+func (c *fakeClient) Dump(ctx context.Context, key string) (models.Result[string], error) {
+	return c.Get(ctx, key)
+}
+
+func (c *fakeClient) RestoreWithOptions(
+	_ context.Context,
+	key string,
+	ttl time.Duration,
+	value string,
+	_ options.RestoreOptions,
+) (string, error) {
+	c.strings[key] = value
+	delete(c.ttls, key)
+	if ttl > 0 {
+		c.ttls[key] = ttl.Milliseconds()
+	}
+	return "OK", nil
+}
+
+func TestMirror_Writes(t *testing.T) {
+	primary, secondary := newFakeClient(), newFakeClient()
+	mirror := New(primary, secondary)
+	ctx := context.Background()
+
+	result, err := mirror.Set(ctx, "a", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", result)
+	_, err = mirror.HSet(ctx, "h", map[string]string{"f1": "v1", "f2": "v2"})
+	assert.NoError(t, err)
+	_, err = mirror.HDel(ctx, "h", []string{"f2"})
+	assert.NoError(t, err)
+	_, err = mirror.PExpire(ctx, "a", time.Minute)
+	assert.NoError(t, err)
+	_, err = mirror.Set(ctx, "b", "2")
+	assert.NoError(t, err)
+	removed, err := mirror.Del(ctx, []string{"b"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), removed)
+
+	assert.Equal(t, primary.strings, secondary.strings)
+	assert.Equal(t, primary.hashes, secondary.hashes)
+	assert.Equal(t, primary.ttls, secondary.ttls)
+}
+
+func TestMirror_ConditionalSet(t *testing.T) {
+	primary, secondary := newFakeClient(), newFakeClient()
+	mirror := New(primary, secondary)
+	ctx := context.Background()
+	primary.strings["a"] = "1"
+
+	result, err := mirror.SetWithOptions(ctx, "a", "2", *options.NewSetOptions().SetOnlyIfDoesNotExist())
+	assert.NoError(t, err)
+	assert.True(t, result.IsNil())
+	assert.NotContains(t, secondary.strings, "a")
+
+	result, err = mirror.SetWithOptions(ctx, "a", "2", *options.NewSetOptions().SetOnlyIfExists())
+	assert.NoError(t, err)
+	assert.Equal(t, "OK", result.Value())
+	assert.Equal(t, "2", secondary.strings["a"])
+
+	result, err = mirror.SetWithOptions(
+		ctx, "a", "3", *options.NewSetOptions().SetOnlyIfEquals("1").SetReturnOldValue(true))
+	assert.NoError(t, err)
+	assert.Equal(t, "2", result.Value())
+	assert.Equal(t, "2", secondary.strings["a"])
+
+	secondary.strings["a"] = "diverged"
+	_, err = mirror.SetWithOptions(ctx, "a", "3", *options.NewSetOptions().SetOnlyIfEquals("2").SetReturnOldValue(true))
+	assert.NoError(t, err)
+	assert.Equal(t, primary.strings, secondary.strings)
+}
+
+func TestMirror_SecondaryErrors(t *testing.T) {
+	primary, secondary := newFakeClient(), newFakeClient()
+	secondary.err = errors.New("unavailable")
+	ctx := context.Background()
+
+	result, err := New(primary, secondary).Set(ctx, "a", "1")
+	assert.Equal(t, "OK", result)
+	var secondaryErr *SecondaryError
+	require.ErrorAs(t, err, &secondaryErr)
+	assert.Equal(t, []string{"a"}, secondaryErr.Keys)
+	assert.ErrorIs(t, err, secondary.err)
+
+	var handled []*SecondaryError
+	mirror := New(primary, secondary).WithSecondaryErrorHandler(func(err *SecondaryError) { handled = append(handled, err) })
+	_, err = mirror.Del(ctx, []string{"a", "b"})
+	assert.NoError(t, err)
+	require.Len(t, handled, 1)
+	assert.Equal(t, []string{"a", "b"}, handled[0].Keys)
+
+	primary.err = errors.New("primary down")
+	secondary.err = nil
+	_, err = mirror.Set(ctx, "c", "3")
+	assert.ErrorIs(t, err, primary.err)
+	assert.NotContains(t, secondary.strings, "c")
+}
+
+func TestMirror_Verify(t *testing.T) {
+	primary, secondary := newFakeClient(), newFakeClient()
+	mirror := New(primary, secondary).WithTTLTolerance(100 * time.Millisecond)
+	ctx := context.Background()
+
+	primary.strings["same"], secondary.strings["same"] = "v", "v"
+	primary.strings["missing"] = "v"
+	secondary.strings["extra"] = "v"
+	primary.strings["type"], secondary.hashes["type"] = "v", map[string]string{"f": "v"}
+	primary.hashes["value"], secondary.hashes["value"] = map[string]string{"f": "1"}, map[string]string{"f": "2"}
+	primary.strings["ttl"], secondary.strings["ttl"] = "v", "v"
+	primary.ttls["ttl"] = 60000
+	primary.strings["close"], secondary.strings["close"] = "v", "v"
+	primary.ttls["close"], secondary.ttls["close"] = 60000, 59950
+
+	mismatches, err := mirror.Verify(ctx, []string{"same", "missing", "extra", "type", "value", "ttl", "close", "none"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Mismatch{
+		{Key: "missing", Kind: MissingOnSecondary},
+		{Key: "extra", Kind: ExtraOnSecondary},
+		{Key: "type", Kind: TypeMismatch},
+		{Key: "value", Kind: ValueMismatch},
+		{Key: "ttl", Kind: TTLMismatch},
+	}, mismatches)
+	assert.Equal(t, "missing on secondary", MissingOnSecondary.String())
+
+	pages := [][]string{{"same", "missing"}, {"ttl"}}
+	scanner := func(ctx context.Context) ([]string, bool, error) {
+		page := pages[0]
+		pages = pages[1:]
+		return page, len(pages) == 0, nil
+	}
+	mismatches, err = mirror.VerifyScan(ctx, scanner)
+	assert.NoError(t, err)
+	assert.Equal(t, []Mismatch{{Key: "missing", Kind: MissingOnSecondary}, {Key: "ttl", Kind: TTLMismatch}}, mismatches)
+}
+
+func TestMirror_Repair(t *testing.T) {
+	primary, secondary := newFakeClient(), newFakeClient()
+	mirror := New(primary, secondary)
+	ctx := context.Background()
+	primary.strings["a"] = "1"
+	primary.ttls["a"] = 5000
+	secondary.strings["b"] = "stale"
+
+	assert.NoError(t, mirror.Repair(ctx, "a"))
+	assert.NoError(t, mirror.Repair(ctx, "b"))
+	assert.Equal(t, map[string]string{"a": "1"}, secondary.strings)
+	assert.Equal(t, int64(5000), secondary.ttls["a"])
+}
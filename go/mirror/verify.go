@@ -0,0 +1,256 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package mirror
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// MismatchKind is the way a key differs between the deployments of a [Mirror].
+type MismatchKind int
+
+const (
+	// MissingOnSecondary is reported for a key which exists only on the primary.
+	MissingOnSecondary MismatchKind = iota
+	// ExtraOnSecondary is reported for a key which exists only on the secondary.
+	ExtraOnSecondary
+	// TypeMismatch is reported for a key holding values of different types.
+	TypeMismatch
+	// ValueMismatch is reported for a key holding different values.
+	ValueMismatch
+	// TTLMismatch is reported for a key whose expiries differ by more than the tolerance, or which expires on only one
+	// of the deployments.
+	TTLMismatch
+)
+
+func (kind MismatchKind) String() string {
+	switch kind {
+	case MissingOnSecondary:
+		return "missing on secondary"
+	case ExtraOnSecondary:
+		return "extra on secondary"
+	case TypeMismatch:
+		return "type mismatch"
+	case ValueMismatch:
+		return "value mismatch"
+	case TTLMismatch:
+		return "TTL mismatch"
+	default:
+		return "unknown mismatch"
+	}
+}
+
+// Mismatch is a key which differs between the deployments of a [Mirror].
+type Mismatch struct {
+	Key  string
+	Kind MismatchKind
+}
+
+// KeyScanner returns the keys to verify page by page, along with whether the last page was returned.
+type KeyScanner func(ctx context.Context) (keys []string, done bool, err error)
+
+// StandaloneScanClient is the subset of the commands of glide.Client used by [ScanStandalone].
+type StandaloneScanClient interface {
+	ScanWithOptions(ctx context.Context, cursor models.Cursor, scanOptions options.ScanOptions) (models.ScanResult, error)
+}
+
+// ClusterScanClient is the subset of the commands of glide.ClusterClient used by [ScanCluster].
+type ClusterScanClient interface {
+	ScanWithOptions(
+		ctx context.Context,
+		cursor models.ClusterScanCursor,
+		opts options.ClusterScanOptions,
+	) (models.ClusterScanResult, error)
+}
+
+// ScanStandalone returns a [KeyScanner] iterating over the keys of a standalone deployment matching pattern with SCAN.
+func ScanStandalone(client StandaloneScanClient, pattern string) KeyScanner {
+	cursor := models.NewCursor()
+	scanOptions := options.NewScanOptions().SetMatch(pattern)
+	return func(ctx context.Context) ([]string, bool, error) {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return nil, false, err
+		}
+		cursor = result.Cursor
+		return result.Data, cursor.IsFinished(), nil
+	}
+}
+
+// ScanCluster returns a [KeyScanner] iterating over the keys of a cluster matching pattern with a cluster scan.
+func ScanCluster(client ClusterScanClient, pattern string) KeyScanner {
+	cursor := models.NewClusterScanCursor()
+	scanOptions := options.NewClusterScanOptions().SetMatch(pattern)
+	return func(ctx context.Context) ([]string, bool, error) {
+		result, err := client.ScanWithOptions(ctx, cursor, *scanOptions)
+		if err != nil {
+			return nil, false, err
+		}
+		cursor = result.Cursor
+		return result.Keys, cursor.IsFinished(), nil
+	}
+}
+
+// Verify compares the given keys on both deployments. Strings and hashes are compared by value, while the values of
+// other types are compared in their serialized form returned by DUMP, which only matches between servers of the same
+// version and encoding configuration. Keys are read from both deployments one after the other, so keys written
+// concurrently may be reported, and should be verified again.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	keys - The keys to compare.
+//
+// Return value:
+//
+//	The keys which differ, in the order of keys.
+func (mirror *Mirror) Verify(ctx context.Context, keys []string) ([]Mismatch, error) {
+	var mismatches []Mismatch
+	for _, key := range keys {
+		kind, differs, err := mirror.compare(ctx, key)
+		if err != nil {
+			return mismatches, err
+		}
+		if differs {
+			mismatches = append(mismatches, Mismatch{Key: key, Kind: kind})
+		}
+	}
+	return mismatches, nil
+}
+
+// VerifyScan compares the keys returned by scanner on both deployments, see [Mirror.Verify]. Keys which only exist on
+// the secondary are only found by scanning the secondary.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	scanner - The scanner returning the keys to compare, e.g. [ScanStandalone] or [ScanCluster].
+//
+// Return value:
+//
+//	The keys which differ.
+func (mirror *Mirror) VerifyScan(ctx context.Context, scanner KeyScanner) ([]Mismatch, error) {
+	var mismatches []Mismatch
+	for {
+		keys, done, err := scanner(ctx)
+		if err != nil {
+			return mismatches, err
+		}
+		found, err := mirror.Verify(ctx, keys)
+		mismatches = append(mismatches, found...)
+		if err != nil || done {
+			return mismatches, err
+		}
+	}
+}
+
+// compare returns how key differs between the deployments, if it does.
+func (mirror *Mirror) compare(ctx context.Context, key string) (MismatchKind, bool, error) {
+	primaryType, err := mirror.primary.Type(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+	secondaryType, err := mirror.secondary.Type(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+	switch {
+	case primaryType == secondaryType && primaryType == "none":
+		return 0, false, nil
+	case secondaryType == "none":
+		return MissingOnSecondary, true, nil
+	case primaryType == "none":
+		return ExtraOnSecondary, true, nil
+	case primaryType != secondaryType:
+		return TypeMismatch, true, nil
+	}
+
+	equal, err := mirror.equalValues(ctx, key, primaryType)
+	if err != nil || !equal {
+		return ValueMismatch, !equal, err
+	}
+
+	primaryTTL, err := mirror.primary.PTTL(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+	secondaryTTL, err := mirror.secondary.PTTL(ctx, key)
+	if err != nil {
+		return 0, false, err
+	}
+	if primaryTTL == -2 || secondaryTTL == -2 {
+		// The key expired or was deleted since its type was read.
+		return 0, false, nil
+	}
+	if (primaryTTL == -1) != (secondaryTTL == -1) {
+		return TTLMismatch, true, nil
+	}
+	if diff := time.Duration(primaryTTL-secondaryTTL) * time.Millisecond; diff > mirror.ttlTolerance ||
+		-diff > mirror.ttlTolerance {
+		return TTLMismatch, true, nil
+	}
+	return 0, false, nil
+}
+
+func (mirror *Mirror) equalValues(ctx context.Context, key string, valueType string) (bool, error) {
+	switch valueType {
+	case "string":
+		primaryValue, err := mirror.primary.Get(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		secondaryValue, err := mirror.secondary.Get(ctx, key)
+		return primaryValue == secondaryValue, err
+	case "hash":
+		primaryValue, err := mirror.primary.HGetAll(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		secondaryValue, err := mirror.secondary.HGetAll(ctx, key)
+		return maps.Equal(primaryValue, secondaryValue), err
+	default:
+		primaryValue, err := mirror.primary.Dump(ctx, key)
+		if err != nil {
+			return false, err
+		}
+		secondaryValue, err := mirror.secondary.Dump(ctx, key)
+		return primaryValue == secondaryValue, err
+	}
+}
+
+// Repair copies key from the primary to the secondary, along with its expiry, or deletes it from the secondary if it
+// does not exist on the primary. The value is copied with DUMP and RESTORE, so the secondary must run the same or a
+// newer version than the primary.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to copy.
+func (mirror *Mirror) Repair(ctx context.Context, key string) error {
+	ttl, err := mirror.primary.PTTL(ctx, key)
+	if err != nil {
+		return err
+	}
+	value, err := mirror.primary.Dump(ctx, key)
+	if err != nil {
+		return err
+	}
+	if value.IsNil() || ttl == -2 {
+		_, err := mirror.secondary.Del(ctx, []string{key})
+		return err
+	}
+	// A TTL of -1 means the key has no expiry, which RESTORE expects as 0.
+	restoreTTL := time.Duration(max(ttl, 0)) * time.Millisecond
+	if ttl >= 0 && restoreTTL == 0 {
+		restoreTTL = time.Millisecond
+	}
+	_, err = mirror.secondary.RestoreWithOptions(
+		ctx, key, restoreTTL, value.Value(), *options.NewRestoreOptions().SetReplace(),
+	)
+	return err
+}
@@ -0,0 +1,37 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// KeyInfo holds the metadata of a key, as returned by KeyInfo.
+type KeyInfo struct {
+	// The name of the key.
+	Key string
+	// Whether the key exists. The other fields are only set if it does.
+	Exists bool
+	// The type of the value stored at the key, e.g. "string" or "hash", as returned by TYPE.
+	Type string
+	// The remaining time to live of the key in milliseconds, or `-1` if the key has no expiry, as returned by PTTL.
+	TTL int64
+	// The internal encoding of the value, e.g. "listpack", as returned by OBJECT ENCODING, or an empty string if it is
+	// not available.
+	Encoding string
+	// The approximate number of bytes used by the key and its value, as returned by MEMORY USAGE, or `0` if it is not
+	// available.
+	Size int64
+}
+
+// KeyPage is a page of keys returned by Client.BrowseKeys.
+type KeyPage struct {
+	// The cursor to pass to get the next page. The browsing is over once it is finished.
+	Cursor Cursor
+	// The keys of the page along with their metadata, sorted by name.
+	Keys []KeyInfo
+}
+
+// ClusterKeyPage is a page of keys returned by ClusterClient.BrowseKeys.
+type ClusterKeyPage struct {
+	// The cursor to pass to get the next page. The browsing is over once it is finished.
+	Cursor ClusterScanCursor
+	// The keys of the page along with their metadata, sorted by name.
+	Keys []KeyInfo
+}
@@ -0,0 +1,25 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// BackgroundTaskStatus is the outcome of a request to start a background persistence task, such as BGSAVE or
+// BGREWRITEAOF.
+type BackgroundTaskStatus int
+
+const (
+	// BackgroundTaskStarted indicates that the task was started right away.
+	BackgroundTaskStarted BackgroundTaskStatus = iota
+	// BackgroundTaskScheduled indicates that the task will start once the persistence task in progress completes.
+	BackgroundTaskScheduled
+)
+
+func (status BackgroundTaskStatus) String() string {
+	switch status {
+	case BackgroundTaskStarted:
+		return "started"
+	case BackgroundTaskScheduled:
+		return "scheduled"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// RawType is the type of a [RawValue], as received from the server.
+type RawType int
+
+const (
+	RawNull RawType = iota
+	RawInt
+	RawFloat
+	RawBool
+	RawString
+	RawArray
+	RawMap
+	RawSet
+	// RawOk is the simple OK status reply.
+	RawOk
+	// RawError is an error reply, found in the responses of batches and of commands routed to multiple nodes.
+	RawError
+)
+
+func (rawType RawType) String() string {
+	switch rawType {
+	case RawNull:
+		return "Null"
+	case RawInt:
+		return "Int"
+	case RawFloat:
+		return "Float"
+	case RawBool:
+		return "Bool"
+	case RawString:
+		return "String"
+	case RawArray:
+		return "Array"
+	case RawMap:
+		return "Map"
+	case RawSet:
+		return "Set"
+	case RawOk:
+		return "Ok"
+	case RawError:
+		return "Error"
+	default:
+		return "Unknown"
+	}
+}
+
+// RawValue is a response, or an element of a response, as received from the server and before any conversion. Only the
+// fields matching its Type are set. It lives in Go memory, so it stays valid after the native response was released.
+type RawValue struct {
+	Type  RawType
+	Int   int64
+	Float float64
+	Bool  bool
+	// Data holds the bytes of a RawString, or the message of a RawError.
+	Data []byte
+	// Elements holds the elements of a RawArray, or the members of a RawSet.
+	Elements []RawValue
+	// Entries holds the entries of a RawMap, in the order they were received, including duplicated keys.
+	Entries []RawEntry
+}
+
+// RawEntry is an entry of a [RawValue] map.
+type RawEntry struct {
+	Key   RawValue
+	Value RawValue
+}
+
+// IsNull reports whether the value is a null reply.
+func (value RawValue) IsNull() bool {
+	return value.Type == RawNull
+}
@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+// ReplicationInfo describes the replication state of a node, as reported by the replication section of INFO.
+type ReplicationInfo struct {
+	// The role of the node, "master" for a primary or "slave" for a replica.
+	Role string
+	// The number of replicas connected to the node.
+	ConnectedReplicas int64
+	// The replicas connected to the node, if it is a primary.
+	Replicas []ReplicaInfo
+	// The replication ID of the node.
+	ReplicationId string
+	// The replication offset of the node.
+	ReplicationOffset int64
+	// The state of an ongoing coordinated failover, e.g. "no-failover".
+	FailoverState string
+	// The host of the primary, if the node is a replica.
+	PrimaryHost string
+	// The port of the primary, if the node is a replica.
+	PrimaryPort int64
+	// The status of the link to the primary, "up" or "down", if the node is a replica.
+	PrimaryLinkStatus string
+	// The replication offset processed by the node, if it is a replica.
+	ReplicaOffset int64
+}
+
+// IsPrimary returns whether the node is a primary.
+func (info ReplicationInfo) IsPrimary() bool {
+	return info.Role == "master"
+}
+
+// ReplicaInfo describes a replica connected to a primary.
+type ReplicaInfo struct {
+	// The IP address of the replica.
+	Host string
+	// The port of the replica.
+	Port int64
+	// The replication state of the replica, e.g. "online".
+	State string
+	// The replication offset acknowledged by the replica.
+	Offset int64
+	// The number of seconds since the last acknowledgement received from the replica.
+	Lag int64
+}
@@ -2,6 +2,14 @@
 
 package models
 
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
 // A value to return alongside with error in case if command failed
 var (
 	DefaultFloatResponse  float64
@@ -18,35 +26,146 @@ type Result[T any] struct {
 // KeyWithMemberAndScore is used by BZPOPMIN/BZPOPMAX, which return an object consisting of the key of the sorted set that was
 // popped, the popped member, and its score.
 type KeyWithMemberAndScore struct {
-	Key    string
-	Member string
-	Score  float64
+	Key    string  `json:"key"`
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+// NewKeyWithMemberAndScore returns a [KeyWithMemberAndScore], e.g. to build the expected results of tests.
+func NewKeyWithMemberAndScore(key string, member string, score float64) KeyWithMemberAndScore {
+	return KeyWithMemberAndScore{Key: key, Member: member, Score: score}
+}
+
+// String returns the key, the member and the score, e.g. "key: member (1.5)".
+func (kms KeyWithMemberAndScore) String() string {
+	return kms.Key + ": " + NewMemberAndScore(kms.Member, kms.Score).String()
+}
+
+// MarshalJSON encodes the infinite scores, which JSON numbers cannot represent, as the strings "inf" and "-inf".
+func (kms KeyWithMemberAndScore) MarshalJSON() ([]byte, error) {
+	type plain KeyWithMemberAndScore
+	return json.Marshal(struct {
+		plain
+		Score jsonScore `json:"score"`
+	}{plain(kms), jsonScore(kms.Score)})
+}
+
+// UnmarshalJSON decodes the encoding of MarshalJSON.
+func (kms *KeyWithMemberAndScore) UnmarshalJSON(data []byte) error {
+	type plain KeyWithMemberAndScore
+	decoded := struct {
+		*plain
+		Score jsonScore `json:"score"`
+	}{plain: (*plain)(kms)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	kms.Score = float64(decoded.Score)
+	return nil
 }
 
 // Response of the [ZMPop] and [BZMPop] command.
 type KeyWithArrayOfMembersAndScores struct {
-	Key              string
-	MembersAndScores []MemberAndScore
+	Key              string           `json:"key"`
+	MembersAndScores []MemberAndScore `json:"membersAndScores"`
+}
+
+// NewKeyWithArrayOfMembersAndScores returns a [KeyWithArrayOfMembersAndScores], e.g. to build the expected results of
+// tests.
+func NewKeyWithArrayOfMembersAndScores(
+	key string,
+	membersAndScores []MemberAndScore,
+) KeyWithArrayOfMembersAndScores {
+	return KeyWithArrayOfMembersAndScores{Key: key, MembersAndScores: membersAndScores}
+}
+
+// String returns the key followed by the members and their scores, e.g. "key: [one (1), two (2)]".
+func (kms KeyWithArrayOfMembersAndScores) String() string {
+	members := make([]string, len(kms.MembersAndScores))
+	for idx, memberAndScore := range kms.MembersAndScores {
+		members[idx] = memberAndScore.String()
+	}
+	return kms.Key + ": [" + strings.Join(members, ", ") + "]"
 }
 
 // MemberAndScore is used by ZRANDMEMBER, which return an object consisting of the sorted set member, and its score.
 type MemberAndScore struct {
-	Member string
-	Score  float64
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+// NewMemberAndScore returns a [MemberAndScore], e.g. to build the expected results of tests.
+func NewMemberAndScore(member string, score float64) MemberAndScore {
+	return MemberAndScore{Member: member, Score: score}
+}
+
+// String returns the member and its score, e.g. "member (1.5)".
+func (ms MemberAndScore) String() string {
+	return ms.Member + " (" + strconv.FormatFloat(ms.Score, 'g', -1, 64) + ")"
+}
+
+// MarshalJSON encodes the infinite scores, which JSON numbers cannot represent, as the strings "inf" and "-inf".
+func (ms MemberAndScore) MarshalJSON() ([]byte, error) {
+	type plain MemberAndScore
+	return json.Marshal(struct {
+		plain
+		Score jsonScore `json:"score"`
+	}{plain(ms), jsonScore(ms.Score)})
+}
+
+// UnmarshalJSON decodes the encoding of MarshalJSON.
+func (ms *MemberAndScore) UnmarshalJSON(data []byte) error {
+	type plain MemberAndScore
+	decoded := struct {
+		*plain
+		Score jsonScore `json:"score"`
+	}{plain: (*plain)(ms)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	ms.Score = float64(decoded.Score)
+	return nil
+}
+
+// jsonScore is a sorted set score encoded as a JSON number, or as the string "inf" or "-inf" for the infinite scores,
+// like the server replies them.
+type jsonScore float64
+
+func (score jsonScore) MarshalJSON() ([]byte, error) {
+	switch {
+	case math.IsInf(float64(score), 1):
+		return []byte(`"inf"`), nil
+	case math.IsInf(float64(score), -1):
+		return []byte(`"-inf"`), nil
+	}
+	return json.Marshal(float64(score))
+}
+
+func (score *jsonScore) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return fmt.Errorf("invalid score %q", text)
+		}
+		*score = jsonScore(value)
+		return nil
+	}
+	return json.Unmarshal(data, (*float64)(score))
 }
 
 // Response type of [XAutoClaim] command.
 type XAutoClaimResponse struct {
-	NextEntry       string
-	ClaimedEntries  []StreamEntry
-	DeletedMessages []string
+	NextEntry       string        `json:"nextEntry"`
+	ClaimedEntries  []StreamEntry `json:"claimedEntries"`
+	DeletedMessages []string      `json:"deletedMessages"`
 }
 
 // Response type of [XAutoClaimJustId] command.
 type XAutoClaimJustIdResponse struct {
-	NextEntry       string
-	ClaimedEntries  []string
-	DeletedMessages []string
+	NextEntry       string   `json:"nextEntry"`
+	ClaimedEntries  []string `json:"claimedEntries"`
+	DeletedMessages []string `json:"deletedMessages"`
 }
 
 func (result Result[T]) IsNil() bool {
@@ -246,16 +365,16 @@ type ConsumerPendingMessage struct {
 // It includes the message ID, the consumer's name, the idle time, and the delivery count.
 type XPendingDetail struct {
 	// Id is the ID of the pending message.
-	Id string
+	Id string `json:"id"`
 
 	// ConsumerName is the name of the consumer who has the pending message.
-	ConsumerName string
+	ConsumerName string `json:"consumerName"`
 
 	// IdleTime is the amount of time (in milliseconds) that the message has been idle.
-	IdleTime int64
+	IdleTime int64 `json:"idleTime"`
 
 	// DeliveryCount is the number of times the message has been delivered.
-	DeliveryCount int64
+	DeliveryCount int64 `json:"deliveryCount"`
 }
 
 func CreateNilXPendingSummary() XPendingSummary {
@@ -297,20 +416,49 @@ type XInfoGroupInfo struct {
 	Lag Result[int64]
 }
 
+// StreamLag represents the backlog of a consumer group, as returned by `StreamLag`.
+type StreamLag struct {
+	// The consumer group's name.
+	Group string
+	// The number of consumers in the group.
+	Consumers int64
+	// The number of entries in the stream that are still waiting to be delivered to the group's consumers, or a `nil` when
+	// that number can't be determined, or on servers older than valkey 7.0.0.
+	Lag Result[int64]
+	// The length of the group's Pending Entries List (PEL), which are messages that were delivered but are yet to be
+	// acknowledged.
+	Pending int64
+	// The ID of the oldest pending message, or a `nil` if there are no pending messages.
+	OldestPendingId Result[string]
+	// The ID of the newest pending message, or a `nil` if there are no pending messages.
+	NewestPendingId Result[string]
+	// The number of pending messages of each consumer with pending messages.
+	ConsumerPending []ConsumerPendingMessage
+	// The ID of the last entry delivered to the group's consumers.
+	LastDeliveredId string
+	// The ID of the last entry added to the stream.
+	LastEntryId string
+	// The number of entries in the stream.
+	StreamLength int64
+	// Whether the last entry added to the stream was delivered to the group, so that no entries are waiting to be
+	// delivered.
+	CaughtUp bool
+}
+
 // StreamEntry represents a single entry/element in a stream
 type StreamEntry struct {
 	// The unique identifier of the entry
-	ID string
+	ID string `json:"id"`
 	// The fields associated with the entry
-	Fields []FieldValue
+	Fields []FieldValue `json:"fields"`
 }
 
 // FieldValue represents the Key-value pairs added to the entry.
 type FieldValue struct {
 	// The name of the field
-	Field string
+	Field string `json:"field"`
 	// The value of the field
-	Value string
+	Value string `json:"value"`
 }
 
 // StreamResponse represents a stream with its entries
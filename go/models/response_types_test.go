@@ -0,0 +1,79 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyWithMemberAndScore_JSON(t *testing.T) {
+	result := NewKeyWithMemberAndScore("key", "member", 1.5)
+	assert.Equal(t, "key: member (1.5)", result.String())
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":"key","member":"member","score":1.5}`, string(data))
+
+	var decoded KeyWithMemberAndScore
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, result, decoded)
+}
+
+func TestKeyWithArrayOfMembersAndScores_JSON(t *testing.T) {
+	result := NewKeyWithArrayOfMembersAndScores("key", []MemberAndScore{
+		NewMemberAndScore("one", 1),
+		NewMemberAndScore("min", math.Inf(-1)),
+		NewMemberAndScore("max", math.Inf(1)),
+	})
+	assert.Equal(t, "key: [one (1), min (-Inf), max (+Inf)]", result.String())
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":"key","membersAndScores":[`+
+		`{"member":"one","score":1},{"member":"min","score":"-inf"},{"member":"max","score":"inf"}]}`, string(data))
+
+	var decoded KeyWithArrayOfMembersAndScores
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, result, decoded)
+
+	var invalid MemberAndScore
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{"member":"m","score":"high"}`), &invalid), `invalid score "high"`)
+}
+
+func TestDecodeClaimedEntries(t *testing.T) {
+	type job struct {
+		Name     string `json:"name"`
+		Attempts int    `json:"attempts,string"`
+	}
+	response := XAutoClaimResponse{
+		NextEntry: "0-0",
+		ClaimedEntries: []StreamEntry{
+			{ID: "1-0", Fields: []FieldValue{{Field: "name", Value: "resize"}, {Field: "attempts", Value: "2"}}},
+			{ID: "2-0", Fields: []FieldValue{{Field: "name", Value: "upload"}}},
+		},
+	}
+	jobs, err := DecodeClaimedEntries[job](response, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []job{{Name: "resize", Attempts: 2}, {Name: "upload"}}, jobs)
+
+	response.ClaimedEntries[1].Fields = []FieldValue{{Field: "attempts", Value: "many"}}
+	_, err = DecodeClaimedEntries[job](response, nil)
+	assert.ErrorContains(t, err, "failed to decode stream entry 2-0")
+
+	data, err := json.Marshal(response.ClaimedEntries[0])
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1-0","fields":[{"field":"name","value":"resize"},{"field":"attempts","value":"2"}]}`,
+		string(data))
+}
+
+func TestXPendingDetail_JSON(t *testing.T) {
+	detail := XPendingDetail{Id: "1-0", ConsumerName: "worker", IdleTime: 1500, DeliveryCount: 3}
+	data, err := json.Marshal(detail)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id":"1-0","consumerName":"worker","idleTime":1500,"deliveryCount":3}`, string(data))
+	assert.Equal(t, 1500*time.Millisecond, detail.Idle())
+}
@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EntryCodec decodes the fields of a stream entry into a value, e.g. a struct.
+type EntryCodec interface {
+	DecodeFields(fields []FieldValue, value any) error
+}
+
+// JSONEntryCodec decodes the fields of a stream entry as a JSON object mapping each field to its string value, with
+// the rules of encoding/json. Struct fields are matched by their json tag, and numeric or boolean struct fields need
+// the ",string" option of the tag, e.g. `json:"attempts,string"`. It is the codec used by [StreamEntry.Decode].
+type JSONEntryCodec struct{}
+
+func (JSONEntryCodec) DecodeFields(fields []FieldValue, value any) error {
+	data, err := json.Marshal(fieldMap(fields))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, value)
+}
+
+func fieldMap(fields []FieldValue) map[string]string {
+	values := make(map[string]string, len(fields))
+	for _, field := range fields {
+		values[field.Field] = field.Value
+	}
+	return values
+}
+
+// FieldMap returns the fields of the entry as a map. If a field appears several times, its last value is kept.
+func (entry StreamEntry) FieldMap() map[string]string {
+	return fieldMap(entry.Fields)
+}
+
+// Decode decodes the fields of the entry into value with [JSONEntryCodec].
+func (entry StreamEntry) Decode(value any) error {
+	return entry.DecodeWith(JSONEntryCodec{}, value)
+}
+
+// DecodeWith decodes the fields of the entry into value with codec.
+func (entry StreamEntry) DecodeWith(codec EntryCodec, value any) error {
+	if err := codec.DecodeFields(entry.Fields, value); err != nil {
+		return fmt.Errorf("failed to decode stream entry %s: %w", entry.ID, err)
+	}
+	return nil
+}
+
+// DecodeStreamEntries decodes the fields of each entry into a new T with codec, or with [JSONEntryCodec] if codec is
+// nil.
+func DecodeStreamEntries[T any](entries []StreamEntry, codec EntryCodec) ([]T, error) {
+	if codec == nil {
+		codec = JSONEntryCodec{}
+	}
+	values := make([]T, len(entries))
+	for idx, entry := range entries {
+		if err := entry.DecodeWith(codec, &values[idx]); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// DecodeClaimedEntries decodes the claimed entries of the response into values of type T with codec, or with
+// [JSONEntryCodec] if codec is nil, e.g. to process the messages recovered from a failed consumer:
+//
+//	response, err := client.XAutoClaim(ctx, "jobs", "workers", "worker-2", time.Minute, "0-0")
+//	jobs, err := models.DecodeClaimedEntries[Job](response, nil)
+func DecodeClaimedEntries[T any](response XAutoClaimResponse, codec EntryCodec) ([]T, error) {
+	return DecodeStreamEntries[T](response.ClaimedEntries, codec)
+}
+
+// Idle returns the time the message has been idle, see IdleTime.
+func (detail XPendingDetail) Idle() time.Duration {
+	return time.Duration(detail.IdleTime) * time.Millisecond
+}
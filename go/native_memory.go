@@ -0,0 +1,100 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"expvar"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Counters of the native memory exchanged with the client core, across all clients.
+var (
+	responsesReceived atomic.Int64
+	responsesFreed    atomic.Int64
+	buffersAllocated  atomic.Int64
+	buffersFreed      atomic.Int64
+)
+
+// NativeMemoryStats counts the native objects allocated and released by all the clients of the process. Responses
+// received but not yet freed, and buffers allocated but not yet freed, are either in use by running commands or leaked,
+// so their numbers growing while the load is steady indicates a native memory leak.
+type NativeMemoryStats struct {
+	// ResponsesReceived is the number of responses allocated by the client core and passed to the Go client.
+	ResponsesReceived int64
+	// ResponsesFreed is the number of responses released by the Go client.
+	ResponsesFreed int64
+	// BuffersAllocated is the number of buffers allocated by the Go client to pass arguments, such as routes, to the
+	// client core.
+	BuffersAllocated int64
+	// BuffersFreed is the number of those buffers released.
+	BuffersFreed int64
+}
+
+// LiveResponses returns the number of responses received but not yet freed.
+func (stats NativeMemoryStats) LiveResponses() int64 {
+	return stats.ResponsesReceived - stats.ResponsesFreed
+}
+
+// LiveBuffers returns the number of buffers allocated but not yet freed.
+func (stats NativeMemoryStats) LiveBuffers() int64 {
+	return stats.BuffersAllocated - stats.BuffersFreed
+}
+
+// GetNativeMemoryStats returns a snapshot of the native memory counters of the process.
+func GetNativeMemoryStats() NativeMemoryStats {
+	return NativeMemoryStats{
+		ResponsesReceived: responsesReceived.Load(),
+		ResponsesFreed:    responsesFreed.Load(),
+		BuffersAllocated:  buffersAllocated.Load(),
+		BuffersFreed:      buffersFreed.Load(),
+	}
+}
+
+// PublishNativeMemoryStats publishes the native memory counters as an expvar variable of the given name, served as JSON
+// by the /debug/vars endpoint of the expvar package. Like expvar.Publish, it panics if the name is already in use.
+func PublishNativeMemoryStats(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		stats := GetNativeMemoryStats()
+		return map[string]int64{
+			"responsesReceived": stats.ResponsesReceived,
+			"responsesFreed":    stats.ResponsesFreed,
+			"liveResponses":     stats.LiveResponses(),
+			"buffersAllocated":  stats.BuffersAllocated,
+			"buffersFreed":      stats.BuffersFreed,
+			"liveBuffers":       stats.LiveBuffers(),
+		}
+	}))
+}
+
+// freeCommandResponse releases a response received from the client core.
+func freeCommandResponse(response *C.struct_CommandResponse) {
+	if response != nil {
+		responsesFreed.Add(1)
+	}
+	C.free_command_response(response)
+}
+
+// cBytes copies bytes into a native buffer, which must be released with freeCBuffer.
+func cBytes(bytes []byte) unsafe.Pointer {
+	buffersAllocated.Add(1)
+	return C.CBytes(bytes)
+}
+
+// cString copies a string into a native null-terminated buffer, which must be released with freeCBuffer.
+func cString(str string) *C.char {
+	buffersAllocated.Add(1)
+	return C.CString(str)
+}
+
+// freeCBuffer releases a buffer allocated by cBytes or cString. Releasing a nil buffer does nothing.
+func freeCBuffer(buffer unsafe.Pointer) {
+	if buffer == nil {
+		return
+	}
+	buffersFreed.Add(1)
+	C.free(buffer)
+}
@@ -0,0 +1,35 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNativeMemoryStats(t *testing.T) {
+	before := GetNativeMemoryStats()
+	buffer := cBytes([]byte("route"))
+	str := cString("cursor")
+	during := GetNativeMemoryStats()
+	assert.Equal(t, before.BuffersAllocated+2, during.BuffersAllocated)
+	assert.Equal(t, before.LiveBuffers()+2, during.LiveBuffers())
+
+	freeCBuffer(buffer)
+	freeCBuffer(unsafe.Pointer(str))
+	freeCommandResponse(nil)
+	after := GetNativeMemoryStats()
+	assert.Equal(t, before.LiveBuffers(), after.LiveBuffers())
+	assert.Equal(t, before.ResponsesFreed, after.ResponsesFreed)
+
+	PublishNativeMemoryStats("glide_native_memory_test")
+	var published map[string]int64
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get("glide_native_memory_test").String()), &published))
+	assert.Equal(t, after.BuffersAllocated, published["buffersAllocated"])
+	assert.Equal(t, after.LiveResponses(), published["liveResponses"])
+}
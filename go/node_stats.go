@@ -0,0 +1,273 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// NodeStats is a snapshot of the statistics of a node of the cluster, see
+// [config.AdvancedClusterClientConfiguration.WithNodeStats].
+type NodeStats struct {
+	// Address is the address of the node, as "host:port".
+	Address string
+	// Primary is set if the node served slots as a primary at the last probe.
+	Primary bool
+	// InFlight is the number of commands sent to the node that are awaiting a response.
+	InFlight int64
+	// Requests is the number of commands sent to the node.
+	Requests int64
+	// Failures is the number of commands and probes sent to the node that timed out, or failed because of the
+	// connection to the node.
+	Failures int64
+	// Reconnects is the number of times the connection of the client to the node was found re-established by a probe.
+	Reconnects int64
+	// LastError is the error of the last failure, or nil if there was none.
+	LastError error
+	// LastErrorTime is the time of the last failure.
+	LastErrorTime time.Time
+	// RTT is the round-trip time to the node, smoothed over the probes, or 0 if no probe succeeded yet.
+	RTT time.Duration
+	// LastProbe is the time of the last successful probe of the node.
+	LastProbe time.Time
+}
+
+// nodeStatsRTTWeight is the weight of the latest probe in the smoothed round-trip time of a node.
+const nodeStatsRTTWeight = 0.25
+
+// nodeCounters holds the statistics of a single node.
+type nodeCounters struct {
+	stats NodeStats
+	// clientID is the ID of the connection of the client to the node at the last probe, or 0 if it is unknown.
+	clientID int64
+}
+
+// nodeStatsMonitor attributes the commands of a cluster client to the nodes they are sent to, and periodically probes
+// every node for its round-trip time and the ID of the connection of the client. It is shared by pointer between the
+// copies of a client.
+type nodeStatsMonitor struct {
+	interval time.Duration
+	client   *baseClient
+
+	mu    sync.Mutex
+	nodes map[string]*nodeCounters
+	// shards is the topology of the cluster at the last probe, sorted by slot.
+	shards   []clusterShard
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newNodeStatsMonitor(interval time.Duration) *nodeStatsMonitor {
+	return &nodeStatsMonitor{
+		interval: interval,
+		nodes:    make(map[string]*nodeCounters),
+		stop:     make(chan struct{}),
+	}
+}
+
+// start begins probing the nodes through the given client. It must be the client returned to the user, so that the
+// probes stop reaching the core once that client is closed.
+func (monitor *nodeStatsMonitor) start(client *baseClient) {
+	monitor.client = client
+	go monitor.run()
+}
+
+func (monitor *nodeStatsMonitor) run() {
+	ticker := time.NewTicker(monitor.interval)
+	defer ticker.Stop()
+	for {
+		monitor.probe()
+		select {
+		case <-monitor.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// probe refreshes the topology of the cluster, and sends CLIENT ID to every node. The nodes that left the cluster are
+// forgotten.
+func (monitor *nodeStatsMonitor) probe() {
+	ctx, cancel := context.WithTimeout(context.Background(), monitor.interval)
+	defer cancel()
+	shards, err := monitor.client.clusterShards(ctx)
+	if err != nil {
+		return
+	}
+	monitor.mu.Lock()
+	monitor.shards = shards
+	known := make(map[string]*nodeCounters, len(monitor.nodes))
+	for _, shard := range shards {
+		for idx, node := range append([]config.ByAddressRoute{shard.primary}, shard.replicas...) {
+			address := routeAddress(node)
+			counters := monitor.counters(address)
+			counters.stats.Primary = idx == 0
+			known[address] = counters
+		}
+	}
+	monitor.nodes = known
+	monitor.mu.Unlock()
+
+	for _, shard := range shards {
+		for _, node := range append([]config.ByAddressRoute{shard.primary}, shard.replicas...) {
+			monitor.probeNode(ctx, node)
+		}
+	}
+}
+
+func (monitor *nodeStatsMonitor) probeNode(ctx context.Context, node config.ByAddressRoute) {
+	started := time.Now()
+	result, err := monitor.client.executeCommandWithRoute(ctx, C.ClientId, []string{}, &node)
+	var clientID int64
+	if err == nil {
+		clientID, err = handleIntResponse(result)
+	}
+	rtt := time.Since(started)
+
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	counters := monitor.nodes[routeAddress(&node)]
+	if counters == nil {
+		return
+	}
+	if err != nil {
+		counters.recordFailure(err)
+		return
+	}
+	counters.recordProbe(clientID, rtt, started)
+}
+
+// counters returns the counters of a node, creating them if the node is new. The caller must hold monitor.mu.
+func (monitor *nodeStatsMonitor) counters(address string) *nodeCounters {
+	counters, ok := monitor.nodes[address]
+	if !ok {
+		counters = &nodeCounters{stats: NodeStats{Address: address}}
+		monitor.nodes[address] = counters
+	}
+	return counters
+}
+
+func (counters *nodeCounters) recordFailure(err error) {
+	counters.stats.Failures++
+	counters.stats.LastError = err
+	counters.stats.LastErrorTime = time.Now()
+}
+
+func (counters *nodeCounters) recordProbe(clientID int64, rtt time.Duration, probed time.Time) {
+	if counters.clientID != 0 && counters.clientID != clientID {
+		counters.stats.Reconnects++
+	}
+	counters.clientID = clientID
+	if counters.stats.RTT == 0 {
+		counters.stats.RTT = rtt
+	} else {
+		counters.stats.RTT += time.Duration(nodeStatsRTTWeight * float64(rtt-counters.stats.RTT))
+	}
+	counters.stats.LastProbe = probed
+}
+
+// track counts a command sent to the node it targets, if the node is known. The returned function must be called with
+// the result of the command, or is nil if the command is not attributed to a node.
+func (monitor *nodeStatsMonitor) track(requestType C.RequestType, args []string, route config.Route) func(error) {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	counters, ok := monitor.nodes[monitor.nodeOf(requestType, args, route)]
+	if !ok {
+		return nil
+	}
+	counters.stats.InFlight++
+	counters.stats.Requests++
+	return func(err error) {
+		monitor.mu.Lock()
+		defer monitor.mu.Unlock()
+		counters.stats.InFlight--
+		if classifyCircuitOutcome(err) == outcomeFailure {
+			counters.recordFailure(err)
+		}
+	}
+}
+
+// nodeOf returns the address of the node a command is sent to, or an empty string if it is not known to the client.
+// The node is known for the commands routed by address, and for the commands sent to the primary of a slot: the
+// commands routed to a primary by slot, and the writes whose keys all map to the same slot. The caller must hold
+// monitor.mu.
+func (monitor *nodeStatsMonitor) nodeOf(requestType C.RequestType, args []string, route config.Route) string {
+	if address := routeAddress(route); address != "" {
+		return address
+	}
+	slot := int64(-1)
+	switch r := route.(type) {
+	case *config.SlotIdRoute:
+		if r.SlotType == config.SlotTypePrimary {
+			slot = int64(r.SlotID)
+		}
+	case *config.SlotKeyRoute:
+		if r.SlotType == config.SlotTypePrimary {
+			slot = int64(keySlot(r.SlotKey))
+		}
+	case nil:
+		slot = writeSlot(requestType, args)
+	}
+	if slot < 0 {
+		return ""
+	}
+	idx := findShard(monitor.shards, slot)
+	if idx < 0 {
+		return ""
+	}
+	return routeAddress(&monitor.shards[idx].primary)
+}
+
+// writeSlot returns the slot of the keys of a write, or -1 if the command is not a write whose keys are known to the
+// client, or if its keys map to different slots. Reads are left out, since they may be sent to replicas.
+func writeSlot(requestType C.RequestType, args []string) int64 {
+	keys := writeKeys(requestType, args)
+	if len(keys) == 0 {
+		return -1
+	}
+	slot := keySlot(keys[0])
+	for _, key := range keys[1:] {
+		if keySlot(key) != slot {
+			return -1
+		}
+	}
+	return int64(slot)
+}
+
+// snapshot returns the statistics of the nodes, sorted by address.
+func (monitor *nodeStatsMonitor) snapshot() []NodeStats {
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	stats := make([]NodeStats, 0, len(monitor.nodes))
+	for _, counters := range monitor.nodes {
+		stats = append(stats, counters.stats)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Address < stats[j].Address })
+	return stats
+}
+
+func (monitor *nodeStatsMonitor) close() {
+	monitor.stopOnce.Do(func() { close(monitor.stop) })
+}
+
+// NodeStats returns the statistics of every node of the cluster, sorted by address, see
+// [config.AdvancedClusterClientConfiguration.WithNodeStats]. The nodes are those of the topology at the last probe,
+// and the statistics of the nodes that left the cluster are discarded.
+//
+// Return value:
+//
+//	The statistics of the nodes, or nil if node statistics are not configured.
+func (client *ClusterClient) NodeStats() []NodeStats {
+	if client.nodeStats == nil {
+		return nil
+	}
+	return client.nodeStats.snapshot()
+}
@@ -0,0 +1,86 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func newTestNodeStatsMonitor() *nodeStatsMonitor {
+	monitor := newNodeStatsMonitor(time.Second)
+	monitor.shards = []clusterShard{
+		{
+			start:    0,
+			end:      8191,
+			primary:  config.ByAddressRoute{Host: "10.0.0.1", Port: 6379},
+			replicas: []config.ByAddressRoute{{Host: "10.0.0.3", Port: 6379}},
+		},
+		{start: 8192, end: 16383, primary: config.ByAddressRoute{Host: "10.0.0.2", Port: 6379}},
+	}
+	for _, address := range []string{"10.0.0.1:6379", "10.0.0.2:6379", "10.0.0.3:6379"} {
+		monitor.counters(address)
+	}
+	return monitor
+}
+
+func TestNodeStatsMonitor_NodeOf(t *testing.T) {
+	monitor := newTestNodeStatsMonitor()
+	names := requestTypesByName()
+	// "b" hashes to slot 3300, and "123456789" to slot 12739.
+	assert.Equal(t, "10.0.0.1:6379", monitor.nodeOf(names["SET"], []string{"b", "value"}, nil))
+	assert.Equal(t, "10.0.0.2:6379", monitor.nodeOf(names["DEL"], []string{"123456789"}, nil))
+	assert.Equal(t, "", monitor.nodeOf(names["DEL"], []string{"b", "123456789"}, nil))
+	assert.Equal(t, "", monitor.nodeOf(names["GET"], []string{"b"}, nil))
+	primary := config.NewSlotKeyRoute(config.SlotTypePrimary, "b")
+	replica := config.NewSlotKeyRoute(config.SlotTypeReplica, "b")
+	assert.Equal(t, "10.0.0.1:6379", monitor.nodeOf(names["GET"], []string{"b"}, primary))
+	assert.Equal(t, "", monitor.nodeOf(names["GET"], []string{"b"}, replica))
+	assert.Equal(t, "10.0.0.2:6379", monitor.nodeOf(names["PING"], nil, config.NewSlotIdRoute(config.SlotTypePrimary, 9000)))
+	assert.Equal(t, "10.0.0.3:6379", monitor.nodeOf(names["PING"], nil, config.NewByAddressRoute("10.0.0.3", 6379)))
+	assert.Equal(t, "", monitor.nodeOf(names["PING"], nil, config.AllPrimaries))
+}
+
+func TestNodeStatsMonitor_Track(t *testing.T) {
+	monitor := newTestNodeStatsMonitor()
+	set := requestTypesByName()["SET"]
+	done := monitor.track(set, []string{"b", "value"}, nil)
+	require.NotNil(t, done)
+	assert.Nil(t, monitor.track(set, []string{"b", "value"}, config.NewByAddressRoute("10.0.0.9", 6379)))
+
+	stats := monitor.snapshot()
+	require.Len(t, stats, 3)
+	assert.Equal(t, "10.0.0.1:6379", stats[0].Address)
+	assert.Equal(t, int64(1), stats[0].InFlight)
+	assert.Equal(t, int64(1), stats[0].Requests)
+
+	done(NewTimeoutError("timed out"))
+	monitor.track(set, []string{"b", "value"}, nil)(errors.New("WRONGTYPE"))
+	monitor.track(set, []string{"b", "value"}, nil)(context.Canceled)
+	stats = monitor.snapshot()
+	assert.Equal(t, int64(0), stats[0].InFlight)
+	assert.Equal(t, int64(3), stats[0].Requests)
+	assert.Equal(t, int64(1), stats[0].Failures)
+	assert.EqualError(t, stats[0].LastError, "timed out")
+}
+
+func TestNodeCounters_RecordProbe(t *testing.T) {
+	counters := &nodeCounters{}
+	counters.recordProbe(7, 4*time.Millisecond, time.Now())
+	assert.Equal(t, 4*time.Millisecond, counters.stats.RTT)
+	assert.Zero(t, counters.stats.Reconnects)
+
+	counters.recordProbe(7, 8*time.Millisecond, time.Now())
+	assert.Equal(t, 5*time.Millisecond, counters.stats.RTT)
+	assert.Zero(t, counters.stats.Reconnects)
+
+	counters.recordProbe(9, 5*time.Millisecond, time.Now())
+	assert.Equal(t, int64(1), counters.stats.Reconnects)
+}
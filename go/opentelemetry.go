@@ -31,11 +31,10 @@ package glide
 import "C"
 
 import (
-	"crypto/rand"
 	"fmt"
-	"log"
-	"math/big"
+	"math/rand/v2"
 	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -80,12 +79,17 @@ type OpenTelemetryConfig struct {
 // SamplePercentage: The percentage of requests to sample and create a span for, used to measure command duration.
 //   - Must be between 0 and 100. If not specified, defaults to 1.
 //
+// MinDuration: The minimum duration of the exported spans, e.g. to trace only the slow commands of hot paths.
+//   - Sampled spans which are shorter are dropped instead of being exported. If not specified, all the sampled spans
+//     are exported. Must not be negative, and is rounded down to microseconds.
+//
 // Note: There is a tradeoff between sampling percentage and performance. Higher sampling percentages will provide more
 // detailed telemetry data but will impact performance. It is recommended to keep this number low (1-5%) in production
 // environments unless you have specific needs for higher sampling rates.
 type OpenTelemetryTracesConfig struct {
 	Endpoint         string
 	SamplePercentage int32
+	MinDuration      time.Duration
 }
 
 // OpenTelemetryMetricsConfig represents the configuration for exporting OpenTelemetry metrics.
@@ -169,21 +173,25 @@ func (o *OpenTelemetry) Init(openTelemetryConfig OpenTelemetryConfig) error {
 	var p pinner
 	defer p.Unpin()
 
+	if openTelemetryConfig.Traces != nil && openTelemetryConfig.Traces.MinDuration < 0 {
+		return fmt.Errorf("the minimum duration of trace spans cannot be negative")
+	}
 	if openTelemetryConfig.Traces != nil {
-		tracesEndpoint := C.CString(openTelemetryConfig.Traces.Endpoint)
-		defer C.free(unsafe.Pointer(tracesEndpoint))
+		tracesEndpoint := cString(openTelemetryConfig.Traces.Endpoint)
+		defer freeCBuffer(unsafe.Pointer(tracesEndpoint))
 		tracesConfig = &C.OpenTelemetryTracesConfig{
 			endpoint:              tracesEndpoint,
 			has_sample_percentage: true,
 			sample_percentage:     C.uint32_t(openTelemetryConfig.Traces.SamplePercentage),
+			min_duration_us:       C.uint64_t(openTelemetryConfig.Traces.MinDuration.Microseconds()),
 		}
 		p.Pin(unsafe.Pointer(tracesConfig))
 		cConfig.traces = tracesConfig
 	}
 
 	if openTelemetryConfig.Metrics != nil {
-		metricsEndpoint := C.CString(openTelemetryConfig.Metrics.Endpoint)
-		defer C.free(unsafe.Pointer(metricsEndpoint))
+		metricsEndpoint := cString(openTelemetryConfig.Metrics.Endpoint)
+		defer freeCBuffer(unsafe.Pointer(metricsEndpoint))
 		metricsConfig = &C.OpenTelemetryMetricsConfig{
 			endpoint: metricsEndpoint,
 		}
@@ -218,12 +226,8 @@ func (o *OpenTelemetry) shouldSample() bool {
 		return false
 	}
 
-	currentRandom, err := rand.Int(rand.Reader, big.NewInt(100))
-	if err != nil {
-		log.Printf("Not sampling otel span due to failure to generate random number: %v", err)
-		return false
-	}
-	return o.IsInitialized() && percentage > 0 && float32(currentRandom.Int64()) < float32(percentage)
+	// Commands are sampled on hot paths, so the sampling decision uses the cheap, lock-free generator of math/rand.
+	return rand.Int32N(100) < percentage
 }
 
 var configMutex sync.RWMutex
@@ -3,13 +3,20 @@
 package options
 
 import (
+	"errors"
 	"strconv"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
 )
 
+// DefaultScanCount is the `COUNT` used by the server when the option is not set.
+const DefaultScanCount int64 = 10
+
 // This base option struct represents the common set of optional arguments for the SCAN family of commands.
 // Concrete implementations of this class are tied to specific SCAN commands (`SCAN`, `SSCAN`, `HSCAN`).
+//
+// The `TYPE` filter is not part of the base options, since only `SCAN` supports it, see [ScanOptions.SetType] and
+// [ClusterScanOptions.SetType].
 type BaseScanOptions struct {
 	Match string
 	Count int64
@@ -35,6 +42,7 @@ func (scanOptions *BaseScanOptions) SetMatch(m string) *BaseScanOptions {
 `COUNT` is a just a hint for the command for how many elements to fetch from the
 sorted set. `COUNT` could be ignored until the sorted set is large enough for the `SCAN` commands to
 represent the results as compact single-allocation packed encoding.
+If not set, the server uses [DefaultScanCount]. The count must be positive.
 */
 func (scanOptions *BaseScanOptions) SetCount(c int64) *BaseScanOptions {
 	scanOptions.Count = c
@@ -44,6 +52,9 @@ func (scanOptions *BaseScanOptions) SetCount(c int64) *BaseScanOptions {
 func (opts *BaseScanOptions) ToArgs() ([]string, error) {
 	args := []string{}
 	var err error
+	if opts.Count < 0 {
+		return nil, errors.New("the COUNT of a scan must be positive")
+	}
 	if opts.Match != "" {
 		args = append(args, constants.MatchKeyword, opts.Match)
 	}
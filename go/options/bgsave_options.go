@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// Optional arguments to `BgSave` for standalone client.
+//
+// [valkey.io]: https://valkey.io/commands/bgsave/
+type BgSaveOptions struct {
+	// Schedule the save to run once an append only file rewrite in progress completes, instead of failing.
+	Schedule bool
+}
+
+// Optional arguments to `BgSave` for cluster client.
+type ClusterBgSaveOptions struct {
+	*BgSaveOptions
+	*RouteOption
+}
+
+func NewBgSaveOptions() *BgSaveOptions {
+	return &BgSaveOptions{}
+}
+
+// SetSchedule schedules the save to run once an append only file rewrite in progress completes, instead of failing.
+func (opts *BgSaveOptions) SetSchedule() *BgSaveOptions {
+	opts.Schedule = true
+	return opts
+}
+
+func (opts *BgSaveOptions) ToArgs() ([]string, error) {
+	if opts == nil || !opts.Schedule {
+		return []string{}, nil
+	}
+	return []string{"SCHEDULE"}, nil
+}
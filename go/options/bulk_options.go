@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+const (
+	// DefaultBulkChunkSize is the number of keys sent in each chunk of a bulk operation, unless set otherwise.
+	DefaultBulkChunkSize = 1000
+	// DefaultBulkParallelism is the number of chunks of a bulk operation in flight at once, unless set otherwise.
+	DefaultBulkParallelism = 4
+	// DefaultExecAllParallelism is the number of functions run at once by `ExecAll`.
+	DefaultExecAllParallelism = 16
+)
+
+// BulkOptions holds the optional arguments of `BulkLoad` and `BulkGet`, which split a large number of keys into chunks
+// sent concurrently.
+type BulkOptions struct {
+	// ChunkSize is the maximum number of keys of each MSET or MGET command.
+	ChunkSize int
+	// Parallelism is the maximum number of chunks in flight at once.
+	Parallelism int
+	// Progress, if set, is called after each chunk completes with the number of keys processed so far and the total
+	// number of keys. It is never called concurrently.
+	Progress func(done int, total int)
+}
+
+// NewBulkOptions creates a new BulkOptions with the default chunk size and parallelism.
+func NewBulkOptions() *BulkOptions {
+	return &BulkOptions{ChunkSize: DefaultBulkChunkSize, Parallelism: DefaultBulkParallelism}
+}
+
+// SetChunkSize sets the maximum number of keys of each chunk.
+func (opts *BulkOptions) SetChunkSize(chunkSize int) *BulkOptions {
+	opts.ChunkSize = chunkSize
+	return opts
+}
+
+// SetParallelism sets the maximum number of chunks in flight at once.
+func (opts *BulkOptions) SetParallelism(parallelism int) *BulkOptions {
+	opts.Parallelism = parallelism
+	return opts
+}
+
+// SetProgress sets the function called after each chunk completes.
+func (opts *BulkOptions) SetProgress(progress func(done int, total int)) *BulkOptions {
+	opts.Progress = progress
+	return opts
+}
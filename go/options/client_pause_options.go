@@ -0,0 +1,15 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// ClientPauseMode defines which client commands are suspended by the CLIENT PAUSE command.
+type ClientPauseMode string
+
+const (
+	// PauseWrite suspends clients only when they attempt to execute a write command.
+	//
+	// Since Valkey 6.2 and above.
+	PauseWrite ClientPauseMode = "WRITE"
+	// PauseAll suspends all client commands.
+	PauseAll ClientPauseMode = "ALL"
+)
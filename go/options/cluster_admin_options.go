@@ -0,0 +1,27 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// ClusterResetMode defines how thoroughly CLUSTER RESET resets a node.
+type ClusterResetMode string
+
+const (
+	// ResetSoft forgets all the other nodes and slot assignments of the node.
+	ResetSoft ClusterResetMode = "SOFT"
+	// ResetHard additionally generates a new node ID and resets the epochs of the node.
+	ResetHard ClusterResetMode = "HARD"
+)
+
+// SlotState defines the state assigned to a hash slot by CLUSTER SETSLOT.
+type SlotState string
+
+const (
+	// SlotImporting marks the slot as being imported from the given node.
+	SlotImporting SlotState = "IMPORTING"
+	// SlotMigrating marks the slot as being migrated to the given node.
+	SlotMigrating SlotState = "MIGRATING"
+	// SlotNode assigns the slot to the given node.
+	SlotNode SlotState = "NODE"
+	// SlotStable clears the importing or migrating state of the slot. No node ID is needed.
+	SlotStable SlotState = "STABLE"
+)
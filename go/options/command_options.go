@@ -21,7 +21,8 @@ type SetOptions struct {
 	// If ConditionalSet is not set the value will be set regardless of prior value existence. If value isn't set because of
 	// the condition, [api.StringCommands.SetWithOptions] will return a zero-value string ("").
 	ConditionalSet constants.ConditionalSet
-	// Value to compare when [SetOptions.ConditionalSet] is set to `OnlyIfEquals`.
+	// Value to compare when [SetOptions.ConditionalSet] is set to [constants.OnlyIfEquals]. Setting it with any other
+	// condition leads to an error.
 	ComparisonValue string
 	// Set command to return the old value stored at the given key, or a zero-value string ("") if the key did not exist. An
 	// error is returned and [api.StringCommands.SetWithOptions] is aborted if the value stored at key is not a string.
@@ -47,7 +48,7 @@ func (setOptions *SetOptions) SetConditionalSet(conditionalSet constants.Conditi
 	return setOptions
 }
 
-// Sets the condition to [SetOptions.OnlyIfExists] for setting the value. The key
+// Sets the condition to [constants.OnlyIfExists] for setting the value. The key
 // will be set if it already exists.
 //
 // This method overrides any previously set [SetOptions.ConditionalSet] and [SetOptions.ComparisonValue].
@@ -57,7 +58,7 @@ func (setOptions *SetOptions) SetOnlyIfExists() *SetOptions {
 	return setOptions
 }
 
-// Sets the condition to [SetOptions.OnlyIfDoesNotExist] for setting the value. The key
+// Sets the condition to [constants.OnlyIfDoesNotExist] for setting the value. The key
 // will not be set if it already exists.
 //
 // This method overrides any previously set [SetOptions.ConditionalSet] and [SetOptions.ComparisonValue].
@@ -67,7 +68,7 @@ func (setOptions *SetOptions) SetOnlyIfDoesNotExist() *SetOptions {
 	return setOptions
 }
 
-// Sets the condition to [SetOptions.OnlyIfEquals] for setting the value. The key
+// Sets the condition to [constants.OnlyIfEquals] for setting the value. The key
 // will be set if the provided comparison value matches the existing value.
 //
 // This method overrides any previously set [SetOptions.ConditionalSet] and [SetOptions.ComparisonValue].
@@ -92,11 +93,17 @@ func (setOptions *SetOptions) SetExpiry(expiry *Expiry) *SetOptions {
 func (opts *SetOptions) ToArgs() ([]string, error) {
 	args := []string{}
 	var err error
-	if opts.ConditionalSet != "" {
+	switch opts.ConditionalSet {
+	case "":
+	case constants.OnlyIfExists, constants.OnlyIfDoesNotExist:
 		args = append(args, string(opts.ConditionalSet))
-		if opts.ConditionalSet == constants.OnlyIfEquals {
-			args = append(args, opts.ComparisonValue)
-		}
+	case constants.OnlyIfEquals:
+		args = append(args, string(opts.ConditionalSet), opts.ComparisonValue)
+	default:
+		return nil, errors.New("invalid conditional set: " + string(opts.ConditionalSet))
+	}
+	if opts.ComparisonValue != "" && opts.ConditionalSet != constants.OnlyIfEquals {
+		return nil, errors.New("comparison value can only be used with the OnlyIfEquals condition")
 	}
 
 	if opts.ReturnOldValue {
@@ -126,6 +133,8 @@ type GetExOptions struct {
 	// If not set, no expiry time will be set for the value.
 	// Supported ExpiryTypes ("EX", "PX", "EXAT", "PXAT", "PERSIST")
 	Expiry *Expiry
+	// multipleExpiries is set when more than one expiry option was supplied through the builder methods.
+	multipleExpiries bool
 }
 
 func NewGetExOptions() *GetExOptions {
@@ -133,17 +142,44 @@ func NewGetExOptions() *GetExOptions {
 }
 
 func (getExOptions *GetExOptions) SetExpiry(expiry *Expiry) *GetExOptions {
+	if getExOptions.Expiry != nil {
+		getExOptions.multipleExpiries = true
+	}
 	getExOptions.Expiry = expiry
 	return getExOptions
 }
 
+// Persist removes the time to live associated with the key, equivalent to the GETEX PERSIST option.
+func (getExOptions *GetExOptions) Persist() *GetExOptions {
+	return getExOptions.SetExpiry(NewExpiryPersist())
+}
+
+// ExpireAtTime sets the absolute time at which the key will expire. The EXAT option is used if the timestamp has no
+// sub-second component, and the PXAT option otherwise.
+func (getExOptions *GetExOptions) ExpireAtTime(timestamp time.Time) *GetExOptions {
+	expiryType := constants.UnixMilliseconds
+	if timestamp.Nanosecond() == 0 {
+		expiryType = constants.UnixSeconds
+	}
+	return getExOptions.SetExpiry(&Expiry{Type: expiryType, Timestamp: timestamp})
+}
+
 func (opts *GetExOptions) ToArgs() ([]string, error) {
 	args := []string{}
 	var err error
 
+	if opts.multipleExpiries {
+		return args, errors.New("only one expiry option can be set for GETEX")
+	}
+
 	if opts.Expiry != nil {
 		switch opts.Expiry.Type {
-		case constants.Seconds, constants.Milliseconds, constants.UnixSeconds, constants.UnixMilliseconds:
+		case constants.Seconds, constants.Milliseconds:
+			args = append(args, string(opts.Expiry.Type), strconv.FormatUint(opts.Expiry.GetTime(), 10))
+		case constants.UnixSeconds, constants.UnixMilliseconds:
+			if opts.Expiry.Timestamp.IsZero() {
+				return args, errors.New("expiry timestamp must be set for EXAT and PXAT")
+			}
 			args = append(args, string(opts.Expiry.Type), strconv.FormatUint(opts.Expiry.GetTime(), 10))
 		case constants.Persist:
 			args = append(args, string(opts.Expiry.Type))
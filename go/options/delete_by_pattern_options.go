@@ -0,0 +1,85 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "errors"
+
+// DefaultDeleteBatchSize is the number of keys scanned and unlinked at once by `DeleteByPattern`, unless set otherwise.
+const DefaultDeleteBatchSize = 500
+
+// DeleteByPatternOptions holds the optional arguments of `DeleteByPattern`, which scans the keys matching a pattern and
+// unlinks them in batches.
+type DeleteByPatternOptions struct {
+	// BatchSize is the COUNT of each SCAN iteration, and the maximum number of keys of each UNLINK command.
+	BatchSize int64
+	// MaxKeysPerSecond, if positive, limits the rate at which keys are unlinked, to spare the server.
+	MaxKeysPerSecond int64
+	// MaxKeys, if positive, stops the deletion once this many keys were deleted, as a guard against a pattern matching
+	// more keys than expected.
+	MaxKeys int64
+	// DryRun counts the matching keys without deleting them.
+	DryRun bool
+	// AllowMatchAll allows the pattern "*", which matches every key of the database.
+	AllowMatchAll bool
+	// Progress, if set, is called after each batch with the number of keys scanned so far and the number of keys
+	// deleted so far, or matched in dry-run mode.
+	Progress func(scanned int64, deleted int64)
+}
+
+// NewDeleteByPatternOptions creates a new DeleteByPatternOptions with the default batch size and no rate limit.
+func NewDeleteByPatternOptions() *DeleteByPatternOptions {
+	return &DeleteByPatternOptions{BatchSize: DefaultDeleteBatchSize}
+}
+
+// SetBatchSize sets the number of keys scanned and unlinked at once.
+func (opts *DeleteByPatternOptions) SetBatchSize(batchSize int64) *DeleteByPatternOptions {
+	opts.BatchSize = batchSize
+	return opts
+}
+
+// SetMaxKeysPerSecond sets the maximum rate at which keys are unlinked.
+func (opts *DeleteByPatternOptions) SetMaxKeysPerSecond(maxKeysPerSecond int64) *DeleteByPatternOptions {
+	opts.MaxKeysPerSecond = maxKeysPerSecond
+	return opts
+}
+
+// SetMaxKeys sets the number of deleted keys after which the deletion stops.
+func (opts *DeleteByPatternOptions) SetMaxKeys(maxKeys int64) *DeleteByPatternOptions {
+	opts.MaxKeys = maxKeys
+	return opts
+}
+
+// SetDryRun sets whether the matching keys are only counted.
+func (opts *DeleteByPatternOptions) SetDryRun(dryRun bool) *DeleteByPatternOptions {
+	opts.DryRun = dryRun
+	return opts
+}
+
+// SetAllowMatchAll sets whether the pattern "*" is allowed.
+func (opts *DeleteByPatternOptions) SetAllowMatchAll(allowMatchAll bool) *DeleteByPatternOptions {
+	opts.AllowMatchAll = allowMatchAll
+	return opts
+}
+
+// SetProgress sets the function called after each batch.
+func (opts *DeleteByPatternOptions) SetProgress(progress func(scanned int64, deleted int64)) *DeleteByPatternOptions {
+	opts.Progress = progress
+	return opts
+}
+
+// Validate checks the options against the pattern they are used with.
+func (opts *DeleteByPatternOptions) Validate(pattern string) error {
+	if pattern == "" {
+		return errors.New("the pattern of the keys to delete cannot be empty")
+	}
+	if pattern == "*" && !opts.AllowMatchAll {
+		return errors.New(`the pattern "*" deletes every key, it must be explicitly allowed with SetAllowMatchAll`)
+	}
+	if opts.BatchSize <= 0 {
+		return errors.New("the batch size must be positive")
+	}
+	if opts.MaxKeysPerSecond < 0 || opts.MaxKeys < 0 {
+		return errors.New("the rate and maximum number of keys cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// Optional arguments to `Failover` for standalone client.
+//
+// [valkey.io]: https://valkey.io/commands/failover/
+type FailoverOptions struct {
+	// The host of the replica to fail over to. If not set, the server picks one of its replicas.
+	Host string
+	// The port of the replica to fail over to.
+	Port int64
+	// Fail over to the target replica even if it does not catch up with the primary before the timeout.
+	Force bool
+	// Abort an ongoing failover.
+	Abort bool
+	// How long the primary waits for the target replica to catch up before the failover is aborted.
+	Timeout time.Duration
+}
+
+func NewFailoverOptions() *FailoverOptions {
+	return &FailoverOptions{}
+}
+
+// SetTo sets the replica to fail over to.
+func (opts *FailoverOptions) SetTo(host string, port int64) *FailoverOptions {
+	opts.Host = host
+	opts.Port = port
+	return opts
+}
+
+// SetForce forces the failover to the target replica once the timeout expires. Requires both a target replica and
+// a timeout.
+func (opts *FailoverOptions) SetForce() *FailoverOptions {
+	opts.Force = true
+	return opts
+}
+
+// SetAbort aborts an ongoing failover. Cannot be combined with any other option.
+func (opts *FailoverOptions) SetAbort() *FailoverOptions {
+	opts.Abort = true
+	return opts
+}
+
+// SetTimeout sets how long the primary waits for the target replica to catch up. The timeout is rounded down to
+// milliseconds.
+func (opts *FailoverOptions) SetTimeout(timeout time.Duration) *FailoverOptions {
+	opts.Timeout = timeout
+	return opts
+}
+
+func (opts *FailoverOptions) ToArgs() ([]string, error) {
+	if opts == nil {
+		return []string{}, nil
+	}
+	if opts.Abort {
+		if opts.Host != "" || opts.Force || opts.Timeout != 0 {
+			return nil, errors.New("ABORT cannot be combined with other FAILOVER options")
+		}
+		return []string{constants.AbortKeyword}, nil
+	}
+	if opts.Timeout < 0 {
+		return nil, errors.New("FAILOVER timeout cannot be negative")
+	}
+	if opts.Force && (opts.Host == "" || opts.Timeout == 0) {
+		return nil, errors.New("FORCE requires both a target replica and a timeout")
+	}
+
+	args := []string{}
+	if opts.Host != "" {
+		args = append(args, constants.ToKeyword, opts.Host, utils.IntToString(opts.Port))
+		if opts.Force {
+			args = append(args, constants.ForceKeyword)
+		}
+	}
+	if opts.Timeout != 0 {
+		args = append(args, constants.TimeoutKeyword, utils.IntToString(opts.Timeout.Milliseconds()))
+	}
+	return args, nil
+}
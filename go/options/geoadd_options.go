@@ -26,6 +26,41 @@ func MapGeoDataToArray(memberGeoMap map[string]GeospatialData) []string {
 	return result
 }
 
+// GeoMember is a named geographic position, as added by `GeoAddMembers`. Unlike a map of member names to
+// [GeospatialData], a slice of members keeps the order they are added in.
+type GeoMember struct {
+	Name string
+	Lon  float64
+	Lat  float64
+}
+
+// NewGeoMemberFromPoint returns a [GeoMember] at a point given as `[longitude, latitude]`, which is the layout of
+// GeoJSON positions and of the point types of geometry libraries, e.g. `orb.Point` which can be passed as is.
+func NewGeoMemberFromPoint(name string, point [2]float64) GeoMember {
+	return GeoMember{Name: name, Lon: point[0], Lat: point[1]}
+}
+
+// NewGeoMemberFromGeospatialData returns a [GeoMember] at the position given by a [GeospatialData].
+func NewGeoMemberFromGeospatialData(name string, data GeospatialData) GeoMember {
+	return GeoMember{Name: name, Lon: data.Longitude, Lat: data.Latitude}
+}
+
+// Point returns the position of the member as `[longitude, latitude]`, which can be converted to the point types of
+// geometry libraries, e.g. `orb.Point(member.Point())`.
+func (member GeoMember) Point() [2]float64 {
+	return [2]float64{member.Lon, member.Lat}
+}
+
+// Helper function to convert geospatial members to a slice of strings, in the order of the members
+// The format is: longitude, latitude, member,...
+func GeoMembersToArray(members []GeoMember) []string {
+	result := make([]string, 0, len(members)*3)
+	for _, member := range members {
+		result = append(result, utils.FloatToString(member.Lon), utils.FloatToString(member.Lat), member.Name)
+	}
+	return result
+}
+
 // Optional arguments to `GeoAdd` in [GeoSpatialCommands]
 type GeoAddOptions struct {
 	ConditionalChange constants.ConditionalSet
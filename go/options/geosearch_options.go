@@ -4,6 +4,7 @@ package options
 
 import (
 	"errors"
+	"math"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
 
@@ -16,6 +17,71 @@ type Location struct {
 	Coord GeospatialData
 	Dist  float64
 	Hash  int64
+	// The unit of Dist, which is the unit of the shape searched by.
+	Unit constants.GeoUnit
+}
+
+// metersPerUnit holds the number of meters in each [constants.GeoUnit], as used by the server.
+var metersPerUnit = map[constants.GeoUnit]float64{
+	constants.GeoUnitMeters:     1,
+	constants.GeoUnitKilometers: 1000,
+	constants.GeoUnitMiles:      1609.34,
+	constants.GeoUnitFeet:       0.3048,
+}
+
+// ConvertGeoDistance converts a distance from one [constants.GeoUnit] to another, with the conversion factors used by the
+// server. An empty unit is treated as meters, which is the default unit of the server.
+func ConvertGeoDistance(distance float64, from constants.GeoUnit, to constants.GeoUnit) (float64, error) {
+	fromMeters, ok := metersPerUnit[from]
+	if from == "" {
+		fromMeters, ok = 1, true
+	}
+	if !ok {
+		return 0, errors.New("unknown geo unit: " + string(from))
+	}
+	toMeters, ok := metersPerUnit[to]
+	if to == "" {
+		toMeters, ok = 1, true
+	}
+	if !ok {
+		return 0, errors.New("unknown geo unit: " + string(to))
+	}
+	return distance * fromMeters / toMeters, nil
+}
+
+// distanceIn returns the distance of the location in the given unit, or NaN if its unit is unknown.
+func (l Location) distanceIn(unit constants.GeoUnit) float64 {
+	distance, err := ConvertGeoDistance(l.Dist, l.Unit, unit)
+	if err != nil {
+		return math.NaN()
+	}
+	return distance
+}
+
+// Meters returns the distance of the location from the search origin in meters.
+func (l Location) Meters() float64 {
+	return l.distanceIn(constants.GeoUnitMeters)
+}
+
+// Kilometers returns the distance of the location from the search origin in kilometers.
+func (l Location) Kilometers() float64 {
+	return l.distanceIn(constants.GeoUnitKilometers)
+}
+
+// Miles returns the distance of the location from the search origin in miles.
+func (l Location) Miles() float64 {
+	return l.distanceIn(constants.GeoUnitMiles)
+}
+
+// Feet returns the distance of the location from the search origin in feet.
+func (l Location) Feet() float64 {
+	return l.distanceIn(constants.GeoUnitFeet)
+}
+
+// Score returns the score of the location in the sorted set, which is its 52-bit geohash. It is only set if the hash
+// was requested with [GeoSearchInfoOptions.SetWithHash], since the server has no option returning the score itself.
+func (l Location) Score() float64 {
+	return float64(l.Hash)
 }
 
 // The interface representing origin of the search for the `GeoSearch` command
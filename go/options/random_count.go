@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// RandomCount selects how many random elements the HRANDFIELD, ZRANDMEMBER and SRANDMEMBER commands return, and
+// whether an element may be returned more than once. It replaces the convention of these commands, where a negative
+// count allows repeated elements. Create it with [Unique] or [AllowRepeats].
+type RandomCount struct {
+	count   int64
+	repeats bool
+}
+
+// Unique returns up to n distinct elements. Fewer than n elements are returned if the collection is smaller.
+func Unique(n int64) RandomCount {
+	return RandomCount{count: n}
+}
+
+// AllowRepeats returns exactly n elements, which may repeat, unless the collection is empty.
+func AllowRepeats(n int64) RandomCount {
+	return RandomCount{count: n, repeats: true}
+}
+
+// SignedCount returns the count argument of the command, which is negative if repeats are allowed.
+func (c RandomCount) SignedCount() (int64, error) {
+	if c.count < 0 {
+		return 0, errors.New("the count of random elements cannot be negative")
+	}
+	if c.repeats {
+		return -c.count, nil
+	}
+	return c.count, nil
+}
+
+func (c RandomCount) ToArgs() ([]string, error) {
+	count, err := c.SignedCount()
+	if err != nil {
+		return nil, err
+	}
+	return []string{utils.IntToString(count)}, nil
+}
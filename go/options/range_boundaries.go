@@ -0,0 +1,95 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// Inclusivity defines whether the value of a range boundary belongs to the range.
+type Inclusivity bool
+
+const (
+	// Inclusive boundaries belong to the range.
+	Inclusive Inclusivity = true
+	// Exclusive boundaries don't belong to the range.
+	Exclusive Inclusivity = false
+)
+
+// ScoreBoundary returns a boundary of a range of scores, e.g. ScoreBoundary(3.5, Inclusive). The infinite boundaries are
+// returned by [NegInf] and [PosInf]. A NaN score makes the range queries using the boundary fail.
+func ScoreBoundary(score float64, inclusivity Inclusivity) ScoreBound {
+	switch {
+	case math.IsInf(score, -1):
+		return NegInf()
+	case math.IsInf(score, 1):
+		return PosInf()
+	case inclusivity == Exclusive:
+		return ScoreBound("(" + utils.FloatToString(score))
+	default:
+		return ScoreBound(utils.FloatToString(score))
+	}
+}
+
+// NegInf returns the lowest score boundary, below every score.
+func NegInf() ScoreBound {
+	return NewInfiniteScoreBoundary(constants.NegativeInfinity)
+}
+
+// PosInf returns the highest score boundary, above every score.
+func PosInf() ScoreBound {
+	return NewInfiniteScoreBoundary(constants.PositiveInfinity)
+}
+
+// LexBoundary returns a boundary of a lexicographical range of members, e.g. LexBoundary("a", Exclusive). The infinite
+// boundaries are returned by [LexNegInf] and [LexPosInf].
+func LexBoundary(member string, inclusivity Inclusivity) LexBound {
+	return NewLexBoundary(member, bool(inclusivity))
+}
+
+// LexNegInf returns the lowest lexicographical boundary, before every member.
+func LexNegInf() LexBound {
+	return NewInfiniteLexBoundary(constants.NegativeInfinity)
+}
+
+// LexPosInf returns the highest lexicographical boundary, after every member.
+func LexPosInf() LexBound {
+	return NewInfiniteLexBoundary(constants.PositiveInfinity)
+}
+
+// validate checks that the boundary is a score, optionally prefixed by "(" if it is exclusive, or an infinity.
+func (bound ScoreBound) validate() error {
+	score, err := strconv.ParseFloat(strings.TrimPrefix(string(bound), "("), 64)
+	if err != nil || math.IsNaN(score) {
+		return errors.New("invalid score boundary: " + strconv.Quote(string(bound)))
+	}
+	return nil
+}
+
+// validate checks that the boundary is a member prefixed by "[" or "(", or an infinity.
+func (bound LexBound) validate() error {
+	if bound == "-" || bound == "+" || strings.HasPrefix(string(bound), "[") || strings.HasPrefix(string(bound), "(") {
+		return nil
+	}
+	return errors.New("invalid lex boundary: " + strconv.Quote(string(bound)))
+}
+
+func validateScoreRange(start ScoreBound, end ScoreBound) error {
+	if err := start.validate(); err != nil {
+		return err
+	}
+	return end.validate()
+}
+
+func validateLexRange(start LexBound, end LexBound) error {
+	if err := start.validate(); err != nil {
+		return err
+	}
+	return end.validate()
+}
@@ -0,0 +1,91 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import "errors"
+
+// ShutdownSaveMode defines whether the server saves the dataset before shutting down.
+type ShutdownSaveMode string
+
+const (
+	// ShutdownSave saves the dataset before shutting down, even if no save points are configured.
+	ShutdownSave ShutdownSaveMode = "SAVE"
+	// ShutdownNoSave shuts down without saving the dataset, even if save points are configured.
+	ShutdownNoSave ShutdownSaveMode = "NOSAVE"
+)
+
+// Optional arguments to `Shutdown` for standalone client.
+//
+// [valkey.io]: https://valkey.io/commands/shutdown/
+type ShutdownOptions struct {
+	// Whether to save the dataset before shutting down. If not set, the server saves only if save points are
+	// configured.
+	SaveMode ShutdownSaveMode
+	// Skip waiting for lagging replicas.
+	Now bool
+	// Ignore errors that would normally prevent the server from exiting, such as failing to save.
+	Force bool
+	// Cancel an ongoing shutdown. Cannot be combined with any other option.
+	Abort bool
+}
+
+// Optional arguments to `Shutdown` for cluster client. A route to a single node is required.
+type ClusterShutdownOptions struct {
+	*ShutdownOptions
+	*RouteOption
+}
+
+func NewShutdownOptions() *ShutdownOptions {
+	return &ShutdownOptions{}
+}
+
+// SetSaveMode sets whether the server saves the dataset before shutting down.
+func (opts *ShutdownOptions) SetSaveMode(mode ShutdownSaveMode) *ShutdownOptions {
+	opts.SaveMode = mode
+	return opts
+}
+
+// SetNow skips waiting for lagging replicas.
+func (opts *ShutdownOptions) SetNow() *ShutdownOptions {
+	opts.Now = true
+	return opts
+}
+
+// SetForce ignores errors that would normally prevent the server from exiting.
+func (opts *ShutdownOptions) SetForce() *ShutdownOptions {
+	opts.Force = true
+	return opts
+}
+
+// SetAbort cancels an ongoing shutdown.
+func (opts *ShutdownOptions) SetAbort() *ShutdownOptions {
+	opts.Abort = true
+	return opts
+}
+
+func (opts *ShutdownOptions) ToArgs() ([]string, error) {
+	if opts == nil {
+		return []string{}, nil
+	}
+	if opts.Abort {
+		if opts.SaveMode != "" || opts.Now || opts.Force {
+			return nil, errors.New("ABORT cannot be combined with other SHUTDOWN options")
+		}
+		return []string{"ABORT"}, nil
+	}
+	args := []string{}
+	switch opts.SaveMode {
+	case "":
+	case ShutdownSave, ShutdownNoSave:
+		args = append(args, string(opts.SaveMode))
+	default:
+		return nil, errors.New("invalid shutdown save mode: " + string(opts.SaveMode))
+	}
+	if opts.Now {
+		args = append(args, "NOW")
+	}
+	if opts.Force {
+		args = append(args, "FORCE")
+	}
+	return args, nil
+}
@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+// TenantOptions holds the optional arguments of `TenantClientWithOptions`, which scopes a client to the keys of a
+// tenant.
+type TenantOptions struct {
+	// KeyPrefix is prepended to the keys of every command of the tenant. If empty, the tenant ID followed by a colon is
+	// used.
+	KeyPrefix string
+	// RateLimit is the maximum sustained number of commands per second of the tenant, or 0 if it is not limited.
+	RateLimit float64
+	// Burst is the number of commands the tenant may send at once above the rate limit.
+	Burst int
+}
+
+// NewTenantOptions creates a new TenantOptions with the default key prefix and no rate limit.
+func NewTenantOptions() *TenantOptions {
+	return &TenantOptions{}
+}
+
+// SetKeyPrefix sets the prefix prepended to the keys of the tenant.
+func (opts *TenantOptions) SetKeyPrefix(prefix string) *TenantOptions {
+	opts.KeyPrefix = prefix
+	return opts
+}
+
+// SetRateLimit limits the tenant to commandsPerSecond commands per second on average, allowing bursts of up to burst
+// commands. The commands of a batch count individually.
+func (opts *TenantOptions) SetRateLimit(commandsPerSecond float64, burst int) *TenantOptions {
+	opts.RateLimit = commandsPerSecond
+	opts.Burst = burst
+	return opts
+}
@@ -0,0 +1,31 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// TTLWithJitter returns base shifted by a random amount of up to jitterFraction of base in either direction, e.g.
+// between 54 and 66 minutes for a base of one hour and a fraction of 0.1. Spreading the expiries of keys written
+// together, such as cache entries filled at startup, prevents them from expiring at once and sending a burst of misses
+// to the backing store. The result is truncated to milliseconds, the resolution of the server, and is never shorter
+// than one millisecond.
+//
+// Pass it wherever a time to live is expected, e.g. to Expire, or to SET with [NewExpiryInWithJitter].
+//
+// Parameters:
+//
+//	base - The time to live before the jitter is applied.
+//	jitterFraction - The maximum shift as a fraction of base, clamped between 0 and 1.
+func TTLWithJitter(base time.Duration, jitterFraction float64) time.Duration {
+	jitterFraction = min(max(jitterFraction, 0), 1)
+	shift := time.Duration((rand.Float64()*2 - 1) * jitterFraction * float64(base))
+	return max((base + shift).Truncate(time.Millisecond), time.Millisecond)
+}
+
+// NewExpiryInWithJitter creates a new Expiry with a duration from now, shifted by a random jitter, see [TTLWithJitter].
+func NewExpiryInWithJitter(base time.Duration, jitterFraction float64) *Expiry {
+	return NewExpiryIn(TTLWithJitter(base, jitterFraction))
+}
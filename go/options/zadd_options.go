@@ -4,6 +4,7 @@ package options
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/valkey-io/valkey-glide/go/v2/constants"
 
@@ -45,11 +46,14 @@ func (options *ZAddOptions) SetChanged(ch bool) (*ZAddOptions, error) {
 	return options, nil
 }
 
-// `INCR` sets the increment value to use when incr is true.
+// `INCR` sets the increment value to use when incr is true. The increment cannot be NaN.
 func (options *ZAddOptions) SetIncr(incr bool, increment float64, member string) (*ZAddOptions, error) {
 	if options.Changed {
 		return nil, errors.New("incr cannot be set when changed is true")
 	}
+	if err := utils.ValidateFloat(increment, true); err != nil {
+		return nil, fmt.Errorf("invalid increment: %w", err)
+	}
 	options.Incr = incr
 	options.Increment = increment
 	options.Member = member
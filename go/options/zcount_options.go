@@ -9,15 +9,18 @@ type ScoreRange interface {
 
 // This struct represents the min and max boundary for the Zcount command.
 type ZCountRange struct {
-	Min scoreBoundary
-	Max scoreBoundary
+	Min ScoreBound
+	Max ScoreBound
 }
 
 // Create a new Zcount range.
-func NewZCountRange(min scoreBoundary, max scoreBoundary) *ZCountRange {
+func NewZCountRange(min ScoreBound, max ScoreBound) *ZCountRange {
 	return &ZCountRange{min, max}
 }
 
 func (zCountRange *ZCountRange) ToArgs() ([]string, error) {
+	if err := validateScoreRange(zCountRange.Min, zCountRange.Max); err != nil {
+		return nil, err
+	}
 	return []string{string(zCountRange.Min), string(zCountRange.Max)}, nil
 }
@@ -28,52 +28,56 @@ type RangeByIndex struct {
 
 // Queries a range of elements from a sorted set by theirs score.
 type RangeByScore struct {
-	Start, End scoreBoundary
+	Start, End ScoreBound
 	Reverse    bool
 	Limit      *Limit
 }
 
 // Queries a range of elements from a sorted set by theirs lexicographical order.
 type RangeByLex struct {
-	Start, End lexBoundary
+	Start, End LexBound
 	Reverse    bool
 	Limit      *Limit
 }
 
 type (
-	scoreBoundary string
-	lexBoundary   string
+	// ScoreBound is a boundary of a range of scores, built by [ScoreBoundary], [NegInf] or [PosInf]. The range queries
+	// fail if a boundary is not a valid score.
+	ScoreBound string
+	// LexBound is a boundary of a lexicographical range of members, built by [LexBoundary], [LexNegInf] or
+	// [LexPosInf]. The range queries fail if a boundary is neither an infinity nor prefixed by "[" or "(".
+	LexBound string
 )
 
 // Create a new inclusive score boundary.
-func NewInclusiveScoreBoundary(bound float64) scoreBoundary {
-	return scoreBoundary(utils.FloatToString(bound))
+func NewInclusiveScoreBoundary(bound float64) ScoreBound {
+	return ScoreBound(utils.FloatToString(bound))
 }
 
 // Create a new score boundary.
-func NewScoreBoundary(bound float64, isInclusive bool) scoreBoundary {
+func NewScoreBoundary(bound float64, isInclusive bool) ScoreBound {
 	if !isInclusive {
-		return scoreBoundary("(" + utils.FloatToString(bound))
+		return ScoreBound("(" + utils.FloatToString(bound))
 	}
-	return scoreBoundary(utils.FloatToString(bound))
+	return ScoreBound(utils.FloatToString(bound))
 }
 
 // Create a new score boundary defined by an infinity.
-func NewInfiniteScoreBoundary(bound constants.InfBoundary) scoreBoundary {
-	return scoreBoundary(string(bound) + "inf")
+func NewInfiniteScoreBoundary(bound constants.InfBoundary) ScoreBound {
+	return ScoreBound(string(bound) + "inf")
 }
 
 // Create a new lex boundary.
-func NewLexBoundary(bound string, isInclusive bool) lexBoundary {
+func NewLexBoundary(bound string, isInclusive bool) LexBound {
 	if !isInclusive {
-		return lexBoundary("(" + bound)
+		return LexBound("(" + bound)
 	}
-	return lexBoundary("[" + bound)
+	return LexBound("[" + bound)
 }
 
 // Create a new lex boundary defined by an infinity.
-func NewInfiniteLexBoundary(bound constants.InfBoundary) lexBoundary {
-	return lexBoundary(string(bound))
+func NewInfiniteLexBoundary(bound constants.InfBoundary) LexBound {
+	return LexBound(string(bound))
 }
 
 // Limit struct represents the range of elements to retrieve
@@ -123,7 +127,7 @@ func (rbi *RangeByIndex) dummyZRangeQuery() {}
 //
 //	start - The start score of the range.
 //	end   - The end score of the range.
-func NewRangeByScoreQuery(start scoreBoundary, end scoreBoundary) *RangeByScore {
+func NewRangeByScoreQuery(start ScoreBound, end ScoreBound) *RangeByScore {
 	return &RangeByScore{start, end, false, nil}
 }
 
@@ -139,7 +143,15 @@ func (rbs *RangeByScore) SetLimit(offset, count int64) *RangeByScore {
 	return rbs
 }
 
+// Validate checks that the boundaries of the range are valid scores or infinities.
+func (rbs *RangeByScore) Validate() error {
+	return validateScoreRange(rbs.Start, rbs.End)
+}
+
 func (rbs *RangeByScore) ToArgs() ([]string, error) {
+	if err := rbs.Validate(); err != nil {
+		return nil, err
+	}
 	args := make([]string, 0, 7)
 	args = append(args, string(rbs.Start), string(rbs.End), "BYSCORE")
 	if rbs.Reverse {
@@ -156,6 +168,9 @@ func (rbs *RangeByScore) ToArgs() ([]string, error) {
 }
 
 func (rbs *RangeByScore) ToArgsRemRange() ([]string, error) {
+	if err := rbs.Validate(); err != nil {
+		return nil, err
+	}
 	return []string{string(rbs.Start), string(rbs.End)}, nil
 }
 
@@ -167,7 +182,7 @@ func (rbi *RangeByScore) dummyZRangeQuery() {}
 //
 //	start - The start lex of the range.
 //	end   - The end lex of the range.
-func NewRangeByLexQuery(start lexBoundary, end lexBoundary) *RangeByLex {
+func NewRangeByLexQuery(start LexBound, end LexBound) *RangeByLex {
 	return &RangeByLex{start, end, false, nil}
 }
 
@@ -183,7 +198,15 @@ func (rbl *RangeByLex) SetLimit(offset, count int64) *RangeByLex {
 	return rbl
 }
 
+// Validate checks that the boundaries of the range are valid lex boundaries or infinities.
+func (rbl *RangeByLex) Validate() error {
+	return validateLexRange(rbl.Start, rbl.End)
+}
+
 func (rbl *RangeByLex) ToArgs() ([]string, error) {
+	if err := rbl.Validate(); err != nil {
+		return nil, err
+	}
 	args := make([]string, 0, 7)
 	args = append(args, string(rbl.Start), string(rbl.End), "BYLEX")
 	if rbl.Reverse {
@@ -200,6 +223,9 @@ func (rbl *RangeByLex) ToArgs() ([]string, error) {
 }
 
 func (rbl *RangeByLex) ToArgsRemRange() ([]string, error) {
+	if err := rbl.Validate(); err != nil {
+		return nil, err
+	}
 	return []string{string(rbl.Start), string(rbl.End)}, nil
 }
 
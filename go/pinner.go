@@ -6,23 +6,32 @@ package glide
 
 import (
 	"runtime"
+	"sync/atomic"
 	"unsafe"
 )
 
+// pinnedObjects tracks the number of Go objects currently pinned for the native layer, across all clients.
+var pinnedObjects atomic.Int64
+
 // pinner is a wrapper of a runtime.Pinner making the interface
 // compatible to the cgo.Handle in the Go < 1.21.
 // Note that this make a pinner can only hold one unsafe.Pointer.
 type pinner struct {
-	r runtime.Pinner
+	r     runtime.Pinner
+	count int64
 }
 
 func (p *pinner) Pin(v unsafe.Pointer) unsafe.Pointer {
 	p.r.Pin(v)
+	p.count++
+	pinnedObjects.Add(1)
 	return v
 }
 
 func (p *pinner) Unpin() {
 	p.r.Unpin()
+	pinnedObjects.Add(-p.count)
+	p.count = 0
 }
 
 func getPinnedPtr(v unsafe.Pointer) unsafe.Pointer {
@@ -18,3 +18,42 @@ func TestPinner(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestPinnerCountsPinnedObjects(t *testing.T) {
+	before := pinnedObjects.Load()
+	v1 := make(chan payload)
+	v2 := make(chan payload)
+
+	p := pinner{}
+	p.Pin(unsafe.Pointer(&v1))
+	p.Pin(unsafe.Pointer(&v2))
+	if got := pinnedObjects.Load() - before; got != 2 {
+		t.Fatalf("expected 2 pinned objects, got %d", got)
+	}
+
+	p.Unpin()
+	if got := pinnedObjects.Load() - before; got != 0 {
+		t.Fatalf("expected 0 pinned objects after Unpin, got %d", got)
+	}
+}
+
+func TestRegisterPendingEnforcesLimit(t *testing.T) {
+	client := &baseClient{pending: make(map[unsafe.Pointer]struct{}), stats: &clientStats{}, maxPending: 2}
+	c1, c2, c3 := make(chan payload), make(chan payload), make(chan payload)
+
+	if err := client.registerPending(unsafe.Pointer(&c1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.registerPending(unsafe.Pointer(&c2)); err != nil {
+		t.Fatal(err)
+	}
+	err := client.registerPending(unsafe.Pointer(&c3))
+	if _, ok := err.(*PendingLimitError); !ok {
+		t.Fatalf("expected PendingLimitError, got %v", err)
+	}
+
+	delete(client.pending, unsafe.Pointer(&c1))
+	if client.stats.peakPendingCommands != 2 || len(client.pending) != 1 {
+		t.Fatalf("unexpected pending state: peak %d, pending %d", client.stats.peakPendingCommands, len(client.pending))
+	}
+}
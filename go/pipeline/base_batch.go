@@ -3242,6 +3242,82 @@ func (b *BaseBatch[T]) ZRangeStore(destination string, key string, rangeQuery op
 	return b.addCmdAndTypeChecker(C.ZRangeStore, args, reflect.Int64, false)
 }
 
+// Stores the elements of the sorted set at `key` with a score between `min` and `max` into a new sorted set at
+// `destination`, like [BaseBatch.ZRangeStore] with a [options.RangeByScore] query. The boundaries are given in the same
+// order whether the range is reversed or not.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	destination - The key for the destination sorted set.
+//	key - The key of the source sorted set.
+//	min - The minimum score of the range, see [options.NewScoreBoundary].
+//	max - The maximum score of the range, see [options.NewScoreBoundary].
+//	reverse - Whether the elements are ordered from the highest score to the lowest, which matters with a limit.
+//	limit - The offset and count of the elements to store, or nil to store every element of the range.
+//
+// Command Response:
+//
+//	The number of elements in the resulting sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/zrangestore/
+func (b *BaseBatch[T]) ZRangeStoreByScore(
+	destination string,
+	key string,
+	min options.ScoreBound,
+	max options.ScoreBound,
+	reverse bool,
+	limit *options.Limit,
+) *T {
+	query := options.NewRangeByScoreQuery(min, max)
+	if reverse {
+		query = options.NewRangeByScoreQuery(max, min).SetReverse()
+	}
+	if limit != nil {
+		query.SetLimit(limit.Offset, limit.Count)
+	}
+	return b.ZRangeStore(destination, key, query)
+}
+
+// Stores the elements of the sorted set at `key` between `min` and `max` in lexicographical order into a new sorted set
+// at `destination`, like [BaseBatch.ZRangeStore] with a [options.RangeByLex] query. The boundaries are given in the same
+// order whether the range is reversed or not.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	destination - The key for the destination sorted set.
+//	key - The key of the source sorted set.
+//	min - The lexicographical minimum of the range, see [options.NewLexBoundary].
+//	max - The lexicographical maximum of the range, see [options.NewLexBoundary].
+//	reverse - Whether the elements are ordered from the last to the first, which matters with a limit.
+//	limit - The offset and count of the elements to store, or nil to store every element of the range.
+//
+// Command Response:
+//
+//	The number of elements in the resulting sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/zrangestore/
+func (b *BaseBatch[T]) ZRangeStoreByLex(
+	destination string,
+	key string,
+	min options.LexBound,
+	max options.LexBound,
+	reverse bool,
+	limit *options.Limit,
+) *T {
+	query := options.NewRangeByLexQuery(min, max)
+	if reverse {
+		query = options.NewRangeByLexQuery(max, min).SetReverse()
+	}
+	if limit != nil {
+		query.SetLimit(limit.Offset, limit.Count)
+	}
+	return b.ZRangeStore(destination, key, query)
+}
+
 // Removes the existing timeout on key, turning the key from volatile
 // (a key with an expire set) to persistent (a key that will never expire as no timeout is associated).
 //
@@ -5547,6 +5623,9 @@ func (b *BaseBatch[T]) ZInterCardWithOptions(keys []string, options options.ZInt
 //
 // [valkey.io]: https://valkey.io/commands/zlexcount/
 func (b *BaseBatch[T]) ZLexCount(key string, rangeQuery options.RangeByLex) *T {
+	if err := rangeQuery.Validate(); err != nil {
+		return b.addError("ZLexCount", err)
+	}
 	args := []string{key}
 	args = append(args, rangeQuery.ToArgsLexCount()...)
 	return b.addCmdAndTypeChecker(C.ZLexCount, args, reflect.Int64, false)
@@ -5636,6 +5715,58 @@ func (b *BaseBatch[T]) GeoAddWithOptions(
 	return b.addCmdAndTypeChecker(C.GeoAdd, args, reflect.Int64, false)
 }
 
+// Adds geospatial members with their positions to the specified sorted set stored at `key`, in the order of `members`.
+// If a member is already a part of the sorted set, its position is updated.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the sorted set.
+//	members - The members and their positions. See [options.GeoMember].
+//	  The command will report an error when index coordinates are out of the specified range.
+//
+// Command Response:
+//
+//	The number of elements added to the sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/geoadd/
+func (b *BaseBatch[T]) GeoAddMembers(key string, members []options.GeoMember) *T {
+	return b.GeoAddMembersWithOptions(key, members, *options.NewGeoAddOptions())
+}
+
+// Adds geospatial members with their positions to the specified sorted set stored at `key`, in the order of `members`.
+// If a member is already a part of the sorted set, its position is updated.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	key - The key of the sorted set.
+//	members - The members and their positions. See [options.GeoMember].
+//	  The command will report an error when index coordinates are out of the specified range.
+//	geoAddOptions - The options for the GeoAdd command, see - [options.GeoAddOptions].
+//
+// Command Response:
+//
+//	The number of elements added to the sorted set.
+//
+// [valkey.io]: https://valkey.io/commands/geoadd/
+func (b *BaseBatch[T]) GeoAddMembersWithOptions(
+	key string,
+	members []options.GeoMember,
+	geoAddOptions options.GeoAddOptions,
+) *T {
+	args := []string{key}
+	optionsArgs, err := geoAddOptions.ToArgs()
+	if err != nil {
+		return b.addError("GeoAddMembersWithOptions", err)
+	}
+	args = append(args, optionsArgs...)
+	args = append(args, options.GeoMembersToArray(members)...)
+	return b.addCmdAndTypeChecker(C.GeoAdd, args, reflect.Int64, false)
+}
+
 // Returns the GeoHash strings representing the positions of all the specified
 // `members` in the sorted set stored at the `key`.
 //
@@ -5793,7 +5924,13 @@ func (b *BaseBatch[T]) GeoSearchWithFullOptions(
 		return b.addError("GeoSearchWithFullOptions", err)
 	}
 	args = append(args, resultOptionsArgs...)
-	return b.addCmdAndConverter(C.GeoSearch, args, reflect.Slice, false, internal.ConvertLocationArrayResponse)
+	return b.addCmdAndConverter(
+		C.GeoSearch,
+		args,
+		reflect.Slice,
+		false,
+		internal.LocationArrayConverter(searchByShape.Unit),
+	)
 }
 
 // Returns the members of a sorted set populated with geospatial information using [BaseBatch.GeoAdd],
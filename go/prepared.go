@@ -0,0 +1,170 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// requestTypesByName maps command names to the request types they are sent with, see [commandNames].
+var requestTypesByName = sync.OnceValue(func() map[string]C.RequestType {
+	byName := make(map[string]C.RequestType, len(commandNames))
+	for requestType, name := range commandNames {
+		byName[name] = requestType
+	}
+	return byName
+})
+
+// PreparedCommand is a command template registered with [Client.Prepare] or [ClusterClient.Prepare]. Its arguments
+// are resolved once, so that executing it only fills in the parameters.
+type PreparedCommand struct {
+	name        string
+	requestType C.RequestType
+	template    []string
+	// params holds the index of the parameter filling each placeholder, keyed by the position of the placeholder.
+	params    map[int]int
+	numParams int
+	client    *baseClient
+}
+
+// preparedCommands holds the prepared commands of a client. It is shared by pointer between the copies of a client.
+type preparedCommands struct {
+	mu       sync.RWMutex
+	commands map[string]*PreparedCommand
+}
+
+// Prepare registers a command template under the given name, for hot commands of a fixed shape. The template holds the
+// arguments following the command name, where an argument of the form "$1", "$2", etc. is a placeholder replaced by
+// the corresponding parameter when the command is executed. Every parameter from "$1" up to the highest one must be
+// used. Preparing a command under an existing name replaces it. For example, the template
+// `[]string{"events", "*", "type", "$1"}` of the command "XADD" adds an event of the type given when it is executed.
+//
+// Parameters:
+//
+//	name - The name the command is registered under.
+//	command - The name of the command, e.g. "XADD" or "CONFIG GET".
+//	template - The arguments of the command, including placeholders.
+//
+// Return value:
+//
+//	The prepared command, which can also be executed with ExecutePrepared.
+func (client *baseClient) Prepare(name string, command string, template []string) (*PreparedCommand, error) {
+	if name == "" {
+		return nil, errors.New("the name of a prepared command cannot be empty")
+	}
+	command = strings.ToUpper(strings.TrimSpace(command))
+	if command == "" {
+		return nil, errors.New("the command of a prepared command cannot be empty")
+	}
+
+	prepared := &PreparedCommand{name: name, client: client, params: make(map[int]int)}
+	if requestType, ok := requestTypesByName()[command]; ok {
+		prepared.requestType = requestType
+		prepared.template = append([]string(nil), template...)
+	} else {
+		// Commands without a request type of their own are sent as custom commands, prefixed with their name.
+		prepared.requestType = C.CustomCommand
+		prepared.template = append(strings.Fields(command), template...)
+	}
+
+	used := make(map[int]bool)
+	for idx, arg := range prepared.template {
+		if len(arg) < 2 || arg[0] != '$' {
+			continue
+		}
+		param, err := strconv.Atoi(arg[1:])
+		if err != nil || param < 1 {
+			continue
+		}
+		prepared.params[idx] = param - 1
+		used[param] = true
+		prepared.numParams = max(prepared.numParams, param)
+	}
+	for param := 1; param <= prepared.numParams; param++ {
+		if !used[param] {
+			return nil, fmt.Errorf("the template of prepared command %q does not use the placeholder $%d", name, param)
+		}
+	}
+	client.prepared.mu.Lock()
+	defer client.prepared.mu.Unlock()
+	client.prepared.commands[name] = prepared
+	return prepared, nil
+}
+
+// Prepared returns the prepared command registered under the given name.
+//
+// Parameters:
+//
+//	name - The name the command is registered under.
+//
+// Return value:
+//
+//	The prepared command, and whether a command is registered under the name.
+func (client *baseClient) Prepared(name string) (*PreparedCommand, bool) {
+	client.prepared.mu.RLock()
+	defer client.prepared.mu.RUnlock()
+	prepared, ok := client.prepared.commands[name]
+	return prepared, ok
+}
+
+// ExecutePrepared executes the prepared command registered under the given name.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	name - The name the command is registered under.
+//	params - The values of the placeholders of the template, in order.
+//
+// Return value:
+//
+//	The result of the command. It fails with a RequestError if no command is registered under the name.
+func (client *baseClient) ExecutePrepared(ctx context.Context, name string, params ...string) (any, error) {
+	prepared, ok := client.Prepared(name)
+	if !ok {
+		return nil, NewRequestError(fmt.Sprintf("no prepared command is registered under %q", name))
+	}
+	return prepared.Execute(ctx, params...)
+}
+
+// Name returns the name the command is registered under.
+func (prepared *PreparedCommand) Name() string {
+	return prepared.name
+}
+
+// Execute fills the placeholders of the template with the given parameters and executes the command.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	params - The values of the placeholders of the template, in order.
+//
+// Return value:
+//
+//	The result of the command. It fails with a RequestError if the number of parameters does not match the template.
+func (prepared *PreparedCommand) Execute(ctx context.Context, params ...string) (any, error) {
+	if len(params) != prepared.numParams {
+		return nil, NewRequestError(fmt.Sprintf(
+			"prepared command %q expects %d parameter(s), got %d", prepared.name, prepared.numParams, len(params),
+		))
+	}
+	// The arguments are not reused between executions, since a hedged read may still be sending them.
+	args := make([]string, len(prepared.template))
+	copy(args, prepared.template)
+	for idx, param := range prepared.params {
+		args[idx] = params[param]
+	}
+
+	result, err := prepared.client.executeCommand(ctx, prepared.requestType, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleInterfaceResponse(result)
+}
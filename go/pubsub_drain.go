@@ -0,0 +1,55 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"fmt"
+	"time"
+)
+
+// pubSubDrainInterval is the interval at which CloseWithDrain checks whether the messages were delivered.
+const pubSubDrainInterval = 10 * time.Millisecond
+
+// undeliveredMessages returns the number of pub/sub messages received by the client and not yet delivered: the
+// messages being handed to a message handler by any client sharing the connection, and the messages waiting in the
+// queue of the client and, unless the client is derived, of its derived clients, see [Client.WithSubscriptions].
+func (client *baseClient) undeliveredMessages() int64 {
+	var undelivered int64
+	if client.subscribers != nil {
+		undelivered += client.subscribers.inFlight.Load()
+		if !client.derived {
+			undelivered += client.subscribers.queued()
+		}
+	}
+	if handler := client.getMessageHandler(); handler != nil {
+		undelivered += handler.queuedMessages()
+	}
+	return undelivered
+}
+
+// CloseWithDrain closes the client like [baseClient.Close], after waiting up to timeout for the pub/sub messages
+// already received to be delivered: the callbacks of the subscriptions have been called with them, or the messages
+// have been read from the queue, see [baseClient.GetQueue]. This prevents the messages received right before shutdown
+// from being silently dropped. Messages keep being received while draining, so a steady flow of messages may keep the
+// client from draining before the timeout.
+//
+// Parameters:
+//
+//	timeout - The maximum time to wait for the messages to be delivered.
+//
+// Return value:
+//
+//	An error if messages were still undelivered when the timeout elapsed. The client is closed in any case.
+func (client *baseClient) CloseWithDrain(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	undelivered := client.undeliveredMessages()
+	for undelivered > 0 && time.Now().Before(deadline) {
+		time.Sleep(min(pubSubDrainInterval, time.Until(deadline)))
+		undelivered = client.undeliveredMessages()
+	}
+	client.Close()
+	if undelivered > 0 {
+		return fmt.Errorf("%d pub/sub messages were not delivered before the client was closed", undelivered)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestUndeliveredMessages(t *testing.T) {
+	root := &baseClient{messageHandler: NewMessageHandler(nil, nil), subscribers: newSubscriberSet(nil)}
+	derived := &baseClient{messageHandler: NewMessageHandler(nil, nil), subscribers: root.subscribers, derived: true}
+	root.subscribers.add(derived, map[uint32][]string{0: {"orders"}})
+	assert.Equal(t, int64(0), root.undeliveredMessages())
+
+	root.dispatchPubSubMessage(models.NewPubSubMessage("o1", "orders"))
+	root.dispatchPubSubMessage(models.NewPubSubMessage("p1", "payments"))
+	root.subscribers.inFlight.Add(1)
+	assert.Equal(t, int64(3), root.undeliveredMessages())
+	assert.Equal(t, int64(2), derived.undeliveredMessages())
+
+	root.subscribers.inFlight.Add(-1)
+	derived.messageHandler.GetQueue().Pop()
+	assert.Equal(t, int64(1), root.undeliveredMessages())
+	assert.Equal(t, int64(0), derived.undeliveredMessages())
+}
+
+func TestCloseWithDrain(t *testing.T) {
+	client := &baseClient{mu: &sync.Mutex{}, messageHandler: NewMessageHandler(nil, nil), subscribers: newSubscriberSet(nil)}
+	client.dispatchPubSubMessage(models.NewPubSubMessage("p1", "payments"))
+	assert.EqualError(t, client.CloseWithDrain(20*time.Millisecond),
+		"1 pub/sub messages were not delivered before the client was closed")
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		client.messageHandler.GetQueue().Pop()
+	}()
+	assert.NoError(t, client.CloseWithDrain(time.Second))
+}
@@ -39,6 +39,8 @@ type MessageHandler struct {
 	callback config.MessageCallback
 	context  any
 	queue    *PubSubMessageQueue
+	// replay keeps the last messages of each channel, or is nil if no replay buffer is configured.
+	replay *replayBuffer
 }
 
 func NewMessageHandler(callback config.MessageCallback, context any) *MessageHandler {
@@ -49,7 +51,19 @@ func NewMessageHandler(callback config.MessageCallback, context any) *MessageHan
 	}
 }
 
+// withReplayBuffer keeps the last size messages of each channel, see
+// [config.StandaloneSubscriptionConfig.WithReplayBuffer].
+func (handler *MessageHandler) withReplayBuffer(size int) *MessageHandler {
+	if size > 0 {
+		handler.replay = newReplayBuffer(size)
+	}
+	return handler
+}
+
 func (handler *MessageHandler) handleMessage(message *models.PubSubMessage) error {
+	if handler.replay != nil {
+		handler.replay.record(message)
+	}
 	if handler.callback != nil {
 		defer func() {
 			if r := recover(); r != nil {
@@ -73,6 +87,14 @@ func (handler *MessageHandler) GetQueue() *PubSubMessageQueue {
 	return handler.queue
 }
 
+// queuedMessages returns the number of messages waiting in the queue. Messages are only queued when the handler has
+// no callback.
+func (handler *MessageHandler) queuedMessages() int64 {
+	handler.queue.mu.Lock()
+	defer handler.queue.mu.Unlock()
+	return int64(len(handler.queue.messages))
+}
+
 // *** Message Queue ***
 
 type PubSubMessageQueue struct {
@@ -0,0 +1,79 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// replayBuffer keeps the last messages received on each channel, see
+// [config.StandaloneSubscriptionConfig.WithReplayBuffer].
+type replayBuffer struct {
+	size int
+
+	mu sync.Mutex
+	// messages holds, for each channel, a ring of up to size messages. next is the index of the oldest message of a
+	// full ring, where the next message is written.
+	messages map[string][]*models.PubSubMessage
+	next     map[string]int
+}
+
+func newReplayBuffer(size int) *replayBuffer {
+	return &replayBuffer{
+		size:     size,
+		messages: make(map[string][]*models.PubSubMessage),
+		next:     make(map[string]int),
+	}
+}
+
+func (buffer *replayBuffer) record(message *models.PubSubMessage) {
+	buffer.mu.Lock()
+	defer buffer.mu.Unlock()
+	ring := buffer.messages[message.Channel]
+	if len(ring) < buffer.size {
+		buffer.messages[message.Channel] = append(ring, message)
+		return
+	}
+	next := buffer.next[message.Channel]
+	ring[next] = message
+	buffer.next[message.Channel] = (next + 1) % buffer.size
+}
+
+// last returns the messages kept for channel, from the oldest to the newest.
+func (buffer *replayBuffer) last(channel string) []*models.PubSubMessage {
+	buffer.mu.Lock()
+	defer buffer.mu.Unlock()
+	ring := buffer.messages[channel]
+	next := buffer.next[channel]
+	return append(append(make([]*models.PubSubMessage, 0, len(ring)), ring[next:]...), ring[:next]...)
+}
+
+// ReplayMessages returns the last messages received on channel, from the oldest to the newest, as kept by the replay
+// buffer of the subscription, see [config.StandaloneSubscriptionConfig.WithReplayBuffer]. The messages are returned
+// whether or not they were already delivered to the callback or popped from the queue, so a consumer should skip
+// the messages it already processed.
+//
+// The buffer is not durable: the messages published while the client was disconnected, or before it subscribed,
+// are never replayed.
+//
+// Parameters:
+//
+//	channel - The channel the messages were received on. For pattern subscriptions, this is the channel matching
+//	  the pattern.
+//
+// Return value:
+//
+//	The messages kept for the channel, or an error if the client has no subscription or no replay buffer.
+func (client *baseClient) ReplayMessages(channel string) ([]*models.PubSubMessage, error) {
+	handler := client.getMessageHandler()
+	if handler == nil {
+		return nil, errors.New("no subscriptions configured for this client")
+	}
+	if handler.replay == nil {
+		return nil, errors.New("no replay buffer configured for this client")
+	}
+	return handler.replay.last(channel), nil
+}
@@ -0,0 +1,54 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func replayedMessages(messages []*models.PubSubMessage) []string {
+	values := make([]string, len(messages))
+	for idx, message := range messages {
+		values[idx] = message.Message
+	}
+	return values
+}
+
+func TestReplayBuffer(t *testing.T) {
+	buffer := newReplayBuffer(3)
+	assert.Empty(t, buffer.last("orders"))
+
+	for idx := range 5 {
+		buffer.record(models.NewPubSubMessage("o"+strconv.Itoa(idx), "orders"))
+	}
+	buffer.record(models.NewPubSubMessage("p0", "payments"))
+
+	assert.Equal(t, []string{"o2", "o3", "o4"}, replayedMessages(buffer.last("orders")))
+	assert.Equal(t, []string{"p0"}, replayedMessages(buffer.last("payments")))
+}
+
+func TestReplayMessages(t *testing.T) {
+	client := &baseClient{}
+	_, err := client.ReplayMessages("orders")
+	assert.Error(t, err)
+
+	client.messageHandler = NewMessageHandler(nil, nil)
+	_, err = client.ReplayMessages("orders")
+	assert.Error(t, err)
+
+	// Replayed messages are kept whether or not the consumer already received them.
+	client.messageHandler = NewMessageHandler(nil, nil).withReplayBuffer(2)
+	client.dispatchPubSubMessage(models.NewPubSubMessage("o1", "orders"))
+	client.dispatchPubSubMessage(models.NewPubSubMessage("o2", "orders"))
+	assert.Equal(t, "o1", client.messageHandler.GetQueue().Pop().Message)
+
+	messages, err := client.ReplayMessages("orders")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"o1", "o2"}, replayedMessages(messages))
+}
@@ -0,0 +1,197 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// MessageMiddleware wraps the handler of a pub/sub message, e.g. to log, trace or recover from the messages, see
+// [MessageRouter.Use].
+type MessageMiddleware func(next config.MessageCallback) config.MessageCallback
+
+type messageRoute struct {
+	pattern string
+	handler config.MessageCallback
+}
+
+// MessageRouter dispatches the pub/sub messages of a client to handlers registered per channel or glob-style
+// pattern, so that a single subscription callback does not need to demultiplex the messages itself. The router is
+// installed as the callback of a subscription:
+//
+//	router := glide.NewMessageRouter().
+//		Handle("orders.*", handleOrder).
+//		Handle("payments", handlePayment)
+//	subscriptionConfig.WithCallback(router.Callback(), nil)
+//
+// Handlers may be registered while messages are dispatched.
+type MessageRouter struct {
+	mu         sync.RWMutex
+	routes     []messageRoute
+	middleware []MessageMiddleware
+	fallback   config.MessageCallback
+}
+
+// NewMessageRouter returns a router without handlers.
+func NewMessageRouter() *MessageRouter {
+	return &MessageRouter{}
+}
+
+// Handle registers the handler of the messages whose channel matches pattern, with the syntax of PSUBSCRIBE: `*`
+// matches any sequence of characters, `?` any single character, `[...]` a set of characters, and `\` escapes the
+// next character. A message is dispatched to the first registered handler whose pattern matches its channel.
+//
+// Parameters:
+//
+//	pattern - The channel, or pattern of channels, of the messages to handle.
+//	handler - The handler of the messages.
+//
+// Return value:
+//
+//	The router, for chaining.
+func (router *MessageRouter) Handle(pattern string, handler config.MessageCallback) *MessageRouter {
+	router.mu.Lock()
+	defer router.mu.Unlock()
+	router.routes = append(router.routes, messageRoute{pattern: pattern, handler: handler})
+	return router
+}
+
+// HandleDefault registers the handler of the messages whose channel matches no pattern. Such messages are dropped if
+// no default handler is registered.
+//
+// Return value:
+//
+//	The router, for chaining.
+func (router *MessageRouter) HandleDefault(handler config.MessageCallback) *MessageRouter {
+	router.mu.Lock()
+	defer router.mu.Unlock()
+	router.fallback = handler
+	return router
+}
+
+// Use adds middleware wrapping every handler of the router, including the default one. The middleware added first is
+// the outermost.
+//
+// Return value:
+//
+//	The router, for chaining.
+func (router *MessageRouter) Use(middleware ...MessageMiddleware) *MessageRouter {
+	router.mu.Lock()
+	defer router.mu.Unlock()
+	router.middleware = append(router.middleware, middleware...)
+	return router
+}
+
+// Callback returns the callback dispatching the messages to the handlers of the router, to be passed to the
+// WithCallback method of a subscription configuration.
+func (router *MessageRouter) Callback() config.MessageCallback {
+	return router.dispatch
+}
+
+func (router *MessageRouter) dispatch(message *models.PubSubMessage, context any) {
+	router.mu.RLock()
+	handler := router.fallback
+	for _, route := range router.routes {
+		if globMatch(route.pattern, message.Channel) {
+			handler = route.handler
+			break
+		}
+	}
+	middleware := router.middleware
+	router.mu.RUnlock()
+
+	if handler == nil {
+		return
+	}
+	for idx := len(middleware) - 1; idx >= 0; idx-- {
+		handler = middleware[idx](handler)
+	}
+	handler(message, context)
+}
+
+// globMatch reports whether s matches the glob-style pattern, following the matching rules of the server, see
+// [MessageRouter.Handle].
+func globMatch(pattern string, s string) bool {
+	// starPattern and starS are the positions right after the last `*` in pattern, and in s where it was tried, so that
+	// the `*` can be retried on a longer part of s.
+	starPattern, starS := -1, -1
+	p, i := 0, 0
+	for i < len(s) {
+		if p < len(pattern) {
+			switch pattern[p] {
+			case '*':
+				for p < len(pattern) && pattern[p] == '*' {
+					p++
+				}
+				starPattern, starS = p, i
+				continue
+			case '?':
+				p++
+				i++
+				continue
+			case '[':
+				if next, ok := matchClass(pattern, p, s[i]); ok {
+					p = next
+					i++
+					continue
+				}
+			case '\\':
+				if p+1 < len(pattern) && pattern[p+1] == s[i] {
+					p += 2
+					i++
+					continue
+				}
+			default:
+				if pattern[p] == s[i] {
+					p++
+					i++
+					continue
+				}
+			}
+		}
+		if starPattern < 0 {
+			return false
+		}
+		starS++
+		p, i = starPattern, starS
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// matchClass matches c against the set of characters starting at pattern[start], which is `[`. It returns the
+// position following the set, and whether c is part of it. An unterminated set extends to the end of the pattern.
+func matchClass(pattern string, start int, c byte) (int, bool) {
+	p := start + 1
+	negate := p < len(pattern) && pattern[p] == '^'
+	if negate {
+		p++
+	}
+	matched := false
+	for p < len(pattern) && pattern[p] != ']' {
+		switch {
+		case pattern[p] == '\\' && p+1 < len(pattern):
+			p++
+			matched = matched || pattern[p] == c
+		case p+2 < len(pattern) && pattern[p+1] == '-' && pattern[p+2] != ']':
+			low, high := pattern[p], pattern[p+2]
+			if low > high {
+				low, high = high, low
+			}
+			matched = matched || (low <= c && c <= high)
+			p += 2
+		default:
+			matched = matched || pattern[p] == c
+		}
+		p++
+	}
+	if p < len(pattern) {
+		p++
+	}
+	return p, matched != negate
+}
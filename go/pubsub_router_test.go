@@ -0,0 +1,61 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestGlobMatch(t *testing.T) {
+	assert.True(t, globMatch("orders", "orders"))
+	assert.False(t, globMatch("orders", "orders.eu"))
+	assert.True(t, globMatch("orders.*", "orders.eu"))
+	assert.True(t, globMatch("*", ""))
+	assert.True(t, globMatch("a*b*c", "aXXbYYbc"))
+	assert.False(t, globMatch("a*b*c", "aXXbYY"))
+	assert.True(t, globMatch("h?llo", "hello"))
+	assert.False(t, globMatch("h?llo", "hllo"))
+	assert.True(t, globMatch("h[ae]llo", "hallo"))
+	assert.False(t, globMatch("h[ae]llo", "hillo"))
+	assert.True(t, globMatch("h[^e]llo", "hallo"))
+	assert.False(t, globMatch("h[^e]llo", "hello"))
+	assert.True(t, globMatch("h[a-c]llo", "hbllo"))
+	assert.True(t, globMatch(`news\*`, "news*"))
+	assert.False(t, globMatch(`news\*`, "news.eu"))
+	assert.True(t, globMatch(escapeGlobPattern("a[1]*"), "a[1]*"))
+}
+
+func TestMessageRouter(t *testing.T) {
+	var handled []string
+	handler := func(name string) config.MessageCallback {
+		return func(message *models.PubSubMessage, context any) {
+			handled = append(handled, name+":"+message.Message)
+		}
+	}
+	tagged := func(next config.MessageCallback) config.MessageCallback {
+		return func(message *models.PubSubMessage, context any) {
+			handled = append(handled, "mw")
+			next(message, context)
+		}
+	}
+	router := NewMessageRouter().
+		Handle("orders.eu", handler("eu")).
+		Handle("orders.*", handler("orders")).
+		Use(tagged)
+	callback := router.Callback()
+
+	callback(models.NewPubSubMessage("1", "orders.eu"), nil)
+	callback(models.NewPubSubMessage("2", "orders.us"), nil)
+	callback(models.NewPubSubMessage("3", "payments"), nil)
+	assert.Equal(t, []string{"mw", "eu:1", "mw", "orders:2"}, handled)
+
+	handled = nil
+	router.HandleDefault(handler("default"))
+	callback(models.NewPubSubMessage("3", "payments"), nil)
+	assert.Equal(t, []string{"mw", "default:3"}, handled)
+}
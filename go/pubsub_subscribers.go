@@ -0,0 +1,363 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// Subscription commands, keyed by the numeric values shared by [config.PubSubChannelMode] and
+// [config.PubSubClusterChannelMode]. The core has no dedicated request types for them, so they are sent as custom
+// commands.
+var (
+	subscribeCommands = map[uint32]string{
+		uint32(config.ExactClusterChannelMode):   "SUBSCRIBE",
+		uint32(config.PatternClusterChannelMode): "PSUBSCRIBE",
+		uint32(config.ShardedClusterChannelMode): "SSUBSCRIBE",
+	}
+	unsubscribeCommands = map[uint32]string{
+		uint32(config.ExactClusterChannelMode):   "UNSUBSCRIBE",
+		uint32(config.PatternClusterChannelMode): "PUNSUBSCRIBE",
+		uint32(config.ShardedClusterChannelMode): "SUNSUBSCRIBE",
+	}
+)
+
+// Delays between the attempts to restore the subscriptions of the derived clients.
+const (
+	restoreMinDelay = 100 * time.Millisecond
+	restoreMaxDelay = 5 * time.Second
+)
+
+// subscriber is a derived client receiving the messages of its own channels and patterns.
+type subscriber struct {
+	subscriptions map[uint32][]string
+	channels      map[string]struct{}
+	patterns      map[string]struct{}
+	// closing is set once the derived client unsubscribes, so that its subscriptions are no longer restored.
+	closing bool
+}
+
+func newSubscriber(subscriptions map[uint32][]string) *subscriber {
+	sub := &subscriber{
+		subscriptions: subscriptions,
+		channels:      make(map[string]struct{}),
+		patterns:      make(map[string]struct{}),
+	}
+	for mode, channels := range subscriptions {
+		for _, channel := range channels {
+			if mode == uint32(config.PatternClusterChannelMode) {
+				sub.patterns[channel] = struct{}{}
+			} else {
+				sub.channels[channel] = struct{}{}
+			}
+		}
+	}
+	return sub
+}
+
+func (sub *subscriber) matches(message *models.PubSubMessage) bool {
+	if message.Pattern.IsNil() {
+		_, ok := sub.channels[message.Channel]
+		return ok
+	}
+	_, ok := sub.patterns[message.Pattern.Value()]
+	return ok
+}
+
+// subscriberSet tracks the derived clients sharing a core connection. It is shared by pointer between the client
+// and all of its derived clients. Lock ordering is client.mu before subscriberSet.mu.
+type subscriberSet struct {
+	mu sync.RWMutex
+	// configured holds the subscriptions set up by the connection configuration, which are never unsubscribed.
+	configured map[uint32][]string
+	// root matches the messages of the configured subscriptions, which belong to the client itself.
+	root        *subscriber
+	subscribers map[*baseClient]*subscriber
+	// inFlight is the number of messages received from the core and not yet handed to a message handler.
+	inFlight atomic.Int64
+	// restores is the number of requests to restore the subscriptions of the derived clients not yet served.
+	restores atomic.Int64
+}
+
+func newSubscriberSet(subscriptions *protobuf.PubSubSubscriptions) *subscriberSet {
+	set := &subscriberSet{
+		configured:  make(map[uint32][]string),
+		subscribers: make(map[*baseClient]*subscriber),
+	}
+	if subscriptions != nil {
+		for mode, channels := range subscriptions.ChannelsOrPatternsByType {
+			for _, channel := range channels.ChannelsOrPatterns {
+				set.configured[mode] = append(set.configured[mode], string(channel))
+			}
+		}
+	}
+	set.root = newSubscriber(set.configured)
+	return set
+}
+
+func (set *subscriberSet) add(client *baseClient, subscriptions map[uint32][]string) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	set.subscribers[client] = newSubscriber(subscriptions)
+}
+
+func (set *subscriberSet) remove(client *baseClient) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	delete(set.subscribers, client)
+}
+
+// matching returns the message handlers of all derived clients subscribed to the message's channel or pattern.
+func (set *subscriberSet) matching(message *models.PubSubMessage) []*MessageHandler {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	var handlers []*MessageHandler
+	for client, sub := range set.subscribers {
+		if sub.matches(message) {
+			handlers = append(handlers, client.getMessageHandler())
+		}
+	}
+	return handlers
+}
+
+// configuredMatch reports whether the message belongs to the subscriptions of the connection configuration.
+func (set *subscriberSet) configuredMatch(message *models.PubSubMessage) bool {
+	return set.root.matches(message)
+}
+
+// held reports whether a derived client which is not closing subscribed to the given channel or pattern.
+func (set *subscriberSet) held(channel string) bool {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	for _, sub := range set.subscribers {
+		_, isChannel := sub.channels[channel]
+		_, isPattern := sub.patterns[channel]
+		if !sub.closing && (isChannel || isPattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// markClosing stops restoring the subscriptions of the given derived client, which keeps receiving its messages
+// until it is removed.
+func (set *subscriberSet) markClosing(client *baseClient) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	if sub, ok := set.subscribers[client]; ok {
+		sub.closing = true
+	}
+}
+
+// queued returns the number of messages waiting in the queues of the derived clients.
+func (set *subscriberSet) queued() int64 {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	var queued int64
+	for client := range set.subscribers {
+		queued += client.getMessageHandler().queuedMessages()
+	}
+	return queued
+}
+
+// exclusive returns the subscriptions of the given derived client that neither the connection configuration nor
+// any other derived client relies on, and which are therefore safe to unsubscribe.
+func (set *subscriberSet) exclusive(client *baseClient) map[uint32][]string {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	sub, ok := set.subscribers[client]
+	if !ok {
+		return nil
+	}
+	inUse := func(mode uint32, channel string) bool {
+		for _, configured := range set.configured[mode] {
+			if configured == channel {
+				return true
+			}
+		}
+		for other, otherSub := range set.subscribers {
+			if other == client {
+				continue
+			}
+			for _, otherChannel := range otherSub.subscriptions[mode] {
+				if otherChannel == channel {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	result := make(map[uint32][]string)
+	for mode, channels := range sub.subscriptions {
+		for _, channel := range channels {
+			if !inUse(mode, channel) {
+				result[mode] = append(result[mode], channel)
+			}
+		}
+	}
+	return result
+}
+
+// detachAll marks all derived clients as closed. The caller must hold client.mu.
+func (set *subscriberSet) detachAll() {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	for client := range set.subscribers {
+		client.coreClient = nil
+	}
+	set.subscribers = make(map[*baseClient]*subscriber)
+}
+
+// reattachAll points all derived clients to a new core client. The caller must hold client.mu.
+func (set *subscriberSet) reattachAll(coreClient unsafe.Pointer) {
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	for client := range set.subscribers {
+		client.coreClient = coreClient
+	}
+}
+
+// subscriptions returns the channels and patterns the derived clients which are not closing subscribed to. They are
+// not restored by the core when it reconnects, since they are not part of the connection configuration.
+func (set *subscriberSet) subscriptions() map[uint32][]string {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	result := make(map[uint32][]string)
+	for _, sub := range set.subscribers {
+		if sub.closing {
+			continue
+		}
+		for mode, channels := range sub.subscriptions {
+			result[mode] = append(result[mode], channels...)
+		}
+	}
+	return result
+}
+
+// dispatchPubSubMessage delivers a pub/sub message to every derived client subscribed to it, and to the client's own
+// message handler if the message belongs to the configured subscriptions or if no derived client claims it, e.g.
+// because the client subscribed through a custom command.
+func (client *baseClient) dispatchPubSubMessage(message *models.PubSubMessage) {
+	var handlers []*MessageHandler
+	toRoot := true
+	if client.subscribers != nil {
+		handlers = client.subscribers.matching(message)
+		toRoot = len(handlers) == 0 || client.subscribers.configuredMatch(message)
+	}
+	if handler := client.getMessageHandler(); toRoot && handler != nil {
+		handler.handleMessage(message)
+	}
+	for _, handler := range handlers {
+		handler.handleMessage(message)
+	}
+}
+
+// resubscribe subscribes the core client again to the channels and patterns of the derived clients.
+func (client *baseClient) resubscribe(ctx context.Context) error {
+	return client.applySubscriptions(ctx, client.subscribers.subscriptions(), subscribeCommands)
+}
+
+// restoreSubscriptions resubscribes in the background the channels and patterns of the derived clients, which the
+// core drops when a connection is lost or when the slot of a sharded channel moves to another node. Failed attempts
+// are retried with an increasing delay until the client is closed. Requests made while restoring are served by one more
+// attempt once it completes.
+func (client *baseClient) restoreSubscriptions() {
+	if client.subscribers.restores.Add(1) > 1 {
+		return
+	}
+	go func() {
+		for requests := int64(1); requests > 0; requests = client.subscribers.restores.Add(-requests) {
+			for delay := restoreMinDelay; ; delay = min(2*delay, restoreMaxDelay) {
+				err := client.resubscribe(context.Background())
+				var closingErr *ClosingError
+				if err == nil || errors.As(err, &closingErr) {
+					break
+				}
+				time.Sleep(delay)
+			}
+		}
+	}()
+}
+
+// newDerivedClient returns a client sharing the core connection, pending requests and counters of this client, with
+// its own message handler. The derived client receives no messages until subscribe is called on it.
+func (client *baseClient) newDerivedClient(subscriptionConfig *config.BaseSubscriptionConfig) baseClient {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	derived := *client
+	derived.messageHandler = NewMessageHandler(subscriptionConfig.GetCallback(), subscriptionConfig.GetContext()).
+		withReplayBuffer(subscriptionConfig.GetReplayBufferSize())
+	derived.derived = true
+	return derived
+}
+
+// subscribe registers a derived client for message delivery and subscribes the shared connection to its channels
+// and patterns. The derived client is closed if subscribing fails.
+func (client *baseClient) subscribe(ctx context.Context, subscriptions map[uint32][]string) error {
+	client.mu.Lock()
+	if client.coreClient == nil {
+		client.mu.Unlock()
+		return NewClosingError("WithSubscriptions failed. The client is closed.")
+	}
+	client.subscribers.add(client, subscriptions)
+	client.mu.Unlock()
+
+	if err := client.applySubscriptions(ctx, subscriptions, subscribeCommands); err != nil {
+		client.Close()
+		return err
+	}
+	return nil
+}
+
+func (client *baseClient) applySubscriptions(
+	ctx context.Context,
+	subscriptions map[uint32][]string,
+	commands map[uint32]string,
+) error {
+	for mode, channels := range subscriptions {
+		if len(channels) == 0 {
+			continue
+		}
+		// Subscriptions are internal commands, so that they are not filtered, limited or audited as the commands of the
+		// user, and do not count towards the quota or the rate limit of the client.
+		args := append([]string{commands[mode]}, channels...)
+		result, err := client.executeInternalCommand(ctx, C.CustomCommand, args, nil)
+		if err != nil {
+			return err
+		}
+		freeCommandResponse(result)
+	}
+	return nil
+}
+
+// closeDerived unsubscribes the channels and patterns used only by this derived client and detaches it from the
+// shared connection, which stays open.
+func (client *baseClient) closeDerived() {
+	client.mu.Lock()
+	closed := client.coreClient == nil
+	client.mu.Unlock()
+	if closed {
+		return
+	}
+
+	// Unsubscribing is best effort: the messages of any remaining subscription are dropped once detached.
+	client.subscribers.markClosing(client)
+	_ = client.applySubscriptions(context.Background(), client.subscribers.exclusive(client), unsubscribeCommands)
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	client.subscribers.remove(client)
+	client.coreClient = nil
+}
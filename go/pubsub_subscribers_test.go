@@ -0,0 +1,117 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestSubscriberSetDispatch(t *testing.T) {
+	root := &baseClient{
+		messageHandler: NewMessageHandler(nil, nil),
+		subscribers:    newSubscriberSet(nil),
+	}
+	derived := &baseClient{messageHandler: NewMessageHandler(nil, nil), subscribers: root.subscribers, derived: true}
+	root.subscribers.add(derived, map[uint32][]string{0: {"orders"}, 1: {"news.*"}})
+
+	root.dispatchPubSubMessage(models.NewPubSubMessage("o1", "orders"))
+	root.dispatchPubSubMessage(models.NewPubSubMessageWithPattern("n1", "news.sports", models.CreateStringResult("news.*")))
+	root.dispatchPubSubMessage(models.NewPubSubMessage("other", "payments"))
+
+	assert.Equal(t, "o1", derived.messageHandler.GetQueue().Pop().Message)
+	assert.Equal(t, "n1", derived.messageHandler.GetQueue().Pop().Message)
+	assert.Nil(t, derived.messageHandler.GetQueue().Pop())
+	assert.Equal(t, "other", root.messageHandler.GetQueue().Pop().Message)
+	assert.Nil(t, root.messageHandler.GetQueue().Pop())
+}
+
+func TestSubscriberSetDispatch_AllOwners(t *testing.T) {
+	root := &baseClient{
+		messageHandler: NewMessageHandler(nil, nil),
+		subscribers: newSubscriberSet(&protobuf.PubSubSubscriptions{
+			ChannelsOrPatternsByType: map[uint32]*protobuf.PubSubChannelsOrPatterns{
+				0: {ChannelsOrPatterns: [][]byte{[]byte("orders")}},
+			},
+		}),
+	}
+	first := &baseClient{messageHandler: NewMessageHandler(nil, nil), subscribers: root.subscribers, derived: true}
+	second := &baseClient{messageHandler: NewMessageHandler(nil, nil), subscribers: root.subscribers, derived: true}
+	root.subscribers.add(first, map[uint32][]string{0: {"orders", "payments"}})
+	root.subscribers.add(second, map[uint32][]string{0: {"orders"}})
+
+	root.dispatchPubSubMessage(models.NewPubSubMessage("o1", "orders"))
+	root.dispatchPubSubMessage(models.NewPubSubMessage("p1", "payments"))
+
+	for _, client := range []*baseClient{root, first, second} {
+		assert.Equal(t, "o1", client.messageHandler.GetQueue().Pop().Message)
+	}
+	assert.Equal(t, "p1", first.messageHandler.GetQueue().Pop().Message)
+	assert.Nil(t, root.messageHandler.GetQueue().Pop())
+	assert.Nil(t, second.messageHandler.GetQueue().Pop())
+}
+
+func TestSubscriberSetClosing(t *testing.T) {
+	set := newSubscriberSet(nil)
+	first, second := &baseClient{}, &baseClient{}
+	set.add(first, map[uint32][]string{0: {"orders"}, 1: {"news.*"}})
+	set.add(second, map[uint32][]string{2: {"shard"}})
+	assert.True(t, set.held("orders"))
+	assert.True(t, set.held("news.*"))
+	assert.False(t, set.held("payments"))
+
+	set.markClosing(first)
+	assert.False(t, set.held("orders"))
+	assert.True(t, set.held("shard"))
+	assert.Equal(t, map[uint32][]string{2: {"shard"}}, set.subscriptions())
+}
+
+func TestApplySubscriptions_Internal(t *testing.T) {
+	quotaCalls := 0
+	client := &baseClient{
+		pending:       make(map[unsafe.Pointer]struct{}),
+		mu:            &sync.Mutex{},
+		stats:         &clientStats{},
+		subscribers:   newSubscriberSet(nil),
+		commandFilter: newCommandFilter(nil, []string{"SUBSCRIBE"}),
+		quotaHook: func(ctx context.Context, request config.QuotaRequest) error {
+			quotaCalls++
+			return nil
+		},
+	}
+
+	// Subscribing bypasses the command filter and the quota, and reaches the closed core client.
+	err := client.applySubscriptions(context.Background(), map[uint32][]string{0: {"orders"}}, subscribeCommands)
+	assert.IsType(t, &ClosingError{}, err)
+	assert.Zero(t, quotaCalls)
+
+	// Restoring the subscriptions stops once the client is closed.
+	client.subscribers.add(&baseClient{}, map[uint32][]string{0: {"orders"}})
+	client.restoreSubscriptions()
+	assert.Eventually(t, func() bool { return client.subscribers.restores.Load() == 0 }, time.Second, time.Millisecond)
+}
+
+func TestSubscriberSetExclusive(t *testing.T) {
+	set := newSubscriberSet(&protobuf.PubSubSubscriptions{
+		ChannelsOrPatternsByType: map[uint32]*protobuf.PubSubChannelsOrPatterns{
+			0: {ChannelsOrPatterns: [][]byte{[]byte("configured")}},
+		},
+	})
+	first, second := &baseClient{}, &baseClient{}
+	set.add(first, map[uint32][]string{0: {"configured", "shared", "own"}})
+	set.add(second, map[uint32][]string{0: {"shared"}})
+
+	assert.Equal(t, map[uint32][]string{0: {"own"}}, set.exclusive(first))
+
+	set.remove(second)
+	assert.Equal(t, map[uint32][]string{0: {"shared", "own"}}, set.exclusive(first))
+	assert.Nil(t, set.exclusive(second))
+}
@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"time"
+)
+
+// ListQueue is an at-most-once [Queue] stored in a list. A message is removed from the list when it is dequeued, so
+// it is lost if the consumer fails before processing it.
+type ListQueue struct {
+	client Client
+	key    string
+}
+
+var _ Queue = (*ListQueue)(nil)
+
+// NewListQueue returns a [ListQueue] stored in the list at key.
+//
+// Parameters:
+//
+//	client - The client used to store the queue, e.g. a glide.Client or glide.ClusterClient.
+//	key - The key of the list holding the queue.
+func NewListQueue(client Client, key string) *ListQueue {
+	return &ListQueue{client: client, key: key}
+}
+
+// Enqueue appends a message to the queue. List messages have no ID, so the returned ID is always empty.
+func (queue *ListQueue) Enqueue(ctx context.Context, payload string) (string, error) {
+	_, err := queue.client.RPush(ctx, queue.key, []string{payload})
+	return "", err
+}
+
+// Dequeue removes the next message from the queue, waiting up to timeout for one to arrive. A timeout of 0 waits
+// indefinitely. It returns [ErrEmpty] if no message arrived in time.
+func (queue *ListQueue) Dequeue(ctx context.Context, timeout time.Duration) (Message, error) {
+	popped, err := queue.client.BLPop(ctx, []string{queue.key}, timeout)
+	if err != nil {
+		return Message{}, err
+	}
+	if len(popped) < 2 {
+		return Message{}, ErrEmpty
+	}
+	return Message{Payload: popped[1], Deliveries: 1}, nil
+}
+
+// Ack does nothing, since messages are removed from the list when they are dequeued.
+func (queue *ListQueue) Ack(ctx context.Context, message Message) error {
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Item is a member of a [PriorityQueue] with its priority.
+type Item struct {
+	Member   string
+	Priority float64
+}
+
+// PriorityQueue is a queue stored in a sorted set, from which members are popped by priority. Pushing a member that
+// is already queued updates its priority.
+type PriorityQueue struct {
+	client Client
+	key    string
+}
+
+// NewPriorityQueue returns a [PriorityQueue] stored in the sorted set at key.
+//
+// Parameters:
+//
+//	client - The client used to store the queue, e.g. a glide.Client or glide.ClusterClient.
+//	key - The key of the sorted set holding the queue.
+func NewPriorityQueue(client Client, key string) *PriorityQueue {
+	return &PriorityQueue{client: client, key: key}
+}
+
+// Push adds a member to the queue, or updates its priority if it is already queued.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	member - The member to add.
+//	priority - The priority of the member.
+func (queue *PriorityQueue) Push(ctx context.Context, member string, priority float64) error {
+	_, err := queue.client.ZAdd(ctx, queue.key, map[string]float64{member: priority})
+	return err
+}
+
+// PopMin removes and returns the member with the lowest priority, or [ErrEmpty] if the queue is empty.
+func (queue *PriorityQueue) PopMin(ctx context.Context) (Item, error) {
+	return first(queue.PopMinN(ctx, 1))
+}
+
+// PopMax removes and returns the member with the highest priority, or [ErrEmpty] if the queue is empty.
+func (queue *PriorityQueue) PopMax(ctx context.Context) (Item, error) {
+	return first(queue.PopMaxN(ctx, 1))
+}
+
+// PopMinN removes and returns up to count members with the lowest priorities, ordered from the lowest priority.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	count - The maximum number of members to pop.
+//
+// Return value:
+//
+//	The popped items, which is empty if the queue is empty.
+func (queue *PriorityQueue) PopMinN(ctx context.Context, count int64) ([]Item, error) {
+	popped, err := queue.client.ZPopMinWithOptions(ctx, queue.key, *options.NewZPopOptions().SetCount(count))
+	if err != nil {
+		return nil, err
+	}
+	return sortedItems(popped, false), nil
+}
+
+// PopMaxN removes and returns up to count members with the highest priorities, ordered from the highest priority.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	count - The maximum number of members to pop.
+//
+// Return value:
+//
+//	The popped items, which is empty if the queue is empty.
+func (queue *PriorityQueue) PopMaxN(ctx context.Context, count int64) ([]Item, error) {
+	popped, err := queue.client.ZPopMaxWithOptions(ctx, queue.key, *options.NewZPopOptions().SetCount(count))
+	if err != nil {
+		return nil, err
+	}
+	return sortedItems(popped, true), nil
+}
+
+// BlockingPopMin removes and returns the member with the lowest priority, waiting up to timeout for a member to be
+// pushed if the queue is empty. A timeout of 0 waits indefinitely. It returns [ErrEmpty] if no member was pushed in
+// time.
+func (queue *PriorityQueue) BlockingPopMin(ctx context.Context, timeout time.Duration) (Item, error) {
+	return itemOf(queue.client.BZPopMin(ctx, []string{queue.key}, timeout))
+}
+
+// BlockingPopMax removes and returns the member with the highest priority, waiting up to timeout for a member to be
+// pushed if the queue is empty. A timeout of 0 waits indefinitely. It returns [ErrEmpty] if no member was pushed in
+// time.
+func (queue *PriorityQueue) BlockingPopMax(ctx context.Context, timeout time.Duration) (Item, error) {
+	return itemOf(queue.client.BZPopMax(ctx, []string{queue.key}, timeout))
+}
+
+func first(items []Item, err error) (Item, error) {
+	if err != nil {
+		return Item{}, err
+	}
+	if len(items) == 0 {
+		return Item{}, ErrEmpty
+	}
+	return items[0], nil
+}
+
+func itemOf(result models.Result[models.KeyWithMemberAndScore], err error) (Item, error) {
+	if err != nil {
+		return Item{}, err
+	}
+	if result.IsNil() {
+		return Item{}, ErrEmpty
+	}
+	return Item{Member: result.Value().Member, Priority: result.Value().Score}, nil
+}
+
+// sortedItems orders popped members the way the server popped them, by priority and then lexicographically.
+func sortedItems(popped map[string]float64, descending bool) []Item {
+	items := make([]Item, 0, len(popped))
+	for member, priority := range popped {
+		items = append(items, Item{Member: member, Priority: priority})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Priority != items[j].Priority {
+			return items[i].Priority < items[j].Priority != descending
+		}
+		return items[i].Member < items[j].Member != descending
+	})
+	return items
+}
@@ -0,0 +1,17 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+)
+
+func TestSortedItems(t *testing.T) {
+	popped := map[string]float64{"b": 1, "a": 1, "c": 3, "d": 2}
+	assert.Equal(t, []Item{{"a", 1}, {"b", 1}, {"d", 2}, {"c", 3}}, sortedItems(popped, false))
+	assert.Equal(t, []Item{{"c", 3}, {"d", 2}, {"b", 1}, {"a", 1}}, sortedItems(popped, true))
+	assert.Empty(t, sortedItems(map[string]float64{}, false))
+}
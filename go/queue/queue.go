@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package queue provides work queues built on a Valkey GLIDE client, behind a single [Queue] interface:
+//
+//   - [ListQueue] delivers each message at most once, using a list.
+//   - [StreamQueue] delivers each message at least once, using a stream and a consumer group. Messages that are not
+//     acknowledged within a visibility timeout are delivered again, and moved to a dead-letter stream after too many
+//     deliveries.
+//
+// It also provides [PriorityQueue], which pops members by priority from a sorted set, and [Scheduler], which delays
+// jobs until their execution time before handing them to a queue.
+package queue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// ErrEmpty is returned by [Queue.Dequeue] when no message arrived within the timeout, and by the pop methods of
+// [PriorityQueue] when the queue is empty.
+var ErrEmpty = errors.New("queue is empty")
+
+// Message is a message taken from a queue.
+type Message struct {
+	// ID identifies the message within the queue. It is empty for messages of a [ListQueue].
+	ID string
+	// Payload is the content of the message.
+	Payload string
+	// Deliveries is the number of times the message was delivered, including this delivery.
+	Deliveries int64
+}
+
+// Queue is a work queue.
+type Queue interface {
+	// Enqueue appends a message to the queue, and returns its ID, if the queue assigns one.
+	Enqueue(ctx context.Context, payload string) (string, error)
+	// Dequeue takes the next message from the queue, waiting up to timeout for one to arrive. A timeout of 0 waits
+	// indefinitely. It returns [ErrEmpty] if no message arrived in time.
+	Dequeue(ctx context.Context, timeout time.Duration) (Message, error)
+	// Ack acknowledges that a message was processed, so that it is not delivered again.
+	Ack(ctx context.Context, message Message) error
+}
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the queues.
+type Client interface {
+	RPush(ctx context.Context, key string, elements []string) (int64, error)
+	BLPop(ctx context.Context, keys []string, timeout time.Duration) ([]string, error)
+	ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) (int64, error)
+	ZRem(ctx context.Context, key string, members []string) (int64, error)
+	ZPopMinWithOptions(ctx context.Context, key string, options options.ZPopOptions) (map[string]float64, error)
+	ZPopMaxWithOptions(ctx context.Context, key string, options options.ZPopOptions) (map[string]float64, error)
+	BZPopMin(ctx context.Context, keys []string, timeout time.Duration) (models.Result[models.KeyWithMemberAndScore], error)
+	BZPopMax(ctx context.Context, keys []string, timeout time.Duration) (models.Result[models.KeyWithMemberAndScore], error)
+	XAdd(ctx context.Context, key string, values []models.FieldValue) (string, error)
+	InvokeScriptWithOptions(ctx context.Context, script options.Script, scriptOptions options.ScriptOptions) (any, error)
+	XAck(ctx context.Context, key string, group string, ids []string) (int64, error)
+	XGroupCreateWithOptions(
+		ctx context.Context,
+		key string,
+		group string,
+		id string,
+		opts options.XGroupCreateOptions,
+	) (string, error)
+	XReadGroupWithOptions(
+		ctx context.Context,
+		group string,
+		consumer string,
+		keysAndIds map[string]string,
+		options options.XReadGroupOptions,
+	) (map[string]models.StreamResponse, error)
+	XAutoClaimWithOptions(
+		ctx context.Context,
+		key string,
+		group string,
+		consumer string,
+		minIdleTime time.Duration,
+		start string,
+		options options.XAutoClaimOptions,
+	) (models.XAutoClaimResponse, error)
+	XPendingWithOptions(
+		ctx context.Context,
+		key string,
+		group string,
+		options options.XPendingOptions,
+	) ([]models.XPendingDetail, error)
+}
@@ -0,0 +1,186 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// DefaultPromoteBatchSize is used if no batch size is set with [Scheduler.WithBatchSize].
+const DefaultPromoteBatchSize = 100
+
+// promoteScript moves the jobs due at the server time from the scheduled sorted set to the ready list or stream.
+const promoteScript = `
+local now = redis.call('TIME')
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', now[1] * 1000 + math.floor(now[2] / 1000), 'LIMIT', 0, ARGV[1])
+for _, job in ipairs(due) do
+	redis.call('ZREM', KEYS[1], job)
+	if ARGV[2] == 'stream' then
+		redis.call('XADD', KEYS[2], '*', ARGV[3], job)
+	else
+		redis.call('RPUSH', KEYS[2], job)
+	end
+end
+return #due
+`
+
+var getPromoteScript = sync.OnceValue(func() *options.Script { return options.NewScript(promoteScript) })
+
+// Scheduler delays jobs until their execution time. Scheduled jobs are kept in a sorted set scored by their execution
+// time, and promoted atomically to a ready list, consumed by a [ListQueue], or to a ready stream, consumed by a
+// [StreamQueue]. Promotion happens when [Scheduler.Promote] is called, typically by [Scheduler.Run] in a polling
+// worker.
+//
+// Jobs are identified by their payload: scheduling a payload that is already scheduled moves it to the new
+// execution time. In cluster mode, the scheduled and ready keys must map to the same hash slot, e.g. by sharing a
+// hash tag such as "{jobs}:scheduled" and "{jobs}:ready".
+type Scheduler struct {
+	client    Client
+	key       string
+	readyKey  string
+	stream    bool
+	batchSize int64
+	clock     clock.Clock
+}
+
+// NewScheduler returns a [Scheduler] keeping jobs in the sorted set at key, and promoting due jobs to the list at
+// readyKey.
+//
+// Parameters:
+//
+//	client - The client used to store the jobs, e.g. a glide.Client or glide.ClusterClient.
+//	key - The key of the sorted set holding the scheduled jobs.
+//	readyKey - The key of the list receiving the due jobs.
+func NewScheduler(client Client, key string, readyKey string) *Scheduler {
+	return &Scheduler{
+		client:    client,
+		key:       key,
+		readyKey:  readyKey,
+		batchSize: DefaultPromoteBatchSize,
+		clock:     clock.System(),
+	}
+}
+
+// WithReadyStream promotes due jobs to a stream instead of a list, with their payload in the [PayloadField] field.
+func (scheduler *Scheduler) WithReadyStream() *Scheduler {
+	scheduler.stream = true
+	return scheduler
+}
+
+// WithBatchSize sets the maximum number of jobs promoted by a single call to [Scheduler.Promote]. If not explicitly
+// set, [DefaultPromoteBatchSize] is used.
+func (scheduler *Scheduler) WithBatchSize(batchSize int64) *Scheduler {
+	scheduler.batchSize = batchSize
+	return scheduler
+}
+
+// WithClock sets the clock the delays of [Scheduler.ScheduleAfter] and the polling interval of [Scheduler.Run] are
+// measured on, e.g. a clock.Fake in unit tests. Jobs are still promoted according to the server clock. If not
+// explicitly set, the system clock is used.
+func (scheduler *Scheduler) WithClock(c clock.Clock) *Scheduler {
+	scheduler.clock = c
+	return scheduler
+}
+
+// Schedule schedules a job to be promoted at the given time, or moves it to that time if it is already scheduled.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The payload of the job.
+//	at - The execution time of the job.
+func (scheduler *Scheduler) Schedule(ctx context.Context, payload string, at time.Time) error {
+	_, err := scheduler.client.ZAdd(ctx, scheduler.key, map[string]float64{payload: float64(at.UnixMilli())})
+	return err
+}
+
+// ScheduleAfter schedules a job to be promoted after the given delay, or moves it if it is already scheduled.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The payload of the job.
+//	delay - The delay after which the job is due.
+func (scheduler *Scheduler) ScheduleAfter(ctx context.Context, payload string, delay time.Duration) error {
+	return scheduler.Schedule(ctx, payload, scheduler.clock.Now().Add(delay))
+}
+
+// Cancel removes a scheduled job that was not promoted yet.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	payload - The payload of the job.
+//
+// Return value:
+//
+//	true if the job was scheduled, false otherwise.
+func (scheduler *Scheduler) Cancel(ctx context.Context, payload string) (bool, error) {
+	removed, err := scheduler.client.ZRem(ctx, scheduler.key, []string{payload})
+	return removed > 0, err
+}
+
+// Promote atomically moves up to the batch size of due jobs to the ready list or stream. Jobs are due once their
+// execution time is reached according to the server clock.
+//
+// Return value:
+//
+//	The number of promoted jobs.
+func (scheduler *Scheduler) Promote(ctx context.Context) (int64, error) {
+	target := "list"
+	if scheduler.stream {
+		target = "stream"
+	}
+	result, err := scheduler.client.InvokeScriptWithOptions(
+		ctx,
+		*getPromoteScript(),
+		*options.NewScriptOptions().
+			WithKeys([]string{scheduler.key, scheduler.readyKey}).
+			WithArgs([]string{strconv.FormatInt(scheduler.batchSize, 10), target, PayloadField}),
+	)
+	if err != nil {
+		return 0, err
+	}
+	promoted, ok := result.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected response to the promote script: %v", result)
+	}
+	return promoted, nil
+}
+
+// Run promotes due jobs every interval until the context is done. Full batches are promoted without waiting, so that
+// a backlog of due jobs is drained quickly.
+//
+// Parameters:
+//
+//	ctx - The context stopping the worker.
+//	interval - The polling interval.
+//
+// Return value:
+//
+//	The error of the first failed promotion, or the error of the context once it is done.
+func (scheduler *Scheduler) Run(ctx context.Context, interval time.Duration) error {
+	ticker := scheduler.clock.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		promoted, err := scheduler.Promote(ctx)
+		if err != nil {
+			return err
+		}
+		if promoted >= scheduler.batchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package queue
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+const (
+	// PayloadField is the stream field holding the payload of a message.
+	PayloadField = "payload"
+	// SourceIDField is the dead-letter stream field holding the ID of the message in the original stream.
+	SourceIDField = "source-id"
+	// DeliveriesField is the dead-letter stream field holding the number of times the message was delivered.
+	DeliveriesField = "deliveries"
+
+	// DefaultVisibilityTimeout is used if no visibility timeout is set with [StreamQueue.WithVisibilityTimeout].
+	DefaultVisibilityTimeout = 30 * time.Second
+
+	streamStart = "0-0"
+)
+
+// StreamQueue is an at-least-once [Queue] stored in a stream and read through a consumer group. A dequeued message
+// stays pending until it is acknowledged. If it is not acknowledged within the visibility timeout, e.g. because its
+// consumer failed, it is delivered again to the next consumer calling Dequeue.
+type StreamQueue struct {
+	client            Client
+	key               string
+	group             string
+	consumer          string
+	visibilityTimeout time.Duration
+	deadLetterKey     string
+	maxDeliveries     int64
+
+	mu           sync.Mutex
+	groupCreated bool
+	claimCursor  string
+}
+
+var _ Queue = (*StreamQueue)(nil)
+
+// NewStreamQueue returns a [StreamQueue] stored in the stream at key, consuming it as the given consumer of the given
+// consumer group. The stream and the group are created on first use if they do not exist.
+//
+// Parameters:
+//
+//	client - The client used to store the queue, e.g. a glide.Client or glide.ClusterClient.
+//	key - The key of the stream holding the queue.
+//	group - The consumer group shared by all consumers of the queue.
+//	consumer - The name of this consumer, unique within the group.
+func NewStreamQueue(client Client, key string, group string, consumer string) *StreamQueue {
+	return &StreamQueue{
+		client:            client,
+		key:               key,
+		group:             group,
+		consumer:          consumer,
+		visibilityTimeout: DefaultVisibilityTimeout,
+		claimCursor:       streamStart,
+	}
+}
+
+// WithVisibilityTimeout sets how long a dequeued message may stay unacknowledged before it is delivered again. If
+// not explicitly set, [DefaultVisibilityTimeout] is used.
+func (queue *StreamQueue) WithVisibilityTimeout(timeout time.Duration) *StreamQueue {
+	queue.visibilityTimeout = timeout
+	return queue
+}
+
+// WithDeadLetter moves messages delivered more than maxDeliveries times to the stream at key, instead of delivering
+// them again. The dead-letter entries hold the payload, the ID of the message in the original stream and the number
+// of deliveries. If not explicitly set, messages are delivered again indefinitely.
+func (queue *StreamQueue) WithDeadLetter(key string, maxDeliveries int64) *StreamQueue {
+	queue.deadLetterKey = key
+	queue.maxDeliveries = maxDeliveries
+	return queue
+}
+
+func (queue *StreamQueue) ensureGroup(ctx context.Context) error {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	if queue.groupCreated {
+		return nil
+	}
+	_, err := queue.client.XGroupCreateWithOptions(
+		ctx,
+		queue.key,
+		queue.group,
+		streamStart,
+		*options.NewXGroupCreateOptions().SetMakeStream(),
+	)
+	if err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	queue.groupCreated = true
+	return nil
+}
+
+// Enqueue appends a message to the stream, and returns its entry ID.
+func (queue *StreamQueue) Enqueue(ctx context.Context, payload string) (string, error) {
+	return queue.client.XAdd(ctx, queue.key, []models.FieldValue{{Field: PayloadField, Value: payload}})
+}
+
+// Dequeue returns the next message of the queue, waiting up to timeout for one to arrive. A timeout of 0 waits
+// indefinitely. Messages whose visibility timeout expired are delivered before new messages. It returns [ErrEmpty] if
+// no message arrived in time.
+func (queue *StreamQueue) Dequeue(ctx context.Context, timeout time.Duration) (Message, error) {
+	if err := queue.ensureGroup(ctx); err != nil {
+		return Message{}, err
+	}
+	message, ok, err := queue.reclaim(ctx)
+	if err != nil || ok {
+		return message, err
+	}
+
+	streams, err := queue.client.XReadGroupWithOptions(
+		ctx,
+		queue.group,
+		queue.consumer,
+		map[string]string{queue.key: ">"},
+		*options.NewXReadGroupOptions().SetCount(1).SetBlock(timeout),
+	)
+	if err != nil {
+		return Message{}, err
+	}
+	entries := streams[queue.key].Entries
+	if len(entries) == 0 {
+		return Message{}, ErrEmpty
+	}
+	return Message{ID: entries[0].ID, Payload: payloadOf(entries[0]), Deliveries: 1}, nil
+}
+
+// reclaim claims a message whose visibility timeout expired, moving messages delivered too many times to the
+// dead-letter stream on the way.
+func (queue *StreamQueue) reclaim(ctx context.Context) (Message, bool, error) {
+	for {
+		queue.mu.Lock()
+		cursor := queue.claimCursor
+		queue.mu.Unlock()
+		claimed, err := queue.client.XAutoClaimWithOptions(
+			ctx,
+			queue.key,
+			queue.group,
+			queue.consumer,
+			queue.visibilityTimeout,
+			cursor,
+			*options.NewXAutoClaimOptions().SetCount(1),
+		)
+		if err != nil {
+			return Message{}, false, err
+		}
+		queue.mu.Lock()
+		queue.claimCursor = claimed.NextEntry
+		queue.mu.Unlock()
+		if len(claimed.ClaimedEntries) == 0 {
+			return Message{}, false, nil
+		}
+
+		entry := claimed.ClaimedEntries[0]
+		deliveries, err := queue.deliveries(ctx, entry.ID)
+		if err != nil {
+			return Message{}, false, err
+		}
+		if deliveries == 0 {
+			// The message was acknowledged in the meantime.
+			continue
+		}
+		message := Message{ID: entry.ID, Payload: payloadOf(entry), Deliveries: deliveries}
+		if queue.deadLetterKey == "" || deliveries <= queue.maxDeliveries {
+			return message, true, nil
+		}
+		if err := queue.deadLetter(ctx, message); err != nil {
+			return Message{}, false, err
+		}
+	}
+}
+
+func (queue *StreamQueue) deliveries(ctx context.Context, id string) (int64, error) {
+	pending, err := queue.client.XPendingWithOptions(ctx, queue.key, queue.group, *options.NewXPendingOptions(id, id, 1))
+	if err != nil {
+		return 0, err
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+	return pending[0].DeliveryCount, nil
+}
+
+func (queue *StreamQueue) deadLetter(ctx context.Context, message Message) error {
+	_, err := queue.client.XAdd(ctx, queue.deadLetterKey, []models.FieldValue{
+		{Field: PayloadField, Value: message.Payload},
+		{Field: SourceIDField, Value: message.ID},
+		{Field: DeliveriesField, Value: strconv.FormatInt(message.Deliveries, 10)},
+	})
+	if err != nil {
+		return err
+	}
+	return queue.Ack(ctx, message)
+}
+
+// Ack acknowledges a message, removing it from the pending messages of the consumer group.
+func (queue *StreamQueue) Ack(ctx context.Context, message Message) error {
+	_, err := queue.client.XAck(ctx, queue.key, queue.group, []string{message.ID})
+	return err
+}
+
+func payloadOf(entry models.StreamEntry) string {
+	for _, field := range entry.Fields {
+		if field.Field == PayloadField {
+			return field.Value
+		}
+	}
+	return ""
+}
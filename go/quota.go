@@ -0,0 +1,24 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// checkQuota asks the quota hook whether a request may be sent, and returns a QuotaExceededError if it may not.
+func (client *baseClient) checkQuota(ctx context.Context, command string, family string, commands int, size int64) error {
+	err := client.quotaHook(ctx, config.QuotaRequest{
+		Command:     command,
+		Family:      family,
+		Commands:    commands,
+		PayloadSize: size,
+	})
+	if err != nil {
+		return NewQuotaExceededError(fmt.Sprintf("%s was rejected by the quota hook: %v", command, err), err)
+	}
+	return nil
+}
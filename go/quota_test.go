@@ -0,0 +1,40 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+type callerKey struct{}
+
+func TestCheckQuota(t *testing.T) {
+	errOverQuota := errors.New("over quota")
+	var requests []config.QuotaRequest
+	client := &baseClient{quotaHook: func(ctx context.Context, request config.QuotaRequest) error {
+		requests = append(requests, request)
+		if ctx.Value(callerKey{}) == "limited" {
+			return errOverQuota
+		}
+		return nil
+	}}
+
+	assert.NoError(t, client.checkQuota(context.Background(), "SET", "String", 1, 10))
+	err := client.checkQuota(context.WithValue(context.Background(), callerKey{}, "limited"), "BATCH", batchFamily, 3, 42)
+	var quotaErr *QuotaExceededError
+	assert.ErrorAs(t, err, &quotaErr)
+	assert.ErrorIs(t, err, errOverQuota)
+	assert.EqualError(t, err, "BATCH was rejected by the quota hook: over quota")
+	assert.Equal(t, []config.QuotaRequest{
+		{Command: "SET", Family: "String", Commands: 1, PayloadSize: 10},
+		{Command: "BATCH", Family: batchFamily, Commands: 3, PayloadSize: 42},
+	}, requests)
+
+	// rejected requests never reached the endpoint
+	assert.Equal(t, outcomeIgnored, classifyCircuitOutcome(NewQuotaExceededError("rejected", context.DeadlineExceeded)))
+}
@@ -0,0 +1,127 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// rawResponseKey marks the context of the commands sent by ExecuteRaw, whose responses are returned as received.
+type rawResponseKey struct{}
+
+// ExecuteRaw sends a command and returns its response without converting it, for writing the conversions of the
+// commands the client has no methods for, such as the commands of modules.
+//
+// The response is copied into Go memory, and the native response is released before ExecuteRaw returns, so the
+// returned value needs no cleanup.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	requestType - The command to send, such as RequestTypeCustomCommand.
+//	args - The arguments of the command. With RequestTypeCustomCommand, the command name comes first.
+//	route - Specifies the nodes a cluster client sends the command to, or nil to route it by its keys. Standalone clients
+//	        reject routes.
+//
+// Return value:
+//
+//	The response as received from the server, regardless of the map key policy of the client. Errors replied for the
+//	command itself are returned as errors.
+func (client *baseClient) ExecuteRaw(
+	ctx context.Context,
+	requestType RequestType,
+	args []string,
+	route config.Route,
+) (models.RawValue, error) {
+	var result *C.struct_CommandResponse
+	var err error
+	ctx = context.WithValue(ctx, rawResponseKey{}, true)
+	switch {
+	case route != nil && !client.runtime.clusterMode:
+		return models.RawValue{}, NewRequestError("a route can only be given to a cluster client")
+	case route == nil:
+		result, err = client.executeCommand(ctx, C.RequestType(requestType), args)
+	default:
+		result, err = client.executeCommandWithRoute(ctx, C.RequestType(requestType), args, route)
+	}
+	if err != nil {
+		return models.RawValue{}, err
+	}
+	return handleRawResponse(result), nil
+}
+
+func handleRawResponse(response *C.struct_CommandResponse) models.RawValue {
+	defer freeCommandResponse(response)
+
+	return parseRaw(response)
+}
+
+// parseRaw copies a native response into Go memory.
+func parseRaw(response *C.struct_CommandResponse) models.RawValue {
+	if response == nil {
+		return models.RawValue{Type: models.RawNull}
+	}
+	switch response.response_type {
+	case C.Int:
+		return models.RawValue{Type: models.RawInt, Int: int64(response.int_value)}
+	case C.Float:
+		return models.RawValue{Type: models.RawFloat, Float: float64(response.float_value)}
+	case C.Bool:
+		return models.RawValue{Type: models.RawBool, Bool: bool(response.bool_value)}
+	case C.String:
+		return models.RawValue{Type: models.RawString, Data: rawBytes(response)}
+	case C.Error:
+		return models.RawValue{Type: models.RawError, Data: rawBytes(response)}
+	case C.Ok:
+		return models.RawValue{Type: models.RawOk}
+	case C.Array:
+		value := models.RawValue{Type: models.RawArray}
+		if response.array_value == nil {
+			return value
+		}
+		value.Elements = make([]models.RawValue, 0, response.array_value_len)
+		for _, element := range unsafe.Slice(response.array_value, response.array_value_len) {
+			value.Elements = append(value.Elements, parseRaw(&element))
+		}
+		return value
+	case C.Map:
+		value := models.RawValue{Type: models.RawMap}
+		if response.array_value == nil {
+			return value
+		}
+		value.Entries = make([]models.RawEntry, 0, response.array_value_len)
+		for _, entry := range unsafe.Slice(response.array_value, response.array_value_len) {
+			value.Entries = append(value.Entries, models.RawEntry{
+				Key:   parseRaw(entry.map_key),
+				Value: parseRaw(entry.map_value),
+			})
+		}
+		return value
+	case C.Sets:
+		value := models.RawValue{Type: models.RawSet}
+		if response.sets_value == nil {
+			return value
+		}
+		value.Elements = make([]models.RawValue, 0, response.sets_value_len)
+		for _, member := range unsafe.Slice(response.sets_value, response.sets_value_len) {
+			value.Elements = append(value.Elements, parseRaw(&member))
+		}
+		return value
+	default:
+		return models.RawValue{Type: models.RawNull}
+	}
+}
+
+func rawBytes(response *C.struct_CommandResponse) []byte {
+	if response.string_value == nil {
+		return []byte{}
+	}
+	return C.GoBytes(unsafe.Pointer(response.string_value), C.int(int64(response.string_value_len)))
+}
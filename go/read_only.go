@@ -0,0 +1,165 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"strings"
+	"sync"
+)
+
+// readOnlyCommands are the built-in commands that never modify the dataset, with their subcommand if any.
+var readOnlyCommands = map[string]struct{}{
+	"BITCOUNT":             {},
+	"BITFIELD_RO":          {},
+	"BITPOS":               {},
+	"DBSIZE":               {},
+	"DUMP":                 {},
+	"EVAL_RO":              {},
+	"EVALSHA_RO":           {},
+	"EXISTS":               {},
+	"EXPIRETIME":           {},
+	"FCALL_RO":             {},
+	"GEODIST":              {},
+	"GEOHASH":              {},
+	"GEOPOS":               {},
+	"GEORADIUS_RO":         {},
+	"GEORADIUSBYMEMBER_RO": {},
+	"GEOSEARCH":            {},
+	"GET":                  {},
+	"GETBIT":               {},
+	"GETRANGE":             {},
+	"HEXISTS":              {},
+	"HGET":                 {},
+	"HGETALL":              {},
+	"HKEYS":                {},
+	"HLEN":                 {},
+	"HMGET":                {},
+	"HRANDFIELD":           {},
+	"HSCAN":                {},
+	"HSTRLEN":              {},
+	"HVALS":                {},
+	"KEYS":                 {},
+	"LCS":                  {},
+	"LINDEX":               {},
+	"LLEN":                 {},
+	"LPOS":                 {},
+	"LRANGE":               {},
+	"MGET":                 {},
+	"OBJECT ENCODING":      {},
+	"OBJECT FREQ":          {},
+	"OBJECT IDLETIME":      {},
+	"OBJECT REFCOUNT":      {},
+	"PEXPIRETIME":          {},
+	"PFCOUNT":              {},
+	"PTTL":                 {},
+	"RANDOMKEY":            {},
+	"SCAN":                 {},
+	"SCARD":                {},
+	"SDIFF":                {},
+	"SINTER":               {},
+	"SINTERCARD":           {},
+	"SISMEMBER":            {},
+	"SMEMBERS":             {},
+	"SMISMEMBER":           {},
+	"SORT_RO":              {},
+	"SRANDMEMBER":          {},
+	"SSCAN":                {},
+	"STRLEN":               {},
+	"SUBSTR":               {},
+	"SUNION":               {},
+	"TTL":                  {},
+	"TYPE":                 {},
+	"XINFO CONSUMERS":      {},
+	"XINFO GROUPS":         {},
+	"XINFO STREAM":         {},
+	"XLEN":                 {},
+	"XPENDING":             {},
+	"XRANGE":               {},
+	"XREAD":                {},
+	"XREVRANGE":            {},
+	"ZCARD":                {},
+	"ZCOUNT":               {},
+	"ZDIFF":                {},
+	"ZINTER":               {},
+	"ZINTERCARD":           {},
+	"ZLEXCOUNT":            {},
+	"ZMSCORE":              {},
+	"ZRANDMEMBER":          {},
+	"ZRANGE":               {},
+	"ZRANGEBYLEX":          {},
+	"ZRANGEBYSCORE":        {},
+	"ZRANK":                {},
+	"ZREVRANGE":            {},
+	"ZREVRANGEBYLEX":       {},
+	"ZREVRANGEBYSCORE":     {},
+	"ZREVRANK":             {},
+	"ZSCAN":                {},
+	"ZSCORE":               {},
+	"ZUNION":               {},
+}
+
+// customReadOnlyCommands are the commands registered by [RegisterReadOnlyCommand], typically module commands.
+var customReadOnlyCommands = struct {
+	mu       sync.RWMutex
+	commands map[string]struct{}
+}{commands: make(map[string]struct{})}
+
+// IsReadOnlyCommand returns whether the command sent for a request type never modifies the dataset. Custom commands
+// have no request type of their own, so RequestTypeCustomCommand is never read-only; custom commands registered by
+// [RegisterReadOnlyCommand] are recognized by their name when they are sent instead.
+//
+// Parameters:
+//
+//	requestType - The request type of the command, e.g. RequestTypeGet.
+//
+// Return value:
+//
+//	true if the command is read-only, false otherwise.
+func IsReadOnlyCommand(requestType RequestType) bool {
+	return isReadOnlyRequest(requestType, nil)
+}
+
+// isReadOnlyRequest returns whether a request never modifies the dataset. Custom commands are recognized by their
+// name, with their subcommand if any, or if they were registered by [RegisterReadOnlyCommand].
+func isReadOnlyRequest(requestType RequestType, args []string) bool {
+	if requestType != RequestTypeCustomCommand {
+		_, ok := readOnlyCommands[CommandName(requestType)]
+		return ok
+	}
+	if len(args) == 0 {
+		return false
+	}
+	name := strings.ToUpper(args[0])
+	if _, ok := readOnlyCommands[name]; ok {
+		return true
+	}
+	if len(args) > 1 {
+		if _, ok := readOnlyCommands[name+" "+strings.ToUpper(args[1])]; ok {
+			return true
+		}
+	}
+	return isRegisteredReadOnlyCommand(name)
+}
+
+// RegisterReadOnlyCommand registers custom commands, typically module commands, as read-only, so that they are
+// treated like the built-in read-only commands. In particular, with hedged reads enabled, a registered command sent
+// through CustomCommand is hedged using its first argument after the command name as its key. Only register
+// commands that take a single key as their first argument. The names are case-insensitive.
+//
+// Parameters:
+//
+//	commands - The names of the commands, e.g. "JSON.GET".
+func RegisterReadOnlyCommand(commands ...string) {
+	customReadOnlyCommands.mu.Lock()
+	defer customReadOnlyCommands.mu.Unlock()
+	for _, command := range commands {
+		customReadOnlyCommands.commands[strings.ToUpper(command)] = struct{}{}
+	}
+}
+
+func isRegisteredReadOnlyCommand(command string) bool {
+	customReadOnlyCommands.mu.RLock()
+	defer customReadOnlyCommands.mu.RUnlock()
+	_, ok := customReadOnlyCommands.commands[command]
+	return ok
+}
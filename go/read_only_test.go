@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReadOnlyCommand(t *testing.T) {
+	assert.True(t, IsReadOnlyCommand(RequestTypeGet))
+	assert.True(t, IsReadOnlyCommand(RequestTypeZRange))
+	assert.True(t, IsReadOnlyCommand(RequestTypeObjectEncoding))
+	assert.True(t, IsReadOnlyCommand(RequestTypeXInfoStream))
+	assert.False(t, IsReadOnlyCommand(RequestTypeSet))
+	assert.False(t, IsReadOnlyCommand(RequestTypeXReadGroup))
+	assert.False(t, IsReadOnlyCommand(RequestTypeCustomCommand))
+	assert.False(t, IsReadOnlyCommand(RequestType(4294967295)))
+}
+
+func TestIsReadOnlyRequest(t *testing.T) {
+	assert.True(t, isReadOnlyRequest(RequestTypeCustomCommand, []string{"get", "key"}))
+	assert.True(t, isReadOnlyRequest(RequestTypeCustomCommand, []string{"object", "encoding", "key"}))
+	assert.False(t, isReadOnlyRequest(RequestTypeCustomCommand, []string{"SET", "key", "value"}))
+	assert.False(t, isReadOnlyRequest(RequestTypeCustomCommand, []string{"JSON.TYPE", "key"}))
+	assert.False(t, isReadOnlyRequest(RequestTypeCustomCommand, nil))
+
+	RegisterReadOnlyCommand("json.type", "JSON.STRLEN")
+	assert.True(t, isReadOnlyRequest(RequestTypeCustomCommand, []string{"JSON.TYPE", "key"}))
+	assert.True(t, isReadOnlyRequest(RequestTypeCustomCommand, []string{"json.strlen", "key"}))
+	assert.False(t, isReadOnlyRequest(RequestTypeCustomCommand, []string{"JSON.SET", "key", "$", "1"}))
+	assert.False(t, isReadOnlyRequest(RequestTypeSet, []string{"JSON.TYPE"}))
+}
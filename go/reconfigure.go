@@ -0,0 +1,119 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// runtimeConfig holds the configuration fields updated by [baseClient.Reconfigure]. It is shared by pointer between the
+// copies of a client.
+type runtimeConfig struct {
+	clusterMode bool
+	// replicaReads is set if the client was created with a read strategy reading from replicas. Otherwise the core does
+	// not send READONLY to the replicas, which redirect the reads routed to them.
+	replicaReads bool
+	// username is the username the client was created with, or "" if it authenticates as the default user.
+	username string
+	// requestTimeout is the request timeout enforced by the client, in nanoseconds, or 0 if only the core enforces one.
+	requestTimeout atomic.Int64
+	// readFrom is the read strategy applied by routing single-key reads, or nil if the core strategy applies.
+	readFrom atomic.Pointer[config.ReadFrom]
+}
+
+// Reconfigure updates selected configuration fields of the client at runtime, without recreating it. The fields are
+// validated before any of them is applied. The credentials are applied first, so that the other fields are left
+// unchanged if authenticating with the new password fails.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	reconfiguration - The configuration fields to update.
+//
+// Return value:
+//
+//	An error if a field is invalid, or cannot be applied to this client.
+func (client *baseClient) Reconfigure(ctx context.Context, reconfiguration *config.Reconfiguration) error {
+	if err := reconfiguration.Validate(); err != nil {
+		return NewConfigurationError(err.Error())
+	}
+	username, password, updateCredentials := reconfiguration.GetCredentials()
+	if updateCredentials && normalizeUsername(username) != normalizeUsername(client.runtime.username) {
+		return NewConfigurationError(fmt.Sprintf(
+			"the username cannot be changed at runtime, the client authenticates as %q",
+			normalizeUsername(client.runtime.username),
+		))
+	}
+	readFrom, updateReadFrom := reconfiguration.GetReadFrom()
+	if updateReadFrom && !client.runtime.clusterMode {
+		return NewConfigurationError("the read strategy can only be changed at runtime for cluster clients")
+	}
+	if updateReadFrom && readFrom == config.PreferReplica && !client.runtime.replicaReads {
+		return NewConfigurationError("the client must be created with a read strategy reading from replicas to read from them")
+	}
+
+	if updateCredentials {
+		if _, err := client.submitConnectionPasswordUpdate(ctx, password, true); err != nil {
+			return err
+		}
+	}
+	if timeout, ok := reconfiguration.GetRequestTimeout(); ok {
+		client.runtime.requestTimeout.Store(int64(timeout))
+	}
+	if updateReadFrom {
+		client.runtime.readFrom.Store(&readFrom)
+	}
+	return nil
+}
+
+func normalizeUsername(username string) string {
+	if username == "" {
+		return "default"
+	}
+	return username
+}
+
+// withRequestTimeout bounds the context of a command by the request timeout set at runtime, if any. The returned limit
+// is 0 if the context is not bounded.
+func (runtime *runtimeConfig) withRequestTimeout(
+	ctx context.Context,
+	requestType C.RequestType,
+) (context.Context, context.CancelFunc, time.Duration) {
+	if runtime == nil {
+		return ctx, func() {}, 0
+	}
+	limit := time.Duration(runtime.requestTimeout.Load())
+	if limit <= 0 || !adaptiveTimeoutApplies(requestType) {
+		return ctx, func() {}, 0
+	}
+	ctx, cancel := context.WithTimeout(ctx, limit)
+	return ctx, cancel, limit
+}
+
+// readRoute returns the route applying the read strategy set at runtime to a single-key read, or nil if the command
+// uses the default routing.
+func (runtime *runtimeConfig) readRoute(requestType C.RequestType, args []string) config.Route {
+	if runtime == nil {
+		return nil
+	}
+	readFrom := runtime.readFrom.Load()
+	if readFrom == nil {
+		return nil
+	}
+	key, ok := readKey(requestType, args)
+	if !ok {
+		return nil
+	}
+	if *readFrom == config.PreferReplica {
+		return config.NewSlotKeyRoute(config.SlotTypeReplica, key)
+	}
+	return config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+}
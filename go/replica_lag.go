@@ -0,0 +1,239 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// clusterShard is a range of hash slots, with the addresses of the primary and replicas serving it, as returned by
+// CLUSTER SLOTS.
+type clusterShard struct {
+	start, end int64
+	primary    config.ByAddressRoute
+	replicas   []config.ByAddressRoute
+}
+
+// replicaLagMonitor periodically measures the replication lag of the replicas of a cluster, and routes single-key reads
+// to the replicas whose lag is within the threshold, see
+// [config.AdvancedClusterClientConfiguration.WithReplicaLagThreshold]. It is shared by pointer between the copies of a
+// client.
+type replicaLagMonitor struct {
+	maxLag   int64
+	interval time.Duration
+	client   *baseClient
+
+	mu sync.RWMutex
+	// shards holds the replicas within the threshold of each shard, sorted by slot, or is nil if the lag is unknown.
+	shards   []clusterShard
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newReplicaLagMonitor(maxLag int64, interval time.Duration) *replicaLagMonitor {
+	return &replicaLagMonitor{
+		maxLag:   maxLag,
+		interval: interval,
+		stop:     make(chan struct{}),
+	}
+}
+
+// start begins measuring the lag through the given client. It must be the client returned to the user, so that the
+// measurements stop reaching the core once that client is closed.
+func (monitor *replicaLagMonitor) start(client *baseClient) {
+	monitor.client = client
+	go monitor.run()
+}
+
+func (monitor *replicaLagMonitor) run() {
+	ticker := time.NewTicker(monitor.interval)
+	defer ticker.Stop()
+	for {
+		monitor.measure()
+		select {
+		case <-monitor.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// measure refreshes the replicas within the threshold. If the topology or the offsets of a primary cannot be read, the
+// reads of the affected slots are sent to their primary until the next measurement.
+func (monitor *replicaLagMonitor) measure() {
+	ctx, cancel := context.WithTimeout(context.Background(), monitor.interval)
+	defer cancel()
+	shards, err := monitor.client.clusterShards(ctx)
+	if err != nil {
+		shards = nil
+	}
+	for idx := range shards {
+		shards[idx].replicas = monitor.replicasWithinLag(ctx, shards[idx])
+	}
+
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	monitor.shards = shards
+}
+
+// replicasWithinLag returns the replicas of a shard whose lag behind the primary is at most the threshold. The
+// replicas whose offset cannot be read are left out.
+func (monitor *replicaLagMonitor) replicasWithinLag(ctx context.Context, shard clusterShard) []config.ByAddressRoute {
+	primary, err := monitor.replicationInfo(ctx, shard.primary)
+	if err != nil {
+		return nil
+	}
+	var replicas []config.ByAddressRoute
+	for _, address := range shard.replicas {
+		replica, err := monitor.replicationInfo(ctx, address)
+		if err == nil && replicaWithinLag(primary, replica, monitor.maxLag) {
+			replicas = append(replicas, address)
+		}
+	}
+	return replicas
+}
+
+func (monitor *replicaLagMonitor) replicationInfo(
+	ctx context.Context,
+	address config.ByAddressRoute,
+) (models.ReplicationInfo, error) {
+	result, err := monitor.client.executeCommandWithRoute(ctx, C.Info, []string{"replication"}, &address)
+	if err != nil {
+		return models.ReplicationInfo{}, err
+	}
+	info, err := handleStringResponse(result)
+	if err != nil {
+		return models.ReplicationInfo{}, err
+	}
+	return parseReplicationInfo(info)
+}
+
+// replicaWithinLag reports whether a replica is connected to its primary, and has processed the replication stream of
+// the primary up to maxLag bytes. The offset of the primary is read first, so the lag may be slightly underestimated.
+func replicaWithinLag(primary models.ReplicationInfo, replica models.ReplicationInfo, maxLag int64) bool {
+	if replica.Role != "slave" || replica.PrimaryLinkStatus != "up" {
+		return false
+	}
+	return primary.ReplicationOffset-replica.ReplicaOffset <= maxLag
+}
+
+// readRoute returns the route of a read of the given key: a random replica of the key's shard within the threshold, or
+// the primary of the shard if there is none.
+func (monitor *replicaLagMonitor) readRoute(key string) config.Route {
+	slot := int64(keySlot(key))
+	monitor.mu.RLock()
+	defer monitor.mu.RUnlock()
+	idx := findShard(monitor.shards, slot)
+	if idx < 0 || len(monitor.shards[idx].replicas) == 0 {
+		return config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+	}
+	replicas := monitor.shards[idx].replicas
+	replica := replicas[rand.IntN(len(replicas))]
+	return &replica
+}
+
+func (monitor *replicaLagMonitor) close() {
+	monitor.stopOnce.Do(func() { close(monitor.stop) })
+}
+
+// clusterShards returns the shards of the cluster, as reported by a random node.
+func (client *baseClient) clusterShards(ctx context.Context) ([]clusterShard, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.CustomCommand, []string{"CLUSTER", "SLOTS"}, config.RandomRoute)
+	if err != nil {
+		return nil, err
+	}
+	slots, err := handleAnyArrayOrNilResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	return parseClusterSlots(slots)
+}
+
+// parseClusterSlots parses the response of CLUSTER SLOTS, in which each slot range is followed by the address of its
+// primary and of its replicas. The shards are sorted by slot.
+func parseClusterSlots(slots []any) ([]clusterShard, error) {
+	shards := make([]clusterShard, 0, len(slots))
+	for _, entry := range slots {
+		fields, ok := entry.([]any)
+		if !ok || len(fields) < 3 {
+			return nil, errors.New("unexpected CLUSTER SLOTS entry")
+		}
+		start, startOk := fields[0].(int64)
+		end, endOk := fields[1].(int64)
+		if !startOk || !endOk {
+			return nil, errors.New("unexpected CLUSTER SLOTS slot range")
+		}
+		shard := clusterShard{start: start, end: end}
+		for idx, node := range fields[2:] {
+			address, err := parseClusterSlotsNode(node)
+			if err != nil {
+				return nil, err
+			}
+			if idx == 0 {
+				shard.primary = address
+			} else {
+				shard.replicas = append(shard.replicas, address)
+			}
+		}
+		shards = append(shards, shard)
+	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i].start < shards[j].start })
+	return shards, nil
+}
+
+// findShard returns the index of the shard serving a slot among shards sorted by slot, or -1 if no shard serves it.
+func findShard(shards []clusterShard, slot int64) int {
+	idx := sort.Search(len(shards), func(idx int) bool { return shards[idx].end >= slot })
+	if idx == len(shards) || shards[idx].start > slot {
+		return -1
+	}
+	return idx
+}
+
+func parseClusterSlotsNode(node any) (config.ByAddressRoute, error) {
+	fields, ok := node.([]any)
+	if !ok || len(fields) < 2 {
+		return config.ByAddressRoute{}, errors.New("unexpected CLUSTER SLOTS node")
+	}
+	host, hostOk := fields[0].(string)
+	port, portOk := fields[1].(int64)
+	if !hostOk || !portOk {
+		return config.ByAddressRoute{}, fmt.Errorf("unexpected CLUSTER SLOTS node address: %v", fields[:2])
+	}
+	return config.ByAddressRoute{Host: host, Port: int32(port)}, nil
+}
+
+// keySlot returns the hash slot of a key, which is the CRC16 of the key modulo 16384. If the key contains a non-empty
+// hash tag between braces, only the tag is hashed.
+func keySlot(key string) uint16 {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	var crc uint16
+	for idx := 0; idx < len(key); idx++ {
+		crc ^= uint16(key[idx]) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc % (maxHashSlot + 1)
+}
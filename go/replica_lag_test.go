@@ -0,0 +1,97 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestKeySlot(t *testing.T) {
+	assert.Equal(t, uint16(12739), keySlot("123456789"))
+	assert.Equal(t, uint16(12182), keySlot("foo"))
+	assert.Equal(t, keySlot("foo"), keySlot("{foo}.bar"))
+	assert.Equal(t, keySlot("foo"), keySlot("bar{foo}"))
+	assert.NotEqual(t, keySlot("{}foo"), keySlot(""))
+	assert.Equal(t, uint16(0), keySlot(""))
+}
+
+func TestParseClusterSlots(t *testing.T) {
+	slots := []any{
+		[]any{int64(8192), int64(16383), []any{"10.0.0.2", int64(6379), "id2"}},
+		[]any{
+			int64(0), int64(8191),
+			[]any{"10.0.0.1", int64(6379), "id1"},
+			[]any{"10.0.0.3", int64(6380), "id3"},
+			[]any{"10.0.0.4", int64(6381), "id4"},
+		},
+	}
+	shards, err := parseClusterSlots(slots)
+	require.NoError(t, err)
+	assert.Equal(t, []clusterShard{
+		{
+			start:   0,
+			end:     8191,
+			primary: config.ByAddressRoute{Host: "10.0.0.1", Port: 6379},
+			replicas: []config.ByAddressRoute{
+				{Host: "10.0.0.3", Port: 6380},
+				{Host: "10.0.0.4", Port: 6381},
+			},
+		},
+		{start: 8192, end: 16383, primary: config.ByAddressRoute{Host: "10.0.0.2", Port: 6379}},
+	}, shards)
+
+	_, err = parseClusterSlots([]any{[]any{int64(0), int64(1)}})
+	assert.Error(t, err)
+	_, err = parseClusterSlots([]any{[]any{int64(0), int64(1), []any{int64(6379), "host"}}})
+	assert.Error(t, err)
+}
+
+func TestReplicaWithinLag(t *testing.T) {
+	primary := models.ReplicationInfo{Role: "master", ReplicationOffset: 1000}
+	replica := models.ReplicationInfo{Role: "slave", PrimaryLinkStatus: "up", ReplicaOffset: 900}
+	assert.True(t, replicaWithinLag(primary, replica, 100))
+	assert.False(t, replicaWithinLag(primary, replica, 99))
+
+	replica.PrimaryLinkStatus = "down"
+	assert.False(t, replicaWithinLag(primary, replica, 1000))
+	assert.False(t, replicaWithinLag(primary, primary, 1000))
+}
+
+func TestReplicaLagMonitor_ReadRoute(t *testing.T) {
+	monitor := newReplicaLagMonitor(0, time.Second)
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "foo"), monitor.readRoute("foo"))
+
+	replica := config.ByAddressRoute{Host: "10.0.0.3", Port: 6380}
+	monitor.shards = []clusterShard{
+		{start: 0, end: 8191, replicas: []config.ByAddressRoute{replica}},
+		{start: 8192, end: 16383},
+	}
+	// "b" hashes to slot 3300, and "123456789" to slot 12739.
+	assert.Equal(t, &replica, monitor.readRoute("b"))
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "123456789"), monitor.readRoute("123456789"))
+}
+
+func TestBaseClient_ReadRoute(t *testing.T) {
+	get := requestTypesByName()["GET"]
+	set := requestTypesByName()["SET"]
+	client := &baseClient{}
+	assert.Nil(t, client.readRoute(get, []string{"b"}))
+
+	replica := config.ByAddressRoute{Host: "10.0.0.3", Port: 6380}
+	client.replicaLag = newReplicaLagMonitor(0, time.Second)
+	client.replicaLag.shards = []clusterShard{{start: 0, end: maxHashSlot, replicas: []config.ByAddressRoute{replica}}}
+	assert.Equal(t, &replica, client.readRoute(get, []string{"b"}))
+	assert.Nil(t, client.readRoute(set, []string{"b", "value"}))
+
+	client.runtime = &runtimeConfig{clusterMode: true}
+	primary := config.Primary
+	client.runtime.readFrom.Store(&primary)
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "b"), client.readRoute(get, []string{"b"}))
+}
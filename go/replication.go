@@ -0,0 +1,96 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// parseReplicationInfo parses the replication section of INFO. Unknown fields are ignored, so that fields added by
+// newer servers do not break parsing.
+func parseReplicationInfo(info string) (models.ReplicationInfo, error) {
+	var result models.ReplicationInfo
+	for _, line := range strings.Split(info, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		field, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		var err error
+		switch {
+		case field == "role":
+			result.Role = value
+		case field == "connected_slaves":
+			result.ConnectedReplicas, err = strconv.ParseInt(value, 10, 64)
+		case field == "master_replid":
+			result.ReplicationId = value
+		case field == "master_repl_offset":
+			result.ReplicationOffset, err = strconv.ParseInt(value, 10, 64)
+		case field == "master_failover_state":
+			result.FailoverState = value
+		case field == "master_host":
+			result.PrimaryHost = value
+		case field == "master_port":
+			result.PrimaryPort, err = strconv.ParseInt(value, 10, 64)
+		case field == "master_link_status":
+			result.PrimaryLinkStatus = value
+		case field == "slave_repl_offset":
+			result.ReplicaOffset, err = strconv.ParseInt(value, 10, 64)
+		case strings.HasPrefix(field, "slave") && isDigits(field[len("slave"):]):
+			var replica models.ReplicaInfo
+			replica, err = parseReplicaInfo(value)
+			result.Replicas = append(result.Replicas, replica)
+		}
+		if err != nil {
+			return models.ReplicationInfo{}, fmt.Errorf("failed to parse replication info field %s: %w", field, err)
+		}
+	}
+	if result.Role == "" {
+		return models.ReplicationInfo{}, fmt.Errorf("replication info does not contain a role")
+	}
+	return result, nil
+}
+
+// parseReplicaInfo parses a replica entry, e.g. "ip=127.0.0.1,port=6380,state=online,offset=42,lag=0".
+func parseReplicaInfo(value string) (models.ReplicaInfo, error) {
+	var replica models.ReplicaInfo
+	for _, pair := range strings.Split(value, ",") {
+		key, val, _ := strings.Cut(pair, "=")
+		var err error
+		switch key {
+		case "ip":
+			replica.Host = val
+		case "port":
+			replica.Port, err = strconv.ParseInt(val, 10, 64)
+		case "state":
+			replica.State = val
+		case "offset":
+			replica.Offset, err = strconv.ParseInt(val, 10, 64)
+		case "lag":
+			replica.Lag, err = strconv.ParseInt(val, 10, 64)
+		}
+		if err != nil {
+			return models.ReplicaInfo{}, err
+		}
+	}
+	return replica, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+func TestParseReplicationInfo_Primary(t *testing.T) {
+	info := "# Replication\r\n" +
+		"role:master\r\n" +
+		"connected_slaves:2\r\n" +
+		"slave0:ip=10.0.0.2,port=6380,state=online,offset=1024,lag=0\r\n" +
+		"slave1:ip=10.0.0.3,port=6381,state=wait_bgsave,offset=0,lag=3\r\n" +
+		"master_failover_state:no-failover\r\n" +
+		"master_replid:3f1c0f5e1d7c4a5e8d3d0b6a7f2e1c9b8a7d6e5f\r\n" +
+		"master_repl_offset:1024\r\n" +
+		"repl_backlog_active:1\r\n"
+
+	result, err := parseReplicationInfo(info)
+	assert.NoError(t, err)
+	assert.True(t, result.IsPrimary())
+	assert.Equal(t, int64(2), result.ConnectedReplicas)
+	assert.Equal(t, "no-failover", result.FailoverState)
+	assert.Equal(t, "3f1c0f5e1d7c4a5e8d3d0b6a7f2e1c9b8a7d6e5f", result.ReplicationId)
+	assert.Equal(t, int64(1024), result.ReplicationOffset)
+	assert.Equal(t, []models.ReplicaInfo{
+		{Host: "10.0.0.2", Port: 6380, State: "online", Offset: 1024, Lag: 0},
+		{Host: "10.0.0.3", Port: 6381, State: "wait_bgsave", Offset: 0, Lag: 3},
+	}, result.Replicas)
+}
+
+func TestParseReplicationInfo_Replica(t *testing.T) {
+	info := "# Replication\r\n" +
+		"role:slave\r\n" +
+		"master_host:10.0.0.1\r\n" +
+		"master_port:6379\r\n" +
+		"master_link_status:down\r\n" +
+		"slave_read_repl_offset:512\r\n" +
+		"slave_repl_offset:500\r\n" +
+		"slave_priority:100\r\n" +
+		"connected_slaves:0\r\n" +
+		"master_repl_offset:500\r\n"
+
+	result, err := parseReplicationInfo(info)
+	assert.NoError(t, err)
+	assert.False(t, result.IsPrimary())
+	assert.Equal(t, "10.0.0.1", result.PrimaryHost)
+	assert.Equal(t, int64(6379), result.PrimaryPort)
+	assert.Equal(t, "down", result.PrimaryLinkStatus)
+	assert.Equal(t, int64(500), result.ReplicaOffset)
+	assert.Empty(t, result.Replicas)
+}
+
+func TestParseReplicationInfo_Invalid(t *testing.T) {
+	_, err := parseReplicationInfo("# Replication\r\nconnected_slaves:0\r\n")
+	assert.ErrorContains(t, err, "does not contain a role")
+
+	_, err = parseReplicationInfo("role:master\r\nconnected_slaves:many\r\n")
+	assert.ErrorContains(t, err, "connected_slaves")
+}
@@ -4,11 +4,15 @@ package glide
 
 import (
 	"fmt"
+	"sync"
 	"testing"
+	"unsafe"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/valkey-io/valkey-glide/go/v2/config"
 	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestSimpleNodeRoute(t *testing.T) {
@@ -98,3 +102,69 @@ func TestByAddressRoute_InvalidHost(t *testing.T) {
 	_, err := config.NewByAddressRouteWithHost(config.DefaultHost)
 	assert.NotNil(t, err)
 }
+
+func TestRouteToCBytes(t *testing.T) {
+	ptr, size, err := routeToCBytes(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, ptr)
+	assert.Zero(t, size)
+
+	route := config.NewSlotKeyRoute(config.SlotTypeReplica, "{user}1")
+	ptr, size, err = routeToCBytes(route)
+	require.NoError(t, err)
+	defer freeCBuffer(unsafe.Pointer(ptr))
+
+	decoded := &protobuf.Routes{}
+	require.NoError(t, proto.Unmarshal(unsafe.Slice((*byte)(unsafe.Pointer(ptr)), int(size)), decoded))
+	expected, err := routeToProtobuf(route)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(expected, decoded))
+}
+
+func TestRouteToCBytes_Concurrent(t *testing.T) {
+	routes := []config.Route{
+		nil,
+		config.AllPrimaries,
+		config.RandomRoute,
+		config.NewSlotIdRoute(config.SlotTypePrimary, 42),
+		config.NewSlotKeyRoute(config.SlotTypePrimary, "key"),
+		config.NewByAddressRoute(config.DefaultHost, config.DefaultPort),
+	}
+	before := GetNativeMemoryStats()
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 32; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				ptr, _, err := routeToCBytes(routes[(worker+i)%len(routes)])
+				if !assert.NoError(t, err) {
+					return
+				}
+				freeCBuffer(unsafe.Pointer(ptr))
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	after := GetNativeMemoryStats()
+	assert.Equal(t, before.LiveBuffers(), after.LiveBuffers())
+	assert.Greater(t, after.BuffersAllocated, before.BuffersAllocated)
+}
+
+func TestCreateRouteInfo(t *testing.T) {
+	p := pinner{}
+	defer p.Unpin()
+
+	info := createRouteInfo(&p, config.NewSlotKeyRoute(config.SlotTypePrimary, "user"))
+	require.NotNil(t, info)
+	assert.Equal(t, "user\x00", string(unsafe.Slice((*byte)(unsafe.Pointer(info.slot_key)), 5)))
+	assert.Nil(t, unsafe.Pointer(info.hostname))
+
+	info = createRouteInfo(&p, config.NewByAddressRoute("", config.DefaultPort))
+	require.NotNil(t, info)
+	assert.Equal(t, byte(0), *(*byte)(unsafe.Pointer(info.hostname)))
+
+	assert.Nil(t, createRouteInfo(&p, nil))
+}
@@ -0,0 +1,298 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"strconv"
+	"strings"
+)
+
+// RequestType identifies a command to the Valkey GLIDE core, which builds the command from the request type and the
+// arguments. The values of the request types are defined by the core, and are stable across releases, so they can be
+// used as keys by middleware, metrics and allow-lists without referring to the native layer.
+type RequestType uint32
+
+// RequestTypeCustomCommand sends the arguments as they are, the command name first, like CustomCommand.
+const RequestTypeCustomCommand RequestType = C.CustomCommand
+
+// The request types of the commands with a name mapped by the core, see [CommandName].
+const (
+	RequestTypeAppend            RequestType = C.Append
+	RequestTypeBLMPop            RequestType = C.BLMPop
+	RequestTypeBLMove            RequestType = C.BLMove
+	RequestTypeBLPop             RequestType = C.BLPop
+	RequestTypeBRPop             RequestType = C.BRPop
+	RequestTypeBZMPop            RequestType = C.BZMPop
+	RequestTypeBZPopMax          RequestType = C.BZPopMax
+	RequestTypeBZPopMin          RequestType = C.BZPopMin
+	RequestTypeBitCount          RequestType = C.BitCount
+	RequestTypeBitField          RequestType = C.BitField
+	RequestTypeBitFieldReadOnly  RequestType = C.BitFieldReadOnly
+	RequestTypeBitOp             RequestType = C.BitOp
+	RequestTypeBitPos            RequestType = C.BitPos
+	RequestTypeClientGetName     RequestType = C.ClientGetName
+	RequestTypeClientGetRedir    RequestType = C.ClientGetRedir
+	RequestTypeClientId          RequestType = C.ClientId
+	RequestTypeClientInfo        RequestType = C.ClientInfo
+	RequestTypeClientKill        RequestType = C.ClientKill
+	RequestTypeClientList        RequestType = C.ClientList
+	RequestTypeClientNoEvict     RequestType = C.ClientNoEvict
+	RequestTypeClientNoTouch     RequestType = C.ClientNoTouch
+	RequestTypeClientPause       RequestType = C.ClientPause
+	RequestTypeClientReply       RequestType = C.ClientReply
+	RequestTypeClientSetInfo     RequestType = C.ClientSetInfo
+	RequestTypeClientSetName     RequestType = C.ClientSetName
+	RequestTypeClientUnblock     RequestType = C.ClientUnblock
+	RequestTypeClientUnpause     RequestType = C.ClientUnpause
+	RequestTypeConfigGet         RequestType = C.ConfigGet
+	RequestTypeConfigResetStat   RequestType = C.ConfigResetStat
+	RequestTypeConfigRewrite     RequestType = C.ConfigRewrite
+	RequestTypeConfigSet         RequestType = C.ConfigSet
+	RequestTypeCopy              RequestType = C.Copy
+	RequestTypeDBSize            RequestType = C.DBSize
+	RequestTypeDecr              RequestType = C.Decr
+	RequestTypeDecrBy            RequestType = C.DecrBy
+	RequestTypeDel               RequestType = C.Del
+	RequestTypeDump              RequestType = C.Dump
+	RequestTypeEcho              RequestType = C.Echo
+	RequestTypeExists            RequestType = C.Exists
+	RequestTypeExpire            RequestType = C.Expire
+	RequestTypeExpireAt          RequestType = C.ExpireAt
+	RequestTypeExpireTime        RequestType = C.ExpireTime
+	RequestTypeFCall             RequestType = C.FCall
+	RequestTypeFCallReadOnly     RequestType = C.FCallReadOnly
+	RequestTypeFlushAll          RequestType = C.FlushAll
+	RequestTypeFlushDB           RequestType = C.FlushDB
+	RequestTypeFtAggregate       RequestType = C.FtAggregate
+	RequestTypeFtAliasAdd        RequestType = C.FtAliasAdd
+	RequestTypeFtAliasDel        RequestType = C.FtAliasDel
+	RequestTypeFtAliasList       RequestType = C.FtAliasList
+	RequestTypeFtAliasUpdate     RequestType = C.FtAliasUpdate
+	RequestTypeFtCreate          RequestType = C.FtCreate
+	RequestTypeFtDropIndex       RequestType = C.FtDropIndex
+	RequestTypeFtExplain         RequestType = C.FtExplain
+	RequestTypeFtExplainCli      RequestType = C.FtExplainCli
+	RequestTypeFtInfo            RequestType = C.FtInfo
+	RequestTypeFtList            RequestType = C.FtList
+	RequestTypeFtProfile         RequestType = C.FtProfile
+	RequestTypeFtSearch          RequestType = C.FtSearch
+	RequestTypeFunctionDelete    RequestType = C.FunctionDelete
+	RequestTypeFunctionDump      RequestType = C.FunctionDump
+	RequestTypeFunctionFlush     RequestType = C.FunctionFlush
+	RequestTypeFunctionKill      RequestType = C.FunctionKill
+	RequestTypeFunctionList      RequestType = C.FunctionList
+	RequestTypeFunctionLoad      RequestType = C.FunctionLoad
+	RequestTypeFunctionRestore   RequestType = C.FunctionRestore
+	RequestTypeFunctionStats     RequestType = C.FunctionStats
+	RequestTypeGeoAdd            RequestType = C.GeoAdd
+	RequestTypeGeoDist           RequestType = C.GeoDist
+	RequestTypeGeoHash           RequestType = C.GeoHash
+	RequestTypeGeoPos            RequestType = C.GeoPos
+	RequestTypeGeoSearch         RequestType = C.GeoSearch
+	RequestTypeGeoSearchStore    RequestType = C.GeoSearchStore
+	RequestTypeGet               RequestType = C.Get
+	RequestTypeGetBit            RequestType = C.GetBit
+	RequestTypeGetDel            RequestType = C.GetDel
+	RequestTypeGetEx             RequestType = C.GetEx
+	RequestTypeGetRange          RequestType = C.GetRange
+	RequestTypeHDel              RequestType = C.HDel
+	RequestTypeHExists           RequestType = C.HExists
+	RequestTypeHGet              RequestType = C.HGet
+	RequestTypeHGetAll           RequestType = C.HGetAll
+	RequestTypeHIncrBy           RequestType = C.HIncrBy
+	RequestTypeHIncrByFloat      RequestType = C.HIncrByFloat
+	RequestTypeHKeys             RequestType = C.HKeys
+	RequestTypeHLen              RequestType = C.HLen
+	RequestTypeHMGet             RequestType = C.HMGet
+	RequestTypeHMSet             RequestType = C.HMSet
+	RequestTypeHRandField        RequestType = C.HRandField
+	RequestTypeHScan             RequestType = C.HScan
+	RequestTypeHSet              RequestType = C.HSet
+	RequestTypeHSetNX            RequestType = C.HSetNX
+	RequestTypeHStrlen           RequestType = C.HStrlen
+	RequestTypeHVals             RequestType = C.HVals
+	RequestTypeIncr              RequestType = C.Incr
+	RequestTypeIncrBy            RequestType = C.IncrBy
+	RequestTypeIncrByFloat       RequestType = C.IncrByFloat
+	RequestTypeInfo              RequestType = C.Info
+	RequestTypeJsonArrAppend     RequestType = C.JsonArrAppend
+	RequestTypeJsonArrIndex      RequestType = C.JsonArrIndex
+	RequestTypeJsonArrInsert     RequestType = C.JsonArrInsert
+	RequestTypeJsonArrLen        RequestType = C.JsonArrLen
+	RequestTypeJsonArrPop        RequestType = C.JsonArrPop
+	RequestTypeJsonArrTrim       RequestType = C.JsonArrTrim
+	RequestTypeJsonClear         RequestType = C.JsonClear
+	RequestTypeJsonDebug         RequestType = C.JsonDebug
+	RequestTypeJsonDel           RequestType = C.JsonDel
+	RequestTypeJsonForget        RequestType = C.JsonForget
+	RequestTypeJsonGet           RequestType = C.JsonGet
+	RequestTypeJsonMGet          RequestType = C.JsonMGet
+	RequestTypeJsonNumIncrBy     RequestType = C.JsonNumIncrBy
+	RequestTypeJsonNumMultBy     RequestType = C.JsonNumMultBy
+	RequestTypeJsonObjKeys       RequestType = C.JsonObjKeys
+	RequestTypeJsonObjLen        RequestType = C.JsonObjLen
+	RequestTypeJsonResp          RequestType = C.JsonResp
+	RequestTypeJsonSet           RequestType = C.JsonSet
+	RequestTypeJsonStrAppend     RequestType = C.JsonStrAppend
+	RequestTypeJsonStrLen        RequestType = C.JsonStrLen
+	RequestTypeJsonToggle        RequestType = C.JsonToggle
+	RequestTypeJsonType          RequestType = C.JsonType
+	RequestTypeLCS               RequestType = C.LCS
+	RequestTypeLIndex            RequestType = C.LIndex
+	RequestTypeLInsert           RequestType = C.LInsert
+	RequestTypeLLen              RequestType = C.LLen
+	RequestTypeLMPop             RequestType = C.LMPop
+	RequestTypeLMove             RequestType = C.LMove
+	RequestTypeLPop              RequestType = C.LPop
+	RequestTypeLPos              RequestType = C.LPos
+	RequestTypeLPush             RequestType = C.LPush
+	RequestTypeLPushX            RequestType = C.LPushX
+	RequestTypeLRange            RequestType = C.LRange
+	RequestTypeLRem              RequestType = C.LRem
+	RequestTypeLSet              RequestType = C.LSet
+	RequestTypeLTrim             RequestType = C.LTrim
+	RequestTypeLastSave          RequestType = C.LastSave
+	RequestTypeLolwut            RequestType = C.Lolwut
+	RequestTypeMGet              RequestType = C.MGet
+	RequestTypeMSet              RequestType = C.MSet
+	RequestTypeMSetNX            RequestType = C.MSetNX
+	RequestTypeMove              RequestType = C.Move
+	RequestTypeObjectEncoding    RequestType = C.ObjectEncoding
+	RequestTypeObjectFreq        RequestType = C.ObjectFreq
+	RequestTypeObjectIdleTime    RequestType = C.ObjectIdleTime
+	RequestTypeObjectRefCount    RequestType = C.ObjectRefCount
+	RequestTypePExpire           RequestType = C.PExpire
+	RequestTypePExpireAt         RequestType = C.PExpireAt
+	RequestTypePExpireTime       RequestType = C.PExpireTime
+	RequestTypePTTL              RequestType = C.PTTL
+	RequestTypePersist           RequestType = C.Persist
+	RequestTypePfAdd             RequestType = C.PfAdd
+	RequestTypePfCount           RequestType = C.PfCount
+	RequestTypePfMerge           RequestType = C.PfMerge
+	RequestTypePing              RequestType = C.Ping
+	RequestTypePubSubChannels    RequestType = C.PubSubChannels
+	RequestTypePubSubNumPat      RequestType = C.PubSubNumPat
+	RequestTypePubSubNumSub      RequestType = C.PubSubNumSub
+	RequestTypePubSubShardNumSub RequestType = C.PubSubShardNumSub
+	RequestTypePublish           RequestType = C.Publish
+	RequestTypeRPop              RequestType = C.RPop
+	RequestTypeRPush             RequestType = C.RPush
+	RequestTypeRPushX            RequestType = C.RPushX
+	RequestTypeRandomKey         RequestType = C.RandomKey
+	RequestTypeRename            RequestType = C.Rename
+	RequestTypeRenameNX          RequestType = C.RenameNX
+	RequestTypeRestore           RequestType = C.Restore
+	RequestTypeSAdd              RequestType = C.SAdd
+	RequestTypeSCard             RequestType = C.SCard
+	RequestTypeSDiff             RequestType = C.SDiff
+	RequestTypeSDiffStore        RequestType = C.SDiffStore
+	RequestTypeSInter            RequestType = C.SInter
+	RequestTypeSInterCard        RequestType = C.SInterCard
+	RequestTypeSInterStore       RequestType = C.SInterStore
+	RequestTypeSIsMember         RequestType = C.SIsMember
+	RequestTypeSMIsMember        RequestType = C.SMIsMember
+	RequestTypeSMembers          RequestType = C.SMembers
+	RequestTypeSMove             RequestType = C.SMove
+	RequestTypeSPop              RequestType = C.SPop
+	RequestTypeSPublish          RequestType = C.SPublish
+	RequestTypeSRandMember       RequestType = C.SRandMember
+	RequestTypeSRem              RequestType = C.SRem
+	RequestTypeSScan             RequestType = C.SScan
+	RequestTypeSUnion            RequestType = C.SUnion
+	RequestTypeSUnionStore       RequestType = C.SUnionStore
+	RequestTypeScan              RequestType = C.Scan
+	RequestTypeScriptExists      RequestType = C.ScriptExists
+	RequestTypeScriptFlush       RequestType = C.ScriptFlush
+	RequestTypeScriptKill        RequestType = C.ScriptKill
+	RequestTypeScriptShow        RequestType = C.ScriptShow
+	RequestTypeSelect            RequestType = C.Select
+	RequestTypeSet               RequestType = C.Set
+	RequestTypeSetBit            RequestType = C.SetBit
+	RequestTypeSetRange          RequestType = C.SetRange
+	RequestTypeSort              RequestType = C.Sort
+	RequestTypeSortReadOnly      RequestType = C.SortReadOnly
+	RequestTypeStrlen            RequestType = C.Strlen
+	RequestTypeTTL               RequestType = C.TTL
+	RequestTypeTime              RequestType = C.Time
+	RequestTypeTouch             RequestType = C.Touch
+	RequestTypeType              RequestType = C.Type
+	RequestTypeUnWatch           RequestType = C.UnWatch
+	RequestTypeUnlink            RequestType = C.Unlink
+	RequestTypeWait              RequestType = C.Wait
+	RequestTypeWatch             RequestType = C.Watch
+	RequestTypeXAck              RequestType = C.XAck
+	RequestTypeXAdd              RequestType = C.XAdd
+	RequestTypeXAutoClaim        RequestType = C.XAutoClaim
+	RequestTypeXClaim            RequestType = C.XClaim
+	RequestTypeXDel              RequestType = C.XDel
+	RequestTypeXGroupCreate      RequestType = C.XGroupCreate
+	RequestTypeXGroupDelConsumer RequestType = C.XGroupDelConsumer
+	RequestTypeXGroupDestroy     RequestType = C.XGroupDestroy
+	RequestTypeXGroupSetId       RequestType = C.XGroupSetId
+	RequestTypeXInfoConsumers    RequestType = C.XInfoConsumers
+	RequestTypeXInfoGroups       RequestType = C.XInfoGroups
+	RequestTypeXInfoStream       RequestType = C.XInfoStream
+	RequestTypeXLen              RequestType = C.XLen
+	RequestTypeXPending          RequestType = C.XPending
+	RequestTypeXRange            RequestType = C.XRange
+	RequestTypeXRead             RequestType = C.XRead
+	RequestTypeXReadGroup        RequestType = C.XReadGroup
+	RequestTypeXRevRange         RequestType = C.XRevRange
+	RequestTypeXTrim             RequestType = C.XTrim
+	RequestTypeZAdd              RequestType = C.ZAdd
+	RequestTypeZCard             RequestType = C.ZCard
+	RequestTypeZCount            RequestType = C.ZCount
+	RequestTypeZDiff             RequestType = C.ZDiff
+	RequestTypeZDiffStore        RequestType = C.ZDiffStore
+	RequestTypeZIncrBy           RequestType = C.ZIncrBy
+	RequestTypeZInter            RequestType = C.ZInter
+	RequestTypeZInterCard        RequestType = C.ZInterCard
+	RequestTypeZInterStore       RequestType = C.ZInterStore
+	RequestTypeZLexCount         RequestType = C.ZLexCount
+	RequestTypeZMPop             RequestType = C.ZMPop
+	RequestTypeZMScore           RequestType = C.ZMScore
+	RequestTypeZPopMax           RequestType = C.ZPopMax
+	RequestTypeZPopMin           RequestType = C.ZPopMin
+	RequestTypeZRandMember       RequestType = C.ZRandMember
+	RequestTypeZRange            RequestType = C.ZRange
+	RequestTypeZRangeStore       RequestType = C.ZRangeStore
+	RequestTypeZRank             RequestType = C.ZRank
+	RequestTypeZRem              RequestType = C.ZRem
+	RequestTypeZRemRangeByLex    RequestType = C.ZRemRangeByLex
+	RequestTypeZRemRangeByRank   RequestType = C.ZRemRangeByRank
+	RequestTypeZRemRangeByScore  RequestType = C.ZRemRangeByScore
+	RequestTypeZRevRank          RequestType = C.ZRevRank
+	RequestTypeZScan             RequestType = C.ZScan
+	RequestTypeZScore            RequestType = C.ZScore
+	RequestTypeZUnion            RequestType = C.ZUnion
+	RequestTypeZUnionStore       RequestType = C.ZUnionStore
+)
+
+// CommandName returns the name of the command sent for a request type, in upper case, e.g. "GET" or "CONFIG GET", or an
+// empty string for RequestTypeCustomCommand and for the request types unknown to the client.
+func CommandName(requestType RequestType) string {
+	return commandNames[C.RequestType(requestType)]
+}
+
+// RequestTypeByName returns the request type a command is sent with, e.g. RequestTypeGet for "get", or false if the
+// command has no request type of its own and can only be sent as a custom command. Subcommands are separated by a
+// space, e.g. "CONFIG GET".
+func RequestTypeByName(name string) (RequestType, bool) {
+	requestType, ok := requestTypesByName()[strings.ToUpper(name)]
+	return RequestType(requestType), ok
+}
+
+// String returns the name of the command sent for the request type, see [CommandName], "CUSTOM" for
+// RequestTypeCustomCommand, or the number of the request type if it is unknown to the client.
+func (requestType RequestType) String() string {
+	if requestType == RequestTypeCustomCommand {
+		return "CUSTOM"
+	}
+	if name := CommandName(requestType); name != "" {
+		return name
+	}
+	return "RequestType(" + strconv.FormatUint(uint64(requestType), 10) + ")"
+}
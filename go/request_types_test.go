@@ -0,0 +1,25 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestTypes(t *testing.T) {
+	assert.Equal(t, "GET", CommandName(RequestTypeGet))
+	assert.Equal(t, "CONFIG GET", CommandName(RequestTypeConfigGet))
+	assert.Equal(t, "", CommandName(RequestTypeCustomCommand))
+
+	requestType, ok := RequestTypeByName("config get")
+	assert.True(t, ok)
+	assert.Equal(t, RequestTypeConfigGet, requestType)
+	_, ok = RequestTypeByName("NOT-A-COMMAND")
+	assert.False(t, ok)
+
+	assert.Equal(t, "ZRANGESTORE", RequestTypeZRangeStore.String())
+	assert.Equal(t, "CUSTOM", RequestTypeCustomCommand.String())
+	assert.Equal(t, "RequestType(4294967295)", RequestType(4294967295).String())
+}
@@ -8,12 +8,15 @@ import "C"
 import (
 	"errors"
 	"fmt"
-	"reflect"
+	"runtime"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
 	"unsafe"
 
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
 	"github.com/valkey-io/valkey-glide/go/v2/internal"
 	"github.com/valkey-io/valkey-glide/go/v2/models"
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -21,13 +24,10 @@ import (
 
 func checkResponseType(response *C.struct_CommandResponse, expectedType C.ResponseType, isNilable bool) error {
 	expectedTypeInt := uint32(expectedType)
-	expectedTypeStr := C.get_response_type_string(expectedTypeInt)
+	expectedTypeStr := C.GoString(C.get_response_type_string(expectedTypeInt))
 
 	if !isNilable && response == nil {
-		return fmt.Errorf(
-			"unexpected return type from Valkey: got nil, expected %s",
-			C.GoString(expectedTypeStr),
-		)
+		return NewConversionError(callerCommand(), expectedTypeStr, "nil", nil)
 	}
 
 	if isNilable && (response == nil || response.response_type == uint32(C.Null)) {
@@ -38,12 +38,127 @@ func checkResponseType(response *C.struct_CommandResponse, expectedType C.Respon
 		return nil
 	}
 
-	actualTypeStr := C.get_response_type_string(response.response_type)
-	return fmt.Errorf(
-		"unexpected return type from Valkey: got %s, expected %s",
-		C.GoString(actualTypeStr),
-		C.GoString(expectedTypeStr),
-	)
+	actualTypeStr := C.GoString(C.get_response_type_string(response.response_type))
+	value, err := parseInterface(response)
+	if err != nil {
+		value = nil
+	}
+	return NewConversionError(callerCommand(), expectedTypeStr, actualTypeStr, value)
+}
+
+// glidePackage prefixes the names of the functions of this package in stack traces.
+const glidePackage = "github.com/valkey-io/valkey-glide/go/v2."
+
+// callerCommand returns the name of the innermost exported client method on the call stack, such as Get, which names
+// the command whose response is being converted. It is only called once a conversion failed, so that the handlers don't
+// need to be told the command they convert the response of.
+func callerCommand() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if name, ok := strings.CutPrefix(frame.Function, glidePackage); ok {
+			// Methods are named like (*baseClient).Get or Batch.Get, and the closures they define like
+			// (*baseClient).Get.func1, while functions are named like handleStringResponse.
+			name = strings.ReplaceAll(name, "[...]", "")
+			if pointerMethod, ok := strings.CutPrefix(name, "(*"); ok {
+				_, name, _ = strings.Cut(pointerMethod, ").")
+			} else if _, method, isMethod := strings.Cut(name, "."); isMethod {
+				name = method
+			} else {
+				name = ""
+			}
+			method, _, _ := strings.Cut(name, ".")
+			if method != "" && unicode.IsUpper(rune(method[0])) {
+				return method
+			}
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+func typeName(value any) string {
+	if value == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// assertType returns a value of a parsed response as a T, or a ConversionError if it has another type.
+func assertType[T any](value any) (T, error) {
+	typed, ok := value.(T)
+	if !ok {
+		return typed, NewConversionError(callerCommand(), internal.GetType[T]().String(), typeName(value), value)
+	}
+	return typed, nil
+}
+
+// arrayElement returns the element at index idx of a parsed array as a T, or a ConversionError holding the array if it
+// is too short or if the element has another type.
+func arrayElement[T any](arr []any, idx int) (T, error) {
+	var typed T
+	if idx >= len(arr) {
+		return typed, NewConversionError(
+			callerCommand(),
+			fmt.Sprintf("array of at least %d elements", idx+1),
+			fmt.Sprintf("array of %d elements", len(arr)),
+			arr,
+		)
+	}
+	typed, ok := arr[idx].(T)
+	if !ok {
+		return typed, NewConversionError(
+			callerCommand(),
+			fmt.Sprintf("%v at index %d", internal.GetType[T](), idx),
+			typeName(arr[idx]),
+			arr,
+		)
+	}
+	return typed, nil
+}
+
+// mapField returns the value of a field of a parsed map as a T, or a ConversionError holding the map if the field is
+// missing or has another type.
+func mapField[T any](fields map[string]any, field string) (T, error) {
+	typed, ok := fields[field].(T)
+	if !ok {
+		return typed, NewConversionError(
+			callerCommand(),
+			fmt.Sprintf("%v field %q", internal.GetType[T](), field),
+			typeName(fields[field]),
+			fields,
+		)
+	}
+	return typed, nil
+}
+
+// convertWith applies a converter of the internal package to a parsed response. The converters assume the shape of the
+// response, so the runtime panics they raise on other shapes, such as failed type assertions or out of range indexes,
+// are returned as ConversionErrors.
+func convertWith[T any](converter func(data any) (any, error), data any) (result T, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			runtimeErr, ok := recovered.(runtime.Error)
+			if !ok {
+				panic(recovered)
+			}
+			var zero T
+			result = zero
+			err = NewConversionError(
+				callerCommand(),
+				internal.GetType[T]().String(),
+				fmt.Sprintf("malformed %s (%v)", typeName(data), runtimeErr),
+				data,
+			)
+		}
+	}()
+	converted, err := converter(data)
+	if err != nil {
+		return result, err
+	}
+	return assertType[T](converted)
 }
 
 func convertCharArrayToString(response *C.struct_CommandResponse, isNilable bool) (models.Result[string], error) {
@@ -193,7 +308,11 @@ func parseMap(response *C.struct_CommandResponse) (any, error) {
 		if err != nil {
 			return nil, err
 		}
-		value_map[res_key.(string)] = res_val
+		key, err := assertType[string](res_key)
+		if err != nil {
+			return nil, err
+		}
+		value_map[key] = res_val
 	}
 	return value_map, nil
 }
@@ -209,7 +328,11 @@ func parseSet(response *C.struct_CommandResponse) (any, error) {
 		if err != nil {
 			return nil, err
 		}
-		slice[res.(string)] = struct{}{}
+		member, err := assertType[string](res)
+		if err != nil {
+			return nil, err
+		}
+		slice[member] = struct{}{}
 	}
 
 	return slice, nil
@@ -233,18 +356,22 @@ func (node mapConverter[T]) convert(data any) (any, error) {
 		if node.canBeNil {
 			return nil, nil
 		} else {
-			return nil, fmt.Errorf("unexpected type received: nil, expected: map[string]%v", internal.GetType[T]())
+			return nil, NewConversionError(callerCommand(), fmt.Sprintf("map[string]%v", internal.GetType[T]()), "nil", nil)
 		}
 	}
+	mapData, err := assertType[map[string]any](data)
+	if err != nil {
+		return nil, err
+	}
 	result := make(map[string]T)
 
 	// Iterate over the map and convert each value to T
-	for key, value := range data.(map[string]any) {
+	for key, value := range mapData {
 		if node.next == nil {
 			// try direct conversion to T when there is no next converter
-			valueT, ok := value.(T)
-			if !ok {
-				return nil, fmt.Errorf("unexpected type of map element: %T, expected: %v", value, internal.GetType[T]())
+			valueT, err := mapField[T](mapData, key)
+			if err != nil {
+				return nil, err
 			}
 			result[key] = valueT
 		} else {
@@ -259,9 +386,9 @@ func (node mapConverter[T]) convert(data any) (any, error) {
 				continue
 			}
 			// convert to T
-			valueT, ok := val.(T)
-			if !ok {
-				return nil, fmt.Errorf("unexpected type of map element: %T, expected: %v", val, internal.GetType[T]())
+			valueT, err := assertType[T](val)
+			if err != nil {
+				return nil, err
 			}
 			result[key] = valueT
 		}
@@ -281,20 +408,23 @@ func (node arrayConverter[T]) convert(data any) (any, error) {
 		if node.canBeNil {
 			return nil, nil
 		} else {
-			return nil, fmt.Errorf("unexpected type received: nil, expected: []%v", internal.GetType[T]())
+			return nil, NewConversionError(callerCommand(), fmt.Sprintf("[]%v", internal.GetType[T]()), "nil", nil)
 		}
 	}
-	arrData := data.([]any)
+	arrData, err := assertType[[]any](data)
+	if err != nil {
+		return nil, err
+	}
 	result := make([]T, 0, len(arrData))
-	for _, value := range arrData {
+	for idx := range arrData {
 		if node.next == nil {
-			valueT, ok := value.(T)
-			if !ok {
-				return nil, fmt.Errorf("unexpected type of array element: %T, expected: %v", value, internal.GetType[T]())
+			valueT, err := arrayElement[T](arrData, idx)
+			if err != nil {
+				return nil, err
 			}
 			result = append(result, valueT)
 		} else {
-			val, err := node.next.convert(value)
+			val, err := node.next.convert(arrData[idx])
 			if err != nil {
 				return nil, err
 			}
@@ -303,9 +433,9 @@ func (node arrayConverter[T]) convert(data any) (any, error) {
 				result = append(result, null)
 				continue
 			}
-			valueT, ok := val.(T)
-			if !ok {
-				return nil, fmt.Errorf("unexpected type of array element: %T, expected: %v", val, internal.GetType[T]())
+			valueT, err := assertType[T](val)
+			if err != nil {
+				return nil, err
 			}
 			result = append(result, valueT)
 		}
@@ -315,7 +445,7 @@ func (node arrayConverter[T]) convert(data any) (any, error) {
 }
 
 func handleAnyArrayOrNilResponse(response *C.struct_CommandResponse) ([]any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -337,26 +467,26 @@ func handleAnyArrayOrNilResponse(response *C.struct_CommandResponse) ([]any, err
 }
 
 func handleInterfaceResponse(response *C.struct_CommandResponse) (any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return parseInterface(response)
 }
 
 func handleStringResponse(response *C.struct_CommandResponse) (string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	res, err := convertCharArrayToString(response, false)
 	return res.Value(), err
 }
 
 func handleStringOrNilResponse(response *C.struct_CommandResponse) (models.Result[string], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertCharArrayToString(response, true)
 }
 
 func handleOkResponse(response *C.struct_CommandResponse) (string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Ok, false)
 	if typeErr != nil {
@@ -366,10 +496,49 @@ func handleOkResponse(response *C.struct_CommandResponse) (string, error) {
 	return "OK", nil
 }
 
+// handleShutdownResponse handles the outcome of SHUTDOWN. A server that shuts down closes the connection instead of
+// replying, so a disconnection counts as success unless the shutdown was being aborted.
+func handleShutdownResponse(response *C.struct_CommandResponse, err error, abort bool) (string, error) {
+	if err != nil {
+		var disconnectErr *DisconnectError
+		if !abort && errors.As(err, &disconnectErr) {
+			return "OK", nil
+		}
+		return models.DefaultStringResponse, err
+	}
+	return handleOkOrMultiNodeOkResponse(response)
+}
+
+// handleOkOrMultiNodeOkResponse handles an OK response, or the map of OK responses per node returned for commands without
+// an aggregation policy that were routed to multiple nodes.
+func handleOkOrMultiNodeOkResponse(response *C.struct_CommandResponse) (string, error) {
+	if response == nil || response.response_type != uint32(C.Map) {
+		return handleOkResponse(response)
+	}
+	defer freeCommandResponse(response)
+
+	data, err := parseMap(response)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	nodes, err := assertType[map[string]any](data)
+	if err != nil {
+		return models.DefaultStringResponse, err
+	}
+	for node, value := range nodes {
+		if value != "OK" {
+			return models.DefaultStringResponse, NewConversionError(
+				callerCommand(), "OK", fmt.Sprintf("%v from node %s", value, node), nodes,
+			)
+		}
+	}
+	return "OK", nil
+}
+
 func handleOkOrStringOrNilResponse(response *C.struct_CommandResponse) (models.Result[string], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
-	if response.response_type == uint32(C.Ok) {
+	if response != nil && response.response_type == uint32(C.Ok) {
 		return models.CreateStringResult("OK"), nil
 	}
 
@@ -377,7 +546,7 @@ func handleOkOrStringOrNilResponse(response *C.struct_CommandResponse) (models.R
 }
 
 func handle2DStringArrayResponse(response *C.struct_CommandResponse) ([][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -404,7 +573,7 @@ func handle2DStringArrayResponse(response *C.struct_CommandResponse) ([][]string
 }
 
 func handle2DFloat64OrNullArrayResponse(response *C.struct_CommandResponse) ([][]float64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -431,13 +600,13 @@ func handle2DFloat64OrNullArrayResponse(response *C.struct_CommandResponse) ([][
 }
 
 func handleAnyResponse(response *C.struct_CommandResponse) (any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return parseInterface(response)
 }
 
-func handleLocationArrayResponse(response *C.struct_CommandResponse) ([]options.Location, error) {
-	defer C.free_command_response(response)
+func handleLocationArrayResponse(response *C.struct_CommandResponse, unit constants.GeoUnit) ([]options.Location, error) {
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -450,11 +619,23 @@ func handleLocationArrayResponse(response *C.struct_CommandResponse) ([]options.
 		if err != nil {
 			return nil, err
 		}
+		locationArray, err := assertType[[]any](responseArray)
+		if err != nil {
+			return nil, err
+		}
+		name, err := arrayElement[string](locationArray, 0)
+		if err != nil {
+			return nil, err
+		}
 		location := options.Location{
-			Name: responseArray.([]any)[0].(string),
+			Name: name,
+			Unit: unit,
 		}
 
-		additionalData := responseArray.([]any)[1].([]any)
+		additionalData, err := arrayElement[[]any](locationArray, 1)
+		if err != nil {
+			return nil, err
+		}
 		for _, value := range additionalData {
 			if v, ok := value.(float64); ok {
 				location.Dist = v
@@ -463,10 +644,15 @@ func handleLocationArrayResponse(response *C.struct_CommandResponse) ([]options.
 				location.Hash = v
 			}
 			if coordArray, ok := value.([]any); ok {
-				location.Coord = options.GeospatialData{
-					Longitude: coordArray[0].(float64),
-					Latitude:  coordArray[1].(float64),
+				longitude, err := arrayElement[float64](coordArray, 0)
+				if err != nil {
+					return nil, err
+				}
+				latitude, err := arrayElement[float64](coordArray, 1)
+				if err != nil {
+					return nil, err
 				}
+				location.Coord = options.GeospatialData{Longitude: longitude, Latitude: latitude}
 			}
 		}
 		slice = append(slice, location)
@@ -476,25 +662,25 @@ func handleLocationArrayResponse(response *C.struct_CommandResponse) ([]options.
 }
 
 func handleStringOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.Result[string], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertStringOrNilArray(response)
 }
 
 func handleStringArrayResponse(response *C.struct_CommandResponse) ([]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertStringArray(response, false)
 }
 
 func handleStringArrayOrNilResponse(response *C.struct_CommandResponse) ([]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	return convertStringArray(response, true)
 }
 
 func handleIntResponse(response *C.struct_CommandResponse) (int64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Int, false)
 	if typeErr != nil {
@@ -505,7 +691,7 @@ func handleIntResponse(response *C.struct_CommandResponse) (int64, error) {
 }
 
 func handleIntOrNilResponse(response *C.struct_CommandResponse) (models.Result[int64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Int, true)
 	if typeErr != nil {
@@ -520,7 +706,7 @@ func handleIntOrNilResponse(response *C.struct_CommandResponse) (models.Result[i
 }
 
 func handleIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -539,7 +725,7 @@ func handleIntArrayResponse(response *C.struct_CommandResponse) ([]int64, error)
 }
 
 func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.Result[int64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -565,7 +751,7 @@ func handleIntOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.R
 }
 
 func handleFloatResponse(response *C.struct_CommandResponse) (float64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Float, false)
 	if typeErr != nil {
@@ -576,7 +762,7 @@ func handleFloatResponse(response *C.struct_CommandResponse) (float64, error) {
 }
 
 func handleFloatOrNilResponse(response *C.struct_CommandResponse) (models.Result[float64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Float, true)
 	if typeErr != nil {
@@ -590,7 +776,7 @@ func handleFloatOrNilResponse(response *C.struct_CommandResponse) (models.Result
 
 // elements in the array could be `null`, but array isn't
 func handleFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]models.Result[float64], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -618,7 +804,7 @@ func handleFloatOrNilArrayResponse(response *C.struct_CommandResponse) ([]models
 func handleRankAndScoreOrNilResponse(
 	response *C.struct_CommandResponse,
 ) (models.Result[models.RankAndScore], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -644,7 +830,7 @@ func handleRankAndScoreOrNilResponse(
 }
 
 func handleBoolResponse(response *C.struct_CommandResponse) (bool, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Bool, false)
 	if typeErr != nil {
@@ -655,7 +841,7 @@ func handleBoolResponse(response *C.struct_CommandResponse) (bool, error) {
 }
 
 func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -674,7 +860,7 @@ func handleBoolArrayResponse(response *C.struct_CommandResponse) ([]bool, error)
 }
 
 func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[string]float64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -685,11 +871,9 @@ func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[stri
 	if err != nil {
 		return nil, err
 	}
-	aMap := data.(map[string]any)
-
 	converted, err := mapConverter[float64]{
 		nil, false,
-	}.convert(aMap)
+	}.convert(data)
 	if err != nil {
 		return nil, err
 	}
@@ -700,8 +884,44 @@ func handleStringDoubleMapResponse(response *C.struct_CommandResponse) (map[stri
 	return result, nil
 }
 
+// parseBackgroundTaskStatus parses the reply of BGSAVE or BGREWRITEAOF, e.g. "Background saving started".
+func parseBackgroundTaskStatus(reply string) (models.BackgroundTaskStatus, error) {
+	switch {
+	case strings.HasSuffix(reply, "started"):
+		return models.BackgroundTaskStarted, nil
+	case strings.HasSuffix(reply, "scheduled"):
+		return models.BackgroundTaskScheduled, nil
+	default:
+		return models.BackgroundTaskStarted, fmt.Errorf("unexpected background task reply: %s", reply)
+	}
+}
+
+func handleBackgroundTaskResponse(response *C.struct_CommandResponse) (models.BackgroundTaskStatus, error) {
+	reply, err := handleStringResponse(response)
+	if err != nil {
+		return models.BackgroundTaskStarted, err
+	}
+	return parseBackgroundTaskStatus(reply)
+}
+
+func handleBackgroundTaskMapResponse(
+	response *C.struct_CommandResponse,
+) (map[string]models.BackgroundTaskStatus, error) {
+	replies, err := handleStringToStringMapResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]models.BackgroundTaskStatus, len(replies))
+	for node, reply := range replies {
+		if result[node], err = parseBackgroundTaskStatus(reply); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[string]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -712,11 +932,9 @@ func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[st
 	if err != nil {
 		return nil, err
 	}
-	aMap := data.(map[string]any)
-
 	converted, err := mapConverter[string]{
 		nil, false,
-	}.convert(aMap)
+	}.convert(data)
 	if err != nil {
 		return nil, err
 	}
@@ -728,7 +946,7 @@ func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[st
 }
 
 func handleStringToStringOrNilMapResponse(response *C.struct_CommandResponse) (map[string]models.Result[string], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -739,7 +957,10 @@ func handleStringToStringOrNilMapResponse(response *C.struct_CommandResponse) (m
 	if err != nil {
 		return nil, err
 	}
-	aMap := data.(map[string]any)
+	aMap, err := assertType[map[string]any](data)
+	if err != nil {
+		return nil, err
+	}
 	result := map[string]models.Result[string]{}
 
 	// Transform into Result[string]
@@ -748,7 +969,11 @@ func handleStringToStringOrNilMapResponse(response *C.struct_CommandResponse) (m
 			result[nodeAddr] = models.CreateNilStringResult()
 			continue
 		}
-		result[nodeAddr] = models.CreateStringResult(nodeData.(string))
+		value, err := mapField[string](aMap, nodeAddr)
+		if err != nil {
+			return nil, err
+		}
+		result[nodeAddr] = models.CreateStringResult(value)
 	}
 
 	return result, nil
@@ -757,7 +982,7 @@ func handleStringToStringOrNilMapResponse(response *C.struct_CommandResponse) (m
 func handleStringToStringArrayMapOrNilResponse(
 	response *C.struct_CommandResponse,
 ) (map[string][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, true)
 	if typeErr != nil {
@@ -792,7 +1017,7 @@ func handleStringToStringArrayMapOrNilResponse(
 func handleKeyValuesArrayOrNilResponse(
 	response *C.struct_CommandResponse,
 ) ([]models.KeyValues, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, true)
 	if typeErr != nil {
@@ -812,7 +1037,7 @@ func handleKeyValuesArrayOrNilResponse(
 }
 
 func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]struct{}, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Sets, false)
 	if typeErr != nil {
@@ -834,7 +1059,7 @@ func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]str
 func handleKeyWithMemberAndScoreResponse(
 	response *C.struct_CommandResponse,
 ) (models.Result[models.KeyWithMemberAndScore], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	if response == nil || response.response_type == uint32(C.Null) {
 		return models.CreateNilKeyWithMemberAndScoreResult(), nil
@@ -850,19 +1075,31 @@ func handleKeyWithMemberAndScoreResponse(
 		return models.CreateNilKeyWithMemberAndScoreResult(), err
 	}
 
-	arr := slice.([]any)
-	key := arr[0].(string)
-	member := arr[1].(string)
-	score := arr[2].(float64)
+	arr, err := assertType[[]any](slice)
+	if err != nil {
+		return models.CreateNilKeyWithMemberAndScoreResult(), err
+	}
+	key, err := arrayElement[string](arr, 0)
+	if err != nil {
+		return models.CreateNilKeyWithMemberAndScoreResult(), err
+	}
+	member, err := arrayElement[string](arr, 1)
+	if err != nil {
+		return models.CreateNilKeyWithMemberAndScoreResult(), err
+	}
+	score, err := arrayElement[float64](arr, 2)
+	if err != nil {
+		return models.CreateNilKeyWithMemberAndScoreResult(), err
+	}
 	return models.CreateKeyWithMemberAndScoreResult(models.KeyWithMemberAndScore{Key: key, Member: member, Score: score}), nil
 }
 
 func handleKeyWithArrayOfMembersAndScoresResponse(
 	response *C.struct_CommandResponse,
 ) (models.Result[models.KeyWithArrayOfMembersAndScores], error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
-	if response.response_type == uint32(C.Null) {
+	if response == nil || response.response_type == uint32(C.Null) {
 		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), nil
 	}
 
@@ -876,12 +1113,22 @@ func handleKeyWithArrayOfMembersAndScoresResponse(
 		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
 	}
 
-	arr := slice.([]any)
-	key := arr[0].(string)
+	arr, err := assertType[[]any](slice)
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+	key, err := arrayElement[string](arr, 0)
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
+	membersAndScores, err := arrayElement[map[string]any](arr, 1)
+	if err != nil {
+		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
+	}
 	converted, err := mapConverter[float64]{
 		nil,
 		false,
-	}.convert(arr[1])
+	}.convert(membersAndScores)
 	if err != nil {
 		return models.CreateNilKeyWithArrayOfMembersAndScoresResult(), err
 	}
@@ -904,7 +1151,7 @@ func handleKeyWithArrayOfMembersAndScoresResponse(
 }
 
 func handleMemberAndScoreArrayResponse(response *C.struct_CommandResponse) ([]models.MemberAndScore, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -916,16 +1163,31 @@ func handleMemberAndScoreArrayResponse(response *C.struct_CommandResponse) ([]mo
 		return nil, err
 	}
 
+	pairs, err := assertType[[]any](slice)
+	if err != nil {
+		return nil, err
+	}
 	var result []models.MemberAndScore
-	for _, arr := range slice.([]any) {
-		pair := arr.([]any)
-		result = append(result, models.MemberAndScore{Member: pair[0].(string), Score: pair[1].(float64)})
+	for idx := range pairs {
+		pair, err := arrayElement[[]any](pairs, idx)
+		if err != nil {
+			return nil, err
+		}
+		member, err := arrayElement[string](pair, 0)
+		if err != nil {
+			return nil, err
+		}
+		score, err := arrayElement[float64](pair, 1)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, models.MemberAndScore{Member: member, Score: score})
 	}
 	return result, nil
 }
 
 func handleScanResponse(response *C.struct_CommandResponse) (models.ScanResult, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -937,12 +1199,11 @@ func handleScanResponse(response *C.struct_CommandResponse) (models.ScanResult,
 		return models.ScanResult{}, err
 	}
 
-	res, err := internal.ConvertScanResult(slice)
-	return res.(models.ScanResult), err
+	return convertWith[models.ScanResult](internal.ConvertScanResult, slice)
 }
 
 func handleXClaimResponse(response *C.struct_CommandResponse) (map[string]models.XClaimResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -954,14 +1215,13 @@ func handleXClaimResponse(response *C.struct_CommandResponse) (map[string]models
 	}
 
 	// Convert the raw response to the structured XClaimResponse format
-	res, err := internal.ConvertXClaimResponse(data)
-	return res.(map[string]models.XClaimResponse), err
+	return convertWith[map[string]models.XClaimResponse](internal.ConvertXClaimResponse, data)
 }
 
 func handleXRangeResponse(response *C.struct_CommandResponse, reverse bool) ([]models.StreamEntry, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
-	if response.response_type == uint32(C.Null) {
+	if response == nil || response.response_type == uint32(C.Null) {
 		return nil, nil
 	}
 
@@ -974,12 +1234,11 @@ func handleXRangeResponse(response *C.struct_CommandResponse, reverse bool) ([]m
 		return nil, err
 	}
 
-	res, err := internal.MakeConvertStreamEntryArray(reverse)(mapData)
-	return res.([]models.StreamEntry), err
+	return convertWith[[]models.StreamEntry](internal.MakeConvertStreamEntryArray(reverse), mapData)
 }
 
 func handleXAutoClaimResponse(response *C.struct_CommandResponse) (models.XAutoClaimResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	var null models.XAutoClaimResponse // default response
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -990,12 +1249,11 @@ func handleXAutoClaimResponse(response *C.struct_CommandResponse) (models.XAutoC
 		return null, err
 	}
 
-	res, err := internal.ConvertXAutoClaimResponse(slice)
-	return res.(models.XAutoClaimResponse), err
+	return convertWith[models.XAutoClaimResponse](internal.ConvertXAutoClaimResponse, slice)
 }
 
 func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (models.XAutoClaimJustIdResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	var null models.XAutoClaimJustIdResponse // default response
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1005,10 +1263,19 @@ func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (models.
 	if err != nil {
 		return null, err
 	}
-	arr := slice.([]any)
+	arr, err := assertType[[]any](slice)
+	if err != nil {
+		return null, err
+	}
 	len := len(arr)
 	if len < 2 || len > 3 {
-		return null, fmt.Errorf("unexpected response array length: %d", len)
+		return null, NewConversionError(
+			callerCommand(), "array of 2 or 3 elements", fmt.Sprintf("array of %d elements", len), arr,
+		)
+	}
+	nextEntry, err := arrayElement[string](arr, 0)
+	if err != nil {
+		return null, err
 	}
 	converted, err := arrayConverter[string]{
 		nil,
@@ -1037,14 +1304,14 @@ func handleXAutoClaimJustIdResponse(response *C.struct_CommandResponse) (models.
 		}
 	}
 	return models.XAutoClaimJustIdResponse{
-		NextEntry:       arr[0].(string),
+		NextEntry:       nextEntry,
 		ClaimedEntries:  claimedEntries,
 		DeletedMessages: deletedMessages,
 	}, nil
 }
 
 func handleStreamResponse(response *C.struct_CommandResponse) (map[string]models.StreamResponse, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	data, err := parseMap(response)
 	if err != nil {
 		return nil, err
@@ -1053,12 +1320,11 @@ func handleStreamResponse(response *C.struct_CommandResponse) (map[string]models
 		return nil, nil
 	}
 
-	res, err := internal.ConvertXReadResponse(data)
-	return res.(map[string]models.StreamResponse), err
+	return convertWith[map[string]models.StreamResponse](internal.ConvertXReadResponse, data)
 }
 
 func handleXPendingSummaryResponse(response *C.struct_CommandResponse) (models.XPendingSummary, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, true)
 	if typeErr != nil {
@@ -1070,47 +1336,77 @@ func handleXPendingSummaryResponse(response *C.struct_CommandResponse) (models.X
 		return models.CreateNilXPendingSummary(), err
 	}
 
-	arr := slice.([]any)
-	NumOfMessages := arr[0].(int64)
+	arr, err := assertType[[]any](slice)
+	if err != nil {
+		return models.CreateNilXPendingSummary(), err
+	}
+	NumOfMessages, err := arrayElement[int64](arr, 0)
+	if err != nil {
+		return models.CreateNilXPendingSummary(), err
+	}
 	var StartId, EndId models.Result[string]
-	if arr[1] == nil {
-		StartId = models.CreateNilStringResult()
-	} else {
-		StartId = models.CreateStringResult(arr[1].(string))
+	if StartId, err = nilableStringElement(arr, 1); err != nil {
+		return models.CreateNilXPendingSummary(), err
 	}
-	if arr[2] == nil {
-		EndId = models.CreateNilStringResult()
-	} else {
-		EndId = models.CreateStringResult(arr[2].(string))
-	}
-
-	if pendingMessages, ok := arr[3].([]any); ok {
-		var ConsumerPendingMessages []models.ConsumerPendingMessage
-		for _, msg := range pendingMessages {
-			consumerMessage := msg.([]any)
-			count, err := strconv.ParseInt(consumerMessage[1].(string), 10, 64)
-			if err == nil {
-				ConsumerPendingMessages = append(ConsumerPendingMessages, models.ConsumerPendingMessage{
-					ConsumerName: consumerMessage[0].(string),
-					MessageCount: count,
-				})
+	if EndId, err = nilableStringElement(arr, 2); err != nil {
+		return models.CreateNilXPendingSummary(), err
+	}
+
+	ConsumerPendingMessages := make([]models.ConsumerPendingMessage, 0)
+	if len(arr) > 3 && arr[3] != nil {
+		pendingMessages, err := arrayElement[[]any](arr, 3)
+		if err != nil {
+			return models.CreateNilXPendingSummary(), err
+		}
+		for idx := range pendingMessages {
+			consumerMessage, err := arrayElement[[]any](pendingMessages, idx)
+			if err != nil {
+				return models.CreateNilXPendingSummary(), err
+			}
+			consumerName, err := arrayElement[string](consumerMessage, 0)
+			if err != nil {
+				return models.CreateNilXPendingSummary(), err
 			}
+			countStr, err := arrayElement[string](consumerMessage, 1)
+			if err != nil {
+				return models.CreateNilXPendingSummary(), err
+			}
+			count, err := strconv.ParseInt(countStr, 10, 64)
+			if err != nil {
+				return models.CreateNilXPendingSummary(), NewConversionError(
+					callerCommand(), "message count at index 1", fmt.Sprintf("%q", countStr), consumerMessage,
+				)
+			}
+			ConsumerPendingMessages = append(ConsumerPendingMessages, models.ConsumerPendingMessage{
+				ConsumerName: consumerName,
+				MessageCount: count,
+			})
 		}
-		return models.XPendingSummary{
-			NumOfMessages:    NumOfMessages,
-			StartId:          StartId,
-			EndId:            EndId,
-			ConsumerMessages: ConsumerPendingMessages,
-		}, nil
-	} else {
-		return models.XPendingSummary{NumOfMessages: NumOfMessages, StartId: StartId, EndId: EndId, ConsumerMessages: make([]models.ConsumerPendingMessage, 0)}, nil
 	}
+	return models.XPendingSummary{
+		NumOfMessages:    NumOfMessages,
+		StartId:          StartId,
+		EndId:            EndId,
+		ConsumerMessages: ConsumerPendingMessages,
+	}, nil
+}
+
+// nilableStringElement returns the element at index idx of a parsed array, which is either a string or nil.
+func nilableStringElement(arr []any, idx int) (models.Result[string], error) {
+	if idx < len(arr) && arr[idx] == nil {
+		return models.CreateNilStringResult(), nil
+	}
+	value, err := arrayElement[string](arr, idx)
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return models.CreateStringResult(value), nil
 }
 
 func handleXPendingDetailResponse(response *C.struct_CommandResponse) ([]models.XPendingDetail, error) {
 	// response should be [][]any
 
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	// TODO: Not sure if this is correct for a nill response
 	if response == nil || response.response_type == uint32(C.Null) {
@@ -1124,37 +1420,42 @@ func handleXPendingDetailResponse(response *C.struct_CommandResponse) ([]models.
 
 	// parse first level of array
 	slice, err := parseArray(response)
-	arr := slice.([]any)
-
+	if err != nil {
+		return make([]models.XPendingDetail, 0), err
+	}
+	arr, err := assertType[[]any](slice)
 	if err != nil {
 		return make([]models.XPendingDetail, 0), err
 	}
 
 	pendingDetails := make([]models.XPendingDetail, 0, len(arr))
 
-	for _, message := range arr {
-		switch detail := message.(type) {
-		case []any:
-			pDetail := models.XPendingDetail{
-				Id:            detail[0].(string),
-				ConsumerName:  detail[1].(string),
-				IdleTime:      detail[2].(int64),
-				DeliveryCount: detail[3].(int64),
-			}
-			pendingDetails = append(pendingDetails, pDetail)
-
-		case models.XPendingDetail:
-			pendingDetails = append(pendingDetails, detail)
-		default:
-			fmt.Printf("handleXPendingDetailResponse - unhandled type: %s\n", reflect.TypeOf(detail))
+	for idx := range arr {
+		detail, err := arrayElement[[]any](arr, idx)
+		if err != nil {
+			return make([]models.XPendingDetail, 0), err
 		}
+		var pDetail models.XPendingDetail
+		if pDetail.Id, err = arrayElement[string](detail, 0); err != nil {
+			return make([]models.XPendingDetail, 0), err
+		}
+		if pDetail.ConsumerName, err = arrayElement[string](detail, 1); err != nil {
+			return make([]models.XPendingDetail, 0), err
+		}
+		if pDetail.IdleTime, err = arrayElement[int64](detail, 2); err != nil {
+			return make([]models.XPendingDetail, 0), err
+		}
+		if pDetail.DeliveryCount, err = arrayElement[int64](detail, 3); err != nil {
+			return make([]models.XPendingDetail, 0), err
+		}
+		pendingDetails = append(pendingDetails, pDetail)
 	}
 
 	return pendingDetails, nil
 }
 
 func handleXInfoConsumersResponse(response *C.struct_CommandResponse) ([]models.XInfoConsumerInfo, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1179,10 +1480,15 @@ func handleXInfoConsumersResponse(response *C.struct_CommandResponse) ([]models.
 	result := make([]models.XInfoConsumerInfo, 0, len(arr))
 
 	for _, group := range arr {
-		info := models.XInfoConsumerInfo{
-			Name:    group["name"].(string),
-			Pending: group["pending"].(int64),
-			Idle:    group["idle"].(int64),
+		var info models.XInfoConsumerInfo
+		if info.Name, err = mapField[string](group, "name"); err != nil {
+			return nil, err
+		}
+		if info.Pending, err = mapField[int64](group, "pending"); err != nil {
+			return nil, err
+		}
+		if info.Idle, err = mapField[int64](group, "idle"); err != nil {
+			return nil, err
 		}
 		switch inactive := group["inactive"].(type) {
 		case int64:
@@ -1197,7 +1503,7 @@ func handleXInfoConsumersResponse(response *C.struct_CommandResponse) ([]models.
 }
 
 func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]models.XInfoGroupInfo, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Array, false)
 	if typeErr != nil {
@@ -1222,11 +1528,18 @@ func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]models.XIn
 	result := make([]models.XInfoGroupInfo, 0, len(arr))
 
 	for _, group := range arr {
-		info := models.XInfoGroupInfo{
-			Name:            group["name"].(string),
-			Consumers:       group["consumers"].(int64),
-			Pending:         group["pending"].(int64),
-			LastDeliveredId: group["last-delivered-id"].(string),
+		var info models.XInfoGroupInfo
+		if info.Name, err = mapField[string](group, "name"); err != nil {
+			return nil, err
+		}
+		if info.Consumers, err = mapField[int64](group, "consumers"); err != nil {
+			return nil, err
+		}
+		if info.Pending, err = mapField[int64](group, "pending"); err != nil {
+			return nil, err
+		}
+		if info.LastDeliveredId, err = mapField[string](group, "last-delivered-id"); err != nil {
+			return nil, err
 		}
 		switch lag := group["lag"].(type) {
 		case int64:
@@ -1247,7 +1560,7 @@ func handleXInfoGroupsResponse(response *C.struct_CommandResponse) ([]models.XIn
 }
 
 func handleStringToAnyMapResponse(response *C.struct_CommandResponse) (map[string]any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -1258,7 +1571,7 @@ func handleStringToAnyMapResponse(response *C.struct_CommandResponse) (map[strin
 	if err != nil {
 		return nil, err
 	}
-	return result.(map[string]any), nil
+	return assertType[map[string]any](result)
 }
 
 func handleLCSMatchResponse(
@@ -1314,7 +1627,7 @@ func handleLCSMatchResponse(
 }
 
 func handleRawStringArrayMapResponse(response *C.struct_CommandResponse) (map[string][]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1341,7 +1654,7 @@ func handleRawStringArrayMapResponse(response *C.struct_CommandResponse) (map[st
 }
 
 func handleMapOfStringMapResponse(response *C.struct_CommandResponse) (map[string]map[string]string, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
 		return nil, typeErr
@@ -1390,7 +1703,7 @@ func handleTimeClusterResponse(response *C.struct_CommandResponse) (models.Clust
 }
 
 func handleStringIntMapResponse(response *C.struct_CommandResponse) (map[string]int64, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -1401,11 +1714,9 @@ func handleStringIntMapResponse(response *C.struct_CommandResponse) (map[string]
 	if err != nil {
 		return nil, err
 	}
-	aMap := data.(map[string]any)
-
 	converted, err := mapConverter[int64]{
 		nil, false,
-	}.convert(aMap)
+	}.convert(data)
 	if err != nil {
 		return nil, err
 	}
@@ -1428,8 +1739,13 @@ func handleFunctionStatsResponse(response *C.struct_CommandResponse) (map[string
 
 	result := make(map[string]models.FunctionStatsResult)
 
+	nodes, err := assertType[map[string]any](data)
+	if err != nil {
+		return nil, err
+	}
+
 	// Process all nodes in the response
-	for nodeAddr, nodeData := range data.(map[string]any) {
+	for nodeAddr, nodeData := range nodes {
 		nodeMap, ok := nodeData.(map[string]any)
 		if !ok {
 			continue // Skip if nodeData is not a map, e.g. when there isn't a running script
@@ -1440,10 +1756,12 @@ func handleFunctionStatsResponse(response *C.struct_CommandResponse) (map[string
 		if enginesMap, ok := nodeMap["engines"].(map[string]any); ok {
 			for engineName, engineData := range enginesMap {
 				if engineMap, ok := engineData.(map[string]any); ok {
-					engine := models.Engine{
-						Language:      engineName,
-						FunctionCount: engineMap["functions_count"].(int64),
-						LibraryCount:  engineMap["libraries_count"].(int64),
+					engine := models.Engine{Language: engineName}
+					if engine.FunctionCount, err = mapField[int64](engineMap, "functions_count"); err != nil {
+						return nil, err
+					}
+					if engine.LibraryCount, err = mapField[int64](engineMap, "libraries_count"); err != nil {
+						return nil, err
 					}
 					engines[engineName] = engine
 				}
@@ -1454,12 +1772,22 @@ func handleFunctionStatsResponse(response *C.struct_CommandResponse) (map[string
 		var runningScript models.RunningScript
 		if scriptData := nodeMap["running_script"]; scriptData != nil {
 			if scriptMap, ok := scriptData.(map[string]any); ok {
-				runningScript = models.RunningScript{
-					Name:     scriptMap["name"].(string),
-					Cmd:      scriptMap["command"].(string),
-					Args:     scriptMap["arguments"].([]string),
-					Duration: time.Duration(scriptMap["duration_ms"].(int64)) * time.Millisecond,
+				if runningScript.Name, err = mapField[string](scriptMap, "name"); err != nil {
+					return nil, err
 				}
+				if runningScript.Cmd, err = mapField[string](scriptMap, "command"); err != nil {
+					return nil, err
+				}
+				args, err := arrayConverter[string]{}.convert(scriptMap["arguments"])
+				if err != nil {
+					return nil, err
+				}
+				runningScript.Args = args.([]string)
+				durationMs, err := mapField[int64](scriptMap, "duration_ms")
+				if err != nil {
+					return nil, err
+				}
+				runningScript.Duration = time.Duration(durationMs) * time.Millisecond
 			}
 		}
 
@@ -1472,9 +1800,13 @@ func handleFunctionStatsResponse(response *C.struct_CommandResponse) (map[string
 	return result, nil
 }
 
-func parseFunctionInfo(items any) []models.FunctionInfo {
-	result := make([]models.FunctionInfo, 0, len(items.([]any)))
-	for _, item := range items.([]any) {
+func parseFunctionInfo(items any) ([]models.FunctionInfo, error) {
+	functions, err := assertType[[]any](items)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.FunctionInfo, 0, len(functions))
+	for _, item := range functions {
 		if function, ok := item.(map[string]any); ok {
 			// Handle nullable description
 			var description string
@@ -1490,27 +1822,37 @@ func parseFunctionInfo(items any) []models.FunctionInfo {
 				}
 			}
 
+			name, err := mapField[string](function, "name")
+			if err != nil {
+				return nil, err
+			}
 			result = append(result, models.FunctionInfo{
-				Name:        function["name"].(string),
+				Name:        name,
 				Description: description,
 				Flags:       flags,
 			})
 		}
 	}
-	return result
+	return result, nil
 }
 
-func parseLibraryInfo(itemMap map[string]any) models.LibraryInfo {
-	libraryInfo := models.LibraryInfo{
-		Name:      itemMap["library_name"].(string),
-		Engine:    itemMap["engine"].(string),
-		Functions: parseFunctionInfo(itemMap["functions"]),
+func parseLibraryInfo(itemMap map[string]any) (models.LibraryInfo, error) {
+	var libraryInfo models.LibraryInfo
+	var err error
+	if libraryInfo.Name, err = mapField[string](itemMap, "library_name"); err != nil {
+		return libraryInfo, err
+	}
+	if libraryInfo.Engine, err = mapField[string](itemMap, "engine"); err != nil {
+		return libraryInfo, err
+	}
+	if libraryInfo.Functions, err = parseFunctionInfo(itemMap["functions"]); err != nil {
+		return libraryInfo, err
 	}
 	// Handle optional library_code field
 	if code, ok := itemMap["library_code"].(string); ok {
 		libraryInfo.Code = code
 	}
-	return libraryInfo
+	return libraryInfo, nil
 }
 
 func handleFunctionListResponse(response *C.struct_CommandResponse) ([]models.LibraryInfo, error) {
@@ -1522,10 +1864,18 @@ func handleFunctionListResponse(response *C.struct_CommandResponse) ([]models.Li
 	if err != nil {
 		return nil, err
 	}
-	result := make([]models.LibraryInfo, 0, len(data.([]any)))
-	for _, item := range data.([]any) {
+	items, err := assertType[[]any](data)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.LibraryInfo, 0, len(items))
+	for _, item := range items {
 		if itemMap, ok := item.(map[string]any); ok {
-			result = append(result, parseLibraryInfo(itemMap))
+			libraryInfo, err := parseLibraryInfo(itemMap)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, libraryInfo)
 		}
 	}
 	return result, nil
@@ -1544,7 +1894,11 @@ func handleFunctionListMultiNodeResponse(response *C.struct_CommandResponse) (ma
 			libs := make([]models.LibraryInfo, 0, len(nodeArray))
 			for _, item := range nodeArray {
 				if itemMap, ok := item.(map[string]any); ok {
-					libs = append(libs, parseLibraryInfo(itemMap))
+					libraryInfo, err := parseLibraryInfo(itemMap)
+					if err != nil {
+						return nil, err
+					}
+					libs = append(libs, libraryInfo)
 				}
 			}
 			multiNodeLibs[node] = libs
@@ -1554,7 +1908,7 @@ func handleFunctionListMultiNodeResponse(response *C.struct_CommandResponse) (ma
 }
 
 func handleSortedSetWithScoresResponse(response *C.struct_CommandResponse, reverse bool) ([]models.MemberAndScore, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -1565,11 +1919,9 @@ func handleSortedSetWithScoresResponse(response *C.struct_CommandResponse, rever
 	if err != nil {
 		return nil, err
 	}
-	aMap := data.(map[string]any)
-
 	converted, err := mapConverter[float64]{
 		nil, false,
-	}.convert(aMap)
+	}.convert(data)
 	if err != nil {
 		return nil, err
 	}
@@ -1604,7 +1956,7 @@ func handleSortedSetWithScoresResponse(response *C.struct_CommandResponse, rever
 }
 
 func handleXInfoStreamCResponse(response *C.struct_CommandResponse) (any, error) {
-	defer C.free_command_response(response)
+	defer freeCommandResponse(response)
 
 	typeErr := checkResponseType(response, C.Map, false)
 	if typeErr != nil {
@@ -1619,8 +1971,7 @@ func handleXInfoStreamResponse(response *C.struct_CommandResponse) (models.XInfo
 	if err != nil {
 		return models.XInfoStreamResponse{}, err
 	}
-	streamInfo, err := internal.ConvertXInfoStreamResponse(result)
-	return streamInfo.(models.XInfoStreamResponse), err
+	return convertWith[models.XInfoStreamResponse](internal.ConvertXInfoStreamResponse, result)
 }
 
 func handleXInfoStreamFullOptionsResponse(response *C.struct_CommandResponse) (models.XInfoStreamFullOptionsResponse, error) {
@@ -1630,6 +1981,5 @@ func handleXInfoStreamFullOptionsResponse(response *C.struct_CommandResponse) (m
 		return models.XInfoStreamFullOptionsResponse{}, err
 	}
 
-	streamInfo, err := internal.ConvertXInfoStreamFullResponse(result)
-	return streamInfo.(models.XInfoStreamFullOptionsResponse), err
+	return convertWith[models.XInfoStreamFullOptionsResponse](internal.ConvertXInfoStreamFullResponse, result)
 }
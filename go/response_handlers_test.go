@@ -0,0 +1,95 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// conversionCaller stands for a client, whose exported methods name the command of the responses they convert.
+type conversionCaller struct{}
+
+func (conversionCaller) ZScore(value any) error {
+	_, err := assertType[float64](value)
+	return err
+}
+
+func TestConversionErrors(t *testing.T) {
+	err := conversionCaller{}.ZScore("1.5")
+	var convErr *ConversionError
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "ZScore", convErr.Command())
+	assert.Equal(t, "float64", convErr.Expected())
+	assert.Equal(t, "string", convErr.Actual())
+	assert.Equal(t, "1.5", convErr.Value())
+	assert.Equal(t, "unexpected return type from Valkey for ZScore: got string, expected float64", err.Error())
+
+	_, err = assertType[[]any](nil)
+	assert.ErrorAs(t, err, &convErr)
+	assert.Empty(t, convErr.Command())
+	assert.Equal(t, "nil", convErr.Actual())
+
+	arr := []any{"member", int64(1)}
+	member, err := arrayElement[string](arr, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "member", member)
+	_, err = arrayElement[float64](arr, 1)
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "float64 at index 1", convErr.Expected())
+	assert.Equal(t, "int64", convErr.Actual())
+	assert.Equal(t, arr, convErr.Value())
+	_, err = arrayElement[string](arr, 2)
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "array of 2 elements", convErr.Actual())
+
+	fields := map[string]any{"name": "group"}
+	_, err = mapField[int64](fields, "pending")
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, `int64 field "pending"`, convErr.Expected())
+	assert.Equal(t, fields, convErr.Value())
+}
+
+func TestConvertersRejectUnexpectedShapes(t *testing.T) {
+	converted, err := mapConverter[[]string]{next: arrayConverter[string]{}}.convert(
+		map[string]any{"key": []any{"a", "b"}},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"key": {"a", "b"}}, converted)
+
+	var convErr *ConversionError
+	_, err = mapConverter[string]{}.convert([]any{"not", "a", "map"})
+	assert.ErrorAs(t, err, &convErr)
+	_, err = mapConverter[[]string]{next: arrayConverter[string]{}}.convert(map[string]any{"key": []any{"a", int64(1)}})
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "string at index 1", convErr.Expected())
+	_, err = arrayConverter[string]{}.convert(nil)
+	assert.ErrorAs(t, err, &convErr)
+	converted, err = arrayConverter[string]{canBeNil: true}.convert(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, converted)
+}
+
+func TestConvertWithRecoversFromMalformedResponses(t *testing.T) {
+	result, err := convertWith[models.ScanResult](func(data any) (any, error) {
+		arr := data.([]any)
+		return models.ScanResult{Cursor: models.NewCursorFromString(arr[0].(string))}, nil
+	}, []any{"0", []any{}})
+	assert.NoError(t, err)
+	assert.Equal(t, "0", result.Cursor.String())
+
+	_, err = convertWith[models.ScanResult](func(data any) (any, error) {
+		arr := data.([]any)
+		return models.ScanResult{Cursor: models.NewCursorFromString(arr[0].(string))}, nil
+	}, []any{int64(0)})
+	var convErr *ConversionError
+	assert.ErrorAs(t, err, &convErr)
+	assert.Equal(t, "models.ScanResult", convErr.Expected())
+	assert.Equal(t, []any{int64(0)}, convErr.Value())
+
+	assert.Panics(t, func() {
+		_, _ = convertWith[models.ScanResult](func(data any) (any, error) { panic("not a runtime error") }, nil)
+	})
+}
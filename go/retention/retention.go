@@ -0,0 +1,174 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package retention enforces retention policies on streams built on a Valkey GLIDE client. A [Manager] holds a
+// [Policy] per stream, and trims the streams to their maximum length and age in a background worker, so that
+// producers do not need to trim them on every XADD.
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the manager.
+type Client interface {
+	XTrim(ctx context.Context, key string, options options.XTrimOptions) (int64, error)
+}
+
+// Policy is the retention policy of a stream. Fields left at zero are not enforced.
+type Policy struct {
+	// MaxLen is the number of entries kept in the stream.
+	MaxLen int64
+	// MaxAge is how long entries are kept, based on the time in their ID. It assumes the entry IDs are generated by
+	// the server, and that the local clock is close to the server clock.
+	MaxAge time.Duration
+}
+
+// Manager trims streams according to their [Policy]. Trimming is approximate, with the "~" modifier of XTRIM, so a
+// stream may keep slightly more entries than its policy allows, in exchange for trimming whole nodes of the stream.
+// The policies can be changed while the manager is running.
+type Manager struct {
+	client   Client
+	mu       sync.Mutex
+	policies map[string]Policy
+	clock    clock.Clock
+}
+
+// NewManager returns a [Manager] without policies.
+//
+// Parameters:
+//
+//	client - The client used to trim the streams, e.g. a glide.Client or glide.ClusterClient.
+func NewManager(client Client) *Manager {
+	return &Manager{client: client, policies: make(map[string]Policy), clock: clock.System()}
+}
+
+// WithClock sets the clock the maximum age of the entries and the trimming interval are measured on, e.g. a
+// clock.Fake in unit tests. If not explicitly set, the system clock is used.
+func (manager *Manager) WithClock(c clock.Clock) *Manager {
+	manager.clock = c
+	return manager
+}
+
+// SetPolicy sets the retention policy of the stream at key, replacing its previous policy.
+//
+// Parameters:
+//
+//	key - The key of the stream.
+//	policy - The retention policy of the stream.
+func (manager *Manager) SetPolicy(key string, policy Policy) error {
+	if policy.MaxLen < 0 {
+		return errors.New("maximum stream length cannot be negative")
+	}
+	if policy.MaxAge < 0 {
+		return errors.New("maximum entry age cannot be negative")
+	}
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	manager.policies[key] = policy
+	return nil
+}
+
+// RemovePolicy stops enforcing the retention policy of the stream at key.
+//
+// Parameters:
+//
+//	key - The key of the stream.
+func (manager *Manager) RemovePolicy(key string) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	delete(manager.policies, key)
+}
+
+// Policies returns a copy of the retention policies, keyed by stream.
+func (manager *Manager) Policies() map[string]Policy {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+	policies := make(map[string]Policy, len(manager.policies))
+	for key, policy := range manager.policies {
+		policies[key] = policy
+	}
+	return policies
+}
+
+// Trim trims every stream once according to its policy: with XTRIM MAXLEN if it has a maximum length, and with XTRIM
+// MINID if it has a maximum age. A stream failing to be trimmed does not prevent the others from being trimmed.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The total number of entries deleted, and the errors of the streams which failed to be trimmed, joined.
+func (manager *Manager) Trim(ctx context.Context) (int64, error) {
+	policies := manager.Policies()
+	keys := make([]string, 0, len(policies))
+	for key := range policies {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	now := manager.clock.Now()
+	var trimmed int64
+	var errs []error
+	for _, key := range keys {
+		for _, trimOptions := range trimOptions(policies[key], now) {
+			deleted, err := manager.client.XTrim(ctx, key, *trimOptions)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to trim stream %q: %w", key, err))
+				break
+			}
+			trimmed += deleted
+		}
+	}
+	return trimmed, errors.Join(errs...)
+}
+
+// trimOptions returns the XTRIM options enforcing policy at the given time.
+func trimOptions(policy Policy, now time.Time) []*options.XTrimOptions {
+	var trims []*options.XTrimOptions
+	if policy.MaxLen > 0 {
+		trims = append(trims, options.NewXTrimOptionsWithMaxLen(policy.MaxLen).SetNearlyExactTrimming())
+	}
+	if policy.MaxAge > 0 {
+		minID := strconv.FormatInt(now.Add(-policy.MaxAge).UnixMilli(), 10)
+		trims = append(trims, options.NewXTrimOptionsWithMinId(minID).SetNearlyExactTrimming())
+	}
+	return trims
+}
+
+// Run trims the streams every interval until the context is done. The worker keeps running when trimming fails, so that
+// a failing stream does not stop the retention of the others, and reports the errors to onError if it is not nil.
+//
+// Parameters:
+//
+//	ctx - The context stopping the worker.
+//	interval - The trimming interval.
+//	onError - The function the errors of [Manager.Trim] are reported to, or nil.
+//
+// Return value:
+//
+//	The error of the context once it is done.
+func (manager *Manager) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	ticker := manager.clock.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := manager.Trim(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C():
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// fakeClient records the arguments of the XTRIM commands it receives.
+type fakeClient struct {
+	mu    sync.Mutex
+	trims []string
+	fail  map[string]bool
+}
+
+func (c *fakeClient) XTrim(_ context.Context, key string, trimOptions options.XTrimOptions) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fail[key] {
+		return 0, errors.New("WRONGTYPE")
+	}
+	args, err := trimOptions.ToArgs()
+	if err != nil {
+		return 0, err
+	}
+	c.trims = append(c.trims, key+" "+args[0]+" "+args[1]+" "+args[2])
+	return 1, nil
+}
+
+func TestManagerTrim(t *testing.T) {
+	client := &fakeClient{fail: map[string]bool{"broken": true}}
+	manager := NewManager(client).WithClock(clock.NewFake(time.UnixMilli(1_700_000_000_000)))
+
+	assert.NoError(t, manager.SetPolicy("events", Policy{MaxLen: 1000, MaxAge: time.Hour}))
+	assert.NoError(t, manager.SetPolicy("audit", Policy{MaxAge: time.Minute}))
+	assert.NoError(t, manager.SetPolicy("broken", Policy{MaxLen: 10}))
+	assert.ErrorContains(t, manager.SetPolicy("events", Policy{MaxLen: -1}), "cannot be negative")
+
+	trimmed, err := manager.Trim(context.Background())
+	assert.Equal(t, int64(3), trimmed)
+	assert.ErrorContains(t, err, `failed to trim stream "broken"`)
+	assert.Equal(t, []string{
+		"audit MINID ~ 1699999940000",
+		"events MAXLEN ~ 1000",
+		"events MINID ~ 1699996400000",
+	}, client.trims)
+
+	manager.RemovePolicy("broken")
+	assert.Len(t, manager.Policies(), 2)
+	_, err = manager.Trim(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestManagerRun(t *testing.T) {
+	client := &fakeClient{fail: map[string]bool{"broken": true}}
+	fakeClock := clock.NewFake(time.Unix(0, 0))
+	manager := NewManager(client).WithClock(fakeClock)
+	assert.NoError(t, manager.SetPolicy("broken", Policy{MaxLen: 10}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 10)
+	done := make(chan error)
+	onError := func(err error) { errs <- err }
+	go func() { done <- manager.Run(ctx, time.Minute, onError) }()
+	<-errs
+	fakeClock.BlockUntil(1)
+	assert.Empty(t, errs)
+	// The worker keeps running after a failure.
+	fakeClock.Advance(time.Minute)
+	<-errs
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
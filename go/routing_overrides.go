@@ -0,0 +1,136 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// routingOverrides is the table of the slots and keys pinned to a node, consulted before the default routing of the
+// commands routed by their keys. It is shared by pointer between the copies of a client.
+type routingOverrides struct {
+	mu    sync.RWMutex
+	slots map[uint16]config.ByAddressRoute
+	keys  map[string]config.ByAddressRoute
+}
+
+func newRoutingOverrides() *routingOverrides {
+	return &routingOverrides{
+		slots: make(map[uint16]config.ByAddressRoute),
+		keys:  make(map[string]config.ByAddressRoute),
+	}
+}
+
+// route returns the node a command is pinned to, or nil if the command follows the default routing. A command is pinned
+// if its keys are known to the client, and all of them are pinned to the same node, by key or by slot.
+func (overrides *routingOverrides) route(requestType C.RequestType, args []string) config.Route {
+	overrides.mu.RLock()
+	defer overrides.mu.RUnlock()
+	if len(overrides.slots) == 0 && len(overrides.keys) == 0 {
+		return nil
+	}
+	keys := commandKeys(requestType, args)
+	if len(keys) == 0 {
+		return nil
+	}
+	var pinned config.ByAddressRoute
+	for idx, key := range keys {
+		node, ok := overrides.keys[key]
+		if !ok {
+			node, ok = overrides.slots[keySlot(key)]
+		}
+		if !ok || (idx > 0 && node != pinned) {
+			return nil
+		}
+		pinned = node
+	}
+	return &pinned
+}
+
+// PinSlotRange pins a range of hash slots to a node: the commands routed by their keys are sent to the node if all
+// their keys map to pinned slots, or are pinned keys, see [ClusterClient.PinKey]. Pins override the default routing,
+// e.g. to test the behavior of the cluster while a slot is migrated, and replace the previous pins of the slots.
+//
+// Only the commands whose keys are known to the client are pinned, such as GET, SET, HSET or DEL. Commands sent with
+// an explicit route, batches and scripts follow their usual routing.
+//
+// Parameters:
+//
+//	start - The first hash slot of the range.
+//	end - The last hash slot of the range, inclusive.
+//	node - The node to send the commands to.
+//
+// Return value:
+//
+//	An error if the range is invalid.
+func (client *ClusterClient) PinSlotRange(start int64, end int64, node config.ByAddressRoute) error {
+	if err := validateSlotRange(start, end); err != nil {
+		return err
+	}
+	client.routingOverrides.mu.Lock()
+	defer client.routingOverrides.mu.Unlock()
+	for slot := start; slot <= end; slot++ {
+		client.routingOverrides.slots[uint16(slot)] = node
+	}
+	return nil
+}
+
+// UnpinSlotRange removes the pins of a range of hash slots, see [ClusterClient.PinSlotRange].
+//
+// Parameters:
+//
+//	start - The first hash slot of the range.
+//	end - The last hash slot of the range, inclusive.
+//
+// Return value:
+//
+//	An error if the range is invalid.
+func (client *ClusterClient) UnpinSlotRange(start int64, end int64) error {
+	if err := validateSlotRange(start, end); err != nil {
+		return err
+	}
+	client.routingOverrides.mu.Lock()
+	defer client.routingOverrides.mu.Unlock()
+	for slot := start; slot <= end; slot++ {
+		delete(client.routingOverrides.slots, uint16(slot))
+	}
+	return nil
+}
+
+// PinKey pins a key to a node: the commands routed by their keys are sent to the node if all their keys are pinned to
+// it, see [ClusterClient.PinSlotRange]. The pin of a key takes precedence over the pin of its slot, and replaces the
+// previous pin of the key.
+//
+// Parameters:
+//
+//	key - The key to pin, as sent to the server.
+//	node - The node to send the commands to.
+func (client *ClusterClient) PinKey(key string, node config.ByAddressRoute) {
+	client.routingOverrides.mu.Lock()
+	defer client.routingOverrides.mu.Unlock()
+	client.routingOverrides.keys[key] = node
+}
+
+// UnpinKey removes the pin of a key, see [ClusterClient.PinKey].
+//
+// Parameters:
+//
+//	key - The pinned key.
+func (client *ClusterClient) UnpinKey(key string) {
+	client.routingOverrides.mu.Lock()
+	defer client.routingOverrides.mu.Unlock()
+	delete(client.routingOverrides.keys, key)
+}
+
+// ClearPins removes every pin of slots and keys, so that all commands follow the default routing again.
+func (client *ClusterClient) ClearPins() {
+	client.routingOverrides.mu.Lock()
+	defer client.routingOverrides.mu.Unlock()
+	clear(client.routingOverrides.slots)
+	clear(client.routingOverrides.keys)
+}
@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestRoutingOverrides(t *testing.T) {
+	names := requestTypesByName()
+	client := &ClusterClient{baseClient{routingOverrides: newRoutingOverrides()}}
+	nodeA := config.ByAddressRoute{Host: "10.0.0.1", Port: 6379}
+	nodeB := config.ByAddressRoute{Host: "10.0.0.2", Port: 6379}
+	assert.Nil(t, client.keyRoute(names["GET"], []string{"b"}))
+
+	// "b" and "{b}x" hash to slot 3300, "f" to slot 3168, and "123456789" to slot 12739.
+	require.NoError(t, client.PinSlotRange(3000, 3999, nodeA))
+	assert.Equal(t, &nodeA, client.keyRoute(names["GET"], []string{"b"}))
+	assert.Equal(t, &nodeA, client.keyRoute(names["SET"], []string{"b", "value"}))
+	assert.Equal(t, &nodeA, client.keyRoute(names["DEL"], []string{"b", "{b}x"}))
+	assert.Nil(t, client.keyRoute(names["DEL"], []string{"b", "123456789"}))
+	assert.Nil(t, client.keyRoute(names["GET"], []string{"123456789"}))
+	assert.Nil(t, client.keyRoute(names["PING"], []string{}))
+
+	client.PinKey("123456789", nodeA)
+	assert.Equal(t, &nodeA, client.keyRoute(names["DEL"], []string{"b", "123456789"}))
+	client.PinKey("b", nodeB)
+	assert.Equal(t, &nodeB, client.keyRoute(names["GET"], []string{"b"}))
+	assert.Nil(t, client.keyRoute(names["DEL"], []string{"b", "123456789"}))
+
+	client.UnpinKey("b")
+	require.NoError(t, client.UnpinSlotRange(3300, 3300))
+	assert.Nil(t, client.keyRoute(names["GET"], []string{"b"}))
+	assert.Equal(t, &nodeA, client.keyRoute(names["GET"], []string{"f"}))
+
+	client.ClearPins()
+	assert.Nil(t, client.keyRoute(names["GET"], []string{"123456789"}))
+
+	assert.ErrorContains(t, client.PinSlotRange(-1, 10, nodeA), "out of range")
+	assert.ErrorContains(t, client.UnpinSlotRange(10, 5), "greater than end")
+}
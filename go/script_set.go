@@ -0,0 +1,167 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// ScriptSet is a registry of the named Lua scripts of an application, loaded together on the server, e.g. at startup.
+// Scripts are invoked by name with EVALSHA, and the SHA1 digest of their source identifies their version, so that
+// deploying a new version of a script only requires registering its new source and loading the set again.
+//
+// The server forgets the loaded scripts after SCRIPT FLUSH, a restart, or a failover to a replica that never loaded
+// them. When EVALSHA replies NOSCRIPT, the script is loaded again and invoked once more.
+type ScriptSet struct {
+	client  *baseClient
+	mu      sync.RWMutex
+	scripts map[string]registeredScript
+}
+
+type registeredScript struct {
+	source string
+	sha    string
+}
+
+// ScriptSet returns an empty [ScriptSet] invoking its scripts with the client.
+func (client *baseClient) ScriptSet() *ScriptSet {
+	return &ScriptSet{client: client, scripts: make(map[string]registeredScript)}
+}
+
+// Register adds a script to the set under name, replacing the previous script of that name. The script is only loaded
+// on the server by [ScriptSet.Load], or by its first invocation.
+//
+// Parameters:
+//
+//	name - The name of the script, used to invoke it.
+//	source - The Lua source of the script.
+func (set *ScriptSet) Register(name string, source string) *ScriptSet {
+	digest := sha1.Sum([]byte(source))
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	set.scripts[name] = registeredScript{source: source, sha: hex.EncodeToString(digest[:])}
+	return set
+}
+
+// SHA returns the SHA1 digest of the script registered under name, which identifies its version, and whether such a
+// script is registered.
+func (set *ScriptSet) SHA(name string) (string, bool) {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	script, ok := set.scripts[name]
+	return script.sha, ok
+}
+
+// Names returns the names of the registered scripts, sorted.
+func (set *ScriptSet) Names() []string {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	names := make([]string, 0, len(set.scripts))
+	for name := range set.scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (set *ScriptSet) script(name string) (registeredScript, error) {
+	set.mu.RLock()
+	defer set.mu.RUnlock()
+	script, ok := set.scripts[name]
+	if !ok {
+		return registeredScript{}, fmt.Errorf("no script is registered under the name %q", name)
+	}
+	return script, nil
+}
+
+// Load loads every registered script with SCRIPT LOAD, on every node in cluster mode, so that the first invocations
+// do not pay for loading them.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+func (set *ScriptSet) Load(ctx context.Context) error {
+	for _, name := range set.Names() {
+		script, err := set.script(name)
+		if err != nil {
+			return err
+		}
+		if err := set.load(ctx, script); err != nil {
+			return fmt.Errorf("failed to load script %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (set *ScriptSet) load(ctx context.Context, script registeredScript) error {
+	var route config.Route
+	if set.client.runtime.clusterMode {
+		route = config.AllNodes
+	}
+	response, err := set.client.executeCommandWithRoute(ctx, C.CustomCommand, []string{"SCRIPT", "LOAD", script.source}, route)
+	if err != nil {
+		return err
+	}
+	_, err = handleAnyResponse(response)
+	return err
+}
+
+// Invoke invokes the script registered under name with EVALSHA. If the server replies NOSCRIPT, e.g. after SCRIPT
+// FLUSH or a failover, the script is loaded again and invoked once more.
+//
+// Note:
+//
+//	When in cluster mode, all keys must map to the same hash slot.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	name - The name of the script.
+//	keys - The keys accessed by the script.
+//	args - The arguments of the script.
+//
+// Return value:
+//
+//	The result of the script.
+func (set *ScriptSet) Invoke(ctx context.Context, name string, keys []string, args []string) (any, error) {
+	script, err := set.script(name)
+	if err != nil {
+		return nil, err
+	}
+	result, err := set.evalSha(ctx, script, keys, args)
+	if err == nil || !isNoScript(err) {
+		return result, err
+	}
+	if err := set.load(ctx, script); err != nil {
+		return nil, fmt.Errorf("failed to reload script %q: %w", name, err)
+	}
+	return set.evalSha(ctx, script, keys, args)
+}
+
+func (set *ScriptSet) evalSha(ctx context.Context, script registeredScript, keys []string, args []string) (any, error) {
+	evalArgs := make([]string, 0, 3+len(keys)+len(args))
+	evalArgs = append(evalArgs, "EVALSHA", script.sha, strconv.Itoa(len(keys)))
+	evalArgs = append(append(evalArgs, keys...), args...)
+	response, err := set.client.executeCommand(ctx, C.CustomCommand, evalArgs)
+	if err != nil {
+		return nil, err
+	}
+	return handleAnyResponse(response)
+}
+
+// isNoScript reports whether err is the NOSCRIPT error replied by EVALSHA for a script the server does not know.
+func isNoScript(err error) bool {
+	return strings.Contains(err.Error(), "NOSCRIPT")
+}
@@ -0,0 +1,34 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptSet_Register(t *testing.T) {
+	set := (&baseClient{}).ScriptSet().
+		Register("ping", "return 'PONG'").
+		Register("echo", "return ARGV[1]")
+	assert.Equal(t, []string{"echo", "ping"}, set.Names())
+
+	sha, ok := set.SHA("ping")
+	assert.True(t, ok)
+	assert.Equal(t, "7814fe8768dc7e582b000899dbd910a0a03a95b4", sha)
+
+	set.Register("ping", "return 'PONG!'")
+	updated, _ := set.SHA("ping")
+	assert.NotEqual(t, sha, updated)
+
+	_, ok = set.SHA("missing")
+	assert.False(t, ok)
+	_, err := set.Invoke(context.Background(), "missing", nil, nil)
+	assert.EqualError(t, err, `no script is registered under the name "missing"`)
+
+	assert.True(t, isNoScript(errors.New("NOSCRIPT No matching script.")))
+	assert.False(t, isNoScript(errors.New("ERR unknown command")))
+}
@@ -0,0 +1,264 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+)
+
+// defaultConnectionTimeout is the connection timeout of the core if none is configured. It also bounds the resolution
+// of the seeds.
+const defaultConnectionTimeout = 2 * time.Second
+
+// seedResolver resolves the configured hostnames into seed addresses when the client connects, and optionally keeps
+// re-resolving them in the background, connecting the client again when they change. It is shared by pointer between
+// the copies of a client.
+type seedResolver struct {
+	resolver  config.Resolver
+	addresses []*protobuf.NodeAddress
+	// allAddresses is set in cluster mode, where every resolved address of a hostname is a valid seed. In standalone
+	// mode each configured address stands for a single node, so only the first resolved address is used.
+	allAddresses bool
+	preference   config.IPPreference
+	// passThrough is set when the hostnames are passed to the core as is. They are then only resolved here to detect
+	// when they change, and the client connects again with the hostnames, which the core resolves anew.
+	passThrough bool
+	// interval is the time between two resolutions after the client connected, or 0 if hostnames are not re-resolved.
+	interval time.Duration
+	// connect creates a new core client with the given seed addresses, or with the current ones if nil.
+	connect func(addresses []*protobuf.NodeAddress) (unsafe.Pointer, error)
+	// reconnect replaces the core client of the client returned to the user with one using the given seed addresses.
+	reconnect func(ctx context.Context, addresses []*protobuf.NodeAddress) error
+
+	mu    sync.Mutex
+	seeds []string
+	// fallback holds the addresses of the other address family, for the configured hostnames which resolve to both
+	// families in standalone mode. It is nil if no hostname does.
+	fallback   []*protobuf.NodeAddress
+	resolvedAt time.Time
+	resolveErr error
+	stop       chan struct{}
+	stopOnce   sync.Once
+}
+
+func newSeedResolver(
+	resolver config.Resolver,
+	preference config.IPPreference,
+	request *protobuf.ConnectionRequest,
+) *seedResolver {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &seedResolver{
+		resolver:     resolver,
+		addresses:    request.Addresses,
+		allAddresses: request.ClusterModeEnabled,
+		preference:   preference,
+		stop:         make(chan struct{}),
+	}
+}
+
+// resolve translates the configured hostnames into addresses, and records the outcome for the client statistics. IP
+// literals are passed through unchanged, unless the preference excludes their address family. A failure keeps the
+// previously resolved seeds.
+func (r *seedResolver) resolve(ctx context.Context) ([]*protobuf.NodeAddress, error) {
+	resolved, fallback, err := r.lookup(ctx)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resolveErr = err
+	if err != nil {
+		return nil, err
+	}
+	r.record(resolved, fallback)
+	return resolved, nil
+}
+
+// refresh resolves the configured hostnames again and, if their addresses changed, connects the client again before
+// recording them. A failure keeps the previously resolved seeds, and the current connections.
+func (r *seedResolver) refresh(ctx context.Context) error {
+	resolved, fallback, err := r.lookup(ctx)
+	if err == nil && r.changed(resolved) {
+		addresses := resolved
+		if r.passThrough {
+			addresses = nil
+		}
+		err = r.reconnect(ctx, addresses)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resolveErr = err
+	if err != nil {
+		return err
+	}
+	r.record(resolved, fallback)
+	return nil
+}
+
+// changed reports whether resolved differs from the recorded seeds.
+func (r *seedResolver) changed(resolved []*protobuf.NodeAddress) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !slices.Equal(r.seeds, seedAddresses(resolved))
+}
+
+// record records the resolved seeds and their fallback. The caller must hold r.mu.
+func (r *seedResolver) record(resolved []*protobuf.NodeAddress, fallback []*protobuf.NodeAddress) {
+	r.fallback = fallback
+	r.seeds = seedAddresses(resolved)
+	r.resolvedAt = time.Now()
+}
+
+func seedAddresses(addresses []*protobuf.NodeAddress) []string {
+	seeds := make([]string, len(addresses))
+	for idx, address := range addresses {
+		seeds[idx] = net.JoinHostPort(address.Host, strconv.FormatUint(uint64(address.Port), 10))
+	}
+	return seeds
+}
+
+func (r *seedResolver) lookup(ctx context.Context) ([]*protobuf.NodeAddress, []*protobuf.NodeAddress, error) {
+	var resolved, fallback []*protobuf.NodeAddress
+	hasFallback := false
+	for _, address := range r.addresses {
+		if net.ParseIP(address.Host) != nil {
+			if len(orderByFamily([]string{address.Host}, r.preference)) == 0 {
+				return nil, nil, fmt.Errorf("address %s is not an %saddress", address.Host, familyName(r.preference))
+			}
+			resolved = append(resolved, address)
+			fallback = append(fallback, address)
+			continue
+		}
+		hosts, err := r.resolver.LookupHost(ctx, address.Host)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve %s: %w", address.Host, err)
+		}
+		hosts = orderByFamily(hosts, r.preference)
+		if len(hosts) == 0 {
+			return nil, nil, fmt.Errorf("failed to resolve %s: no %saddresses found", address.Host, familyName(r.preference))
+		}
+		if !r.allAddresses {
+			alternative := hosts[0]
+			for _, host := range hosts[1:] {
+				if isIPv6(host) != isIPv6(hosts[0]) {
+					alternative = host
+					hasFallback = true
+					break
+				}
+			}
+			fallback = append(fallback, &protobuf.NodeAddress{Host: alternative, Port: address.Port})
+			hosts = hosts[:1]
+		}
+		for _, host := range hosts {
+			resolved = append(resolved, &protobuf.NodeAddress{Host: host, Port: address.Port})
+		}
+	}
+	if !hasFallback {
+		fallback = nil
+	}
+	return resolved, fallback, nil
+}
+
+// fallbackAddresses returns the addresses of the other address family to connect to if connecting to the preferred
+// ones failed, or nil if there are none.
+func (r *seedResolver) fallbackAddresses() []*protobuf.NodeAddress {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.fallback
+}
+
+// orderByFamily orders hosts according to preference, keeping the order of the resolver within each address family,
+// and drops the addresses of the other family if only one is allowed.
+func orderByFamily(hosts []string, preference config.IPPreference) []string {
+	var ipv4, ipv6 []string
+	for _, host := range hosts {
+		if isIPv6(host) {
+			ipv6 = append(ipv6, host)
+		} else {
+			ipv4 = append(ipv4, host)
+		}
+	}
+	switch preference {
+	case config.PreferIPv4:
+		return append(ipv4, ipv6...)
+	case config.PreferIPv6:
+		return append(ipv6, ipv4...)
+	case config.IPv4Only:
+		return ipv4
+	case config.IPv6Only:
+		return ipv6
+	default:
+		return hosts
+	}
+}
+
+func familyName(preference config.IPPreference) string {
+	switch preference {
+	case config.IPv4Only:
+		return "IPv4 "
+	case config.IPv6Only:
+		return "IPv6 "
+	default:
+		return ""
+	}
+}
+
+// isIPv6 reports whether a resolved address is an IPv6 address, possibly with a zone.
+func isIPv6(host string) bool {
+	return strings.Contains(host, ":")
+}
+
+// resolveTimeout returns the time the resolution of the seeds may take, which is the connection timeout of request.
+func resolveTimeout(request *protobuf.ConnectionRequest) time.Duration {
+	if request.ConnectionTimeout == 0 {
+		return defaultConnectionTimeout
+	}
+	return time.Duration(request.ConnectionTimeout) * time.Millisecond
+}
+
+// start begins re-resolving the hostnames every interval, if configured, connecting the given client again when they
+// change. It must be the client returned to the user, so that the resolution stops once that client is closed.
+func (r *seedResolver) start(client *baseClient) {
+	if r.interval <= 0 {
+		return
+	}
+	r.reconnect = func(ctx context.Context, addresses []*protobuf.NodeAddress) error {
+		return client.reconnect(ctx, func() (unsafe.Pointer, error) { return r.connect(addresses) })
+	}
+	go r.run()
+}
+
+func (r *seedResolver) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), r.interval)
+			_ = r.refresh(ctx)
+			cancel()
+		}
+	}
+}
+
+func (r *seedResolver) close() {
+	r.stopOnce.Do(func() { close(r.stop) })
+}
+
+func (r *seedResolver) snapshot() ([]string, time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.seeds...), r.resolvedAt, r.resolveErr
+}
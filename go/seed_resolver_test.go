@@ -0,0 +1,144 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/protobuf"
+)
+
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if hosts, ok := r[host]; ok {
+		return hosts, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func TestSeedResolver(t *testing.T) {
+	resolver := fakeResolver{"endpoint": {"10.0.0.1", "10.0.0.2"}}
+	request := &protobuf.ConnectionRequest{
+		Addresses: []*protobuf.NodeAddress{{Host: "endpoint", Port: 6379}, {Host: "10.0.0.9", Port: 6380}},
+	}
+
+	standalone := newSeedResolver(resolver, config.IPPreferenceNone, request)
+	addresses, err := standalone.resolve(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, addresses, 2)
+	seeds, _, _ := standalone.snapshot()
+	assert.Equal(t, []string{"10.0.0.1:6379", "10.0.0.9:6380"}, seeds)
+
+	request.ClusterModeEnabled = true
+	cluster := newSeedResolver(resolver, config.IPPreferenceNone, request)
+	_, err = cluster.resolve(context.Background())
+	assert.NoError(t, err)
+	seeds, resolvedAt, _ := cluster.snapshot()
+	assert.Equal(t, []string{"10.0.0.1:6379", "10.0.0.2:6379", "10.0.0.9:6380"}, seeds)
+
+	delete(resolver, "endpoint")
+	_, err = cluster.resolve(context.Background())
+	assert.EqualError(t, err, "failed to resolve endpoint: no such host")
+	failedSeeds, failedResolvedAt, resolveErr := cluster.snapshot()
+	assert.Equal(t, seeds, failedSeeds)
+	assert.Equal(t, resolvedAt, failedResolvedAt)
+	assert.Error(t, resolveErr)
+}
+
+func TestSeedResolver_Refresh(t *testing.T) {
+	resolver := fakeResolver{"endpoint": {"10.0.0.1"}}
+	request := &protobuf.ConnectionRequest{Addresses: []*protobuf.NodeAddress{{Host: "endpoint", Port: 6379}}}
+	seeds := newSeedResolver(resolver, config.IPPreferenceNone, request)
+	var reconnections [][]*protobuf.NodeAddress
+	var reconnectErr error
+	seeds.reconnect = func(ctx context.Context, addresses []*protobuf.NodeAddress) error {
+		reconnections = append(reconnections, addresses)
+		return reconnectErr
+	}
+	_, err := seeds.resolve(context.Background())
+	assert.NoError(t, err)
+
+	// Unchanged seeds keep the connections.
+	assert.NoError(t, seeds.refresh(context.Background()))
+	assert.Empty(t, reconnections)
+
+	// Changed seeds are only recorded once the client connected to them.
+	resolver["endpoint"] = []string{"10.0.0.2"}
+	reconnectErr = errors.New("connection refused")
+	assert.ErrorIs(t, seeds.refresh(context.Background()), reconnectErr)
+	current, _, resolveErr := seeds.snapshot()
+	assert.Equal(t, []string{"10.0.0.1:6379"}, current)
+	assert.ErrorIs(t, resolveErr, reconnectErr)
+
+	reconnectErr = nil
+	assert.NoError(t, seeds.refresh(context.Background()))
+	current, _, resolveErr = seeds.snapshot()
+	assert.Equal(t, []string{"10.0.0.2:6379"}, current)
+	assert.NoError(t, resolveErr)
+	assert.Equal(t, []*protobuf.NodeAddress{{Host: "10.0.0.2", Port: 6379}}, reconnections[1])
+
+	// Hostnames resolved by the core are passed to it again as is.
+	seeds.passThrough = true
+	resolver["endpoint"] = []string{"10.0.0.3"}
+	assert.NoError(t, seeds.refresh(context.Background()))
+	assert.Len(t, reconnections, 3)
+	assert.Nil(t, reconnections[2])
+
+	delete(resolver, "endpoint")
+	assert.Error(t, seeds.refresh(context.Background()))
+	assert.Len(t, reconnections, 3)
+	current, _, _ = seeds.snapshot()
+	assert.Equal(t, []string{"10.0.0.3:6379"}, current)
+}
+
+func TestResolveTimeout(t *testing.T) {
+	assert.Equal(t, defaultConnectionTimeout, resolveTimeout(&protobuf.ConnectionRequest{}))
+	assert.Equal(t, 500*time.Millisecond, resolveTimeout(&protobuf.ConnectionRequest{ConnectionTimeout: 500}))
+}
+
+func TestSeedResolver_IPPreference(t *testing.T) {
+	resolver := fakeResolver{
+		"dual": {"10.0.0.1", "fd00::1", "10.0.0.2", "fd00::2"},
+		"v4":   {"10.0.0.3"},
+	}
+	request := &protobuf.ConnectionRequest{
+		Addresses: []*protobuf.NodeAddress{{Host: "dual", Port: 6379}, {Host: "v4", Port: 6380}},
+	}
+
+	standalone := newSeedResolver(resolver, config.PreferIPv6, request)
+	addresses, err := standalone.resolve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*protobuf.NodeAddress{{Host: "fd00::1", Port: 6379}, {Host: "10.0.0.3", Port: 6380}}, addresses)
+	assert.Equal(t, []*protobuf.NodeAddress{{Host: "10.0.0.1", Port: 6379}, {Host: "10.0.0.3", Port: 6380}},
+		standalone.fallbackAddresses())
+
+	request.ClusterModeEnabled = true
+	cluster := newSeedResolver(resolver, config.PreferIPv6, request)
+	_, err = cluster.resolve(context.Background())
+	assert.NoError(t, err)
+	seeds, _, _ := cluster.snapshot()
+	assert.Equal(t, []string{"[fd00::1]:6379", "[fd00::2]:6379", "10.0.0.1:6379", "10.0.0.2:6379", "10.0.0.3:6380"}, seeds)
+	assert.Nil(t, cluster.fallbackAddresses())
+
+	request.ClusterModeEnabled = false
+	_, err = newSeedResolver(resolver, config.IPv6Only, request).resolve(context.Background())
+	assert.EqualError(t, err, "failed to resolve v4: no IPv6 addresses found")
+	ipv4Only := newSeedResolver(resolver, config.IPv4Only, request)
+	addresses, err = ipv4Only.resolve(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []*protobuf.NodeAddress{{Host: "10.0.0.1", Port: 6379}, {Host: "10.0.0.3", Port: 6380}}, addresses)
+	assert.Nil(t, ipv4Only.fallbackAddresses())
+
+	request.Addresses = []*protobuf.NodeAddress{{Host: "v4", Port: 6379}, {Host: "fd00::9", Port: 6380}}
+	_, err = newSeedResolver(resolver, config.IPv4Only, request).resolve(context.Background())
+	assert.EqualError(t, err, "address fd00::9 is not an IPv4 address")
+	request.Addresses = []*protobuf.NodeAddress{{Host: "10.0.0.9", Port: 6379}}
+	_, err = newSeedResolver(resolver, config.IPv6Only, request).resolve(context.Background())
+	assert.EqualError(t, err, "address 10.0.0.9 is not an IPv6 address")
+}
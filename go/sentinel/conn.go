@@ -0,0 +1,142 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package sentinel
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// maxReplyLength bounds the length of the bulk strings and arrays read from a Sentinel.
+const maxReplyLength = 64 * 1024 * 1024
+
+// replyError is an error reply of a Sentinel.
+type replyError string
+
+func (e replyError) Error() string { return string(e) }
+
+// conn is a RESP2 connection to a Sentinel. The client core only connects to data nodes, so Sentinels are queried
+// over a connection of their own, which only supports the few commands used by the package.
+type conn struct {
+	netConn net.Conn
+	reader  *bufio.Reader
+	writer  *bufio.Writer
+}
+
+// dial connects to the Sentinel at address, and authenticates if credentials are set.
+func (cfg *Config) dial(ctx context.Context, address string) (*conn, error) {
+	dialer := &net.Dialer{Timeout: cfg.dialTimeout}
+	var netConn net.Conn
+	var err error
+	if cfg.sentinelTLS != nil {
+		netConn, err = (&tls.Dialer{NetDialer: dialer, Config: cfg.sentinelTLS}).DialContext(ctx, "tcp", address)
+	} else {
+		netConn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn), writer: bufio.NewWriter(netConn)}
+	if cfg.sentinelPassword != "" {
+		args := []string{"AUTH", cfg.sentinelPassword}
+		if cfg.sentinelUsername != "" {
+			args = []string{"AUTH", cfg.sentinelUsername, cfg.sentinelPassword}
+		}
+		if _, err := c.do(ctx, args...); err != nil {
+			c.close()
+			return nil, fmt.Errorf("failed to authenticate to sentinel %s: %w", address, err)
+		}
+	}
+	return c, nil
+}
+
+// do sends a command and reads its reply, bounded by the deadline of ctx.
+func (c *conn) do(ctx context.Context, args ...string) (any, error) {
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = c.netConn.SetDeadline(deadline)
+		defer c.netConn.SetDeadline(time.Time{})
+	}
+	if err := c.send(args...); err != nil {
+		return nil, err
+	}
+	reply, err := c.receive()
+	if err != nil {
+		return nil, err
+	}
+	if replyErr, ok := reply.(replyError); ok {
+		return nil, replyErr
+	}
+	return reply, nil
+}
+
+func (c *conn) send(args ...string) error {
+	c.writer.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		c.writer.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n" + arg + "\r\n")
+	}
+	return c.writer.Flush()
+}
+
+// receive reads a reply: a string for simple and bulk strings, an int64 for integers, a []any for arrays, a
+// replyError for errors, or nil for null replies.
+func (c *conn) receive() (any, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, errors.New("invalid reply from sentinel")
+	}
+	kind, payload := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return payload, nil
+	case '-':
+		return replyError(payload), nil
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		length, err := parseLength(payload)
+		if err != nil || length < 0 {
+			return nil, err
+		}
+		data := make([]byte, length+2)
+		if _, err := io.ReadFull(c.reader, data); err != nil {
+			return nil, err
+		}
+		return string(data[:length]), nil
+	case '*':
+		length, err := parseLength(payload)
+		if err != nil || length < 0 {
+			return nil, err
+		}
+		elements := make([]any, length)
+		for idx := range elements {
+			if elements[idx], err = c.receive(); err != nil {
+				return nil, err
+			}
+		}
+		return elements, nil
+	default:
+		return nil, fmt.Errorf("unsupported reply type %q from sentinel", kind)
+	}
+}
+
+func parseLength(payload string) (int, error) {
+	length, err := strconv.Atoi(payload)
+	if err != nil || length > maxReplyLength {
+		return 0, errors.New("invalid reply length from sentinel")
+	}
+	return length, nil
+}
+
+func (c *conn) close() {
+	_ = c.netConn.Close()
+}
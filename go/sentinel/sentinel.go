@@ -0,0 +1,390 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package sentinel connects a standalone Valkey GLIDE client to the primary of a deployment monitored by Sentinel (or
+// by a compatible service), for classic high-availability setups which do not run in cluster mode. A [Client]
+// discovers the current primary through the Sentinels, and reconnects to the new primary after a failover.
+package sentinel
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	glide "github.com/valkey-io/valkey-glide/go/v2"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+const (
+	// DefaultPollInterval is the interval at which the Sentinels are asked for the current primary, unless set
+	// otherwise with [Config.WithPollInterval].
+	DefaultPollInterval = time.Second
+	// DefaultDialTimeout is the timeout of connecting to a Sentinel, unless set otherwise with [Config.WithDialTimeout].
+	DefaultDialTimeout = time.Second
+
+	// switchMasterChannel is the channel on which Sentinels announce failovers.
+	switchMasterChannel = "+switch-master"
+)
+
+// Config configures a [Client]: the name of the monitored primary, the Sentinels to ask for its address, and the
+// configuration of the client connected to it.
+type Config struct {
+	masterName       string
+	sentinels        []config.NodeAddress
+	clientConfig     *config.ClientConfiguration
+	sentinelUsername string
+	sentinelPassword string
+	sentinelTLS      *tls.Config
+	pollInterval     time.Duration
+	dialTimeout      time.Duration
+	onFailover       func(primary config.NodeAddress, err error)
+}
+
+// NewConfig returns a [Config] for the primary monitored under masterName.
+//
+// Parameters:
+//
+//	masterName - The name of the primary, as configured in the Sentinels.
+//	clientConfig - The configuration of the client connected to the primary. It must not contain addresses, since the
+//	  address of the primary is discovered through the Sentinels.
+//	sentinels - The addresses of the Sentinels. They are asked in order, until one of them answers.
+func NewConfig(masterName string, clientConfig *config.ClientConfiguration, sentinels ...config.NodeAddress) *Config {
+	return &Config{
+		masterName:   masterName,
+		sentinels:    sentinels,
+		clientConfig: clientConfig,
+		pollInterval: DefaultPollInterval,
+		dialTimeout:  DefaultDialTimeout,
+	}
+}
+
+// WithSentinelCredentials sets the credentials used to authenticate to the Sentinels. The username may be empty to
+// authenticate with a password only. If not explicitly set, the Sentinels are not authenticated to.
+func (cfg *Config) WithSentinelCredentials(username string, password string) *Config {
+	cfg.sentinelUsername = username
+	cfg.sentinelPassword = password
+	return cfg
+}
+
+// WithSentinelTLS sets the TLS configuration used to connect to the Sentinels. If not explicitly set, the Sentinels are
+// connected to without TLS. The TLS mode of the client connected to the primary is set by its own configuration.
+func (cfg *Config) WithSentinelTLS(tlsConfig *tls.Config) *Config {
+	cfg.sentinelTLS = tlsConfig
+	return cfg
+}
+
+// WithPollInterval sets the interval at which the Sentinels are asked for the current primary. Failovers are usually
+// detected earlier through the announcements of the Sentinels, so polling only catches the ones which were missed, e.g.
+// while no Sentinel could be reached. If not explicitly set, [DefaultPollInterval] is used.
+func (cfg *Config) WithPollInterval(interval time.Duration) *Config {
+	cfg.pollInterval = interval
+	return cfg
+}
+
+// WithDialTimeout sets the timeout of connecting to a Sentinel. If not explicitly set, [DefaultDialTimeout] is used.
+func (cfg *Config) WithDialTimeout(timeout time.Duration) *Config {
+	cfg.dialTimeout = timeout
+	return cfg
+}
+
+// WithFailoverHandler sets a function called whenever the client reconnects to a new primary, with the error of
+// connecting to it if it failed, e.g. to log failovers. The function is called synchronously by the monitoring of the
+// Sentinels, so it should return quickly.
+func (cfg *Config) WithFailoverHandler(handler func(primary config.NodeAddress, err error)) *Config {
+	cfg.onFailover = handler
+	return cfg
+}
+
+func (cfg *Config) validate() error {
+	switch {
+	case cfg.masterName == "":
+		return errors.New("the master name cannot be empty")
+	case len(cfg.sentinels) == 0:
+		return errors.New("at least one sentinel address is required")
+	case cfg.clientConfig == nil:
+		return errors.New("the client configuration cannot be nil")
+	case cfg.pollInterval <= 0:
+		return errors.New("the poll interval must be positive")
+	case cfg.dialTimeout <= 0:
+		return errors.New("the dial timeout must be positive")
+	}
+	request, err := cfg.clientConfig.ToProtobuf()
+	if err != nil {
+		return err
+	}
+	if len(request.Addresses) > 0 {
+		return errors.New("the client configuration cannot contain addresses, the primary is discovered by the sentinels")
+	}
+	return nil
+}
+
+// discover asks the Sentinels for the address of the primary, in order, until one of them answers.
+func (cfg *Config) discover(ctx context.Context) (config.NodeAddress, error) {
+	var errs []error
+	for _, sentinel := range cfg.sentinels {
+		primary, err := cfg.askSentinel(ctx, sentinel)
+		if err == nil {
+			return primary, nil
+		}
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return config.NodeAddress{}, fmt.Errorf("failed to discover the primary of %q: %w", cfg.masterName, errors.Join(errs...))
+}
+
+func (cfg *Config) askSentinel(ctx context.Context, sentinel config.NodeAddress) (config.NodeAddress, error) {
+	address := joinHostPort(sentinel)
+	c, err := cfg.dial(ctx, address)
+	if err != nil {
+		return config.NodeAddress{}, err
+	}
+	defer c.close()
+	reply, err := c.do(ctx, "SENTINEL", "GET-MASTER-ADDR-BY-NAME", cfg.masterName)
+	if err != nil {
+		return config.NodeAddress{}, fmt.Errorf("sentinel %s: %w", address, err)
+	}
+	fields, _ := reply.([]any)
+	if len(fields) != 2 {
+		return config.NodeAddress{}, fmt.Errorf("sentinel %s does not monitor %q", address, cfg.masterName)
+	}
+	host, _ := fields[0].(string)
+	port, err := strconv.Atoi(fmt.Sprint(fields[1]))
+	if host == "" || err != nil {
+		return config.NodeAddress{}, fmt.Errorf("sentinel %s returned an invalid address %v", address, fields)
+	}
+	return config.NodeAddress{Host: host, Port: port}, nil
+}
+
+// connect creates a client connected to primary, with the configured client configuration.
+func (cfg *Config) connect(primary config.NodeAddress) (*glide.Client, error) {
+	clientConfig := *cfg.clientConfig
+	return glide.NewClient(clientConfig.WithAddress(&primary))
+}
+
+// Client is a standalone client connected to the current primary of a deployment monitored by Sentinel. After a
+// failover, a new [glide.Client] connected to the new primary replaces the previous one, which is closed, so callers
+// should get the client with [Client.Current] for every operation rather than keep it. Commands in flight on the
+// previous client when it is closed fail.
+type Client struct {
+	config  *Config
+	current atomic.Pointer[glide.Client]
+	trigger chan struct{}
+	stop    chan struct{}
+	wg      sync.WaitGroup
+
+	mu         sync.Mutex
+	primary    config.NodeAddress
+	subscriber *conn
+	closed     bool
+}
+
+// NewClient discovers the current primary through the Sentinels of cfg, connects to it, and starts monitoring the
+// Sentinels for failovers.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the discovery of the primary.
+//	cfg - The [Config] of the client.
+//
+// Return value:
+//
+//	The client, or an error if the configuration is invalid, if no Sentinel knows the primary, or if connecting to the
+//	primary failed.
+func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, glide.NewConfigurationError(err.Error())
+	}
+	primary, err := cfg.discover(ctx)
+	if err != nil {
+		return nil, glide.NewConnectionError(err.Error())
+	}
+	current, err := cfg.connect(primary)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{
+		config:  cfg,
+		primary: primary,
+		trigger: make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+	}
+	client.current.Store(current)
+	client.wg.Add(2)
+	go client.poll()
+	go client.subscribe()
+	return client, nil
+}
+
+// Current returns the client connected to the current primary.
+func (client *Client) Current() *glide.Client {
+	return client.current.Load()
+}
+
+// Primary returns the address of the current primary.
+func (client *Client) Primary() config.NodeAddress {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.primary
+}
+
+// Refresh asks the Sentinels for the current primary, and reconnects to it if it changed. It is called by the
+// monitoring of the Sentinels, but may be called to react to a failover without waiting for it, e.g. after commands
+// failed with a READONLY error.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the discovery of the primary.
+//
+// Return value:
+//
+//	An error if no Sentinel knows the primary, or if connecting to a new primary failed. The client remains connected
+//	to the previous primary in that case.
+func (client *Client) Refresh(ctx context.Context) error {
+	primary, err := client.config.discover(ctx)
+	if err != nil {
+		return err
+	}
+	client.mu.Lock()
+	previous, closed := client.primary, client.closed
+	client.mu.Unlock()
+	if closed || primary == previous {
+		return nil
+	}
+	// Connecting may take up to the connection timeout, so it is done without holding the lock, which Primary and
+	// Close take.
+	current, err := client.config.connect(primary)
+	if err != nil {
+		if client.config.onFailover != nil {
+			client.config.onFailover(primary, err)
+		}
+		return err
+	}
+	client.mu.Lock()
+	if client.closed || client.primary != previous {
+		// The client was closed meanwhile, or a concurrent refresh already replaced the previous primary.
+		client.mu.Unlock()
+		current.Close()
+		return nil
+	}
+	client.primary = primary
+	replaced := client.current.Swap(current)
+	client.mu.Unlock()
+	replaced.Close()
+	if client.config.onFailover != nil {
+		client.config.onFailover(primary, nil)
+	}
+	return nil
+}
+
+// Close stops monitoring the Sentinels, and closes the client connected to the primary.
+func (client *Client) Close() {
+	client.mu.Lock()
+	if client.closed {
+		client.mu.Unlock()
+		return
+	}
+	client.closed = true
+	close(client.stop)
+	if client.subscriber != nil {
+		client.subscriber.close()
+	}
+	client.mu.Unlock()
+	client.wg.Wait()
+	client.current.Load().Close()
+}
+
+// poll refreshes the primary every poll interval, and whenever a Sentinel announces a failover.
+func (client *Client) poll() {
+	defer client.wg.Done()
+	ticker := time.NewTicker(client.config.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-client.stop:
+			return
+		case <-ticker.C:
+		case <-client.trigger:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), client.config.pollInterval+client.config.dialTimeout)
+		_ = client.Refresh(ctx)
+		cancel()
+	}
+}
+
+// subscribe listens to the failovers announced by the Sentinels, one Sentinel at a time, and triggers a refresh for
+// the ones of the monitored primary.
+func (client *Client) subscribe() {
+	defer client.wg.Done()
+	for idx := 0; ; idx = (idx + 1) % len(client.config.sentinels) {
+		client.listen(client.config.sentinels[idx])
+		select {
+		case <-client.stop:
+			return
+		case <-time.After(client.config.pollInterval):
+		}
+	}
+}
+
+// listen subscribes to the failovers announced by sentinel, until the connection fails or the client is closed.
+func (client *Client) listen(sentinel config.NodeAddress) {
+	ctx, cancel := context.WithTimeout(context.Background(), client.config.dialTimeout)
+	c, err := client.config.dial(ctx, joinHostPort(sentinel))
+	cancel()
+	if err != nil {
+		return
+	}
+	client.mu.Lock()
+	if client.closed {
+		client.mu.Unlock()
+		c.close()
+		return
+	}
+	client.subscriber = c
+	client.mu.Unlock()
+	defer func() {
+		client.mu.Lock()
+		client.subscriber = nil
+		client.mu.Unlock()
+		c.close()
+	}()
+
+	if err := c.send("SUBSCRIBE", switchMasterChannel); err != nil {
+		return
+	}
+	for {
+		reply, err := c.receive()
+		if err != nil {
+			return
+		}
+		if isFailoverOf(reply, client.config.masterName) {
+			select {
+			case client.trigger <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// isFailoverOf reports whether reply is an announcement of a failover of masterName, whose payload is
+// "<master name> <old ip> <old port> <new ip> <new port>".
+func isFailoverOf(reply any, masterName string) bool {
+	message, _ := reply.([]any)
+	if len(message) != 3 || message[0] != "message" || message[1] != switchMasterChannel {
+		return false
+	}
+	payload, _ := message[2].(string)
+	name, _, _ := strings.Cut(payload, " ")
+	return name == masterName
+}
+
+func joinHostPort(address config.NodeAddress) string {
+	return net.JoinHostPort(address.Host, strconv.Itoa(address.Port))
+}
@@ -0,0 +1,162 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package sentinel
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+// fakeSentinel answers GET-MASTER-ADDR-BY-NAME with a fixed primary, requires a password if set, and announces a
+// failover to its subscribers on demand.
+type fakeSentinel struct {
+	listener net.Listener
+	password string
+	primary  map[string]string
+	announce chan string
+}
+
+func newFakeSentinel(t *testing.T, password string, primary map[string]string) *fakeSentinel {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	sentinel := &fakeSentinel{listener: listener, password: password, primary: primary, announce: make(chan string, 1)}
+	t.Cleanup(func() { listener.Close() })
+	go sentinel.serve()
+	return sentinel
+}
+
+func (s *fakeSentinel) address() config.NodeAddress {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return config.NodeAddress{Host: addr.IP.String(), Port: addr.Port}
+}
+
+func (s *fakeSentinel) serve() {
+	for {
+		netConn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(&conn{netConn: netConn, reader: bufio.NewReader(netConn), writer: bufio.NewWriter(netConn)})
+	}
+}
+
+func (s *fakeSentinel) handle(c *conn) {
+	defer c.close()
+	authenticated := s.password == ""
+	for {
+		request, err := c.receive()
+		if err != nil {
+			return
+		}
+		args := make([]string, 0)
+		for _, arg := range request.([]any) {
+			args = append(args, arg.(string))
+		}
+		reply := "-ERR unknown command\r\n"
+		switch {
+		case args[0] == "AUTH":
+			authenticated = args[len(args)-1] == s.password
+			reply = "+OK\r\n"
+			if !authenticated {
+				reply = "-WRONGPASS invalid password\r\n"
+			}
+		case !authenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case args[0] == "SENTINEL" && args[1] == "GET-MASTER-ADDR-BY-NAME":
+			reply = "*-1\r\n"
+			if address, ok := s.primary[args[2]]; ok {
+				host, port, _ := net.SplitHostPort(address)
+				reply = "*2\r\n$" + strconv.Itoa(len(host)) + "\r\n" + host + "\r\n$" + strconv.Itoa(len(port)) + "\r\n" +
+					port + "\r\n"
+			}
+		case args[0] == "SUBSCRIBE":
+			c.writer.WriteString("*3\r\n$9\r\nsubscribe\r\n$14\r\n+switch-master\r\n:1\r\n")
+			c.writer.Flush()
+			for payload := range s.announce {
+				c.writer.WriteString("*3\r\n$7\r\nmessage\r\n$14\r\n+switch-master\r\n$" + strconv.Itoa(len(payload)) +
+					"\r\n" + payload + "\r\n")
+				c.writer.Flush()
+			}
+			return
+		}
+		c.writer.WriteString(reply)
+		c.writer.Flush()
+	}
+}
+
+func TestConfig_Discover(t *testing.T) {
+	unknown := newFakeSentinel(t, "", nil)
+	known := newFakeSentinel(t, "secret", map[string]string{"mymaster": "10.0.0.1:6379"})
+	ctx := context.Background()
+
+	cfg := NewConfig("mymaster", config.NewClientConfiguration(), unknown.address(), known.address()).
+		WithSentinelCredentials("", "secret")
+	primary, err := cfg.discover(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, config.NodeAddress{Host: "10.0.0.1", Port: 6379}, primary)
+
+	_, err = NewConfig("mymaster", config.NewClientConfiguration(), known.address()).discover(ctx)
+	assert.ErrorContains(t, err, "NOAUTH")
+	_, err = NewConfig("other", config.NewClientConfiguration(), unknown.address()).discover(ctx)
+	assert.ErrorContains(t, err, `does not monitor "other"`)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	sentinel := config.NodeAddress{Host: "localhost", Port: 26379}
+	assert.NoError(t, NewConfig("mymaster", config.NewClientConfiguration(), sentinel).validate())
+	assert.EqualError(t, NewConfig("", config.NewClientConfiguration(), sentinel).validate(),
+		"the master name cannot be empty")
+	assert.EqualError(t, NewConfig("mymaster", config.NewClientConfiguration()).validate(),
+		"at least one sentinel address is required")
+	assert.EqualError(t, NewConfig("mymaster", config.NewClientConfiguration(), sentinel).WithPollInterval(0).validate(),
+		"the poll interval must be positive")
+	withAddress := config.NewClientConfiguration().WithAddress(&config.NodeAddress{Host: "localhost"})
+	assert.ErrorContains(t, NewConfig("mymaster", withAddress, sentinel).validate(), "cannot contain addresses")
+}
+
+func TestClient_Listen(t *testing.T) {
+	sentinel := newFakeSentinel(t, "", nil)
+	client := &Client{
+		config:  NewConfig("mymaster", config.NewClientConfiguration(), sentinel.address()),
+		trigger: make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+	}
+	done := make(chan struct{})
+	go func() {
+		client.listen(sentinel.address())
+		close(done)
+	}()
+
+	sentinel.announce <- "other 10.0.0.1 6379 10.0.0.2 6379"
+	sentinel.announce <- "mymaster 10.0.0.1 6379 10.0.0.2 6379"
+	select {
+	case <-client.trigger:
+	case <-time.After(time.Second):
+		t.Fatal("failover was not detected")
+	}
+	assert.Empty(t, client.trigger)
+
+	client.mu.Lock()
+	client.subscriber.close()
+	client.mu.Unlock()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listen did not return after its connection was closed")
+	}
+}
+
+func TestIsFailoverOf(t *testing.T) {
+	assert.True(t, isFailoverOf([]any{"message", "+switch-master", "mymaster 1.1.1.1 1 2.2.2.2 2"}, "mymaster"))
+	assert.False(t, isFailoverOf([]any{"message", "+switch-master", "mymaster2 1.1.1.1 1 2.2.2.2 2"}, "mymaster"))
+	assert.False(t, isFailoverOf([]any{"subscribe", "+switch-master", int64(1)}, "mymaster"))
+	assert.False(t, isFailoverOf("OK", "mymaster"))
+}
@@ -439,3 +439,40 @@ func ExampleClusterClient_ConfigRewriteWithOptions() {
 	// Random route result: OK
 	// Multi node route result: OK
 }
+
+func ExampleClusterClient_ReplicationInfoWithOptions() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	opts := options.RouteOption{Route: config.RandomRoute}
+	result, err := client.ReplicationInfoWithOptions(context.Background(), opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsSingleValue())
+	fmt.Println(result.SingleValue().Role != "")
+
+	// Output:
+	// true
+	// true
+}
+
+func ExampleClusterClient_Save() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.Save(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleClusterClient_CommandGetKeys() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+	result, err := client.CommandGetKeys(context.Background(), []string{"SET", "key", "value"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [key]
+}
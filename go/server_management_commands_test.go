@@ -265,3 +265,36 @@ func ExampleClient_ConfigRewrite() {
 	// Output:
 	// OK
 }
+
+func ExampleClient_ReplicationInfo() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.ReplicationInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.IsPrimary())
+
+	// Output: true
+}
+
+func ExampleClient_Save() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.Save(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: OK
+}
+
+func ExampleClient_CommandGetKeys() {
+	var client *Client = getExampleClient() // example helper function
+	result, err := client.CommandGetKeys(context.Background(), []string{"MSET", "key1", "value1", "key2", "value2"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: [key1 key2]
+}
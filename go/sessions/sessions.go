@@ -0,0 +1,259 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package sessions provides a session store built on a Valkey GLIDE client. Each session is stored as a hash holding
+// its encoded data and creation time, and expires after a sliding TTL that is extended whenever the session is read
+// or refreshed.
+package sessions
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+const (
+	// DefaultPrefix is the prefix of the session keys if none is set with [Store.WithPrefix].
+	DefaultPrefix = "session:"
+
+	dataField    = "data"
+	createdField = "created"
+	idBytes      = 32
+)
+
+// ErrNotFound is returned when a session does not exist, either because it was never created, was destroyed, or
+// expired.
+var ErrNotFound = errors.New("session not found")
+
+// Client is the subset of the commands of glide.Client and glide.ClusterClient used by the store.
+type Client interface {
+	HSet(ctx context.Context, key string, values map[string]string) (int64, error)
+	HGetAll(ctx context.Context, key string) (map[string]string, error)
+	Expire(ctx context.Context, key string, expireTime time.Duration) (bool, error)
+	Del(ctx context.Context, keys []string) (int64, error)
+}
+
+type standaloneExecutor interface {
+	Exec(ctx context.Context, batch pipeline.StandaloneBatch, raiseOnError bool) ([]any, error)
+}
+
+type clusterExecutor interface {
+	Exec(ctx context.Context, batch pipeline.ClusterBatch, raiseOnError bool) ([]any, error)
+}
+
+// Codec encodes and decodes the data of sessions.
+type Codec interface {
+	Marshal(value any) ([]byte, error)
+	Unmarshal(data []byte, value any) error
+}
+
+// JSONCodec encodes session data as JSON. It is the default codec of a [Store].
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(value any) ([]byte, error) { return json.Marshal(value) }
+
+func (JSONCodec) Unmarshal(data []byte, value any) error { return json.Unmarshal(data, value) }
+
+// Session describes a stored session.
+type Session struct {
+	// ID is the identifier of the session, as returned by [Store.Create].
+	ID string
+	// CreatedAt is the time the session was created.
+	CreatedAt time.Time
+}
+
+// Store creates and retrieves sessions with a sliding TTL.
+type Store struct {
+	client Client
+	ttl    time.Duration
+	prefix string
+	codec  Codec
+	clock  clock.Clock
+}
+
+// NewStore returns a [Store] keeping sessions alive for ttl after they were last created, read or refreshed.
+//
+// Parameters:
+//
+//	client - The client used to store the sessions, e.g. a glide.Client or glide.ClusterClient.
+//	ttl - The sliding time to live of the sessions. It must be at least one second.
+func NewStore(client Client, ttl time.Duration) *Store {
+	return &Store{client: client, ttl: ttl, prefix: DefaultPrefix, codec: JSONCodec{}, clock: clock.System()}
+}
+
+// WithPrefix sets the prefix of the session keys. If not explicitly set, [DefaultPrefix] is used.
+func (store *Store) WithPrefix(prefix string) *Store {
+	store.prefix = prefix
+	return store
+}
+
+// WithCodec sets the codec of the session data. If not explicitly set, [JSONCodec] is used.
+func (store *Store) WithCodec(codec Codec) *Store {
+	store.codec = codec
+	return store
+}
+
+// WithClock sets the clock recording the creation time of the sessions, e.g. a clock.Fake in unit tests. If not
+// explicitly set, the system clock is used.
+func (store *Store) WithClock(c clock.Clock) *Store {
+	store.clock = c
+	return store
+}
+
+func (store *Store) key(id string) string {
+	return store.prefix + id
+}
+
+func newSessionID() (string, error) {
+	buf := make([]byte, idBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// Create stores a new session holding the given data, and returns its randomly generated identifier. If the client is
+// a glide.Client or glide.ClusterClient, the session is stored and its TTL set in a single transaction. Otherwise the
+// commands are sent one by one, and the session is deleted if setting its TTL fails.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	data - The data of the session, encoded by the codec of the store.
+//
+// Return value:
+//
+//	The identifier of the new session.
+func (store *Store) Create(ctx context.Context, data any) (string, error) {
+	if store.ttl < time.Second {
+		return "", errors.New("session TTL must be at least one second")
+	}
+	encoded, err := store.codec.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	id, err := newSessionID()
+	if err != nil {
+		return "", err
+	}
+	key := store.key(id)
+	values := map[string]string{
+		dataField:    string(encoded),
+		createdField: strconv.FormatInt(store.clock.Now().UnixMilli(), 10),
+	}
+	switch executor := store.client.(type) {
+	case standaloneExecutor:
+		batch := pipeline.NewStandaloneBatch(true)
+		batch.HSet(key, values).Expire(key, store.ttl)
+		_, err = executor.Exec(ctx, *batch, true)
+	case clusterExecutor:
+		batch := pipeline.NewClusterBatch(true)
+		batch.HSet(key, values).Expire(key, store.ttl)
+		_, err = executor.Exec(ctx, *batch, true)
+	default:
+		err = store.createOneByOne(ctx, key, values)
+	}
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (store *Store) createOneByOne(ctx context.Context, key string, values map[string]string) error {
+	if _, err := store.client.HSet(ctx, key, values); err != nil {
+		return err
+	}
+	if _, err := store.client.Expire(ctx, key, store.ttl); err != nil {
+		// Do not leave a session without expiry behind.
+		_, _ = store.client.Del(context.WithoutCancel(ctx), []string{key})
+		return err
+	}
+	return nil
+}
+
+// Get decodes the data of a session into dest, and extends its TTL.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	id - The identifier of the session.
+//	dest - A pointer to the value the data of the session is decoded into.
+//
+// Return value:
+//
+//	The [Session], or [ErrNotFound] if the session does not exist.
+func (store *Store) Get(ctx context.Context, id string, dest any) (Session, error) {
+	key := store.key(id)
+	values, err := store.client.HGetAll(ctx, key)
+	if err != nil {
+		return Session{}, err
+	}
+	data, ok := values[dataField]
+	if !ok {
+		return Session{}, ErrNotFound
+	}
+	if err := store.codec.Unmarshal([]byte(data), dest); err != nil {
+		return Session{}, err
+	}
+	session := Session{ID: id}
+	if created, err := strconv.ParseInt(values[createdField], 10, 64); err == nil {
+		session.CreatedAt = time.UnixMilli(created)
+	}
+	extended, err := store.client.Expire(ctx, key, store.ttl)
+	if err != nil {
+		return Session{}, err
+	}
+	if !extended {
+		// The session expired after it was read.
+		return Session{}, ErrNotFound
+	}
+	return session, nil
+}
+
+// Refresh extends the TTL of a session without reading its data.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	id - The identifier of the session.
+//
+// Return value:
+//
+//	nil, or [ErrNotFound] if the session does not exist.
+func (store *Store) Refresh(ctx context.Context, id string) error {
+	extended, err := store.client.Expire(ctx, store.key(id), store.ttl)
+	if err != nil {
+		return err
+	}
+	if !extended {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Destroy deletes a session.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	id - The identifier of the session.
+//
+// Return value:
+//
+//	nil, or [ErrNotFound] if the session does not exist.
+func (store *Store) Destroy(ctx context.Context, id string) error {
+	deleted, err := store.client.Del(ctx, []string{store.key(id)})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/clock"
+	_ "github.com/valkey-io/valkey-glide/go/v2/internal/nativelink"
+	"github.com/valkey-io/valkey-glide/go/v2/pipeline"
+)
+
+// fakeClient keeps hashes in memory and records the TTL set on them.
+type fakeClient struct {
+	hashes map[string]map[string]string
+	ttls   map[string]time.Duration
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{hashes: make(map[string]map[string]string), ttls: make(map[string]time.Duration)}
+}
+
+func (c *fakeClient) HSet(_ context.Context, key string, values map[string]string) (int64, error) {
+	if c.hashes[key] == nil {
+		c.hashes[key] = make(map[string]string)
+	}
+	for field, value := range values {
+		c.hashes[key][field] = value
+	}
+	return int64(len(values)), nil
+}
+
+func (c *fakeClient) HGetAll(_ context.Context, key string) (map[string]string, error) {
+	if c.hashes[key] == nil {
+		return map[string]string{}, nil
+	}
+	return c.hashes[key], nil
+}
+
+func (c *fakeClient) Expire(_ context.Context, key string, expireTime time.Duration) (bool, error) {
+	if _, ok := c.hashes[key]; !ok {
+		return false, nil
+	}
+	c.ttls[key] = expireTime
+	return true, nil
+}
+
+func (c *fakeClient) Del(_ context.Context, keys []string) (int64, error) {
+	var deleted int64
+	for _, key := range keys {
+		if _, ok := c.hashes[key]; ok {
+			delete(c.hashes, key)
+			delete(c.ttls, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// fakeBatchClient records the batches it is asked to execute, without executing them.
+type fakeBatchClient struct {
+	*fakeClient
+	batches []pipeline.StandaloneBatch
+}
+
+func (c *fakeBatchClient) Exec(_ context.Context, batch pipeline.StandaloneBatch, _ bool) ([]any, error) {
+	c.batches = append(c.batches, batch)
+	return []any{int64(2), true}, nil
+}
+
+type profile struct {
+	User  string `json:"user"`
+	Admin bool   `json:"admin"`
+}
+
+func TestStoreLifecycle(t *testing.T) {
+	client := newFakeClient()
+	createdAt := time.UnixMilli(1_700_000_000_000)
+	store := NewStore(client, time.Minute).WithPrefix("app:session:").WithClock(clock.NewFake(createdAt))
+	ctx := context.Background()
+
+	id, err := store.Create(ctx, profile{User: "alice", Admin: true})
+	assert.NoError(t, err)
+	assert.Len(t, id, 2*idBytes)
+	assert.Equal(t, time.Minute, client.ttls["app:session:"+id])
+
+	client.ttls["app:session:"+id] = time.Second
+	var got profile
+	session, err := store.Get(ctx, id, &got)
+	assert.NoError(t, err)
+	assert.Equal(t, profile{User: "alice", Admin: true}, got)
+	assert.Equal(t, id, session.ID)
+	assert.Equal(t, createdAt, session.CreatedAt)
+	assert.Equal(t, time.Minute, client.ttls["app:session:"+id])
+
+	assert.NoError(t, store.Refresh(ctx, id))
+	assert.NoError(t, store.Destroy(ctx, id))
+	_, err = store.Get(ctx, id, &got)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.Refresh(ctx, id), ErrNotFound)
+	assert.ErrorIs(t, store.Destroy(ctx, id), ErrNotFound)
+}
+
+func TestStoreRejectsShortTTL(t *testing.T) {
+	_, err := NewStore(newFakeClient(), time.Millisecond).Create(context.Background(), "data")
+	assert.ErrorContains(t, err, "at least one second")
+}
+
+func TestStoreCreatesInTransaction(t *testing.T) {
+	client := &fakeBatchClient{fakeClient: newFakeClient()}
+	id, err := NewStore(client, time.Minute).Create(context.Background(), "data")
+	assert.NoError(t, err)
+	assert.Empty(t, client.hashes)
+	assert.Len(t, client.batches, 1)
+	batch := client.batches[0]
+	assert.True(t, batch.IsAtomic)
+	assert.Len(t, batch.Commands, 2)
+	assert.Equal(t, DefaultPrefix+id, batch.Commands[0].Args[0])
+	assert.Equal(t, []string{DefaultPrefix + id, "60"}, batch.Commands[1].Args)
+}
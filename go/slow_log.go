@@ -0,0 +1,240 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+const (
+	// slowCommandMaxArgs is the number of arguments kept in the preview of a slow command.
+	slowCommandMaxArgs = 16
+	// slowCommandMaxArgLength is the number of bytes kept of each argument in the preview of a slow command.
+	slowCommandMaxArgLength = 64
+)
+
+// SlowCommand is a command recorded by the slow command log of a client, see
+// [config.AdvancedClientConfiguration.WithSlowCommandLog].
+type SlowCommand struct {
+	// Command is the name of the command in upper case, e.g. "GET" or "CONFIG GET". Batches are recorded as "BATCH" and
+	// scripts as "EVALSHA".
+	Command string
+	// Args is a preview of the arguments following the command name, redacted with the default rules of
+	// [config.NewRedaction]. Only the first 16 arguments are kept, and arguments longer than 64 bytes are truncated. The
+	// arguments of a batch are the names of its commands.
+	Args []string
+	// Duration is the time from sending the command to receiving its response or error.
+	Duration time.Duration
+	// Route describes the route the command was sent with, e.g. "AllPrimaries" or "slot 42 (replica)", or is empty if
+	// the command was routed by its keys.
+	Route string
+	// Node is the address of the node the command was sent to, as "host:port". The node is chosen by the core, so it is
+	// only known for commands routed by address, and is empty otherwise.
+	Node string
+	// Time is the time the command was sent.
+	Time time.Time
+}
+
+// slowCommandLog keeps the latest commands exceeding a duration threshold in a ring buffer.
+type slowCommandLog struct {
+	threshold time.Duration
+	redaction *config.Redaction
+	mu        sync.Mutex
+	entries   []SlowCommand
+	// next is the index of the oldest entry, overwritten by the next record once the log is full.
+	next int
+}
+
+func newSlowCommandLog(threshold time.Duration, capacity int) *slowCommandLog {
+	return &slowCommandLog{
+		threshold: threshold,
+		redaction: config.NewRedaction(),
+		entries:   make([]SlowCommand, 0, capacity),
+	}
+}
+
+// record adds a command to the log, dropping the oldest entry if the log is full.
+func (log *slowCommandLog) record(command SlowCommand) {
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	if len(log.entries) < cap(log.entries) {
+		log.entries = append(log.entries, command)
+		return
+	}
+	log.entries[log.next] = command
+	log.next = (log.next + 1) % len(log.entries)
+}
+
+// snapshot returns a copy of the entries, from the oldest to the latest.
+func (log *slowCommandLog) snapshot() []SlowCommand {
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	entries := make([]SlowCommand, 0, len(log.entries))
+	entries = append(entries, log.entries[log.next:]...)
+	return append(entries, log.entries[:log.next]...)
+}
+
+// previewArgs returns the redacted and truncated arguments of a command.
+func (log *slowCommandLog) previewArgs(command string, args []string) []string {
+	preview := log.redaction.Redact(command, args[:min(len(args), slowCommandMaxArgs)])
+	for idx, arg := range preview {
+		if len(arg) > slowCommandMaxArgLength {
+			preview[idx] = arg[:slowCommandMaxArgLength] + "..."
+		}
+	}
+	if len(args) > slowCommandMaxArgs {
+		preview = append(preview, fmt.Sprintf("... (%d more)", len(args)-slowCommandMaxArgs))
+	}
+	return preview
+}
+
+// SlowCommands returns the commands recorded by the slow command log of the client, from the oldest to the latest, see
+// [config.AdvancedClientConfiguration.WithSlowCommandLog]. The log is kept by the client, independently of the SLOWLOG
+// of the server.
+//
+// Return value:
+//
+//	The recorded commands, or nil if the slow command log is not configured.
+func (client *baseClient) SlowCommands() []SlowCommand {
+	if client.slowLog == nil {
+		return nil
+	}
+	return client.slowLog.snapshot()
+}
+
+// recordSlowCommand adds a command to the slow command log, if any, when it took longer than the threshold.
+func (client *baseClient) recordSlowCommand(
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	started time.Time,
+) {
+	if client.slowLog == nil {
+		return
+	}
+	duration := time.Since(started)
+	if duration < client.slowLog.threshold {
+		return
+	}
+	name, args := commandName(requestType, args)
+	client.slowLog.record(SlowCommand{
+		Command:  name,
+		Args:     client.slowLog.previewArgs(name, args),
+		Duration: duration,
+		Route:    describeRoute(route),
+		Node:     routeAddress(route),
+		Time:     started,
+	})
+}
+
+// recordSlowBatch adds a batch to the slow command log, if any, when it took longer than the threshold.
+func (client *baseClient) recordSlowBatch(batch internal.Batch, route config.Route, started time.Time) {
+	if client.slowLog == nil {
+		return
+	}
+	duration := time.Since(started)
+	if duration < client.slowLog.threshold {
+		return
+	}
+	names := make([]string, 0, min(len(batch.Commands), slowCommandMaxArgs+1))
+	for idx, cmd := range batch.Commands {
+		if idx == slowCommandMaxArgs {
+			names = append(names, fmt.Sprintf("... (%d more)", len(batch.Commands)-slowCommandMaxArgs))
+			break
+		}
+		name, _ := commandName(C.RequestType(cmd.RequestType), cmd.Args)
+		names = append(names, name)
+	}
+	client.slowLog.record(SlowCommand{
+		Command:  "BATCH",
+		Args:     names,
+		Duration: duration,
+		Route:    describeRoute(route),
+		Node:     routeAddress(route),
+		Time:     started,
+	})
+}
+
+// recordSlowScript adds a script invocation to the slow command log, if any, when it took longer than the threshold,
+// as the EVALSHA command it is sent as.
+func (client *baseClient) recordSlowScript(
+	hash string,
+	keys []string,
+	args []string,
+	route config.Route,
+	started time.Time,
+) {
+	if client.slowLog == nil {
+		return
+	}
+	duration := time.Since(started)
+	if duration < client.slowLog.threshold {
+		return
+	}
+	evalArgs := make([]string, 0, 2+len(keys)+len(args))
+	evalArgs = append(evalArgs, hash, utils.IntToString(int64(len(keys))))
+	evalArgs = append(evalArgs, keys...)
+	evalArgs = append(evalArgs, args...)
+	client.slowLog.record(SlowCommand{
+		Command:  "EVALSHA",
+		Args:     client.slowLog.previewArgs("EVALSHA", evalArgs),
+		Duration: duration,
+		Route:    describeRoute(route),
+		Node:     routeAddress(route),
+		Time:     started,
+	})
+}
+
+// describeRoute returns a description of a route for diagnostics, or an empty string if route is nil.
+func describeRoute(route config.Route) string {
+	slotType := func(slotType config.SlotType) string {
+		if slotType == config.SlotTypeReplica {
+			return "replica"
+		}
+		return "primary"
+	}
+	switch r := route.(type) {
+	case nil:
+		return ""
+	case config.SimpleNodeRoute:
+		return describeSimpleRoute(r)
+	case config.SimpleSingleNodeRoute:
+		return describeSimpleRoute(config.SimpleNodeRoute(r))
+	case config.SimpleMultiNodeRoute:
+		return describeSimpleRoute(config.SimpleNodeRoute(r))
+	case config.SlotIdRoute:
+		return fmt.Sprintf("slot %d (%s)", r.SlotID, slotType(r.SlotType))
+	case *config.SlotIdRoute:
+		return fmt.Sprintf("slot %d (%s)", r.SlotID, slotType(r.SlotType))
+	case config.SlotKeyRoute:
+		return fmt.Sprintf("slot of key %s (%s)", r.SlotKey, slotType(r.SlotType))
+	case *config.SlotKeyRoute:
+		return fmt.Sprintf("slot of key %s (%s)", r.SlotKey, slotType(r.SlotType))
+	}
+	if address := routeAddress(route); address != "" {
+		return "address " + address
+	}
+	return fmt.Sprintf("%T", route)
+}
+
+func describeSimpleRoute(route config.SimpleNodeRoute) string {
+	switch route {
+	case config.SimpleNodeRoute(config.AllNodes):
+		return "AllNodes"
+	case config.SimpleNodeRoute(config.AllPrimaries):
+		return "AllPrimaries"
+	case config.SimpleNodeRoute(config.RandomRoute):
+		return "RandomRoute"
+	default:
+		return fmt.Sprintf("SimpleNodeRoute(%d)", int(route))
+	}
+}
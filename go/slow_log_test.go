@@ -0,0 +1,71 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+)
+
+func TestSlowCommandLog_RingBuffer(t *testing.T) {
+	log := newSlowCommandLog(0, 3)
+	assert.Empty(t, log.snapshot())
+	for _, command := range []string{"A", "B", "C", "D", "E"} {
+		log.record(SlowCommand{Command: command})
+	}
+	commands := make([]string, 0, 3)
+	for _, entry := range log.snapshot() {
+		commands = append(commands, entry.Command)
+	}
+	assert.Equal(t, []string{"C", "D", "E"}, commands)
+}
+
+func TestSlowCommandLog_PreviewArgs(t *testing.T) {
+	log := newSlowCommandLog(0, 1)
+	assert.Equal(t, []string{"key", config.DefaultRedactionMask}, log.previewArgs("SET", []string{"key", "secret"}))
+
+	long := strings.Repeat("k", slowCommandMaxArgLength+1)
+	args := make([]string, slowCommandMaxArgs+2)
+	for idx := range args {
+		args[idx] = long
+	}
+	preview := log.previewArgs("DEL", args)
+	require.Len(t, preview, slowCommandMaxArgs+1)
+	assert.Equal(t, long[:slowCommandMaxArgLength]+"...", preview[0])
+	assert.Equal(t, "... (2 more)", preview[slowCommandMaxArgs])
+}
+
+func TestRecordSlowCommand(t *testing.T) {
+	client := &baseClient{slowLog: newSlowCommandLog(time.Hour, 4)}
+	set := requestTypesByName()["SET"]
+	client.recordSlowCommand(set, []string{"key", "value"}, nil, time.Now())
+	assert.Empty(t, client.SlowCommands())
+
+	client.slowLog.threshold = 0
+	started := time.Now()
+	client.recordSlowCommand(set, []string{"key", "value"}, config.NewByAddressRoute("localhost", 6379), started)
+	slow := client.SlowCommands()
+	require.Len(t, slow, 1)
+	assert.Equal(t, "SET", slow[0].Command)
+	assert.Equal(t, []string{"key", config.DefaultRedactionMask}, slow[0].Args)
+	assert.Equal(t, "address localhost:6379", slow[0].Route)
+	assert.Equal(t, "localhost:6379", slow[0].Node)
+	assert.Equal(t, started, slow[0].Time)
+
+	assert.Nil(t, (&baseClient{}).SlowCommands())
+}
+
+func TestDescribeRoute(t *testing.T) {
+	assert.Equal(t, "", describeRoute(nil))
+	assert.Equal(t, "AllPrimaries", describeRoute(config.AllPrimaries))
+	assert.Equal(t, "RandomRoute", describeRoute(config.RandomRoute))
+	assert.Equal(t, "slot 42 (replica)", describeRoute(config.NewSlotIdRoute(config.SlotTypeReplica, 42)))
+	assert.Equal(t, "slot of key foo (primary)", describeRoute(config.NewSlotKeyRoute(config.SlotTypePrimary, "foo")))
+	assert.Equal(t, "address localhost:6379", describeRoute(config.ByAddressRoute{Host: "localhost", Port: 6379}))
+}
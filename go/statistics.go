@@ -0,0 +1,110 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"fmt"
+	"time"
+	"unsafe"
+)
+
+// clientStats holds the counters collected by a single client. It is shared by pointer, so that the copies of
+// [baseClient] embedded in [Client] and [ClusterClient] report the same values. All fields are guarded by the
+// client mutex.
+type clientStats struct {
+	peakPendingCommands int
+	bytesByFamily       map[string]*CommandBytes
+	hedgedReads         int64
+	hedgeWins           int64
+	coalescedReads      int64
+}
+
+// ClientStatistics is a snapshot of the internal state of a client, intended for diagnostics.
+type ClientStatistics struct {
+	// PendingCommands is the number of commands currently awaiting a response from this client.
+	PendingCommands int
+	// PeakPendingCommands is the highest number of commands that were awaiting a response at the same time since the
+	// client was created.
+	PeakPendingCommands int
+	// MaxPendingCommands is the configured cap on pending commands, or 0 if the number is not limited.
+	MaxPendingCommands int
+	// PinnedObjects is the number of Go objects currently pinned for the native layer. Objects are pinned for the
+	// lifetime of a command, so this counter is shared by all clients in the process.
+	PinnedObjects int64
+	// SeedAddresses are the most recently resolved seed addresses, if a resolver, an IP preference or a DNS refresh
+	// interval is configured.
+	SeedAddresses []string
+	// SeedsResolvedAt is the time the seed addresses were last resolved successfully.
+	SeedsResolvedAt time.Time
+	// SeedResolutionError is the error of the last resolution attempt, or nil if it succeeded. A failed refresh of the
+	// seeds keeps the previous connections.
+	SeedResolutionError error
+	// LastHeartbeatSuccess is the time of the last heartbeat answered by every node, if heartbeats are configured.
+	LastHeartbeatSuccess time.Time
+	// ConsecutiveHeartbeatFailures is the number of heartbeats that failed since the last successful one.
+	ConsecutiveHeartbeatFailures int
+	// HeartbeatReconnections is the number of times the client replaced its connections because the consecutive
+	// heartbeat failures reached the configured threshold.
+	HeartbeatReconnections int64
+	// BytesByFamily holds the traffic of the client per command family, e.g. "String" or "SortedSet", to help
+	// estimate the egress costs of the client. Custom commands are accounted under "Custom" and batches under "Batch".
+	BytesByFamily map[string]CommandBytes
+	// HedgedReads is the number of reads for which a duplicate was sent to a replica, if hedged reads are configured.
+	HedgedReads int64
+	// HedgeWins is the number of hedged reads answered first by the duplicate. The ratio of HedgeWins to HedgedReads
+	// is the hedge win rate.
+	HedgeWins int64
+	// CoalescedReads is the number of reads answered with the result of an identical concurrent read, if read
+	// coalescing is enabled.
+	CoalescedReads int64
+	// Healthy is false once the consecutive heartbeat failures reach the configured threshold. It is always true if
+	// heartbeats are not configured.
+	Healthy bool
+}
+
+// registerPending records a command awaiting a response, enforcing the configured cap on pending commands.
+// The caller must hold client.mu.
+func (client *baseClient) registerPending(channelPtr unsafe.Pointer) error {
+	if client.maxPending > 0 && len(client.pending) >= client.maxPending {
+		return NewPendingLimitError(
+			fmt.Sprintf("the client has reached the limit of %d pending commands", client.maxPending),
+		)
+	}
+	client.pending[channelPtr] = struct{}{}
+	if len(client.pending) > client.stats.peakPendingCommands {
+		client.stats.peakPendingCommands = len(client.pending)
+	}
+	return nil
+}
+
+// Statistics returns a snapshot of the client's internal counters.
+//
+// Return value:
+//
+//	A [ClientStatistics] describing the pending commands and pinned memory of the client.
+func (client *baseClient) Statistics() ClientStatistics {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	stats := ClientStatistics{
+		PendingCommands:     len(client.pending),
+		PeakPendingCommands: client.stats.peakPendingCommands,
+		MaxPendingCommands:  client.maxPending,
+		PinnedObjects:       pinnedObjects.Load(),
+		HedgedReads:         client.stats.hedgedReads,
+		HedgeWins:           client.stats.hedgeWins,
+		CoalescedReads:      client.stats.coalescedReads,
+		Healthy:             true,
+		BytesByFamily:       make(map[string]CommandBytes, len(client.stats.bytesByFamily)),
+	}
+	for family, totals := range client.stats.bytesByFamily {
+		stats.BytesByFamily[family] = *totals
+	}
+	if client.heartbeat != nil {
+		stats.LastHeartbeatSuccess, stats.ConsecutiveHeartbeatFailures, stats.Healthy, stats.HeartbeatReconnections =
+			client.heartbeat.snapshot()
+	}
+	if client.seedResolver != nil {
+		stats.SeedAddresses, stats.SeedsResolvedAt, stats.SeedResolutionError = client.seedResolver.snapshot()
+	}
+	return stats
+}
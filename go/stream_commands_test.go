@@ -2446,6 +2446,49 @@ func ExampleClient_XInfoGroups() {
 	// Lag:                    0
 }
 
+func ExampleClient_StreamLag() {
+	var client *Client = getExampleClient() // example helper function
+	key := uuid.NewString()
+	group := "myGroup"
+
+	// create an empty stream with a group, add three entries and read two of them
+	client.XGroupCreateWithOptions(context.Background(), key, group, "0-0", *options.NewXGroupCreateOptions().SetMakeStream())
+	for _, id := range []string{"0-1", "0-2", "0-3"} {
+		client.XAddWithOptions(
+			context.Background(),
+			key,
+			[]models.FieldValue{{Field: "field", Value: "value"}},
+			*options.NewXAddOptions().SetId(id),
+		)
+	}
+	client.XReadGroupWithOptions(
+		context.Background(),
+		group,
+		"myConsumer",
+		map[string]string{key: ">"},
+		*options.NewXReadGroupOptions().SetCount(2),
+	)
+
+	lag, err := client.StreamLag(context.Background(), key, group)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Printf("Pending:                %d\n", lag.Pending)
+	fmt.Printf("Oldest pending message: %s\n", lag.OldestPendingId.Value())
+	fmt.Printf("Last delivered message: %s\n", lag.LastDeliveredId)
+	fmt.Printf("Last entry:             %s\n", lag.LastEntryId)
+	fmt.Printf("Caught up:              %t\n", lag.CaughtUp)
+	fmt.Printf("Lag:                    %d\n", lag.Lag.Value()) // Added in version 7.0.0
+
+	// Output:
+	// Pending:                2
+	// Oldest pending message: 0-1
+	// Last delivered message: 0-2
+	// Last entry:             0-3
+	// Caught up:              false
+	// Lag:                    1
+}
+
 func ExampleClusterClient_XInfoGroups() {
 	var client *ClusterClient = getExampleClusterClient() // example helper function
 	key := uuid.NewString()
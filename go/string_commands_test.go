@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/valkey-io/valkey-glide/go/v2/options"
@@ -147,6 +148,39 @@ func ExampleClusterClient_GetEx() {
 	// -1
 }
 
+func ExampleClient_GetWithTTL() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	client.Expire(context.Background(), "my_key", 10*time.Second)
+	result, ttl, err := client.GetWithTTL(context.Background(), "my_key")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+	fmt.Println(ttl > 0 && ttl <= 10*time.Second)
+
+	// Output:
+	// my_value
+	// true
+}
+
+func ExampleClusterClient_GetWithTTL() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	result, ttl, err := client.GetWithTTL(context.Background(), "my_key")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Value())
+	fmt.Println(ttl)
+
+	// Output:
+	// my_value
+	// -1ns
+}
+
 func ExampleClient_GetExWithOptions() {
 	var client *Client = getExampleClient() // example helper function
 
@@ -876,3 +910,247 @@ func ExampleClusterClient_LCSWithOptions_minmatchlen() {
 	//   "Len": 6
 	// }
 }
+
+func ExampleClient_CompareAndSet() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "v1")
+	swapped, err := client.CompareAndSet(context.Background(), "my_key", "v1", "v2")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(swapped)
+	swapped, _ = client.CompareAndSet(context.Background(), "my_key", "v1", "v3") // value is no longer "v1"
+	fmt.Println(swapped)
+	value, _ := client.Get(context.Background(), "my_key")
+	fmt.Println(value.Value())
+
+	// Output:
+	// true
+	// false
+	// v2
+}
+
+func ExampleClusterClient_CompareAndSet() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "v1")
+	swapped, err := client.CompareAndSet(context.Background(), "my_key", "v1", "v2")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(swapped)
+	swapped, _ = client.CompareAndSet(context.Background(), "my_key", "v1", "v3") // value is no longer "v1"
+	fmt.Println(swapped)
+	value, _ := client.Get(context.Background(), "my_key")
+	fmt.Println(value.Value())
+
+	// Output:
+	// true
+	// false
+	// v2
+}
+
+func ExampleClient_CompareAndDelete() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	deleted, err := client.CompareAndDelete(context.Background(), "my_key", "other_value")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(deleted)
+	deleted, _ = client.CompareAndDelete(context.Background(), "my_key", "my_value")
+	fmt.Println(deleted)
+
+	// Output:
+	// false
+	// true
+}
+
+func ExampleClusterClient_CompareAndDelete() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "my_value")
+	deleted, err := client.CompareAndDelete(context.Background(), "my_key", "other_value")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(deleted)
+	deleted, _ = client.CompareAndDelete(context.Background(), "my_key", "my_value")
+	fmt.Println(deleted)
+
+	// Output:
+	// false
+	// true
+}
+
+func ExampleClient_MGetDel() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.MSet(context.Background(), map[string]string{"job1": "payload1", "job2": "payload2"})
+	result, err := client.MGetDel(context.Background(), []string{"job1", "job2", "job3"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, res := range result {
+		fmt.Println(res.Value(), res.IsNil())
+	}
+	exists, _ := client.Exists(context.Background(), []string{"job1", "job2"})
+	fmt.Println(exists)
+
+	// Output:
+	// payload1 false
+	// payload2 false
+	//  true
+	// 0
+}
+
+func ExampleClusterClient_MGetDel() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.MSet(context.Background(), map[string]string{"{job}1": "payload1", "{job}2": "payload2"})
+	result, err := client.MGetDel(context.Background(), []string{"{job}1", "{job}2", "{job}3"})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, res := range result {
+		fmt.Println(res.Value(), res.IsNil())
+	}
+	exists, _ := client.Exists(context.Background(), []string{"{job}1", "{job}2"})
+	fmt.Println(exists)
+
+	// Output:
+	// payload1 false
+	// payload2 false
+	//  true
+	// 0
+}
+
+func ExampleClient_SetPreservingTTL() {
+	var client *Client = getExampleClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "v1")
+	client.Expire(context.Background(), "my_key", 100*time.Second)
+	result, err := client.SetPreservingTTL(context.Background(), "my_key", "v2")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	ttl, _ := client.TTL(context.Background(), "my_key")
+	fmt.Println(ttl > 0)
+
+	// Output:
+	// OK
+	// true
+}
+
+func ExampleClusterClient_SetPreservingTTL() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	client.Set(context.Background(), "my_key", "v1")
+	client.Expire(context.Background(), "my_key", 100*time.Second)
+	result, err := client.SetPreservingTTL(context.Background(), "my_key", "v2")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	ttl, _ := client.TTL(context.Background(), "my_key")
+	fmt.Println(ttl > 0)
+
+	// Output:
+	// OK
+	// true
+}
+
+func ExampleClient_ReplaceValueKeepTTL() {
+	var client *Client = getExampleClient() // example helper function
+
+	replaced, err := client.ReplaceValueKeepTTL(context.Background(), "my_key", "v1")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(replaced)
+	client.Set(context.Background(), "my_key", "v1")
+	replaced, _ = client.ReplaceValueKeepTTL(context.Background(), "my_key", "v2")
+	fmt.Println(replaced)
+
+	// Output:
+	// false
+	// true
+}
+
+func ExampleClusterClient_ReplaceValueKeepTTL() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	replaced, err := client.ReplaceValueKeepTTL(context.Background(), "my_key", "v1")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(replaced)
+	client.Set(context.Background(), "my_key", "v1")
+	replaced, _ = client.ReplaceValueKeepTTL(context.Background(), "my_key", "v2")
+	fmt.Println(replaced)
+
+	// Output:
+	// false
+	// true
+}
+
+func ExampleClient_BulkLoad() {
+	var client *Client = getExampleClient() // example helper function
+
+	values := make(map[string]string)
+	for i := 0; i < 2500; i++ {
+		values[fmt.Sprintf("warmup:%d", i)] = strconv.Itoa(i)
+	}
+	opts := options.NewBulkOptions().SetChunkSize(1000).SetParallelism(2)
+	loaded, err := client.BulkLoad(context.Background(), values, *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(loaded)
+
+	result, err := client.BulkGet(context.Background(), []string{"warmup:0", "warmup:2499", "warmup:2500"}, *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, res := range result {
+		fmt.Println(res.Value(), res.IsNil())
+	}
+
+	// Output:
+	// 2500
+	// 0 false
+	// 2499 false
+	//  true
+}
+
+func ExampleClusterClient_BulkLoad() {
+	var client *ClusterClient = getExampleClusterClient() // example helper function
+
+	values := make(map[string]string)
+	for i := 0; i < 2500; i++ {
+		values[fmt.Sprintf("warmup:%d", i)] = strconv.Itoa(i)
+	}
+	opts := options.NewBulkOptions().SetChunkSize(1000).SetParallelism(2)
+	loaded, err := client.BulkLoad(context.Background(), values, *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(loaded)
+
+	result, err := client.BulkGet(context.Background(), []string{"warmup:0", "warmup:2499", "warmup:2500"}, *opts)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, res := range result {
+		fmt.Println(res.Value(), res.IsNil())
+	}
+
+	// Output:
+	// 2500
+	// 0 false
+	// 2499 false
+	//  true
+}
@@ -0,0 +1,376 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+// keyPositions locate the keys among the arguments of a command: the key at first, and every stride-th argument after
+// it, unless stride is 0.
+type keyPositions struct {
+	first  int
+	stride int
+}
+
+var (
+	singleKey = keyPositions{}
+	allKeys   = keyPositions{stride: 1}
+)
+
+// tenantKeyArgs are the commands tenant clients may send. Their keys are prefixed with the key prefix of the tenant,
+// and their responses contain no key names. Any other command could reach the keys of other tenants, and is rejected.
+var tenantKeyArgs = map[C.RequestType]keyPositions{
+	C.Append:           singleKey,
+	C.BitCount:         singleKey,
+	C.Decr:             singleKey,
+	C.DecrBy:           singleKey,
+	C.Del:              allKeys,
+	C.Exists:           allKeys,
+	C.Expire:           singleKey,
+	C.ExpireAt:         singleKey,
+	C.ExpireTime:       singleKey,
+	C.Get:              singleKey,
+	C.GetBit:           singleKey,
+	C.GetDel:           singleKey,
+	C.GetEx:            singleKey,
+	C.GetRange:         singleKey,
+	C.HDel:             singleKey,
+	C.HExists:          singleKey,
+	C.HGet:             singleKey,
+	C.HGetAll:          singleKey,
+	C.HIncrBy:          singleKey,
+	C.HIncrByFloat:     singleKey,
+	C.HKeys:            singleKey,
+	C.HLen:             singleKey,
+	C.HMGet:            singleKey,
+	C.HRandField:       singleKey,
+	C.HSet:             singleKey,
+	C.HSetNX:           singleKey,
+	C.HStrlen:          singleKey,
+	C.HVals:            singleKey,
+	C.Incr:             singleKey,
+	C.IncrBy:           singleKey,
+	C.IncrByFloat:      singleKey,
+	C.LIndex:           singleKey,
+	C.LInsert:          singleKey,
+	C.LLen:             singleKey,
+	C.LPop:             singleKey,
+	C.LPos:             singleKey,
+	C.LPush:            singleKey,
+	C.LRange:           singleKey,
+	C.LRem:             singleKey,
+	C.LSet:             singleKey,
+	C.LTrim:            singleKey,
+	C.MGet:             allKeys,
+	C.MSet:             {stride: 2},
+	C.MSetNX:           {stride: 2},
+	C.PExpire:          singleKey,
+	C.PExpireAt:        singleKey,
+	C.PExpireTime:      singleKey,
+	C.PTTL:             singleKey,
+	C.Persist:          singleKey,
+	C.PfAdd:            singleKey,
+	C.PfCount:          allKeys,
+	C.PfMerge:          allKeys,
+	C.RPop:             singleKey,
+	C.RPush:            singleKey,
+	C.Rename:           allKeys,
+	C.RenameNX:         allKeys,
+	C.SAdd:             singleKey,
+	C.SCard:            singleKey,
+	C.SDiff:            allKeys,
+	C.SInter:           allKeys,
+	C.SIsMember:        singleKey,
+	C.SMIsMember:       singleKey,
+	C.SMembers:         singleKey,
+	C.SPop:             singleKey,
+	C.SRandMember:      singleKey,
+	C.SRem:             singleKey,
+	C.SUnion:           allKeys,
+	C.Set:              singleKey,
+	C.SetBit:           singleKey,
+	C.SetRange:         singleKey,
+	C.Strlen:           singleKey,
+	C.TTL:              singleKey,
+	C.Touch:            allKeys,
+	C.Type:             singleKey,
+	C.Unlink:           allKeys,
+	C.XAdd:             singleKey,
+	C.XDel:             singleKey,
+	C.XLen:             singleKey,
+	C.XRange:           singleKey,
+	C.XRevRange:        singleKey,
+	C.XTrim:            singleKey,
+	C.ZAdd:             singleKey,
+	C.ZCard:            singleKey,
+	C.ZCount:           singleKey,
+	C.ZIncrBy:          singleKey,
+	C.ZMScore:          singleKey,
+	C.ZPopMax:          singleKey,
+	C.ZPopMin:          singleKey,
+	C.ZRandMember:      singleKey,
+	C.ZRange:           singleKey,
+	C.ZRank:            singleKey,
+	C.ZRem:             singleKey,
+	C.ZRemRangeByRank:  singleKey,
+	C.ZRemRangeByScore: singleKey,
+	C.ZRevRank:         singleKey,
+	C.ZScore:           singleKey,
+}
+
+// commandKeys returns the keys of a command, or nil if they are not known to the client.
+func commandKeys(requestType C.RequestType, args []string) []string {
+	positions, ok := tenantKeyArgs[requestType]
+	if !ok {
+		if key, ok := readKey(requestType, args); ok {
+			return []string{key}
+		}
+		return nil
+	}
+	var keys []string
+	for idx := positions.first; idx < len(args); idx += positions.stride {
+		keys = append(keys, args[idx])
+		if positions.stride == 0 {
+			break
+		}
+	}
+	return keys
+}
+
+// writeKeys returns the keys of a command that may modify them, or nil if the command is read-only, or if its keys are
+// not known to the client.
+func writeKeys(requestType C.RequestType, args []string) []string {
+	if _, ok := tenantKeyArgs[requestType]; !ok {
+		return nil
+	}
+	if isReadOnlyRequest(RequestType(requestType), args) {
+		return nil
+	}
+	return commandKeys(requestType, args)
+}
+
+// tenantScope restricts a tenant client to the keys starting with its prefix, and to its rate limit. It is shared by
+// pointer between the copies of a tenant client.
+type tenantScope struct {
+	id     string
+	prefix string
+	// limiter is nil if the commands of the tenant are not rate limited.
+	limiter *tokenBucket
+}
+
+// scope returns a copy of the arguments of a command with its keys prefixed, or an error if the command may not be
+// sent by the tenant.
+func (tenant *tenantScope) scope(requestType C.RequestType, args []string) ([]string, error) {
+	positions, ok := tenantKeyArgs[requestType]
+	if !ok {
+		name, _ := commandName(requestType, args)
+		return nil, NewRequestError(fmt.Sprintf("%s cannot be sent by the client of tenant %q", name, tenant.id))
+	}
+	scoped := append([]string(nil), args...)
+	for idx := positions.first; idx < len(scoped); idx += positions.stride {
+		scoped[idx] = tenant.prefix + scoped[idx]
+		if positions.stride == 0 {
+			break
+		}
+	}
+	return scoped, nil
+}
+
+// scopeBatch returns a copy of a batch with the keys of its commands prefixed, or an error if one of its commands may not
+// be sent by the tenant.
+func (tenant *tenantScope) scopeBatch(batch internal.Batch) (internal.Batch, error) {
+	scoped := batch
+	scoped.Commands = make([]internal.Cmd, len(batch.Commands))
+	for idx, cmd := range batch.Commands {
+		args, err := tenant.scope(C.RequestType(cmd.RequestType), cmd.Args)
+		if err != nil {
+			return internal.Batch{}, err
+		}
+		cmd.Args = args
+		scoped.Commands[idx] = cmd
+	}
+	return scoped, nil
+}
+
+// allow takes n commands from the rate limit of the tenant, or returns an error if the limit is exceeded.
+func (tenant *tenantScope) allow(n int) error {
+	if tenant.limiter == nil || tenant.limiter.take(n, time.Now()) {
+		return nil
+	}
+	return NewRateLimitError(fmt.Sprintf("tenant %q exceeded its rate limit", tenant.id))
+}
+
+// unsupported returns the error of an operation that tenant clients do not support.
+func (tenant *tenantScope) unsupported(operation string) error {
+	return NewRequestError(fmt.Sprintf("%s cannot be used by the client of tenant %q", operation, tenant.id))
+}
+
+// tokenBucket is a rate limiter refilled continuously at rate tokens per second, up to burst tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: now}
+}
+
+// take removes n tokens from the bucket, and reports whether there were enough.
+func (bucket *tokenBucket) take(n int, now time.Time) bool {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+	bucket.tokens = min(bucket.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.rate)
+	bucket.last = now
+	if bucket.tokens < float64(n) {
+		return false
+	}
+	bucket.tokens -= float64(n)
+	return true
+}
+
+// newTenantClient returns a client sharing the core connection of this client, restricted to the keys of a tenant.
+func (client *baseClient) newTenantClient(tenantID string, opts options.TenantOptions) (baseClient, error) {
+	if tenantID == "" {
+		return baseClient{}, NewConfigurationError("the tenant ID cannot be empty")
+	}
+	if opts.RateLimit < 0 || opts.Burst < 0 || (opts.RateLimit > 0 && opts.Burst < 1) {
+		return baseClient{}, NewConfigurationError("the rate limit and burst cannot be negative, and the burst must be " +
+			"at least 1 if the rate is limited")
+	}
+	tenant := &tenantScope{id: tenantID, prefix: opts.KeyPrefix}
+	if tenant.prefix == "" {
+		tenant.prefix = tenantID + ":"
+	}
+	if client.tenant != nil {
+		// A tenant client derived from another one stays within the keyspace of its parent.
+		tenant.prefix = client.tenant.prefix + tenant.prefix
+	}
+	if opts.RateLimit > 0 {
+		tenant.limiter = newTokenBucket(opts.RateLimit, opts.Burst, time.Now())
+	}
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	derived := *client
+	derived.messageHandler = nil
+	derived.derived = true
+	derived.tenant = tenant
+	if client.coalescer != nil {
+		// Coalescing keys are built from the unscoped keys, so sharing the coalescer of the parent would hand the values
+		// of one tenant to the reads of another.
+		derived.coalescer = newReadCoalescer()
+	}
+	return derived, nil
+}
+
+// registerTenant registers a tenant client with the subscribers of the shared connection, without subscriptions, so
+// that it is closed along with the client it was derived from.
+func (client *baseClient) registerTenant() error {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.coreClient == nil {
+		return NewClosingError("TenantClient failed. The client is closed.")
+	}
+	client.subscribers.add(client, nil)
+	return nil
+}
+
+// TenantClient returns a [Client] sharing the connection of this client, whose commands only access the keys of the
+// given tenant, prefixed with the tenant ID followed by a colon, see [Client.TenantClientWithOptions].
+//
+// Parameters:
+//
+//	tenantID - The ID of the tenant.
+//
+// Return value:
+//
+//	A [Client] scoped to the keys of the tenant.
+func (client *Client) TenantClient(tenantID string) (*Client, error) {
+	return client.TenantClientWithOptions(tenantID, *options.NewTenantOptions())
+}
+
+// TenantClientWithOptions returns a [Client] sharing the connection of this client, whose commands only access the keys
+// of the given tenant, for multi-tenant applications sharing a single keyspace. The keys of the commands are prefixed
+// with the key prefix of the tenant, and the commands whose keys cannot be prefixed, or whose responses contain key
+// names, such as SCAN, KEYS, scripts, functions, Pub/Sub or server management commands, are rejected with a
+// [RequestError]. Commands exceeding the rate limit of the tenant are rejected with a [RateLimitError]. Commands
+// rejected by the other checks of the client, such as the command filter or the quota hook, do not count against it.
+//
+// Closing the tenant client leaves the shared connection open. Closing this client also closes all tenant clients.
+//
+// Parameters:
+//
+//	tenantID - The ID of the tenant.
+//	opts - The key prefix and rate limit of the tenant.
+//
+// Return value:
+//
+//	A [Client] scoped to the keys of the tenant.
+func (client *Client) TenantClientWithOptions(tenantID string, opts options.TenantOptions) (*Client, error) {
+	derived, err := client.newTenantClient(tenantID, opts)
+	if err != nil {
+		return nil, err
+	}
+	tenantClient := &Client{derived}
+	if err := tenantClient.registerTenant(); err != nil {
+		return nil, err
+	}
+	return tenantClient, nil
+}
+
+// TenantClient returns a [ClusterClient] sharing the connection of this client, whose commands only access the keys of
+// the given tenant, prefixed with the tenant ID followed by a colon, see [ClusterClient.TenantClientWithOptions].
+//
+// Parameters:
+//
+//	tenantID - The ID of the tenant.
+//
+// Return value:
+//
+//	A [ClusterClient] scoped to the keys of the tenant.
+func (client *ClusterClient) TenantClient(tenantID string) (*ClusterClient, error) {
+	return client.TenantClientWithOptions(tenantID, *options.NewTenantOptions())
+}
+
+// TenantClientWithOptions returns a [ClusterClient] sharing the connection of this client, whose commands only access
+// the keys of the given tenant, for multi-tenant applications sharing a single keyspace. The keys of the commands are
+// prefixed with the key prefix of the tenant, and the commands whose keys cannot be prefixed, or whose responses
+// contain key names, such as SCAN, KEYS, scripts, functions, Pub/Sub or server management commands, are rejected with
+// a [RequestError]. Commands exceeding the rate limit of the tenant are rejected with a [RateLimitError].
+//
+// The keys of a tenant are spread over the slots of the cluster, unless the key prefix contains a hash tag, e.g.
+// "{tenant}:", which places all of them in the same slot.
+//
+// Closing the tenant client leaves the shared connection open. Closing this client also closes all tenant clients.
+//
+// Parameters:
+//
+//	tenantID - The ID of the tenant.
+//	opts - The key prefix and rate limit of the tenant.
+//
+// Return value:
+//
+//	A [ClusterClient] scoped to the keys of the tenant.
+func (client *ClusterClient) TenantClientWithOptions(tenantID string, opts options.TenantOptions) (*ClusterClient, error) {
+	derived, err := client.newTenantClient(tenantID, opts)
+	if err != nil {
+		return nil, err
+	}
+	tenantClient := &ClusterClient{derived}
+	if err := tenantClient.registerTenant(); err != nil {
+		return nil, err
+	}
+	return tenantClient, nil
+}
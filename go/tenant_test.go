@@ -0,0 +1,93 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	bucket := newTokenBucket(10, 2, now)
+	assert.True(t, bucket.take(1, now))
+	assert.True(t, bucket.take(1, now))
+	assert.False(t, bucket.take(1, now))
+
+	// refilled at 10 tokens per second, up to the burst
+	assert.True(t, bucket.take(1, now.Add(100*time.Millisecond)))
+	assert.False(t, bucket.take(1, now.Add(100*time.Millisecond)))
+	assert.False(t, bucket.take(3, now.Add(time.Hour)))
+	assert.True(t, bucket.take(2, now.Add(time.Hour)))
+}
+
+func TestTenantScopeAllow(t *testing.T) {
+	unlimited := &tenantScope{id: "t1"}
+	assert.NoError(t, unlimited.allow(1000))
+
+	limited := &tenantScope{id: "t1", limiter: newTokenBucket(0.001, 1, time.Now())}
+	assert.NoError(t, limited.allow(1))
+	var rateErr *RateLimitError
+	assert.ErrorAs(t, limited.allow(1), &rateErr)
+}
+
+func TestTenantRateLimitTakenAfterChecks(t *testing.T) {
+	client := &baseClient{
+		pending:       make(map[unsafe.Pointer]struct{}),
+		mu:            &sync.Mutex{},
+		stats:         &clientStats{},
+		commandFilter: newCommandFilter(nil, []string{"SET"}),
+		tenant:        &tenantScope{id: "t1", prefix: "t1:", limiter: newTokenBucket(0.001, 1, time.Now())},
+	}
+	ctx := context.Background()
+	for range 3 {
+		_, err := client.Set(ctx, "key", "value")
+		var filterErr *ForbiddenCommandError
+		assert.ErrorAs(t, err, &filterErr)
+	}
+
+	// The rejected commands did not use the only token of the tenant, which the next command takes.
+	_, err := client.Get(ctx, "key")
+	assert.IsType(t, &ClosingError{}, err)
+	_, err = client.Get(ctx, "key")
+	var rateErr *RateLimitError
+	assert.ErrorAs(t, err, &rateErr)
+}
+
+func TestNewTenantClient(t *testing.T) {
+	client := &baseClient{mu: &sync.Mutex{}}
+	_, err := client.newTenantClient("", *options.NewTenantOptions())
+	assert.ErrorContains(t, err, "the tenant ID cannot be empty")
+	_, err = client.newTenantClient("t1", *options.NewTenantOptions().SetRateLimit(10, 0))
+	assert.ErrorContains(t, err, "the burst must be at least 1")
+
+	tenant, err := client.newTenantClient("t1", *options.NewTenantOptions())
+	assert.NoError(t, err)
+	assert.True(t, tenant.derived)
+	assert.Equal(t, "t1:", tenant.tenant.prefix)
+	assert.Nil(t, tenant.tenant.limiter)
+
+	nested, err := tenant.newTenantClient("t2", *options.NewTenantOptions().SetKeyPrefix("{t2}/").SetRateLimit(10, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, "t1:{t2}/", nested.tenant.prefix)
+	assert.NotNil(t, nested.tenant.limiter)
+
+	assert.ErrorContains(t, tenant.registerTenant(), "The client is closed")
+}
+
+func TestNewTenantClient_OwnCoalescer(t *testing.T) {
+	client := &baseClient{mu: &sync.Mutex{}, coalescer: newReadCoalescer()}
+	tenantA, err := client.newTenantClient("a", *options.NewTenantOptions())
+	assert.NoError(t, err)
+	tenantB, err := client.newTenantClient("b", *options.NewTenantOptions())
+	assert.NoError(t, err)
+	assert.NotNil(t, tenantA.coalescer)
+	assert.NotSame(t, client.coalescer, tenantA.coalescer)
+	assert.NotSame(t, tenantA.coalescer, tenantB.coalescer)
+}
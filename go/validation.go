@@ -0,0 +1,192 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/valkey-io/valkey-glide/go/v2/internal/utils"
+)
+
+// argumentCheck validates the arguments of a command, returning the reason they are invalid, or "" if they are valid.
+type argumentCheck func(args []string) string
+
+// requireKey requires the first argument to be a non-empty key.
+func requireKey(args []string) string {
+	if len(args) == 0 {
+		return "a key is required"
+	}
+	if args[0] == "" {
+		return "the key cannot be empty"
+	}
+	return ""
+}
+
+// requireKeys requires at least one argument, with every stride-th argument, starting from the first one, being a
+// non-empty key.
+func requireKeys(stride int) argumentCheck {
+	return func(args []string) string {
+		if len(args) == 0 {
+			return "at least one key is required"
+		}
+		for idx := 0; idx < len(args); idx += stride {
+			if args[idx] == "" {
+				return fmt.Sprintf("the key at argument %d cannot be empty", idx)
+			}
+		}
+		return ""
+	}
+}
+
+// requireElements requires at least n arguments after the key.
+func requireElements(n int) argumentCheck {
+	return func(args []string) string {
+		if len(args)-1 < n {
+			return fmt.Sprintf("at least %d element(s) are required after the key", n)
+		}
+		return ""
+	}
+}
+
+// requireMultipleOf requires the number of arguments after the first skip arguments to be a multiple of n.
+func requireMultipleOf(n int, skip int) argumentCheck {
+	return func(args []string) string {
+		if len(args) < skip || (len(args)-skip)%n != 0 {
+			return fmt.Sprintf("the arguments must come in groups of %d", n)
+		}
+		return ""
+	}
+}
+
+// requireNonNegative requires the argument at idx, if present, to be an integer that is not negative.
+func requireNonNegative(idx int) argumentCheck {
+	return func(args []string) string {
+		if idx >= len(args) {
+			return ""
+		}
+		value, err := strconv.ParseInt(args[idx], 10, 64)
+		if err != nil || value < 0 {
+			return fmt.Sprintf("argument %d must be a non-negative integer, got %q", idx, args[idx])
+		}
+		return ""
+	}
+}
+
+// requireNonNegativeTimeout requires the last argument to be a non-negative timeout in seconds.
+func requireNonNegativeTimeout(args []string) string {
+	if len(args) == 0 {
+		return "a timeout is required"
+	}
+	value, err := strconv.ParseFloat(args[len(args)-1], 64)
+	if err != nil || value < 0 {
+		return fmt.Sprintf("the timeout must not be negative, got %q", args[len(args)-1])
+	}
+	return ""
+}
+
+// withoutLast applies check to all arguments but the last one.
+func withoutLast(check argumentCheck) argumentCheck {
+	return func(args []string) string {
+		if len(args) == 0 {
+			return check(args)
+		}
+		return check(args[:len(args)-1])
+	}
+}
+
+// strictRules are the commands checked in strict validation mode. Commands not listed here are sent unchecked.
+var strictRules = map[C.RequestType][]argumentCheck{
+	C.Append:    {requireKey, requireElements(1)},
+	C.BLPop:     {withoutLast(requireKeys(1)), requireNonNegativeTimeout},
+	C.BRPop:     {withoutLast(requireKeys(1)), requireNonNegativeTimeout},
+	C.Decr:      {requireKey},
+	C.DecrBy:    {requireKey, requireElements(1)},
+	C.Del:       {requireKeys(1)},
+	C.Exists:    {requireKeys(1)},
+	C.Expire:    {requireKey, requireElements(1)},
+	C.Get:       {requireKey},
+	C.GetBit:    {requireKey, requireElements(1), requireNonNegative(1)},
+	C.GetDel:    {requireKey},
+	C.HDel:      {requireKey, requireElements(1)},
+	C.HExists:   {requireKey, requireElements(1)},
+	C.HGet:      {requireKey, requireElements(1)},
+	C.HGetAll:   {requireKey},
+	C.HLen:      {requireKey},
+	C.HMGet:     {requireKey, requireElements(1)},
+	C.HSet:      {requireKey, requireElements(2), requireMultipleOf(2, 1)},
+	C.Incr:      {requireKey},
+	C.IncrBy:    {requireKey, requireElements(1)},
+	C.LLen:      {requireKey},
+	C.LPop:      {requireKey, requireNonNegative(1)},
+	C.LPush:     {requireKey, requireElements(1)},
+	C.LRange:    {requireKey, requireElements(2)},
+	C.MGet:      {requireKeys(1)},
+	C.MSet:      {requireKeys(2), requireMultipleOf(2, 0)},
+	C.MSetNX:    {requireKeys(2), requireMultipleOf(2, 0)},
+	C.PExpire:   {requireKey, requireElements(1)},
+	C.PTTL:      {requireKey},
+	C.Persist:   {requireKey},
+	C.PfAdd:     {requireKey},
+	C.PfCount:   {requireKeys(1)},
+	C.RPop:      {requireKey, requireNonNegative(1)},
+	C.RPush:     {requireKey, requireElements(1)},
+	C.SAdd:      {requireKey, requireElements(1)},
+	C.SCard:     {requireKey},
+	C.SDiff:     {requireKeys(1)},
+	C.SInter:    {requireKeys(1)},
+	C.SIsMember: {requireKey, requireElements(1)},
+	C.SMembers:  {requireKey},
+	C.SPop:      {requireKey, requireNonNegative(1)},
+	C.SRem:      {requireKey, requireElements(1)},
+	C.SUnion:    {requireKeys(1)},
+	C.Set:       {requireKey, requireElements(1)},
+	C.SetBit:    {requireKey, requireElements(2), requireNonNegative(1)},
+	C.SetRange:  {requireKey, requireElements(2), requireNonNegative(1)},
+	C.Strlen:    {requireKey},
+	C.TTL:       {requireKey},
+	C.Touch:     {requireKeys(1)},
+	C.Type:      {requireKey},
+	C.Unlink:    {requireKeys(1)},
+	C.XAdd:      {requireKey, requireElements(3)},
+	C.XLen:      {requireKey},
+	C.ZAdd:      {requireKey, requireElements(2)},
+	C.ZCard:     {requireKey},
+	C.ZPopMax:   {requireKey, requireNonNegative(1)},
+	C.ZPopMin:   {requireKey, requireNonNegative(1)},
+	C.ZRem:      {requireKey, requireElements(1)},
+	C.ZScore:    {requireKey, requireElements(1)},
+}
+
+// validateStrict checks the arguments of a command in strict validation mode, before it is sent to the server.
+func validateStrict(requestType C.RequestType, args []string) error {
+	for _, check := range strictRules[requestType] {
+		if reason := check(args); reason != "" {
+			name, _ := commandName(requestType, args)
+			return NewRequestError(fmt.Sprintf("invalid arguments for %s: %s", name, reason))
+		}
+	}
+	return nil
+}
+
+// validatePopCount rejects the counts of the commands popping several elements that are not positive, which the server
+// rejects, or answers inconsistently across versions for a count of 0.
+func validatePopCount(command string, count int64) error {
+	if count <= 0 {
+		return NewRequestError(fmt.Sprintf("invalid arguments for %s: the count must be positive, got %d", command, count))
+	}
+	return nil
+}
+
+// validateFloat rejects a NaN argument of command, which the server fails to parse, or stores as an unexpected score
+// when it is formatted as "nan". Infinities are rejected unless allowInf is set, since INCRBYFLOAT and HINCRBYFLOAT
+// refuse to produce them.
+func validateFloat(command string, value float64, allowInf bool) error {
+	if err := utils.ValidateFloat(value, allowInf); err != nil {
+		return NewRequestError(fmt.Sprintf("invalid arguments for %s: %s", command, err))
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/valkey-io/valkey-glide/go/v2/constants"
+	"github.com/valkey-io/valkey-glide/go/v2/options"
+)
+
+func TestArgumentChecks(t *testing.T) {
+	assert.Empty(t, requireKey([]string{"key"}))
+	assert.Equal(t, "the key cannot be empty", requireKey([]string{""}))
+	assert.Equal(t, "a key is required", requireKey(nil))
+
+	assert.Empty(t, requireKeys(2)([]string{"k1", "", "k2", ""}))
+	assert.Equal(t, "the key at argument 2 cannot be empty", requireKeys(2)([]string{"k1", "v1", "", "v2"}))
+	assert.Equal(t, "at least one key is required", requireKeys(1)([]string{}))
+
+	assert.Empty(t, requireElements(2)([]string{"key", "a", "b"}))
+	assert.NotEmpty(t, requireElements(2)([]string{"key", "a"}))
+
+	assert.Empty(t, requireMultipleOf(2, 1)([]string{"key", "f1", "v1"}))
+	assert.NotEmpty(t, requireMultipleOf(2, 1)([]string{"key", "f1", "v1", "f2"}))
+
+	assert.Empty(t, requireNonNegative(1)([]string{"key"}))
+	assert.Empty(t, requireNonNegative(1)([]string{"key", "0"}))
+	assert.NotEmpty(t, requireNonNegative(1)([]string{"key", "-1"}))
+
+	assert.Empty(t, withoutLast(requireKeys(1))([]string{"k1", "0.5"}))
+	assert.NotEmpty(t, withoutLast(requireKeys(1))([]string{"0.5"}))
+	assert.NotEmpty(t, requireNonNegativeTimeout([]string{"k1", "-1"}))
+
+	assert.NoError(t, validatePopCount("LPOP", 1))
+	assert.EqualError(t, validatePopCount("LPOP", 0), "invalid arguments for LPOP: the count must be positive, got 0")
+	assert.IsType(t, &RequestError{}, validatePopCount("RPOP", -1))
+
+	assert.NoError(t, validateFloat("ZINCRBY", math.Inf(1), true))
+	assert.EqualError(t, validateFloat("ZINCRBY", math.NaN(), true), "invalid arguments for ZINCRBY: the value cannot be NaN")
+	assert.EqualError(t, validateFloat("INCRBYFLOAT", math.Inf(-1), false),
+		"invalid arguments for INCRBYFLOAT: the value cannot be -Inf")
+}
+
+func TestScoreBoundaries(t *testing.T) {
+	assert.Equal(t, options.ScoreBound("3.5"), options.ScoreBoundary(3.5, options.Inclusive))
+	assert.Equal(t, options.ScoreBound("(3.5"), options.ScoreBoundary(3.5, options.Exclusive))
+	assert.Equal(t, options.NegInf(), options.ScoreBoundary(math.Inf(-1), options.Exclusive))
+	assert.Equal(t, options.PosInf(), options.ScoreBoundary(math.Inf(1), options.Inclusive))
+
+	args, err := options.NewRangeByScoreQuery(options.NegInf(), options.ScoreBoundary(2, options.Exclusive)).
+		SetReverse().
+		ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"-inf", "(2", "BYSCORE", "REV"}, args)
+	_, err = options.NewRangeByScoreQuery(options.ScoreBoundary(math.NaN(), options.Inclusive), options.PosInf()).ToArgs()
+	assert.EqualError(t, err, `invalid score boundary: "NaN"`)
+	_, err = options.NewRangeByScoreQuery(options.NegInf(), options.ScoreBound("[1")).ToArgsRemRange()
+	assert.EqualError(t, err, `invalid score boundary: "[1"`)
+	_, err = options.NewZCountRange(options.ScoreBound(""), options.PosInf()).ToArgs()
+	assert.EqualError(t, err, `invalid score boundary: ""`)
+}
+
+func TestLexBoundaries(t *testing.T) {
+	assert.Equal(t, options.LexBound("[a"), options.LexBoundary("a", options.Inclusive))
+	assert.Equal(t, options.LexBound("(a"), options.LexBoundary("a", options.Exclusive))
+	assert.Equal(t, options.LexBound("-"), options.LexNegInf())
+	assert.Equal(t, options.LexBound("+"), options.LexPosInf())
+
+	args, err := options.NewRangeByLexQuery(options.LexBoundary("a", options.Exclusive), options.LexPosInf()).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"(a", "+", "BYLEX"}, args)
+	err = options.NewRangeByLexQuery(options.LexBound("a"), options.LexPosInf()).Validate()
+	assert.EqualError(t, err, `invalid lex boundary: "a"`)
+	_, err = options.NewRangeByLexQuery(options.LexNegInf(), options.LexBound("")).ToArgsRemRange()
+	assert.EqualError(t, err, `invalid lex boundary: ""`)
+}
+
+func TestZAddIncrOptions(t *testing.T) {
+	opts, err := options.NewZAddOptions().SetIncr(true, 1e21, "member")
+	assert.NoError(t, err)
+	args, err := opts.ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"INCR", "1000000000000000000000", "member"}, args)
+
+	_, err = options.NewZAddOptions().SetIncr(true, math.NaN(), "member")
+	assert.EqualError(t, err, "invalid increment: the value cannot be NaN")
+}
+
+func TestScanOptions(t *testing.T) {
+	args, err := options.NewScanOptions().SetMatch("k*").SetCount(options.DefaultScanCount).
+		SetType(constants.ObjectTypeHash).
+		ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TYPE", "hash", "MATCH", "k*", "COUNT", "10"}, args)
+
+	_, err = options.NewScanOptions().SetCount(-1).ToArgs()
+	assert.EqualError(t, err, "the COUNT of a scan must be positive")
+	_, err = options.NewClusterScanOptions().SetCount(-1).ToArgs()
+	assert.Error(t, err)
+	_, err = options.NewHashScanOptions().SetCount(-1).ToArgs()
+	assert.Error(t, err)
+}
+
+func TestDeleteByPatternOptions(t *testing.T) {
+	opts := options.NewDeleteByPatternOptions()
+	assert.NoError(t, opts.Validate("session:*"))
+	assert.EqualError(t, opts.Validate(""), "the pattern of the keys to delete cannot be empty")
+	assert.Error(t, opts.Validate("*"))
+	assert.NoError(t, opts.SetAllowMatchAll(true).Validate("*"))
+	assert.EqualError(t, opts.SetBatchSize(0).Validate("session:*"), "the batch size must be positive")
+	assert.Error(t, options.NewDeleteByPatternOptions().SetMaxKeysPerSecond(-1).Validate("session:*"))
+}
+
+func TestTTLWithJitter(t *testing.T) {
+	assert.Equal(t, time.Hour, options.TTLWithJitter(time.Hour, 0))
+	assert.Equal(t, time.Hour, options.TTLWithJitter(time.Hour, -1))
+	assert.Equal(t, time.Millisecond, options.TTLWithJitter(0, 0.5))
+	for range 100 {
+		ttl := options.TTLWithJitter(time.Hour, 0.1)
+		assert.GreaterOrEqual(t, ttl, 54*time.Minute)
+		assert.LessOrEqual(t, ttl, 66*time.Minute)
+		assert.Zero(t, ttl%time.Millisecond)
+
+		expiry := options.NewExpiryInWithJitter(10*time.Second, 0.5)
+		millis := expiry.Duration
+		if expiry.Type == constants.Seconds {
+			millis *= 1000
+		}
+		assert.GreaterOrEqual(t, millis, uint64(5000))
+		assert.LessOrEqual(t, millis, uint64(15000))
+	}
+}
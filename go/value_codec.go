@@ -0,0 +1,121 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+// #include "lib.h"
+import "C"
+
+import (
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// valuePositions locate the values among the arguments of a command: the value at first, and every stride-th argument
+// after it, unless stride is 0.
+type valuePositions struct {
+	first  int
+	stride int
+}
+
+// encodedValueArgs are the commands whose values are encoded before being written.
+var encodedValueArgs = map[C.RequestType]valuePositions{
+	C.HSet:   {first: 2, stride: 2},
+	C.HSetNX: {first: 2},
+	C.MSet:   {first: 1, stride: 2},
+	C.MSetNX: {first: 1, stride: 2},
+	C.Set:    {first: 1},
+}
+
+// encodeArgs returns a copy of the arguments of a command with its values encoded, or the arguments as is if the
+// values of the command are not encoded.
+func (client *baseClient) encodeArgs(requestType C.RequestType, args []string) ([]string, error) {
+	positions, ok := encodedValueArgs[requestType]
+	if !ok || len(client.transformers) == 0 {
+		return args, nil
+	}
+	encoded := append([]string(nil), args...)
+	for idx := positions.first; idx < len(encoded); idx += positions.stride {
+		value, err := client.encodeValue(encoded[idx])
+		if err != nil {
+			return nil, err
+		}
+		encoded[idx] = value
+		if positions.stride == 0 {
+			break
+		}
+	}
+	return encoded, nil
+}
+
+// encodeValue passes a value through the transformers, before it is written.
+func (client *baseClient) encodeValue(value string) (string, error) {
+	var err error
+	for _, transformer := range client.transformers {
+		if value, err = transformer.Encode(value); err != nil {
+			return "", err
+		}
+	}
+	return value, nil
+}
+
+// decodeValue passes a value read from the server through the transformers in reverse order, restoring its original
+// form.
+func (client *baseClient) decodeValue(value string) (string, error) {
+	var err error
+	for idx := len(client.transformers) - 1; idx >= 0; idx-- {
+		if value, err = client.transformers[idx].Decode(value); err != nil {
+			return "", err
+		}
+	}
+	return value, nil
+}
+
+// decodeResult decodes the value of a result, unless it is nil or err is set.
+func (client *baseClient) decodeResult(result models.Result[string], err error) (models.Result[string], error) {
+	if err != nil || result.IsNil() || len(client.transformers) == 0 {
+		return result, err
+	}
+	value, err := client.decodeValue(result.Value())
+	if err != nil {
+		return models.CreateNilStringResult(), err
+	}
+	return models.CreateStringResult(value), nil
+}
+
+// decodeResults decodes the values of results, unless err is set.
+func (client *baseClient) decodeResults(results []models.Result[string], err error) ([]models.Result[string], error) {
+	if err != nil || len(client.transformers) == 0 {
+		return results, err
+	}
+	for idx, result := range results {
+		if results[idx], err = client.decodeResult(result, nil); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// decodeStrings decodes values in place, unless err is set.
+func (client *baseClient) decodeStrings(values []string, err error) ([]string, error) {
+	if err != nil || len(client.transformers) == 0 {
+		return values, err
+	}
+	for idx, value := range values {
+		if values[idx], err = client.decodeValue(value); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// decodeMap decodes the values of a map in place, unless err is set.
+func (client *baseClient) decodeMap(values map[string]string, err error) (map[string]string, error) {
+	if err != nil || len(client.transformers) == 0 {
+		return values, err
+	}
+	for key, value := range values {
+		if values[key], err = client.decodeValue(value); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
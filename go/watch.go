@@ -0,0 +1,185 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/v2/config"
+	"github.com/valkey-io/valkey-glide/go/v2/models"
+)
+
+// DefaultWatchInterval is the interval at which WatchValue polls a key when the given interval is not positive.
+const DefaultWatchInterval = time.Second
+
+// WatchValue returns a channel receiving the value of key every time it changes, starting with its current value. A
+// value of the channel contains nil while the key does not exist or does not hold a string.
+//
+// If keyspace notifications of string commands and generic commands are enabled on the server, e.g. with the "KA" or
+// "K$g" flags of notify-keyspace-events, the key is read again whenever a notification about it is received.
+// Otherwise, or if subscribing fails, the key is polled every interval.
+//
+// The channel is closed once stop is called, ctx is done, or the client is closed. Values are not buffered, so a
+// change is only reported once the previous value has been received, and intermediate changes may be missed.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the lifetime of the watch.
+//	key - The key to watch.
+//	interval - The interval at which the key is polled if keyspace notifications are not enabled. If not
+//	  positive, [DefaultWatchInterval] is used.
+//
+// Return value:
+//
+//	A channel receiving the values of the key, and a function stopping the watch.
+func (client *Client) WatchValue(
+	ctx context.Context,
+	key string,
+	interval time.Duration,
+) (<-chan models.Result[string], func()) {
+	if !client.keyspaceNotificationsEnabled(ctx) {
+		return client.watchValue(ctx, key, interval, nil, nil)
+	}
+	notifications := make(chan struct{}, 1)
+	notify := func(message *models.PubSubMessage, _ any) {
+		select {
+		case notifications <- struct{}{}:
+		default:
+			// A notification is already pending, which reads the latest value.
+		}
+	}
+	// The pattern matches the key in every database, since the client does not track the selected one.
+	pattern := "__keyspace@*__:" + escapeGlobPattern(key)
+	subscriber, err := client.WithSubscriptions(
+		ctx,
+		config.NewStandaloneSubscriptionConfig().
+			WithSubscription(config.PatternChannelMode, pattern).
+			WithCallback(notify, nil),
+	)
+	if err != nil {
+		return client.watchValue(ctx, key, interval, nil, nil)
+	}
+	return client.watchValue(ctx, key, interval, notifications, subscriber.Close)
+}
+
+// WatchValue returns a channel receiving the value of key every time it changes, starting with its current value. A
+// value of the channel contains nil while the key does not exist or does not hold a string.
+//
+// The key is polled every interval. Unlike [Client.WatchValue], keyspace notifications are not used, since they are
+// only published by the node holding the key, which may change over time.
+//
+// The channel is closed once stop is called, ctx is done, or the client is closed. Values are not buffered, so a
+// change is only reported once the previous value has been received, and intermediate changes may be missed.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the lifetime of the watch.
+//	key - The key to watch.
+//	interval - The interval at which the key is polled. If not positive, [DefaultWatchInterval] is used.
+//
+// Return value:
+//
+//	A channel receiving the values of the key, and a function stopping the watch.
+func (client *ClusterClient) WatchValue(
+	ctx context.Context,
+	key string,
+	interval time.Duration,
+) (<-chan models.Result[string], func()) {
+	return client.watchValue(ctx, key, interval, nil, nil)
+}
+
+// keyspaceNotificationsEnabled returns whether the server publishes keyspace notifications for the commands changing
+// a string value, and for the deletion and expiry of keys.
+func (client *Client) keyspaceNotificationsEnabled(ctx context.Context) bool {
+	result, err := client.ConfigGet(ctx, []string{"notify-keyspace-events"})
+	if err != nil {
+		return false
+	}
+	flags := result["notify-keyspace-events"]
+	if !strings.Contains(flags, "K") {
+		return false
+	}
+	return strings.Contains(flags, "A") || (strings.Contains(flags, "$") && strings.Contains(flags, "g"))
+}
+
+// watchValue reads key whenever a notification is received, or every interval if notifications is nil, and sends its
+// value to the returned channel whenever it changes. The cleanup function, if any, is called once the watch stops.
+func (client *baseClient) watchValue(
+	ctx context.Context,
+	key string,
+	interval time.Duration,
+	notifications <-chan struct{},
+	cleanup func(),
+) (<-chan models.Result[string], func()) {
+	// The ticker is created in the goroutine, where a panic on a non-positive interval would crash the process.
+	interval = watchInterval(interval)
+	values := make(chan models.Result[string])
+	stopped := make(chan struct{})
+	var stopOnce sync.Once
+	stop := func() { stopOnce.Do(func() { close(stopped) }) }
+
+	go func() {
+		defer close(values)
+		if cleanup != nil {
+			defer cleanup()
+		}
+		var poll <-chan time.Time
+		if notifications == nil {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			poll = ticker.C
+		}
+		var last *models.Result[string]
+		for {
+			value, err := client.Get(ctx, key)
+			var closingErr *ClosingError
+			if errors.As(err, &closingErr) {
+				return
+			}
+			if err == nil && (last == nil || *last != value) {
+				select {
+				case values <- value:
+					last = &value
+				case <-stopped:
+					return
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-poll:
+			case <-notifications:
+			case <-stopped:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return values, stop
+}
+
+// watchInterval returns interval, or DefaultWatchInterval if it is not positive.
+func watchInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return DefaultWatchInterval
+	}
+	return interval
+}
+
+// escapeGlobPattern escapes the characters of s which have a special meaning in glob-style patterns.
+func escapeGlobPattern(s string) string {
+	var builder strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			builder.WriteByte('\\')
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
@@ -0,0 +1,21 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package glide
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeGlobPattern(t *testing.T) {
+	assert.Equal(t, "key", escapeGlobPattern("key"))
+	assert.Equal(t, `user:\*:\?\[a\]\\`, escapeGlobPattern(`user:*:?[a]\`))
+}
+
+func TestWatchInterval(t *testing.T) {
+	assert.Equal(t, 50*time.Millisecond, watchInterval(50*time.Millisecond))
+	assert.Equal(t, DefaultWatchInterval, watchInterval(0))
+	assert.Equal(t, DefaultWatchInterval, watchInterval(-time.Second))
+}